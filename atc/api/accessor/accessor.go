@@ -30,6 +30,7 @@ type Claims struct {
 	PreferredUsername string
 	Email             string
 	Connector         string
+	APIToken          bool
 }
 
 type Verification struct {
@@ -40,7 +41,9 @@ type Verification struct {
 
 type access struct {
 	verification           Verification
+	action                 string
 	requiredRole           string
+	pipelineName           string
 	systemClaimKey         string
 	systemClaimValues      []string
 	teams                  []db.Team
@@ -51,7 +54,9 @@ type access struct {
 
 func NewAccessor(
 	verification Verification,
+	action string,
 	requiredRole string,
+	pipelineName string,
 	systemClaimKey string,
 	systemClaimValues []string,
 	teams []db.Team,
@@ -59,7 +64,9 @@ func NewAccessor(
 ) *access {
 	a := &access{
 		verification:           verification,
+		action:                 action,
 		requiredRole:           requiredRole,
+		pipelineName:           pipelineName,
 		systemClaimKey:         systemClaimKey,
 		systemClaimValues:      systemClaimValues,
 		teams:                  teams,
@@ -72,8 +79,20 @@ func NewAccessor(
 func (a *access) computeTeamRoles() {
 	a.teamRoles = map[string][]string{}
 
+	apiTokenTeam, apiTokenRole, isAPIToken := a.apiTokenGrant()
+
 	for _, team := range a.teams {
-		roles := a.rolesForTeam(team.Auth())
+		var roles []string
+		if isAPIToken {
+			// api tokens are limited to the role they were created with,
+			// regardless of how the team's auth is configured
+			if team.Name() == apiTokenTeam {
+				roles = []string{apiTokenRole}
+			}
+		} else {
+			roles = a.rolesForTeam(team.Auth())
+		}
+
 		if len(roles) > 0 {
 			a.teamRoles[team.Name()] = roles
 		}
@@ -149,13 +168,13 @@ func (a *access) IsAuthenticated() bool {
 }
 
 func (a *access) IsAuthorized(teamName string) bool {
-	return a.isAdmin || a.hasPermission(a.teamRoles[teamName])
+	return a.isAdmin || a.hasPermission(a.teamRoles[teamName]) || a.hasCustomPermission(teamName)
 }
 
 func (a *access) TeamNames() []string {
 	teamNames := []string{}
 	for _, team := range a.teams {
-		if a.isAdmin || a.hasPermission(a.teamRoles[team.Name()]) {
+		if a.IsAuthorized(team.Name()) {
 			teamNames = append(teamNames, team.Name())
 		}
 	}
@@ -163,6 +182,32 @@ func (a *access) TeamNames() []string {
 	return teamNames
 }
 
+// hasCustomPermission returns whether any of the custom roles the user has on
+// the team permit the action being performed, on the pipeline it is being
+// performed on.
+func (a *access) hasCustomPermission(teamName string) bool {
+	roles := a.teamRoles[teamName]
+	if len(roles) == 0 {
+		return false
+	}
+
+	for _, team := range a.teams {
+		if team.Name() != teamName {
+			continue
+		}
+
+		customRoles := team.CustomRoles()
+		for _, role := range roles {
+			customRole, found := customRoles.Lookup(role)
+			if found && customRole.Permits(a.action, a.pipelineName) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
 func (a *access) hasPermission(roles []string) bool {
 	allow := false
 	for _, role := range roles {
@@ -175,7 +220,13 @@ func (a *access) hasPermission(roles []string) bool {
 }
 
 func (a *access) hasRequiredRole(role string) bool {
-	switch a.requiredRole {
+	return RoleIncludes(role, a.requiredRole)
+}
+
+// RoleIncludes returns whether the built-in role grants everything the
+// required built-in role does.
+func RoleIncludes(role string, requiredRole string) bool {
+	switch requiredRole {
 	case OwnerRole:
 		return role == OwnerRole
 	case MemberRole:
@@ -223,6 +274,30 @@ func (a *access) claim(name string) string {
 	return ""
 }
 
+// apiTokenGrant returns the team and role granted by the API token the
+// request was authenticated with, if it was authenticated with one.
+func (a *access) apiTokenGrant() (string, string, bool) {
+	raw, ok := a.claims()[APITokenClaim]
+	if !ok {
+		return "", "", false
+	}
+
+	grant, ok := raw.(map[string]interface{})
+	if !ok {
+		return "", "", false
+	}
+
+	team, _ := grant["team"].(string)
+	role, _ := grant["role"].(string)
+
+	return team, role, true
+}
+
+func (a *access) isAPIToken() bool {
+	_, _, isAPIToken := a.apiTokenGrant()
+	return isAPIToken
+}
+
 func (a *access) userID() string {
 	return a.federatedClaim("user_id")
 }
@@ -280,6 +355,7 @@ func (a *access) Claims() Claims {
 		UserName:          a.claim("name"),
 		PreferredUsername: a.claim("preferred_username"),
 		Connector:         a.connectorID(),
+		APIToken:          a.isAPIToken(),
 	}
 }
 
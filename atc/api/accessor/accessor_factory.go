@@ -3,6 +3,7 @@ package accessor
 import (
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
@@ -42,12 +43,30 @@ type accessFactory struct {
 	displayUserIdGenerator atc.DisplayUserIdGenerator
 }
 
-func (a *accessFactory) Create(req *http.Request, role string) (Access, error) {
+func (a *accessFactory) Create(req *http.Request, action string, role string) (Access, error) {
 	teams, err := a.teamFetcher.GetTeams()
 	if err != nil {
 		return nil, fmt.Errorf("fetch teams: %w", err)
 	}
-	return NewAccessor(a.verifyToken(req), role, a.systemClaimKey, a.systemClaimValues, teams, a.displayUserIdGenerator), nil
+
+	// custom roles may be limited to certain pipelines, so note which one
+	// (if any) the action is being performed on
+	pipelineName := routeParam(req, action, "pipeline_name")
+
+	return NewAccessor(a.verifyToken(req), action, role, pipelineName, a.systemClaimKey, a.systemClaimValues, teams, a.displayUserIdGenerator), nil
+}
+
+// routeParam returns the value of a parameter declared in the path of the
+// action's route. The router only prepends route parameters to the query, so
+// on routes which do not declare it the caller could supply it themselves;
+// those are treated as not having the parameter at all.
+func routeParam(req *http.Request, action string, param string) string {
+	route, found := atc.Routes.FindRouteByName(action)
+	if !found || !strings.Contains(route.Path, "/:"+param) {
+		return ""
+	}
+
+	return req.URL.Query().Get(":" + param)
 }
 
 func (a *accessFactory) verifyToken(req *http.Request) Verification {
@@ -26,7 +26,8 @@ var _ = Describe("AccessorFactory", func() {
 
 		fakeDisplayUserIdGenerator *atcfakes.FakeDisplayUserIdGenerator
 
-		role string
+		action string
+		role   string
 	)
 
 	BeforeEach(func() {
@@ -39,6 +40,7 @@ var _ = Describe("AccessorFactory", func() {
 
 		fakeDisplayUserIdGenerator = new(atcfakes.FakeDisplayUserIdGenerator)
 
+		action = atc.GetPipeline
 		role = "viewer"
 	})
 
@@ -51,7 +53,7 @@ var _ = Describe("AccessorFactory", func() {
 
 		JustBeforeEach(func() {
 			factory := accessor.NewAccessFactory(fakeTokenVerifier, fakeTeamFetcher, systemClaimKey, systemClaimValues, fakeDisplayUserIdGenerator)
-			access, err = factory.Create(dummyRequest, role)
+			access, err = factory.Create(dummyRequest, action, role)
 		})
 
 		Context("when the token is valid", func() {
@@ -84,6 +86,60 @@ var _ = Describe("AccessorFactory", func() {
 			})
 		})
 
+		Context("when the user has a custom role limited to certain pipelines", func() {
+			BeforeEach(func() {
+				role = accessor.MemberRole
+
+				fakeTokenVerifier.VerifyReturns(map[string]interface{}{
+					"preferred_username": "user1",
+					"federated_claims": map[string]interface{}{
+						"connector_id": "github",
+					},
+				}, nil)
+
+				team := new(dbfakes.FakeTeam)
+				team.NameReturns("t1")
+				team.AuthReturns(atc.TeamAuth{"releaser": map[string][]string{
+					"users": {"github:user1"},
+				}})
+				team.CustomRolesReturns(atc.CustomRoles{
+					{Name: "releaser", Permissions: []string{atc.GetPipeline, atc.CreateBuild}, Pipelines: []string{"release-*"}},
+				})
+				fakeTeamFetcher.GetTeamsReturns([]db.Team{team}, nil)
+			})
+
+			Context("when the request is for a matching pipeline", func() {
+				BeforeEach(func() {
+					dummyRequest, _ = http.NewRequest("GET", "/?:team_name=t1&:pipeline_name=release-1", nil)
+				})
+
+				It("is authorized", func() {
+					Expect(access.IsAuthorized("t1")).To(BeTrue())
+				})
+			})
+
+			Context("when the request is for another pipeline", func() {
+				BeforeEach(func() {
+					dummyRequest, _ = http.NewRequest("GET", "/?:team_name=t1&:pipeline_name=deploy", nil)
+				})
+
+				It("is not authorized", func() {
+					Expect(access.IsAuthorized("t1")).To(BeFalse())
+				})
+			})
+
+			Context("when the action's route has no pipeline but one is given in the query", func() {
+				BeforeEach(func() {
+					action = atc.CreateBuild
+					dummyRequest, _ = http.NewRequest("POST", "/?:team_name=t1&:pipeline_name=release-1", nil)
+				})
+
+				It("is not authorized", func() {
+					Expect(access.IsAuthorized("t1")).To(BeFalse())
+				})
+			})
+		})
+
 		Context("when the team fetcher returns an error", func() {
 			BeforeEach(func() {
 				fakeTeamFetcher.GetTeamsReturns(nil, errors.New("nope"))
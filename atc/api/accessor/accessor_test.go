@@ -15,7 +15,9 @@ import (
 var _ = Describe("Accessor", func() {
 	var (
 		verification accessor.Verification
+		action       string
 		requiredRole string
+		pipelineName string
 		teams        []db.Team
 		access       accessor.Access
 
@@ -35,6 +37,8 @@ var _ = Describe("Accessor", func() {
 		fakeTeam3.NameReturns("some-team-3")
 
 		verification = accessor.Verification{}
+		action = ""
+		pipelineName = ""
 
 		teams = []db.Team{fakeTeam1, fakeTeam2, fakeTeam3}
 
@@ -42,7 +46,7 @@ var _ = Describe("Accessor", func() {
 	})
 
 	JustBeforeEach(func() {
-		access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
+		access = accessor.NewAccessor(verification, action, requiredRole, pipelineName, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
 	})
 
 	Describe("HasToken", func() {
@@ -195,7 +199,7 @@ var _ = Describe("Accessor", func() {
 				},
 			})
 
-			access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
+			access = accessor.NewAccessor(verification, action, requiredRole, pipelineName, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
 			result := access.IsAuthorized("some-team")
 			Expect(expected).Should(Equal(result))
 		},
@@ -242,7 +246,7 @@ var _ = Describe("Accessor", func() {
 				},
 			})
 
-			access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
+			access = accessor.NewAccessor(verification, action, requiredRole, pipelineName, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
 			result := access.IsAuthorized("some-team")
 			Expect(expected).Should(Equal(result))
 		},
@@ -303,7 +307,7 @@ var _ = Describe("Accessor", func() {
 				})
 			}
 
-			access = accessor.NewAccessor(verification, requiredRole, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
+			access = accessor.NewAccessor(verification, action, requiredRole, pipelineName, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
 			result := access.IsAuthorized("some-team")
 			Expect(expected).Should(Equal(result))
 		},
@@ -326,6 +330,140 @@ var _ = Describe("Accessor", func() {
 		Entry("user is viewer and group is member attempting viewer action", "viewer", "viewer", "viewer", true),
 	)
 
+	DescribeTable("IsAuthorized for custom roles",
+		func(permittedAction string, pipelines []string, attemptedAction string, attemptedPipeline string, expected bool) {
+			verification.HasToken = true
+			verification.IsTokenValid = true
+			verification.RawClaims = map[string]interface{}{
+				"federated_claims": map[string]interface{}{
+					"connector_id": "some-connector",
+					"user_id":      "some-user-id",
+				},
+			}
+
+			fakeTeam1.NameReturns("some-team")
+			fakeTeam1.AuthReturns(atc.TeamAuth{
+				"releaser": map[string][]string{
+					"users": {"some-connector:some-user-id"},
+				},
+			})
+			fakeTeam1.CustomRolesReturns(atc.CustomRoles{
+				{
+					Name:        "releaser",
+					Permissions: []string{permittedAction},
+					Pipelines:   pipelines,
+				},
+			})
+
+			access = accessor.NewAccessor(verification, attemptedAction, accessor.MemberRole, attemptedPipeline, "sub", []string{"system"}, teams, fakeDisplayUserIdGenerator)
+			result := access.IsAuthorized("some-team")
+			Expect(expected).Should(Equal(result))
+		},
+
+		Entry("permitted action", atc.CreateJobBuild, nil, atc.CreateJobBuild, "some-pipeline", true),
+		Entry("other action", atc.CreateJobBuild, nil, atc.SaveConfig, "some-pipeline", false),
+		Entry("permitted action on matching pipeline", atc.CreateJobBuild, []string{"release-*"}, atc.CreateJobBuild, "release-1", true),
+		Entry("permitted action on other pipeline", atc.CreateJobBuild, []string{"release-*"}, atc.CreateJobBuild, "deploy", false),
+		Entry("permitted action on no pipeline when scoped", atc.CreateJobBuild, []string{"release-*"}, atc.CreateJobBuild, "", false),
+	)
+
+	Context("when the user has a custom role on another team", func() {
+		BeforeEach(func() {
+			verification.HasToken = true
+			verification.IsTokenValid = true
+			verification.RawClaims = map[string]interface{}{
+				"federated_claims": map[string]interface{}{
+					"connector_id": "some-connector",
+					"user_id":      "some-user-id",
+				},
+			}
+
+			fakeTeam1.AuthReturns(atc.TeamAuth{
+				"releaser": map[string][]string{
+					"users": {"some-connector:some-user-id"},
+				},
+			})
+			fakeTeam2.CustomRolesReturns(atc.CustomRoles{
+				{Name: "releaser", Permissions: []string{atc.CreateJobBuild}},
+			})
+
+			action = atc.CreateJobBuild
+			requiredRole = accessor.OperatorRole
+		})
+
+		It("does not use the other team's definition of the role", func() {
+			Expect(access.IsAuthorized("some-team-1")).To(BeFalse())
+		})
+	})
+
+	Context("when the request was authenticated with an api token", func() {
+		BeforeEach(func() {
+			verification.HasToken = true
+			verification.IsTokenValid = true
+			verification.RawClaims = map[string]interface{}{
+				"sub":  "api-token:42",
+				"name": "some-token",
+				"federated_claims": map[string]interface{}{
+					"connector_id": "api-token",
+					"user_id":      "some-token",
+				},
+				accessor.APITokenClaim: map[string]interface{}{
+					"team": "some-team-1",
+					"role": accessor.OperatorRole,
+				},
+			}
+
+			// allow-all-users on every team, which must not widen the
+			// token's grant
+			fakeTeam2.AuthReturns(atc.TeamAuth{accessor.OwnerRole: map[string][]string{}})
+			fakeTeam3.AuthReturns(atc.TeamAuth{accessor.OwnerRole: map[string][]string{}})
+			fakeTeam3.AdminReturns(true)
+		})
+
+		It("only has the token's role on the token's team", func() {
+			Expect(access.TeamRoles()).To(Equal(map[string][]string{
+				"some-team-1": {accessor.OperatorRole},
+			}))
+			Expect(access.IsAdmin()).To(BeFalse())
+		})
+
+		It("marks the claims as coming from an api token", func() {
+			Expect(access.Claims().APIToken).To(BeTrue())
+		})
+
+		Context("when the action requires the token's role", func() {
+			BeforeEach(func() {
+				requiredRole = accessor.OperatorRole
+			})
+
+			It("is authorized on the token's team only", func() {
+				Expect(access.IsAuthorized("some-team-1")).To(BeTrue())
+				Expect(access.IsAuthorized("some-team-2")).To(BeFalse())
+			})
+		})
+
+		Context("when the action requires a greater role", func() {
+			BeforeEach(func() {
+				requiredRole = accessor.MemberRole
+			})
+
+			It("is not authorized", func() {
+				Expect(access.IsAuthorized("some-team-1")).To(BeFalse())
+			})
+		})
+	})
+
+	DescribeTable("RoleIncludes",
+		func(role string, requiredRole string, expected bool) {
+			Expect(accessor.RoleIncludes(role, requiredRole)).To(Equal(expected))
+		},
+		Entry("owner includes viewer", accessor.OwnerRole, accessor.ViewerRole, true),
+		Entry("member includes operator", accessor.MemberRole, accessor.OperatorRole, true),
+		Entry("operator does not include member", accessor.OperatorRole, accessor.MemberRole, false),
+		Entry("viewer does not include owner", accessor.ViewerRole, accessor.OwnerRole, false),
+		Entry("custom roles include nothing", "releaser", accessor.ViewerRole, false),
+	)
+
 	Describe("TeamNames", func() {
 		var result []string
 
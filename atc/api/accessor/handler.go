@@ -7,6 +7,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/auditor"
+	"github.com/felixge/httpsnoop"
 )
 
 //counterfeiter:generate net/http.Handler
@@ -15,7 +16,7 @@ const accessorContextKey atc.ContextKey = "accessor"
 
 //counterfeiter:generate . AccessFactory
 type AccessFactory interface {
-	Create(req *http.Request, role string) (Access, error)
+	Create(req *http.Request, action string, role string) (Access, error)
 }
 
 func NewHandler(
@@ -51,7 +52,7 @@ func (h *accessorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		requiredRole = DefaultRoles[h.action]
 	}
 
-	acc, err := h.accessFactory.Create(r, requiredRole)
+	acc, err := h.accessFactory.Create(r, h.action, requiredRole)
 	if err != nil {
 		h.logger.Error("failed-to-construct-accessor", err)
 		w.WriteHeader(http.StatusInternalServerError)
@@ -62,8 +63,11 @@ func (h *accessorHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	ctx := context.WithValue(r.Context(), accessorContextKey, acc)
 
-	h.auditor.Audit(h.action, claims.UserName, r)
-	h.handler.ServeHTTP(w, r.WithContext(ctx))
+	responded := h.auditor.Audit(h.action, claims.UserName, r)
+
+	metrics := httpsnoop.CaptureMetrics(h.handler, w, r.WithContext(ctx))
+
+	responded(metrics.Code)
 }
 
 func GetAccessor(r *http.Request) Access {
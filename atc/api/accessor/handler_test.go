@@ -22,6 +22,7 @@ var _ = Describe("Handler", func() {
 		fakeAccess          *accessorfakes.FakeAccess
 		fakeAccessorFactory *accessorfakes.FakeAccessFactory
 		fakeAuditor         *auditorfakes.FakeAuditor
+		respondedWith       chan int
 
 		createAccessError error
 
@@ -39,6 +40,8 @@ var _ = Describe("Handler", func() {
 		fakeAccess = new(accessorfakes.FakeAccess)
 		fakeAccessorFactory = new(accessorfakes.FakeAccessFactory)
 		fakeAuditor = new(auditorfakes.FakeAuditor)
+		respondedWith = make(chan int, 1)
+		fakeAuditor.AuditReturns(func(status int) { respondedWith <- status })
 
 		action = "some-action"
 		customRoles = map[string]string{"some-action": "some-role"}
@@ -77,9 +80,15 @@ var _ = Describe("Handler", func() {
 
 				It("finds the role", func() {
 					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, role := fakeAccessorFactory.CreateArgsForCall(0)
+					_, _, role := fakeAccessorFactory.CreateArgsForCall(0)
 					Expect(role).To(Equal(accessor.MemberRole))
 				})
+
+				It("passes along the action for custom roles", func() {
+					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
+					_, action, _ := fakeAccessorFactory.CreateArgsForCall(0)
+					Expect(action).To(Equal(atc.SaveConfig))
+				})
 			})
 
 			Context("when the role has been customized", func() {
@@ -91,7 +100,7 @@ var _ = Describe("Handler", func() {
 
 				It("finds the role", func() {
 					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, role := fakeAccessorFactory.CreateArgsForCall(0)
+					_, _, role := fakeAccessorFactory.CreateArgsForCall(0)
 					Expect(role).To(Equal(accessor.ViewerRole))
 				})
 			})
@@ -109,7 +118,7 @@ var _ = Describe("Handler", func() {
 
 				It("sends a blank role (admin roles don't have defaults)", func() {
 					Expect(fakeAccessorFactory.CreateCallCount()).To(Equal(1))
-					_, role := fakeAccessorFactory.CreateArgsForCall(0)
+					_, _, role := fakeAccessorFactory.CreateArgsForCall(0)
 					Expect(role).To(BeEmpty())
 				})
 			})
@@ -131,6 +140,34 @@ var _ = Describe("Handler", func() {
 				Expect(action).To(Equal("some-action"))
 				Expect(userName).To(Equal("some-user"))
 				Expect(req).To(Equal(r))
+				Expect(respondedWith).To(Receive(Equal(http.StatusOK)))
+			})
+
+			Context("while the handler is running", func() {
+				var auditedBeforeHandling bool
+
+				BeforeEach(func() {
+					fakeHandler.ServeHTTPStub = func(w http.ResponseWriter, r *http.Request) {
+						auditedBeforeHandling = fakeAuditor.AuditCallCount() == 1
+					}
+				})
+
+				It("audits the event before invoking the handler", func() {
+					Expect(auditedBeforeHandling).To(BeTrue())
+				})
+			})
+
+			Context("when the handler responds with an error", func() {
+				BeforeEach(func() {
+					fakeHandler.ServeHTTPStub = func(w http.ResponseWriter, r *http.Request) {
+						w.WriteHeader(http.StatusForbidden)
+					}
+				})
+
+				It("sends the status it responded with", func() {
+					Expect(fakeAuditor.AuditCallCount()).To(Equal(1))
+					Expect(respondedWith).To(Receive(Equal(http.StatusForbidden)))
+				})
 			})
 
 			It("invokes the handler", func() {
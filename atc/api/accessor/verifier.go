@@ -2,11 +2,13 @@ package accessor
 
 import (
 	"errors"
+	"fmt"
 	"net/http"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"gopkg.in/square/go-jose.v2/jwt"
 )
@@ -23,9 +25,19 @@ type AccessTokenFetcher interface {
 	GetAccessToken(rawToken string) (db.AccessToken, bool, error)
 }
 
-func NewVerifier(accessTokenFetcher AccessTokenFetcher, audience []string) *verifier {
+//counterfeiter:generate . APITokenFetcher
+type APITokenFetcher interface {
+	FindAPIToken(value string) (atc.APIToken, bool, error)
+}
+
+// APITokenClaim is the claim identifying the team and role granted to
+// requests authenticated with an API token.
+const APITokenClaim = "api_token"
+
+func NewVerifier(accessTokenFetcher AccessTokenFetcher, apiTokenFetcher APITokenFetcher, audience []string) *verifier {
 	return &verifier{
 		accessTokenFetcher: accessTokenFetcher,
+		apiTokenFetcher:    apiTokenFetcher,
 		audience:           audience,
 	}
 }
@@ -33,6 +45,7 @@ func NewVerifier(accessTokenFetcher AccessTokenFetcher, audience []string) *veri
 type verifier struct {
 	sync.Mutex
 	accessTokenFetcher AccessTokenFetcher
+	apiTokenFetcher    APITokenFetcher
 	audience           []string
 }
 
@@ -48,6 +61,10 @@ func (v *verifier) Verify(r *http.Request) (map[string]interface{}, error) {
 		return nil, ErrVerificationInvalidToken
 	}
 
+	if strings.HasPrefix(parts[1], atc.APITokenPrefix) {
+		return v.verifyAPIToken(parts[1])
+	}
+
 	return v.verify(parts[1])
 }
 
@@ -74,3 +91,32 @@ func (v *verifier) verify(rawToken string) (map[string]interface{}, error) {
 
 	return nil, ErrVerificationInvalidAudience
 }
+
+// verifyAPIToken looks up the token every time, rather than caching it like
+// access tokens, so that revoking it takes effect immediately.
+func (v *verifier) verifyAPIToken(rawToken string) (map[string]interface{}, error) {
+	token, found, err := v.apiTokenFetcher.FindAPIToken(rawToken)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, ErrVerificationInvalidToken
+	}
+
+	if token.ExpiresAt != 0 && time.Now().Unix() >= token.ExpiresAt {
+		return nil, ErrVerificationTokenExpired
+	}
+
+	return map[string]interface{}{
+		"sub":  fmt.Sprintf("api-token:%d", token.ID),
+		"name": token.Name,
+		"federated_claims": map[string]interface{}{
+			"connector_id": "api-token",
+			"user_id":      token.Name,
+		},
+		APITokenClaim: map[string]interface{}{
+			"team": token.TeamName,
+			"role": token.Role,
+		},
+	}, nil
+}
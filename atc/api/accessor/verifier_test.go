@@ -5,6 +5,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/db"
 	. "github.com/onsi/ginkgo"
@@ -18,6 +19,7 @@ var _ = Describe("Verifier", func() {
 	var (
 		accessTokenFetcher *accessorfakes.FakeAccessTokenFetcher
 		accessToken        db.AccessToken
+		apiTokenFetcher    *accessorfakes.FakeAPITokenFetcher
 
 		req *http.Request
 
@@ -32,16 +34,19 @@ var _ = Describe("Verifier", func() {
 			return accessToken, true, nil
 		})
 
+		apiTokenFetcher = new(accessorfakes.FakeAPITokenFetcher)
+
 		req, _ = http.NewRequest("GET", "localhost:8080", nil)
 		req.Header.Set("Authorization", "bearer 1234567890")
 
-		verifier = accessor.NewVerifier(accessTokenFetcher, []string{"some-aud"})
+		verifier = accessor.NewVerifier(accessTokenFetcher, apiTokenFetcher, []string{"some-aud"})
 	})
 
 	Describe("Verify", func() {
+		var claims map[string]interface{}
 
 		JustBeforeEach(func() {
-			_, err = verifier.Verify(req)
+			claims, err = verifier.Verify(req)
 		})
 
 		Context("when request has no token", func() {
@@ -140,5 +145,86 @@ var _ = Describe("Verifier", func() {
 				Expect(err).ToNot(HaveOccurred())
 			})
 		})
+
+		Context("when the request has an api token", func() {
+			var apiToken atc.APIToken
+
+			BeforeEach(func() {
+				req.Header.Set("Authorization", "bearer "+atc.APITokenPrefix+"some-value")
+
+				apiToken = atc.APIToken{
+					ID:       42,
+					Name:     "some-token",
+					TeamName: "some-team",
+					Role:     "member",
+				}
+
+				apiTokenFetcher.FindAPITokenCalls(func(string) (atc.APIToken, bool, error) {
+					return apiToken, true, nil
+				})
+			})
+
+			It("looks it up by its value", func() {
+				Expect(apiTokenFetcher.FindAPITokenCallCount()).To(Equal(1))
+				Expect(apiTokenFetcher.FindAPITokenArgsForCall(0)).To(Equal(atc.APITokenPrefix + "some-value"))
+				Expect(accessTokenFetcher.GetAccessTokenCallCount()).To(BeZero())
+			})
+
+			It("returns claims granting the token's role on its team", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(claims).To(Equal(map[string]interface{}{
+					"sub":  "api-token:42",
+					"name": "some-token",
+					"federated_claims": map[string]interface{}{
+						"connector_id": "api-token",
+						"user_id":      "some-token",
+					},
+					accessor.APITokenClaim: map[string]interface{}{
+						"team": "some-team",
+						"role": "member",
+					},
+				}))
+			})
+
+			Context("when the token has not expired yet", func() {
+				BeforeEach(func() {
+					apiToken.ExpiresAt = time.Now().Add(time.Hour).Unix()
+				})
+
+				It("succeeds", func() {
+					Expect(err).ToNot(HaveOccurred())
+				})
+			})
+
+			Context("when the token has expired", func() {
+				BeforeEach(func() {
+					apiToken.ExpiresAt = time.Now().Add(-time.Hour).Unix()
+				})
+
+				It("fails verification", func() {
+					Expect(err).To(Equal(accessor.ErrVerificationTokenExpired))
+				})
+			})
+
+			Context("when the token is not found", func() {
+				BeforeEach(func() {
+					apiTokenFetcher.FindAPITokenReturns(atc.APIToken{}, false, nil)
+				})
+
+				It("fails verification", func() {
+					Expect(err).To(Equal(accessor.ErrVerificationInvalidToken))
+				})
+			})
+
+			Context("when looking up the token errors", func() {
+				BeforeEach(func() {
+					apiTokenFetcher.FindAPITokenReturns(atc.APIToken{}, false, errors.New("db error"))
+				})
+
+				It("errors", func() {
+					Expect(err).To(MatchError("db error"))
+				})
+			})
+		})
 	})
 })
@@ -22,12 +22,14 @@ import (
 	"github.com/concourse/concourse/atc/api/containerserver/containerserverfakes"
 	"github.com/concourse/concourse/atc/api/policychecker/policycheckerfakes"
 	"github.com/concourse/concourse/atc/auditor/auditorfakes"
+	"github.com/concourse/concourse/atc/blobstore/blobstorefakes"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/creds/credsfakes"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/gc/gcfakes"
 	"github.com/concourse/concourse/atc/policy"
+	"github.com/concourse/concourse/atc/scheduler/schedulerfakes"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 	"github.com/concourse/concourse/atc/wrappa"
 
@@ -59,9 +61,14 @@ var (
 	build                   *dbfakes.FakeBuild
 	dbBuildFactory          *dbfakes.FakeBuildFactory
 	dbUserFactory           *dbfakes.FakeUserFactory
+	dbArchivedArtifacts     *dbfakes.FakeArchivedArtifactRepository
+	dbAuditEvents           *dbfakes.FakeAuditEventRepository
+	dbAPITokens             *dbfakes.FakeAPITokenRepository
+	fakeArtifactStore       *blobstorefakes.FakeStore
 	dbCheckFactory          *dbfakes.FakeCheckFactory
 	dbTeam                  *dbfakes.FakeTeam
 	dbWall                  *dbfakes.FakeWall
+	fakeAlgorithm           *schedulerfakes.FakeAlgorithm
 	fakeSecretManager       *credsfakes.FakeSecrets
 	fakeVarSourcePool       *credsfakes.FakeVarSourcePool
 	fakePolicyChecker       *policycheckerfakes.FakePolicyChecker
@@ -108,8 +115,13 @@ var _ = BeforeEach(func() {
 	dbResourceConfigFactory = new(dbfakes.FakeResourceConfigFactory)
 	dbBuildFactory = new(dbfakes.FakeBuildFactory)
 	dbUserFactory = new(dbfakes.FakeUserFactory)
+	dbArchivedArtifacts = new(dbfakes.FakeArchivedArtifactRepository)
+	dbAuditEvents = new(dbfakes.FakeAuditEventRepository)
+	dbAPITokens = new(dbfakes.FakeAPITokenRepository)
+	fakeArtifactStore = new(blobstorefakes.FakeStore)
 	dbCheckFactory = new(dbfakes.FakeCheckFactory)
 	dbWall = new(dbfakes.FakeWall)
+	fakeAlgorithm = new(schedulerfakes.FakeAlgorithm)
 
 	interceptTimeoutFactory = new(containerserverfakes.FakeInterceptTimeoutFactory)
 	interceptTimeout = new(containerserverfakes.FakeInterceptTimeout)
@@ -200,6 +212,12 @@ var _ = BeforeEach(func() {
 		dbCheckFactory,
 		dbResourceConfigFactory,
 		dbUserFactory,
+		dbArchivedArtifacts,
+		dbAuditEvents,
+		dbAPITokens,
+
+		fakeAlgorithm,
+		fakeArtifactStore,
 
 		constructedEventHandler.Construct,
 
@@ -230,7 +248,7 @@ var _ = BeforeEach(func() {
 		"some-action",
 		handler,
 		fakeAccessor,
-		new(auditorfakes.FakeAuditor),
+		newFakeAuditor(),
 		map[string]string{},
 	)
 
@@ -255,3 +273,9 @@ func TestAPI(t *testing.T) {
 	RegisterFailHandler(Fail)
 	RunSpecs(t, "API Suite")
 }
+
+func newFakeAuditor() *auditorfakes.FakeAuditor {
+	fakeAuditor := new(auditorfakes.FakeAuditor)
+	fakeAuditor.AuditReturns(func(int) {})
+	return fakeAuditor
+}
@@ -0,0 +1,388 @@
+package api_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("API Tokens API", func() {
+	var (
+		response *http.Response
+		fakeTeam *dbfakes.FakeTeam
+	)
+
+	BeforeEach(func() {
+		fakeTeam = new(dbfakes.FakeTeam)
+		fakeTeam.IDReturns(1)
+		fakeTeam.NameReturns("some-team")
+		dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+	})
+
+	Describe("GET /api/v1/teams/:team_name/tokens", func() {
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/tokens")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the team has tokens", func() {
+				BeforeEach(func() {
+					dbAPITokens.APITokensReturns([]atc.APIToken{
+						{
+							ID:        42,
+							Name:      "some-token",
+							TeamName:  "some-team",
+							Role:      "member",
+							CreatedBy: "some-user",
+							CreatedAt: 100,
+							ExpiresAt: 200,
+						},
+					}, nil)
+				})
+
+				It("returns them", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response).To(IncludeHeaderEntries(map[string]string{
+						"Content-Type": "application/json",
+					}))
+
+					Expect(dbAPITokens.APITokensArgsForCall(0)).To(Equal(1))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`[
+						{
+							"id": 42,
+							"name": "some-token",
+							"team_name": "some-team",
+							"role": "member",
+							"created_by": "some-user",
+							"created_at": 100,
+							"expires_at": 200
+						}
+					]`))
+				})
+			})
+
+			Context("when getting the tokens fails", func() {
+				BeforeEach(func() {
+					dbAPITokens.APITokensReturns(nil, errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("POST /api/v1/teams/:team_name/tokens", func() {
+		var body string
+
+		BeforeEach(func() {
+			body = `{"name": "some-token", "role": "member"}`
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Post(server.URL+"/api/v1/teams/some-team/tokens", "application/json", bytes.NewBufferString(body))
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbAPITokens.CreateAPITokenCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				fakeAccess.TeamRolesReturns(map[string][]string{"some-team": {"owner"}})
+				fakeAccess.UserInfoReturns(atc.UserInfo{DisplayUserId: "some-user"})
+
+				dbAPITokens.CreateAPITokenReturns(atc.APIToken{
+					ID:        42,
+					Name:      "some-token",
+					TeamName:  "some-team",
+					Role:      "member",
+					CreatedBy: "some-user",
+					CreatedAt: 100,
+					Token:     atc.APITokenPrefix + "some-value",
+				}, nil)
+			})
+
+			It("creates the token on behalf of the user", func() {
+				Expect(dbAPITokens.CreateAPITokenCallCount()).To(Equal(1))
+
+				teamID, token := dbAPITokens.CreateAPITokenArgsForCall(0)
+				Expect(teamID).To(Equal(1))
+				Expect(token).To(Equal(atc.APIToken{
+					Name:      "some-token",
+					Role:      "member",
+					CreatedBy: "some-user",
+				}))
+			})
+
+			It("returns the token along with its value", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusCreated))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON(`{
+					"id": 42,
+					"name": "some-token",
+					"team_name": "some-team",
+					"role": "member",
+					"created_by": "some-user",
+					"created_at": 100,
+					"token": "concourse_some-value"
+				}`))
+			})
+
+			Context("when the user is authenticated with an api token", func() {
+				BeforeEach(func() {
+					fakeAccess.ClaimsReturns(accessor.Claims{APIToken: true})
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(dbAPITokens.CreateAPITokenCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the token has an expiry", func() {
+				var expiresAt int64
+
+				BeforeEach(func() {
+					expiresAt = time.Now().Add(time.Hour).Unix()
+					body = `{"name": "some-token", "role": "member", "expires_at": ` + strconv.FormatInt(expiresAt, 10) + `}`
+				})
+
+				It("creates the token with it", func() {
+					_, token := dbAPITokens.CreateAPITokenArgsForCall(0)
+					Expect(token.ExpiresAt).To(Equal(expiresAt))
+				})
+			})
+
+			Context("when the expiry has already passed", func() {
+				BeforeEach(func() {
+					body = `{"name": "some-token", "role": "member", "expires_at": 1}`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbAPITokens.CreateAPITokenCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the name is missing", func() {
+				BeforeEach(func() {
+					body = `{"role": "member"}`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when the role does not exist", func() {
+				BeforeEach(func() {
+					body = `{"name": "some-token", "role": "bogus"}`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					reason, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(reason)).To(ContainSubstring("unknown role 'bogus'"))
+				})
+			})
+
+			Context("when the role is one of the team's custom roles", func() {
+				BeforeEach(func() {
+					body = `{"name": "some-token", "role": "releaser"}`
+					fakeTeam.CustomRolesReturns(atc.CustomRoles{
+						{Name: "releaser", Permissions: []string{atc.CreateJobBuild}},
+					})
+				})
+
+				It("creates the token", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusCreated))
+				})
+
+				Context("when the user is not an owner of the team", func() {
+					BeforeEach(func() {
+						fakeAccess.TeamRolesReturns(map[string][]string{"some-team": {"member"}})
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+						Expect(dbAPITokens.CreateAPITokenCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when the role is greater than the user's own", func() {
+				BeforeEach(func() {
+					fakeAccess.TeamRolesReturns(map[string][]string{"some-team": {"pipeline-operator"}})
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(dbAPITokens.CreateAPITokenCallCount()).To(BeZero())
+				})
+
+				Context("when the user is an admin", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAdminReturns(true)
+					})
+
+					It("creates the token", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusCreated))
+					})
+				})
+			})
+
+			Context("when a token with the same name exists", func() {
+				BeforeEach(func() {
+					dbAPITokens.CreateAPITokenReturns(atc.APIToken{}, db.ErrAPITokenAlreadyExists)
+				})
+
+				It("returns 409", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusConflict))
+				})
+			})
+
+			Context("when creating the token fails", func() {
+				BeforeEach(func() {
+					dbAPITokens.CreateAPITokenReturns(atc.APIToken{}, errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the request is malformed", func() {
+				BeforeEach(func() {
+					body = `{`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/tokens/:token_name", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/some-team/tokens/some-token", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the token exists", func() {
+				BeforeEach(func() {
+					dbAPITokens.RevokeAPITokenReturns(true, nil)
+				})
+
+				It("revokes it", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+					teamID, name := dbAPITokens.RevokeAPITokenArgsForCall(0)
+					Expect(teamID).To(Equal(1))
+					Expect(name).To(Equal("some-token"))
+				})
+			})
+
+			Context("when the user is authenticated with an api token", func() {
+				BeforeEach(func() {
+					fakeAccess.ClaimsReturns(accessor.Claims{APIToken: true})
+				})
+
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(dbAPITokens.RevokeAPITokenCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the token does not exist", func() {
+				BeforeEach(func() {
+					dbAPITokens.RevokeAPITokenReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when revoking the token fails", func() {
+				BeforeEach(func() {
+					dbAPITokens.RevokeAPITokenReturns(false, errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+})
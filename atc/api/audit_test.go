@@ -0,0 +1,151 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Audit API", func() {
+	var (
+		response *http.Response
+		query    url.Values
+	)
+
+	Describe("GET /api/v1/audit", func() {
+		BeforeEach(func() {
+			query = url.Values{}
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("GET", server.URL+"/api/v1/audit", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			req.URL.RawQuery = query.Encode()
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(dbAuditEvents.EventsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbAuditEvents.EventsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+
+				dbAuditEvents.EventsReturns([]atc.AuditEvent{
+					{
+						ID:         1,
+						Time:       1625961600,
+						User:       "some-user",
+						Team:       "some-team",
+						Action:     atc.SaveConfig,
+						Target:     "/api/v1/teams/some-team/pipelines/some-pipeline/config",
+						Parameters: map[string][]string{"check_creds": {"true"}},
+						Status:     http.StatusOK,
+					},
+				}, nil)
+			})
+
+			It("returns the events", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response).To(IncludeHeaderEntries(map[string]string{
+					"Content-Type": "application/json",
+				}))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON(`[
+					{
+						"id": 1,
+						"time": 1625961600,
+						"user": "some-user",
+						"team": "some-team",
+						"action": "SaveConfig",
+						"target": "/api/v1/teams/some-team/pipelines/some-pipeline/config",
+						"parameters": {"check_creds": ["true"]},
+						"status": 200
+					}
+				]`))
+			})
+
+			It("returns the default number of events", func() {
+				Expect(dbAuditEvents.EventsArgsForCall(0)).To(Equal(db.AuditEventFilter{
+					Limit: atc.PaginationAPIDefaultLimit,
+				}))
+			})
+
+			Context("when filters are given", func() {
+				BeforeEach(func() {
+					query.Set("team", "some-team")
+					query.Set("user", "some-user")
+					query.Set("action", atc.SaveConfig)
+					query.Set("since", "1625961600")
+					query.Set("limit", "10")
+				})
+
+				It("filters the events", func() {
+					Expect(dbAuditEvents.EventsArgsForCall(0)).To(Equal(db.AuditEventFilter{
+						Team:   "some-team",
+						User:   "some-user",
+						Action: atc.SaveConfig,
+						Since:  time.Unix(1625961600, 0),
+						Limit:  10,
+					}))
+				})
+			})
+
+			Context("when since is not a timestamp", func() {
+				BeforeEach(func() {
+					query.Set("since", "yesterday")
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbAuditEvents.EventsCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when getting the events fails", func() {
+				BeforeEach(func() {
+					dbAuditEvents.EventsReturns(nil, errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+})
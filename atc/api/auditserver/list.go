@@ -0,0 +1,51 @@
+package auditserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListAuditEvents(w http.ResponseWriter, r *http.Request) {
+	hLog := s.logger.Session("list-audit-events")
+
+	filter := db.AuditEventFilter{
+		Team:   r.FormValue("team"),
+		User:   r.FormValue("user"),
+		Action: r.FormValue("action"),
+	}
+
+	filter.Limit, _ = strconv.Atoi(r.FormValue(atc.PaginationQueryLimit))
+	if filter.Limit <= 0 {
+		filter.Limit = atc.PaginationAPIDefaultLimit
+	}
+
+	if since := r.FormValue("since"); since != "" {
+		unix, err := strconv.ParseInt(since, 10, 64)
+		if err != nil {
+			http.Error(w, "since must be a unix timestamp", http.StatusBadRequest)
+			return
+		}
+
+		filter.Since = time.Unix(unix, 0)
+	}
+
+	events, err := s.auditEvents.Events(filter)
+	if err != nil {
+		hLog.Error("failed-to-get-audit-events", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+
+	err = json.NewEncoder(w).Encode(events)
+	if err != nil {
+		hLog.Error("failed-to-encode-audit-events", err)
+		w.WriteHeader(http.StatusInternalServerError)
+	}
+}
@@ -0,0 +1,21 @@
+package auditserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger      lager.Logger
+	auditEvents db.AuditEventRepository
+}
+
+func NewServer(
+	logger lager.Logger,
+	auditEvents db.AuditEventRepository,
+) *Server {
+	return &Server{
+		logger:      logger,
+		auditEvents: auditEvents,
+	}
+}
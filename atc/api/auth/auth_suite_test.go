@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/auditor/auditorfakes"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 )
@@ -18,3 +19,9 @@ var logger lager.Logger
 var _ = BeforeEach(func() {
 	logger = lager.NewLogger("test")
 })
+
+func newFakeAuditor() *auditorfakes.FakeAuditor {
+	fakeAuditor := new(auditorfakes.FakeAuditor)
+	fakeAuditor.AuditReturns(func(int) {})
+	return fakeAuditor
+}
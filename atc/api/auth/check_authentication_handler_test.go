@@ -11,7 +11,6 @@ import (
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/api/auth/authfakes"
-	"github.com/concourse/concourse/atc/auditor/auditorfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -71,7 +70,7 @@ var _ = Describe("AuthenticationHandler", func() {
 				"some-action",
 				innerHandler,
 				fakeAccessor,
-				new(auditorfakes.FakeAuditor),
+				newFakeAuditor(),
 				map[string]string{},
 			))
 		})
@@ -129,7 +128,7 @@ var _ = Describe("AuthenticationHandler", func() {
 				"some-action",
 				innerHandler,
 				fakeAccessor,
-				new(auditorfakes.FakeAuditor),
+				newFakeAuditor(),
 				map[string]string{},
 			))
 		})
@@ -12,7 +12,6 @@ import (
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/api/auth/authfakes"
-	"github.com/concourse/concourse/atc/auditor/auditorfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -58,7 +57,7 @@ var _ = Describe("CheckAuthorizationHandler", func() {
 			"some-action",
 			innerHandler,
 			fakeAccessor,
-			new(auditorfakes.FakeAuditor),
+			newFakeAuditor(),
 			map[string]string{},
 		))
 
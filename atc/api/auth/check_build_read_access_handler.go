@@ -79,7 +79,7 @@ func (h checkBuildReadAccessHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 
 	acc := accessor.GetAccessor(r)
 
-	allow, err := h.allow(build, acc)
+	allow, err := CanReadBuild(build, acc, h.allowPrivateJob)
 	if err != nil {
 		if err == errDisappeared {
 			w.WriteHeader(http.StatusNotFound)
@@ -103,7 +103,11 @@ func (h checkBuildReadAccessHandler) ServeHTTP(w http.ResponseWriter, r *http.Re
 // above
 var errDisappeared = errors.New("internal: build parent disappeared")
 
-func (h checkBuildReadAccessHandler) allow(build db.Build, acc accessor.Access) (bool, error) {
+// CanReadBuild returns whether the build may be read by acc, either because
+// it belongs to one of acc's teams or because its pipeline is public. Unless
+// allowPrivateJob is set, builds of private jobs within public pipelines may
+// only be read by their team.
+func CanReadBuild(build db.Build, acc accessor.Access, allowPrivateJob bool) (bool, error) {
 	if acc.IsAuthenticated() && acc.IsAuthorized(build.TeamName()) {
 		return true, nil
 	}
@@ -125,7 +129,7 @@ func (h checkBuildReadAccessHandler) allow(build db.Build, acc accessor.Access)
 		return false, nil
 	}
 
-	if h.allowPrivateJob {
+	if allowPrivateJob {
 		return true, nil
 	}
 
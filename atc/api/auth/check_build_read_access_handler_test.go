@@ -9,7 +9,6 @@ import (
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/auth"
-	"github.com/concourse/concourse/atc/auditor/auditorfakes"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	. "github.com/onsi/ginkgo"
@@ -113,7 +112,7 @@ var _ = Describe("CheckBuildReadAccessHandler", func() {
 				"some-action",
 				innerHandler,
 				fakeAccessor,
-				new(auditorfakes.FakeAuditor),
+				newFakeAuditor(),
 				map[string]string{},
 			)
 		})
@@ -245,7 +244,7 @@ var _ = Describe("CheckBuildReadAccessHandler", func() {
 				"some-action",
 				innerHandler,
 				fakeAccessor,
-				new(auditorfakes.FakeAuditor),
+				newFakeAuditor(),
 				map[string]string{},
 			)
 		})
@@ -8,7 +8,6 @@ import (
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/auth"
-	"github.com/concourse/concourse/atc/auditor/auditorfakes"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 
 	. "github.com/onsi/ginkgo"
@@ -50,7 +49,7 @@ var _ = Describe("CheckBuildWriteAccessHandler", func() {
 			"some-action",
 			innerHandler,
 			fakeAccessor,
-			new(auditorfakes.FakeAuditor),
+			newFakeAuditor(),
 			map[string]string{},
 		)
 	})
@@ -8,7 +8,6 @@ import (
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/auth"
-	"github.com/concourse/concourse/atc/auditor/auditorfakes"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 
@@ -49,7 +48,7 @@ var _ = Describe("CheckPipelineAccessHandler", func() {
 			"some-action",
 			innerHandler,
 			fakeAccessor,
-			new(auditorfakes.FakeAuditor),
+			newFakeAuditor(),
 			map[string]string{},
 		)
 	})
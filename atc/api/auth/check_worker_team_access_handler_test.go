@@ -9,7 +9,6 @@ import (
 	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/api/accessor/accessorfakes"
 	"github.com/concourse/concourse/atc/api/auth"
-	"github.com/concourse/concourse/atc/auditor/auditorfakes"
 	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/tedsuo/rata"
 
@@ -45,7 +44,7 @@ var _ = Describe("CheckWorkerTeamAccessHandler", func() {
 			"some-action",
 			innerHandler,
 			fakeAccessor,
-			new(auditorfakes.FakeAuditor),
+			newFakeAuditor(),
 			map[string]string{},
 		)
 	})
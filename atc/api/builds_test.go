@@ -7,13 +7,18 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/blobstore"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/event"
 	. "github.com/concourse/concourse/atc/testhelpers"
 	. "github.com/onsi/ginkgo"
+	"github.com/gorilla/websocket"
 	. "github.com/onsi/gomega"
 )
 
@@ -1018,6 +1023,263 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/builds/events", func() {
+		var (
+			conn *websocket.Conn
+
+			fakeEventSource *dbfakes.FakeEventSource
+		)
+
+		envelope := func(status atc.BuildStatus) event.Envelope {
+			payload, err := json.Marshal(event.Status{Status: status})
+			Expect(err).NotTo(HaveOccurred())
+
+			return event.Envelope{
+				Data:    (*json.RawMessage)(&payload),
+				Event:   event.EventTypeStatus,
+				Version: event.Status{}.Version(),
+			}
+		}
+
+		subscribe := func(buildID int, cursor *uint) {
+			Expect(conn.WriteJSON(event.StreamRequest{
+				Action:  event.StreamSubscribe,
+				BuildID: buildID,
+				Cursor:  cursor,
+			})).To(Succeed())
+		}
+
+		receive := func() event.StreamMessage {
+			var msg event.StreamMessage
+			Expect(conn.SetReadDeadline(time.Now().Add(5 * time.Second))).To(Succeed())
+			Expect(conn.ReadJSON(&msg)).To(Succeed())
+			return msg
+		}
+
+		BeforeEach(func() {
+			fakeEventSource = new(dbfakes.FakeEventSource)
+			fakeEventSource.NextReturnsOnCall(0, envelope(atc.StatusStarted), nil)
+			fakeEventSource.NextReturnsOnCall(1, envelope(atc.StatusSucceeded), nil)
+			fakeEventSource.NextReturns(event.Envelope{}, db.ErrEndOfBuildEventStream)
+
+			build.IDReturns(128)
+			build.TeamNameReturns("some-team")
+			build.EventsReturns(fakeEventSource, nil)
+			dbBuildFactory.BuildReturns(build, true, nil)
+
+			fakeAccess.IsAuthenticatedReturns(true)
+			fakeAccess.IsAuthorizedReturns(true)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			conn, _, err = websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http")+"/api/v1/builds/events", nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			_ = conn.Close()
+		})
+
+		It("streams the events of the subscribed build, followed by the end", func() {
+			subscribe(128, nil)
+
+			msg := receive()
+			Expect(msg.Type).To(Equal(event.StreamEvent))
+			Expect(msg.BuildID).To(Equal(128))
+			Expect(msg.ID).To(Equal(uint(0)))
+
+			var message event.Message
+			Expect(json.Unmarshal(msg.Event, &message)).To(Succeed())
+			Expect(message.Event).To(Equal(event.Status{Status: atc.StatusStarted}))
+
+			msg = receive()
+			Expect(msg.Type).To(Equal(event.StreamEvent))
+			Expect(msg.ID).To(Equal(uint(1)))
+
+			msg = receive()
+			Expect(msg).To(Equal(event.StreamMessage{
+				Type:    event.StreamEnd,
+				BuildID: 128,
+				ID:      2,
+			}))
+
+			Expect(dbBuildFactory.BuildArgsForCall(0)).To(Equal(128))
+			_, from := build.EventsArgsForCall(0)
+			Expect(from).To(Equal(uint(0)))
+			Eventually(fakeEventSource.CloseCallCount).Should(Equal(1))
+		})
+
+		It("resumes from after the cursor", func() {
+			cursor := uint(41)
+			subscribe(128, &cursor)
+
+			msg := receive()
+			Expect(msg.ID).To(Equal(uint(42)))
+
+			_, from := build.EventsArgsForCall(0)
+			Expect(from).To(Equal(uint(42)))
+		})
+
+		Context("when subscribed to several builds", func() {
+			var otherBuild *dbfakes.FakeBuild
+
+			BeforeEach(func() {
+				otherEvents := new(dbfakes.FakeEventSource)
+				otherEvents.NextReturns(event.Envelope{}, db.ErrEndOfBuildEventStream)
+
+				otherBuild = new(dbfakes.FakeBuild)
+				otherBuild.IDReturns(129)
+				otherBuild.TeamNameReturns("some-team")
+				otherBuild.EventsReturns(otherEvents, nil)
+
+				dbBuildFactory.BuildStub = func(id int) (db.Build, bool, error) {
+					if id == 129 {
+						return otherBuild, true, nil
+					}
+
+					return build, true, nil
+				}
+			})
+
+			It("multiplexes their events", func() {
+				subscribe(128, nil)
+				subscribe(129, nil)
+
+				ends := map[int]uint{}
+				for len(ends) < 2 {
+					msg := receive()
+					if msg.Type == event.StreamEnd {
+						ends[msg.BuildID] = msg.ID
+					}
+				}
+
+				Expect(ends).To(Equal(map[int]uint{128: 2, 129: 0}))
+			})
+		})
+
+		Context("when the build's events are still being written", func() {
+			BeforeEach(func() {
+				closed := make(chan struct{})
+
+				fakeEventSource.NextStub = func() (event.Envelope, error) {
+					<-closed
+					return event.Envelope{}, db.ErrBuildEventStreamClosed
+				}
+
+				fakeEventSource.CloseStub = func() error {
+					close(closed)
+					return nil
+				}
+			})
+
+			It("stops following the build when unsubscribed", func() {
+				subscribe(128, nil)
+
+				Eventually(fakeEventSource.NextCallCount).Should(Equal(1))
+
+				Expect(conn.WriteJSON(event.StreamRequest{
+					Action:  event.StreamUnsubscribe,
+					BuildID: 128,
+				})).To(Succeed())
+
+				Eventually(fakeEventSource.CloseCallCount).Should(Equal(1))
+			})
+
+			It("refuses to subscribe to the same build twice", func() {
+				subscribe(128, nil)
+				subscribe(128, nil)
+
+				Expect(receive()).To(Equal(event.StreamMessage{
+					Type:    event.StreamError,
+					BuildID: 128,
+					Error:   "already subscribed",
+				}))
+			})
+
+			It("stops following the build when the connection is closed", func() {
+				subscribe(128, nil)
+
+				Eventually(fakeEventSource.NextCallCount).Should(Equal(1))
+
+				Expect(conn.Close()).To(Succeed())
+
+				Eventually(fakeEventSource.CloseCallCount).Should(Equal(1))
+			})
+		})
+
+		Context("when not authorized to read the build", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("sends an error for the build", func() {
+				subscribe(128, nil)
+
+				Expect(receive()).To(Equal(event.StreamMessage{
+					Type:    event.StreamError,
+					BuildID: 128,
+					Error:   "not authorized",
+				}))
+
+				Expect(build.EventsCallCount()).To(BeZero())
+			})
+
+			Context("when the build's job is public", func() {
+				BeforeEach(func() {
+					fakeJob := new(dbfakes.FakeJob)
+					fakeJob.PublicReturns(true)
+
+					fakePipeline.PublicReturns(true)
+					fakePipeline.JobReturns(fakeJob, true, nil)
+
+					build.PipelineIDReturns(42)
+					build.PipelineReturns(fakePipeline, true, nil)
+					build.JobIDReturns(42)
+					build.JobNameReturns("job1")
+				})
+
+				It("streams its events", func() {
+					subscribe(128, nil)
+
+					Expect(receive().Type).To(Equal(event.StreamEvent))
+				})
+			})
+		})
+
+		Context("when the build can not be found", func() {
+			BeforeEach(func() {
+				dbBuildFactory.BuildReturns(nil, false, nil)
+			})
+
+			It("sends an error for the build", func() {
+				subscribe(128, nil)
+
+				Expect(receive()).To(Equal(event.StreamMessage{
+					Type:    event.StreamError,
+					BuildID: 128,
+					Error:   "build not found",
+				}))
+			})
+		})
+
+		Context("when the request is not understood", func() {
+			It("sends an error and keeps the connection open", func() {
+				Expect(conn.WriteJSON(map[string]interface{}{"action": "bogus", "build_id": 128})).To(Succeed())
+
+				Expect(receive()).To(Equal(event.StreamMessage{
+					Type:    event.StreamError,
+					BuildID: 128,
+					Error:   "unknown action 'bogus'",
+				}))
+
+				subscribe(128, nil)
+
+				Expect(receive().Type).To(Equal(event.StreamEvent))
+			})
+		})
+	})
+
 	Describe("PUT /api/v1/builds/:build_id/abort", func() {
 		var (
 			response *http.Response
@@ -1113,6 +1375,351 @@ var _ = Describe("Builds API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/builds/:build_id/approvals/:plan_id", func() {
+		var (
+			requestBody string
+			response    *http.Response
+		)
+
+		BeforeEach(func() {
+			requestBody = `{"approved":true,"comment":"ship it"}`
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/builds/128/approvals/some-plan", bytes.NewBufferString(requestBody))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+			})
+
+			Context("when the build can not be found", func() {
+				BeforeEach(func() {
+					dbBuildFactory.BuildReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the build is found", func() {
+				var approvalPlan atc.ApprovalPlan
+
+				BeforeEach(func() {
+					approvalPlan = atc.ApprovalPlan{Name: "some-approval"}
+
+					build.TeamNameReturns("some-team")
+					build.IsRunningReturns(true)
+					dbBuildFactory.BuildReturns(build, true, nil)
+				})
+
+				Context("when not authorized", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(false)
+					})
+
+					It("returns 403", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					})
+				})
+
+				Context("when authorized", func() {
+					BeforeEach(func() {
+						fakeAccess.IsAuthorizedReturns(true)
+						fakeAccess.UserInfoReturns(atc.UserInfo{DisplayUserId: "some-user"})
+						fakeAccess.ClaimsReturns(accessor.Claims{
+							Connector: "github",
+							UserID:    "some-user-id",
+						})
+						fakeAccess.TeamRolesReturns(map[string][]string{
+							"some-team": {"member"},
+						})
+					})
+
+					Context("when the plan has no such approval step", func() {
+						BeforeEach(func() {
+							build.PrivatePlanReturns(atc.Plan{
+								ID:   "some-plan",
+								Task: &atc.TaskPlan{Name: "some-task"},
+							})
+						})
+
+						It("returns 404", func() {
+							Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+						})
+					})
+
+					Context("when the plan has the approval step", func() {
+						BeforeEach(func() {
+							build.PrivatePlanReturns(atc.Plan{
+								ID: "some-do",
+								Do: &atc.DoPlan{
+									{
+										ID:       "some-plan",
+										Approval: &approvalPlan,
+									},
+								},
+							})
+						})
+
+						Context("when the request body is malformed", func() {
+							BeforeEach(func() {
+								requestBody = `{`
+							})
+
+							It("returns 400", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							})
+						})
+
+						Context("when the step does not restrict its approvers", func() {
+							BeforeEach(func() {
+								build.SaveApprovalReturns(true, nil)
+							})
+
+							It("returns 204", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+							})
+
+							It("saves the approval as the requester", func() {
+								Expect(build.SaveApprovalCallCount()).To(Equal(1))
+								planID, approval := build.SaveApprovalArgsForCall(0)
+								Expect(planID).To(Equal(atc.PlanID("some-plan")))
+								Expect(approval).To(Equal(atc.BuildApproval{
+									Approved: true,
+									User:     "some-user",
+									Comment:  "ship it",
+								}))
+							})
+						})
+
+						Context("when the requester does not have an allowed role", func() {
+							BeforeEach(func() {
+								approvalPlan.Roles = []string{"owner"}
+							})
+
+							It("returns 403", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+								Expect(build.SaveApprovalCallCount()).To(BeZero())
+							})
+
+							Context("when the requester is an admin", func() {
+								BeforeEach(func() {
+									fakeAccess.IsAdminReturns(true)
+									build.SaveApprovalReturns(true, nil)
+								})
+
+								It("returns 204", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+								})
+							})
+						})
+
+						Context("when the requester has an allowed role", func() {
+							BeforeEach(func() {
+								approvalPlan.Roles = []string{"owner", "member"}
+								build.SaveApprovalReturns(true, nil)
+							})
+
+							It("returns 204", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+							})
+						})
+
+						Context("when the requester is an allowed user", func() {
+							BeforeEach(func() {
+								approvalPlan.Users = []string{"GitHub:Some-User-ID"}
+								build.SaveApprovalReturns(true, nil)
+							})
+
+							It("returns 204", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+							})
+						})
+
+						Context("when the requester is not an allowed user", func() {
+							BeforeEach(func() {
+								approvalPlan.Users = []string{"github:some-other-user"}
+							})
+
+							It("returns 403", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+							})
+						})
+
+						Context("when the build has completed", func() {
+							BeforeEach(func() {
+								build.IsRunningReturns(false)
+							})
+
+							It("returns 409", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusConflict))
+								Expect(build.SaveApprovalCallCount()).To(BeZero())
+							})
+						})
+
+						Context("when the step has already been decided", func() {
+							BeforeEach(func() {
+								build.SaveApprovalReturns(false, nil)
+							})
+
+							It("returns 409", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusConflict))
+							})
+						})
+
+						Context("when saving the approval fails", func() {
+							BeforeEach(func() {
+								build.SaveApprovalReturns(false, errors.New("nope"))
+							})
+
+							It("returns 500", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+							})
+						})
+					})
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/builds/:build_id/artifacts/:artifact_name", func() {
+		var response *http.Response
+
+		BeforeEach(func() {
+			build.IDReturns(3)
+			build.TeamNameReturns("some-team")
+			build.PipelineIDReturns(42)
+			build.PipelineReturns(fakePipeline, true, nil)
+			dbBuildFactory.BuildReturns(build, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/builds/3/artifacts/some-output")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated and the pipeline is private", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+				fakePipeline.PublicReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated, but not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authenticated and authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the artifact was archived", func() {
+				BeforeEach(func() {
+					fakeArtifact := new(dbfakes.FakeArchivedArtifact)
+					fakeArtifact.NameReturns("some-output")
+					fakeArtifact.KeyReturns("some-key")
+					fakeArtifact.SizeReturns(int64(len("some-tarball")))
+					dbArchivedArtifacts.FindArchivedArtifactReturns(fakeArtifact, true, nil)
+
+					fakeArtifactStore.GetReturns(ioutil.NopCloser(bytes.NewBufferString("some-tarball")), nil)
+				})
+
+				It("looks up the artifact by build and name", func() {
+					Expect(dbArchivedArtifacts.FindArchivedArtifactCallCount()).To(Equal(1))
+
+					buildID, name := dbArchivedArtifacts.FindArchivedArtifactArgsForCall(0)
+					Expect(buildID).To(Equal(3))
+					Expect(name).To(Equal("some-output"))
+
+					_, key := fakeArtifactStore.GetArgsForCall(0)
+					Expect(key).To(Equal("some-key"))
+				})
+
+				It("returns the archived tarball", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response.Header.Get("Content-Type")).To(Equal("application/gzip"))
+					Expect(response.Header.Get("Content-Disposition")).To(Equal(`attachment; filename="some-output.tgz"`))
+					Expect(response.ContentLength).To(Equal(int64(len("some-tarball"))))
+					Expect(ioutil.ReadAll(response.Body)).To(Equal([]byte("some-tarball")))
+				})
+
+				Context("when the artifact is missing from the store", func() {
+					BeforeEach(func() {
+						fakeArtifactStore.GetReturns(nil, blobstore.ErrBlobNotFound)
+					})
+
+					It("returns 404", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+					})
+				})
+
+				Context("when reading from the store fails", func() {
+					BeforeEach(func() {
+						fakeArtifactStore.GetReturns(nil, errors.New("disaster"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
+			Context("when the artifact was not archived", func() {
+				BeforeEach(func() {
+					dbArchivedArtifacts.FindArchivedArtifactReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when looking up the artifact fails", func() {
+				BeforeEach(func() {
+					dbArchivedArtifacts.FindArchivedArtifactReturns(nil, false, errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/builds/:build_id/preparation", func() {
 		var response *http.Response
 
@@ -1530,4 +2137,106 @@ var _ = Describe("Builds API", func() {
 			})
 		})
 	})
+
+	Describe("GET /api/v1/builds/:build_id/resource-usage", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = http.Get(server.URL + "/api/v1/builds/42/resource-usage")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the build is found", func() {
+			BeforeEach(func() {
+				build.IDReturns(42)
+				build.TeamNameReturns("some-team")
+				build.JobIDReturns(42)
+				build.JobNameReturns("job1")
+				build.PipelineIDReturns(42)
+				dbBuildFactory.BuildReturns(build, true, nil)
+			})
+
+			Context("when not authenticated and the pipeline is private", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(false)
+					build.PipelineReturns(fakePipeline, true, nil)
+					fakePipeline.PublicReturns(false)
+				})
+
+				It("returns 401", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				})
+			})
+
+			Context("when authenticated", func() {
+				BeforeEach(func() {
+					fakeAccess.IsAuthenticatedReturns(true)
+					fakeAccess.IsAuthorizedReturns(true)
+				})
+
+				Context("when getting the resource usage succeeds", func() {
+					BeforeEach(func() {
+						build.ResourceUsageReturns(atc.BuildResourceUsage{
+							BuildID: 42,
+							Steps:   2,
+							ResourceUsage: atc.ResourceUsage{
+								CPUUsage:   300,
+								CPUUser:    200,
+								CPUSystem:  100,
+								MemoryPeak: 2048,
+								Duration:   time.Minute,
+							},
+						}, nil)
+					})
+
+					It("returns 200", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusOK))
+					})
+
+					It("returns Content-Type 'application/json'", func() {
+						expectedHeaderEntries := map[string]string{
+							"Content-Type": "application/json",
+						}
+						Expect(response).Should(IncludeHeaderEntries(expectedHeaderEntries))
+					})
+
+					It("returns the totals of the build's steps", func() {
+						body, err := ioutil.ReadAll(response.Body)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(body).To(MatchJSON(`{
+							"build_id": 42,
+							"steps": 2,
+							"cpu_usage": 300,
+							"cpu_user": 200,
+							"cpu_system": 100,
+							"memory_peak": 2048,
+							"duration": 60000000000
+						}`))
+					})
+				})
+
+				Context("when getting the resource usage fails", func() {
+					BeforeEach(func() {
+						build.ResourceUsageReturns(atc.BuildResourceUsage{}, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+
+		Context("when the build is not found", func() {
+			BeforeEach(func() {
+				dbBuildFactory.BuildReturns(nil, false, nil)
+			})
+
+			It("returns Not Found", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+			})
+		})
+	})
 })
@@ -0,0 +1,109 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) SetBuildApproval(build db.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		planID := atc.PlanID(r.FormValue(":plan_id"))
+
+		logger := s.logger.Session("set-build-approval", lager.Data{
+			"build": build.ID(),
+			"plan":  planID,
+		})
+
+		var approval atc.BuildApproval
+		err := json.NewDecoder(r.Body).Decode(&approval)
+		if err != nil {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		var approvalPlan *atc.ApprovalPlan
+		plan := build.PrivatePlan()
+		plan.Each(func(p *atc.Plan) {
+			if p.ID == planID && p.Approval != nil {
+				approvalPlan = p.Approval
+			}
+		})
+
+		if approvalPlan == nil {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		acc := accessor.GetAccessor(r)
+		if !canApprove(acc, build.TeamName(), *approvalPlan) {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
+		if !build.IsRunning() {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintf(w, "build has already completed")
+			return
+		}
+
+		approval.User = acc.UserInfo().DisplayUserId
+
+		saved, err := build.SaveApproval(planID, approval)
+		if err != nil {
+			logger.Error("failed-to-save-approval", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !saved {
+			w.WriteHeader(http.StatusConflict)
+			fmt.Fprintf(w, "step has already been decided")
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+// canApprove determines whether the requester may decide the step. Steps
+// which do not restrict their approvers may be decided by anyone who can
+// write to the build; otherwise the requester must either have one of the
+// listed roles on the team or be one of the listed users.
+func canApprove(acc accessor.Access, teamName string, plan atc.ApprovalPlan) bool {
+	if len(plan.Roles) == 0 && len(plan.Users) == 0 {
+		return true
+	}
+
+	if acc.IsAdmin() {
+		return true
+	}
+
+	for _, role := range acc.TeamRoles()[teamName] {
+		for _, allowed := range plan.Roles {
+			if role == allowed {
+				return true
+			}
+		}
+	}
+
+	claims := acc.Claims()
+	for _, user := range plan.Users {
+		if claims.UserID != "" && strings.EqualFold(user, claims.Connector+":"+claims.UserID) {
+			return true
+		}
+
+		if claims.PreferredUsername != "" && strings.EqualFold(user, claims.Connector+":"+claims.PreferredUsername) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,66 @@
+package buildserver
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/blobstore"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) GetBuildArtifact(build db.Build) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		artifactName := r.FormValue(":artifact_name")
+
+		logger := s.logger.Session("get-build-artifact", lager.Data{
+			"build":    build.ID(),
+			"artifact": artifactName,
+		})
+
+		artifact, found, err := s.archivedArtifacts.FindArchivedArtifact(build.ID(), artifactName)
+		if err != nil {
+			logger.Error("failed-to-find-archived-artifact", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		if s.artifactStore == nil {
+			logger.Info("artifact-store-not-configured")
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		reader, err := s.artifactStore.Get(r.Context(), artifact.Key())
+		if err != nil {
+			if err == blobstore.ErrBlobNotFound {
+				logger.Info("archived-artifact-missing-from-store")
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			logger.Error("failed-to-get-archived-artifact", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		defer reader.Close()
+
+		w.Header().Set("Content-Type", "application/gzip")
+		w.Header().Set("Content-Length", strconv.FormatInt(artifact.Size(), 10))
+		w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", artifact.Name()+".tgz"))
+		w.WriteHeader(http.StatusOK)
+
+		_, err = io.Copy(w, reader)
+		if err != nil {
+			logger.Error("failed-to-stream-archived-artifact", err)
+		}
+	})
+}
@@ -1,6 +1,7 @@
 package buildserver_test
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	. "github.com/concourse/concourse/atc/testhelpers"
@@ -69,7 +70,7 @@ var _ = Describe("Handler", func() {
 
 				fakeEventSource = new(dbfakes.FakeEventSource)
 
-				build.EventsStub = func(from uint) (db.EventSource, error) {
+				build.EventsStub = func(_ context.Context, from uint) (db.EventSource, error) {
 					fakeEventSource.NextStub = func() (event.Envelope, error) {
 						defer GinkgoRecover()
 
@@ -105,7 +106,7 @@ var _ = Describe("Handler", func() {
 			It("gets the events from the right build, starting at 0", func() {
 				_ = response.Body.Close()
 				Eventually(build.EventsCallCount).Should(Equal(1))
-				actualFrom := build.EventsArgsForCall(0)
+				_, actualFrom := build.EventsArgsForCall(0)
 				Expect(actualFrom).To(BeZero())
 			})
 
@@ -175,7 +176,7 @@ var _ = Describe("Handler", func() {
 				It("starts subscribing from after the id", func() {
 					_ = response.Body.Close()
 					Eventually(build.EventsCallCount).Should(Equal(1))
-					actualFrom := build.EventsArgsForCall(0)
+					_, actualFrom := build.EventsArgsForCall(0)
 					Expect(actualFrom).To(Equal(uint(2)))
 				})
 			})
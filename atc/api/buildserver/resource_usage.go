@@ -0,0 +1,29 @@
+package buildserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) GetBuildResourceUsage(build db.Build) http.Handler {
+	logger := s.logger.Session("get-build-resource-usage")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		usage, err := build.ResourceUsage()
+		if err != nil {
+			logger.Error("failed-to-get-resource-usage", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(usage)
+		if err != nil {
+			logger.Error("failed-to-encode-resource-usage", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
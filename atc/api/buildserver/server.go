@@ -5,6 +5,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc/api/auth"
+	"github.com/concourse/concourse/atc/blobstore"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -19,6 +20,9 @@ type Server struct {
 	buildFactory        db.BuildFactory
 	eventHandlerFactory EventHandlerFactory
 	rejector            auth.Rejector
+
+	archivedArtifacts db.ArchivedArtifactRepository
+	artifactStore     blobstore.Store
 }
 
 func NewServer(
@@ -27,6 +31,8 @@ func NewServer(
 	teamFactory db.TeamFactory,
 	buildFactory db.BuildFactory,
 	eventHandlerFactory EventHandlerFactory,
+	archivedArtifacts db.ArchivedArtifactRepository,
+	artifactStore blobstore.Store,
 ) *Server {
 	return &Server{
 		logger: logger,
@@ -38,5 +44,8 @@ func NewServer(
 		eventHandlerFactory: eventHandlerFactory,
 
 		rejector: auth.UnauthorizedRejector{},
+
+		archivedArtifacts: archivedArtifacts,
+		artifactStore:     artifactStore,
 	}
 }
@@ -0,0 +1,328 @@
+package buildserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/api/auth"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/gorilla/websocket"
+)
+
+// MaxStreamSubscriptions is how many builds a single connection to the build
+// event stream may follow at once.
+const MaxStreamSubscriptions = 1000
+
+const (
+	streamPingInterval = 30 * time.Second
+	streamWriteTimeout = 10 * time.Second
+	streamReadLimit    = 4096
+)
+
+var streamUpgrader = websocket.Upgrader{
+	HandshakeTimeout: 5 * time.Second,
+}
+
+// StreamBuildEvents serves the events of any number of builds over a single
+// WebSocket connection. Clients send event.StreamRequests to subscribe to and
+// unsubscribe from builds, and receive event.StreamMessages tagged with the
+// build they belong to.
+//
+// Each subscription is authorized separately, the same way as a build's own
+// event stream.
+func (s *Server) StreamBuildEvents(w http.ResponseWriter, r *http.Request) {
+	logger := s.logger.Session("stream-build-events")
+
+	acc := accessor.GetAccessor(r)
+
+	conn, err := streamUpgrader.Upgrade(w, r, nil)
+	if err != nil {
+		logger.Error("failed-to-upgrade", err)
+		return
+	}
+
+	defer conn.Close()
+
+	conn.SetReadLimit(streamReadLimit)
+
+	ctx, cancel := context.WithCancel(r.Context())
+	defer cancel()
+
+	stream := &buildEventStream{
+		logger:        logger,
+		conn:          conn,
+		access:        acc,
+		buildFactory:  s.buildFactory,
+		messages:      make(chan event.StreamMessage),
+		subscriptions: map[int]*streamSubscription{},
+	}
+
+	writerDone := make(chan struct{})
+	go func() {
+		defer close(writerDone)
+		stream.write(ctx)
+	}()
+
+	stream.read(ctx)
+
+	cancel()
+	stream.wg.Wait()
+	<-writerDone
+}
+
+type buildEventStream struct {
+	logger       lager.Logger
+	conn         *websocket.Conn
+	access       accessor.Access
+	buildFactory db.BuildFactory
+
+	messages chan event.StreamMessage
+
+	subscriptionsL sync.Mutex
+	subscriptions  map[int]*streamSubscription
+
+	wg sync.WaitGroup
+}
+
+type streamSubscription struct {
+	cancel func()
+}
+
+func (stream *buildEventStream) read(ctx context.Context) {
+	for {
+		_, payload, err := stream.conn.ReadMessage()
+		if err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure, websocket.CloseGoingAway) {
+				stream.logger.Info("failed-to-read", lager.Data{"error": err.Error()})
+			}
+
+			return
+		}
+
+		var req event.StreamRequest
+		err = json.Unmarshal(payload, &req)
+		if err != nil {
+			stream.fail(ctx, 0, fmt.Sprintf("malformed request: %s", err))
+			continue
+		}
+
+		switch req.Action {
+		case event.StreamSubscribe:
+			stream.subscribe(ctx, req)
+		case event.StreamUnsubscribe:
+			stream.unsubscribe(req.BuildID)
+		default:
+			stream.fail(ctx, req.BuildID, fmt.Sprintf("unknown action '%s'", req.Action))
+		}
+	}
+}
+
+// write sends messages to the client one at a time, as only one goroutine may
+// write to the connection at once. It also pings the client periodically so
+// that idle connections are kept open by any proxies in between.
+func (stream *buildEventStream) write(ctx context.Context) {
+	ticker := time.NewTicker(streamPingInterval)
+	defer ticker.Stop()
+
+	for {
+		var err error
+
+		select {
+		case <-ctx.Done():
+			return
+
+		case msg := <-stream.messages:
+			_ = stream.conn.SetWriteDeadline(time.Now().Add(streamWriteTimeout))
+			err = stream.conn.WriteJSON(msg)
+
+		case <-ticker.C:
+			err = stream.conn.WriteControl(websocket.PingMessage, nil, time.Now().Add(streamWriteTimeout))
+		}
+
+		if err != nil {
+			stream.logger.Info("failed-to-write", lager.Data{"error": err.Error()})
+
+			// unblock the reader so that the connection is torn down
+			_ = stream.conn.Close()
+			return
+		}
+	}
+}
+
+func (stream *buildEventStream) subscribe(ctx context.Context, req event.StreamRequest) {
+	logger := stream.logger.WithData(lager.Data{"build": req.BuildID})
+
+	build, found, err := stream.buildFactory.Build(req.BuildID)
+	if err != nil {
+		logger.Error("failed-to-get-build", err)
+		stream.fail(ctx, req.BuildID, "failed to get build")
+		return
+	}
+
+	if !found {
+		stream.fail(ctx, req.BuildID, "build not found")
+		return
+	}
+
+	allowed, err := auth.CanReadBuild(build, stream.access, false)
+	if err != nil {
+		logger.Error("failed-to-check-access", err)
+		stream.fail(ctx, req.BuildID, "failed to check access to build")
+		return
+	}
+
+	if !allowed {
+		stream.fail(ctx, req.BuildID, "not authorized")
+		return
+	}
+
+	var from uint
+	if req.Cursor != nil {
+		from = *req.Cursor + 1
+	}
+
+	subCtx, cancel := context.WithCancel(ctx)
+	sub := &streamSubscription{cancel: cancel}
+
+	err = stream.add(req.BuildID, sub)
+	if err != nil {
+		cancel()
+		stream.fail(ctx, req.BuildID, err.Error())
+		return
+	}
+
+	stream.wg.Add(1)
+	go func() {
+		defer stream.wg.Done()
+		defer stream.remove(req.BuildID, sub)
+
+		stream.follow(subCtx, logger, build, from)
+	}()
+}
+
+func (stream *buildEventStream) add(buildID int, sub *streamSubscription) error {
+	stream.subscriptionsL.Lock()
+	defer stream.subscriptionsL.Unlock()
+
+	if _, found := stream.subscriptions[buildID]; found {
+		return errors.New("already subscribed")
+	}
+
+	if len(stream.subscriptions) >= MaxStreamSubscriptions {
+		return fmt.Errorf("too many subscriptions (max %d)", MaxStreamSubscriptions)
+	}
+
+	stream.subscriptions[buildID] = sub
+
+	return nil
+}
+
+func (stream *buildEventStream) unsubscribe(buildID int) {
+	stream.subscriptionsL.Lock()
+	defer stream.subscriptionsL.Unlock()
+
+	sub, found := stream.subscriptions[buildID]
+	if !found {
+		return
+	}
+
+	sub.cancel()
+	delete(stream.subscriptions, buildID)
+}
+
+func (stream *buildEventStream) remove(buildID int, sub *streamSubscription) {
+	stream.subscriptionsL.Lock()
+	defer stream.subscriptionsL.Unlock()
+
+	sub.cancel()
+
+	if stream.subscriptions[buildID] == sub {
+		delete(stream.subscriptions, buildID)
+	}
+}
+
+func (stream *buildEventStream) follow(ctx context.Context, logger lager.Logger, build db.Build, from uint) {
+	events, err := build.Events(ctx, from)
+	if err != nil {
+		logger.Error("failed-to-get-build-events", err, lager.Data{"start": from})
+		stream.fail(ctx, build.ID(), "failed to get build events")
+		return
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-done:
+		}
+
+		// also interrupts a pending call to Next
+		db.Close(events)
+	}()
+
+	eventID := from
+	for {
+		ev, err := events.Next()
+		if err != nil {
+			switch err {
+			case db.ErrEndOfBuildEventStream:
+				stream.send(ctx, event.StreamMessage{
+					Type:    event.StreamEnd,
+					BuildID: build.ID(),
+					ID:      eventID,
+				})
+			case db.ErrBuildEventStreamClosed:
+			default:
+				logger.Error("failed-to-get-next-build-event", err)
+				stream.fail(ctx, build.ID(), "failed to get build events")
+			}
+
+			return
+		}
+
+		payload, err := json.Marshal(ev)
+		if err != nil {
+			logger.Error("failed-to-marshal-build-event", err)
+			stream.fail(ctx, build.ID(), "failed to marshal build event")
+			return
+		}
+
+		if !stream.send(ctx, event.StreamMessage{
+			Type:    event.StreamEvent,
+			BuildID: build.ID(),
+			ID:      eventID,
+			Event:   payload,
+		}) {
+			return
+		}
+
+		eventID++
+	}
+}
+
+func (stream *buildEventStream) fail(ctx context.Context, buildID int, message string) {
+	stream.send(ctx, event.StreamMessage{
+		Type:    event.StreamError,
+		BuildID: buildID,
+		Error:   message,
+	})
+}
+
+func (stream *buildEventStream) send(ctx context.Context, msg event.StreamMessage) bool {
+	select {
+	case stream.messages <- msg:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
@@ -350,6 +350,7 @@ var _ = Describe("Config API", func() {
 			BeforeEach(func() {
 				fakeAccess.IsAuthenticatedReturns(true)
 				fakeAccess.IsAuthorizedReturns(true)
+				fakeAccess.UserInfoReturns(atc.UserInfo{DisplayUserId: "some-user"})
 			})
 
 			Context("when an identifier is invalid", func() {
@@ -513,11 +514,12 @@ var _ = Describe("Config API", func() {
 						It("saves it initially paused", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-							ref, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
+							ref, savedConfig, id, initiallyPaused, createdBy := dbTeam.SavePipelineArgsForCall(0)
 							Expect(ref.Name).To(Equal("a-pipeline"))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
 							Expect(initiallyPaused).To(BeTrue())
+							Expect(createdBy).To(Equal("some-user"))
 						})
 
 						Context("and saving it fails", func() {
@@ -534,6 +536,28 @@ var _ = Describe("Config API", func() {
 							})
 						})
 
+						Context("and a job in the passed constraints can't be found", func() {
+							BeforeEach(func() {
+								dbTeam.SavePipelineReturns(nil, false, db.ErrPassedJobNotFound(atc.PassedJob{
+									Pipeline: atc.PipelineRef{Name: "other-pipeline"},
+									Job:      "some-job",
+								}))
+							})
+
+							It("returns 400", func() {
+								Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+							})
+
+							It("returns the error in the response body", func() {
+								Expect(ioutil.ReadAll(response.Body)).To(MatchJSON(`
+									{
+										"errors": [
+											"job 'other-pipeline/some-job' in passed constraints not found"
+										]
+									}`))
+							})
+						})
+
 						Context("when it's the first time the pipeline has been created", func() {
 							BeforeEach(func() {
 								returnedPipeline := new(dbfakes.FakePipeline)
@@ -611,7 +635,7 @@ var _ = Describe("Config API", func() {
 						It("saves it initially paused", func() {
 							Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-							ref, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
+							ref, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineArgsForCall(0)
 							Expect(ref.Name).To(Equal("a-pipeline"))
 							Expect(savedConfig).To(Equal(pipelineConfig))
 							Expect(id).To(Equal(db.ConfigVersion(42)))
@@ -658,7 +682,7 @@ jobs:
 							It("saves it", func() {
 								Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-								ref, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
+								ref, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineArgsForCall(0)
 								Expect(ref.Name).To(Equal("a-pipeline"))
 								Expect(savedConfig).To(Equal(atc.Config{
 									Resources: []atc.ResourceConfig{
@@ -967,7 +991,7 @@ jobs:
 									It("passes validation and saves it un-interpolated", func() {
 										Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-										ref, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
+										ref, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineArgsForCall(0)
 										Expect(ref.Name).To(Equal("a-pipeline"))
 										Expect(savedConfig).To(Equal(payloadAsConfig))
 										Expect(id).To(Equal(db.ConfigVersion(42)))
@@ -1117,7 +1141,7 @@ jobs:
 								It("saves an instanced pipeline", func() {
 									Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-									ref, _, _, _ := dbTeam.SavePipelineArgsForCall(0)
+									ref, _, _, _, _ := dbTeam.SavePipelineArgsForCall(0)
 									Expect(ref).To(Equal(atc.PipelineRef{
 										Name:         "a-pipeline",
 										InstanceVars: atc.InstanceVars{"branch": "feature"},
@@ -1217,7 +1241,7 @@ jobs:
 					It("saves it", func() {
 						Expect(dbTeam.SavePipelineCallCount()).To(Equal(1))
 
-						ref, savedConfig, id, initiallyPaused := dbTeam.SavePipelineArgsForCall(0)
+						ref, savedConfig, id, initiallyPaused, _ := dbTeam.SavePipelineArgsForCall(0)
 						Expect(ref.Name).To(Equal("a-pipeline"))
 						Expect(savedConfig).To(Equal(atc.Config{
 							Jobs: atc.JobConfigs{
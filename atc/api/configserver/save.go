@@ -3,12 +3,14 @@ package configserver
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"net/http"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
 	"github.com/concourse/concourse/atc/configvalidate"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
@@ -128,8 +130,17 @@ func (s *Server) SaveConfig(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	_, created, err := team.SavePipeline(pipelineRef, config, version, true)
+	acc := accessor.GetAccessor(r)
+
+	_, created, err := team.SavePipeline(pipelineRef, config, version, true, acc.UserInfo().DisplayUserId)
 	if err != nil {
+		var passedJobNotFound db.ErrPassedJobNotFound
+		if errors.As(err, &passedJobNotFound) {
+			session.Info("unknown-passed-job", lager.Data{"error": err.Error()})
+			s.handleBadRequest(w, err.Error())
+			return
+		}
+
 		session.Error("failed-to-save-config", err)
 		w.WriteHeader(http.StatusInternalServerError)
 		fmt.Fprintf(w, "failed to save config: %s", err)
@@ -0,0 +1,259 @@
+package api_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Custom Roles API", func() {
+	var (
+		response *http.Response
+		fakeTeam *dbfakes.FakeTeam
+	)
+
+	BeforeEach(func() {
+		fakeTeam = new(dbfakes.FakeTeam)
+		dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+	})
+
+	Describe("GET /api/v1/teams/:team_name/roles", func() {
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/roles")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the team has custom roles", func() {
+				BeforeEach(func() {
+					fakeTeam.CustomRolesReturns(atc.CustomRoles{
+						{
+							Name:        "deployer",
+							Permissions: []string{atc.CreateJobBuild},
+							Pipelines:   []string{"deploy-*"},
+						},
+					})
+				})
+
+				It("returns them", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response).To(IncludeHeaderEntries(map[string]string{
+						"Content-Type": "application/json",
+					}))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`[
+						{
+							"name": "deployer",
+							"permissions": ["CreateJobBuild"],
+							"pipelines": ["deploy-*"]
+						}
+					]`))
+				})
+			})
+
+			Context("when the team has no custom roles", func() {
+				It("returns an empty list", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`[]`))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/roles/:role_name", func() {
+		var (
+			roleName string
+			body     string
+		)
+
+		BeforeEach(func() {
+			roleName = "deployer"
+			body = `{
+				"permissions": ["CreateJobBuild", "GetJob"],
+				"pipelines": ["deploy-*"]
+			}`
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/some-team/roles/"+roleName, bytes.NewBufferString(body))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeTeam.SaveCustomRoleCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("saves the role under the name in the url", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+				Expect(fakeTeam.SaveCustomRoleCallCount()).To(Equal(1))
+				Expect(fakeTeam.SaveCustomRoleArgsForCall(0)).To(Equal(atc.CustomRole{
+					Name:        "deployer",
+					Permissions: []string{atc.CreateJobBuild, atc.GetJob},
+					Pipelines:   []string{"deploy-*"},
+				}))
+			})
+
+			It("notifies the team cacher", func() {
+				Expect(dbTeamFactory.NotifyCacherCallCount()).To(Equal(1))
+			})
+
+			Context("when the name is a built-in role", func() {
+				BeforeEach(func() {
+					roleName = "owner"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					reason, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(reason)).To(ContainSubstring("'owner' is a built-in role"))
+
+					Expect(fakeTeam.SaveCustomRoleCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the role is invalid", func() {
+				BeforeEach(func() {
+					body = `{"permissions": ["ExplodeEverything"]}`
+				})
+
+				It("returns 400 with the reason", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					reason, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(reason)).To(ContainSubstring("unknown action 'ExplodeEverything'"))
+
+					Expect(fakeTeam.SaveCustomRoleCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the request is malformed", func() {
+				BeforeEach(func() {
+					body = `{`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when saving the role fails", func() {
+				BeforeEach(func() {
+					fakeTeam.SaveCustomRoleReturns(errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					Expect(dbTeamFactory.NotifyCacherCallCount()).To(BeZero())
+				})
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/roles/:role_name", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/some-team/roles/deployer", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the role exists", func() {
+				BeforeEach(func() {
+					fakeTeam.DestroyCustomRoleReturns(true, nil)
+				})
+
+				It("destroys it", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+					Expect(fakeTeam.DestroyCustomRoleArgsForCall(0)).To(Equal("deployer"))
+					Expect(dbTeamFactory.NotifyCacherCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when the role does not exist", func() {
+				BeforeEach(func() {
+					fakeTeam.DestroyCustomRoleReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when destroying the role fails", func() {
+				BeforeEach(func() {
+					fakeTeam.DestroyCustomRoleReturns(false, errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+})
@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/api/artifactserver"
+	"github.com/concourse/concourse/atc/api/auditserver"
 	"github.com/concourse/concourse/atc/api/buildserver"
 	"github.com/concourse/concourse/atc/api/ccserver"
 	"github.com/concourse/concourse/atc/api/cliserver"
@@ -17,18 +18,22 @@ import (
 	"github.com/concourse/concourse/atc/api/infoserver"
 	"github.com/concourse/concourse/atc/api/jobserver"
 	"github.com/concourse/concourse/atc/api/loglevelserver"
+	"github.com/concourse/concourse/atc/api/notificationserver"
 	"github.com/concourse/concourse/atc/api/pipelineserver"
 	"github.com/concourse/concourse/atc/api/resourceserver"
 	"github.com/concourse/concourse/atc/api/resourceserver/versionserver"
 	"github.com/concourse/concourse/atc/api/teamserver"
+	"github.com/concourse/concourse/atc/api/tokenserver"
 	"github.com/concourse/concourse/atc/api/usersserver"
 	"github.com/concourse/concourse/atc/api/volumeserver"
 	"github.com/concourse/concourse/atc/api/wallserver"
 	"github.com/concourse/concourse/atc/api/workerserver"
+	"github.com/concourse/concourse/atc/blobstore"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/gc"
 	"github.com/concourse/concourse/atc/mainredirect"
+	"github.com/concourse/concourse/atc/scheduler"
 	"github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/wrappa"
 	"github.com/tedsuo/rata"
@@ -55,6 +60,12 @@ func NewHandler(
 	dbCheckFactory db.CheckFactory,
 	dbResourceConfigFactory db.ResourceConfigFactory,
 	dbUserFactory db.UserFactory,
+	dbArchivedArtifactRepository db.ArchivedArtifactRepository,
+	dbAuditEventRepository db.AuditEventRepository,
+	dbAPITokenRepository db.APITokenRepository,
+
+	algorithm scheduler.Algorithm,
+	artifactStore blobstore.Store,
 
 	eventHandlerFactory buildserver.EventHandlerFactory,
 
@@ -85,8 +96,8 @@ func NewHandler(
 	buildHandlerFactory := buildserver.NewScopedHandlerFactory(logger)
 	teamHandlerFactory := NewTeamScopedHandlerFactory(logger, dbTeamFactory)
 
-	buildServer := buildserver.NewServer(logger, externalURL, dbTeamFactory, dbBuildFactory, eventHandlerFactory)
-	jobServer := jobserver.NewServer(logger, externalURL, secretManager, dbJobFactory, dbCheckFactory)
+	buildServer := buildserver.NewServer(logger, externalURL, dbTeamFactory, dbBuildFactory, eventHandlerFactory, dbArchivedArtifactRepository, artifactStore)
+	jobServer := jobserver.NewServer(logger, externalURL, secretManager, dbJobFactory, dbCheckFactory, algorithm)
 	resourceServer := resourceserver.NewServer(logger, secretManager, varSourcePool, dbCheckFactory, dbResourceFactory, dbResourceConfigFactory)
 
 	versionServer := versionserver.NewServer(logger, externalURL)
@@ -102,7 +113,10 @@ func NewHandler(
 	infoServer := infoserver.NewServer(logger, version, workerVersion, externalURL, clusterName, credsManagers)
 	artifactServer := artifactserver.NewServer(logger, workerPool)
 	usersServer := usersserver.NewServer(logger, dbUserFactory)
+	auditServer := auditserver.NewServer(logger, dbAuditEventRepository)
+	tokenServer := tokenserver.NewServer(logger, dbAPITokenRepository)
 	wallServer := wallserver.NewServer(dbWall, logger)
+	notificationServer := notificationserver.NewServer(logger)
 
 	handlers := map[string]http.Handler{
 		atc.GetConfig:  http.HandlerFunc(configServer.GetConfig),
@@ -110,15 +124,19 @@ func NewHandler(
 
 		atc.GetCC: http.HandlerFunc(ccServer.GetCC),
 
-		atc.ListBuilds:          http.HandlerFunc(buildServer.ListBuilds),
-		atc.CreateBuild:         teamHandlerFactory.HandlerFor(buildServer.CreateBuild),
-		atc.GetBuild:            buildHandlerFactory.HandlerFor(buildServer.GetBuild),
-		atc.BuildResources:      buildHandlerFactory.HandlerFor(buildServer.BuildResources),
-		atc.AbortBuild:          buildHandlerFactory.HandlerFor(buildServer.AbortBuild),
-		atc.GetBuildPlan:        buildHandlerFactory.HandlerFor(buildServer.GetBuildPlan),
-		atc.GetBuildPreparation: buildHandlerFactory.HandlerFor(buildServer.GetBuildPreparation),
-		atc.BuildEvents:         buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
-		atc.ListBuildArtifacts:  buildHandlerFactory.HandlerFor(buildServer.GetBuildArtifacts),
+		atc.ListBuilds:            http.HandlerFunc(buildServer.ListBuilds),
+		atc.CreateBuild:           teamHandlerFactory.HandlerFor(buildServer.CreateBuild),
+		atc.GetBuild:              buildHandlerFactory.HandlerFor(buildServer.GetBuild),
+		atc.BuildResources:        buildHandlerFactory.HandlerFor(buildServer.BuildResources),
+		atc.AbortBuild:            buildHandlerFactory.HandlerFor(buildServer.AbortBuild),
+		atc.SetBuildApproval:      buildHandlerFactory.HandlerFor(buildServer.SetBuildApproval),
+		atc.GetBuildPlan:          buildHandlerFactory.HandlerFor(buildServer.GetBuildPlan),
+		atc.GetBuildPreparation:   buildHandlerFactory.HandlerFor(buildServer.GetBuildPreparation),
+		atc.GetBuildResourceUsage: buildHandlerFactory.HandlerFor(buildServer.GetBuildResourceUsage),
+		atc.BuildEvents:           buildHandlerFactory.HandlerFor(buildServer.BuildEvents),
+		atc.StreamBuildEvents:     http.HandlerFunc(buildServer.StreamBuildEvents),
+		atc.ListBuildArtifacts:    buildHandlerFactory.HandlerFor(buildServer.GetBuildArtifacts),
+		atc.GetBuildArtifact:      buildHandlerFactory.HandlerFor(buildServer.GetBuildArtifact),
 
 		atc.ListAllJobs:    http.HandlerFunc(jobServer.ListAllJobs),
 		atc.ListJobs:       pipelineHandlerFactory.HandlerFor(jobServer.ListJobs),
@@ -139,6 +157,8 @@ func NewHandler(
 
 		atc.ClearTaskCache: pipelineHandlerFactory.HandlerFor(jobServer.ClearTaskCache),
 
+		atc.GetJobSchedulingExplanation: pipelineHandlerFactory.HandlerFor(jobServer.GetJobSchedulingExplanation),
+
 		atc.ListAllPipelines:          http.HandlerFunc(pipelineServer.ListAllPipelines),
 		atc.ListPipelines:             http.HandlerFunc(pipelineServer.ListPipelines),
 		atc.GetPipeline:               pipelineHandlerFactory.HandlerFor(pipelineServer.GetPipeline),
@@ -155,6 +175,11 @@ func NewHandler(
 		atc.ListPipelineBuilds:        pipelineHandlerFactory.HandlerFor(pipelineServer.ListPipelineBuilds),
 		atc.CreatePipelineBuild:       pipelineHandlerFactory.HandlerFor(pipelineServer.CreateBuild),
 		atc.PipelineBadge:             pipelineHandlerFactory.HandlerFor(pipelineServer.PipelineBadge),
+		atc.GetPipelineGraph:          pipelineHandlerFactory.HandlerFor(pipelineServer.GetPipelineGraph),
+
+		atc.ListPipelineConfigHistory:   pipelineHandlerFactory.HandlerFor(pipelineServer.ListPipelineConfigHistory),
+		atc.GetPipelineConfigRevision:   pipelineHandlerFactory.HandlerFor(pipelineServer.GetPipelineConfigRevision),
+		atc.DiffPipelineConfigRevisions: pipelineHandlerFactory.HandlerFor(pipelineServer.DiffPipelineConfigRevisions),
 
 		atc.ListAllResources:        http.HandlerFunc(resourceServer.ListAllResources),
 		atc.ListResources:           pipelineHandlerFactory.HandlerFor(resourceServer.ListResources),
@@ -195,6 +220,8 @@ func NewHandler(
 		atc.GetUser:              http.HandlerFunc(usersServer.GetUser),
 		atc.ListActiveUsersSince: http.HandlerFunc(usersServer.GetUsersSince),
 
+		atc.ListAuditEvents: http.HandlerFunc(auditServer.ListAuditEvents),
+
 		atc.ListContainers:           teamHandlerFactory.HandlerFor(containerServer.ListContainers),
 		atc.GetContainer:             teamHandlerFactory.HandlerFor(containerServer.GetContainer),
 		atc.HijackContainer:          teamHandlerFactory.HandlerFor(containerServer.HijackContainer),
@@ -208,9 +235,25 @@ func NewHandler(
 		atc.ListTeams:      http.HandlerFunc(teamServer.ListTeams),
 		atc.GetTeam:        teamHandlerFactory.HandlerFor(teamServer.GetTeam),
 		atc.SetTeam:        http.HandlerFunc(teamServer.SetTeam),
+		atc.SetTeams:       http.HandlerFunc(teamServer.SetTeams),
 		atc.RenameTeam:     teamHandlerFactory.HandlerFor(teamServer.RenameTeam),
 		atc.DestroyTeam:    teamHandlerFactory.HandlerFor(teamServer.DestroyTeam),
 		atc.ListTeamBuilds: teamHandlerFactory.HandlerFor(teamServer.ListTeamBuilds),
+		atc.TeamEvents:     teamHandlerFactory.HandlerFor(teamServer.TeamEvents),
+
+		atc.ListCustomRoles:   teamHandlerFactory.HandlerFor(teamServer.ListCustomRoles),
+		atc.SetCustomRole:     teamHandlerFactory.HandlerFor(teamServer.SetCustomRole),
+		atc.DestroyCustomRole: teamHandlerFactory.HandlerFor(teamServer.DestroyCustomRole),
+
+		atc.ListAPITokens:  teamHandlerFactory.HandlerFor(tokenServer.ListAPITokens),
+		atc.CreateAPIToken: teamHandlerFactory.HandlerFor(tokenServer.CreateAPIToken),
+		atc.RevokeAPIToken: teamHandlerFactory.HandlerFor(tokenServer.RevokeAPIToken),
+
+		atc.ListNotificationSubscriptions:   teamHandlerFactory.HandlerFor(notificationServer.ListNotificationSubscriptions),
+		atc.SetNotificationSubscription:     teamHandlerFactory.HandlerFor(notificationServer.SetNotificationSubscription),
+		atc.DestroyNotificationSubscription: teamHandlerFactory.HandlerFor(notificationServer.DestroyNotificationSubscription),
+		atc.ListNotificationDeliveries:      teamHandlerFactory.HandlerFor(notificationServer.ListNotificationDeliveries),
+		atc.RedeliverNotification:           teamHandlerFactory.HandlerFor(notificationServer.RedeliverNotification),
 
 		atc.CreateArtifact: teamHandlerFactory.HandlerFor(artifactServer.CreateArtifact),
 		atc.GetArtifact:    teamHandlerFactory.HandlerFor(artifactServer.GetArtifact),
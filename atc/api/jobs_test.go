@@ -513,6 +513,65 @@ var _ = Describe("Jobs API", func() {
 								})
 							})
 
+							Context("when the job has a schedule", func() {
+								BeforeEach(func() {
+									fakeJob.PausedReturns(false)
+									fakeJob.ConfigReturns(atc.JobConfig{
+										Name: "some-job",
+										Schedule: &atc.JobSchedule{
+											Cron: "0 * * * *",
+										},
+									}, nil)
+									fakeJob.ScheduleLastFiredReturns(time.Date(2021, 7, 1, 10, 30, 0, 0, time.UTC))
+								})
+
+								It("returns the next time the schedule will fire", func() {
+									var job atc.Job
+									err := json.NewDecoder(response.Body).Decode(&job)
+									Expect(err).NotTo(HaveOccurred())
+
+									Expect(job.NextScheduledTime).To(Equal(time.Date(2021, 7, 1, 11, 0, 0, 0, time.UTC).Unix()))
+								})
+
+								Context("when the job is paused", func() {
+									BeforeEach(func() {
+										fakeJob.PausedReturns(true)
+									})
+
+									It("does not return a next scheduled time", func() {
+										var job atc.Job
+										err := json.NewDecoder(response.Body).Decode(&job)
+										Expect(err).NotTo(HaveOccurred())
+
+										Expect(job.NextScheduledTime).To(BeZero())
+									})
+								})
+
+								Context("when the pipeline is paused", func() {
+									BeforeEach(func() {
+										fakePipeline.PausedReturns(true)
+									})
+
+									It("does not return a next scheduled time", func() {
+										var job atc.Job
+										err := json.NewDecoder(response.Body).Decode(&job)
+										Expect(err).NotTo(HaveOccurred())
+
+										Expect(job.NextScheduledTime).To(BeZero())
+									})
+								})
+							})
+
+							Context("when getting the job's config fails", func() {
+								BeforeEach(func() {
+									fakeJob.ConfigReturns(atc.JobConfig{}, errors.New("oh no!"))
+								})
+
+								It("returns 500", func() {
+									Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+								})
+							})
+
 							Context("when getting the job's builds fails", func() {
 								BeforeEach(func() {
 									fakeJob.FinishedAndNextBuildReturns(nil, nil, errors.New("oh no!"))
@@ -1886,6 +1945,319 @@ var _ = Describe("Jobs API", func() {
 		})
 	})
 
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/scheduling-explanation", func() {
+		var response *http.Response
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/pipelines/some-pipeline/jobs/some-job/scheduling-explanation")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when authenticated and not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authenticated and authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the job is not found", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when getting the job fails", func() {
+				BeforeEach(func() {
+					fakePipeline.JobReturns(nil, false, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+
+			Context("when the job is found", func() {
+				var (
+					fakeResource    *dbfakes.FakeResource
+					fakeUpstreamJob *dbfakes.FakeJob
+					inputConfigs    db.InputConfigs
+				)
+
+				BeforeEach(func() {
+					fakeJob.NameReturns("some-job")
+					fakeJob.InputsReturns([]atc.JobInput{
+						{
+							Name:     "some-input",
+							Resource: "some-resource",
+							Trigger:  true,
+						},
+						{
+							Name:     "some-other-input",
+							Resource: "some-other-resource",
+							Passed:   []string{"upstream-job"},
+						},
+					}, nil)
+
+					inputConfigs = db.InputConfigs{
+						{
+							Name:       "some-input",
+							Trigger:    true,
+							ResourceID: 1,
+							JobID:      2,
+						},
+						{
+							Name:       "some-other-input",
+							Passed:     db.JobSet{3: true},
+							ResourceID: 4,
+							JobID:      2,
+						},
+					}
+					fakeJob.AlgorithmInputsReturns(inputConfigs, nil)
+					fakeJob.ConfigReturns(atc.JobConfig{Name: "some-job"}, nil)
+
+					fakeAlgorithm.ComputeReturns(db.InputMapping{
+						"some-input": db.InputResult{
+							Input: &db.AlgorithmInput{
+								AlgorithmVersion: db.AlgorithmVersion{
+									ResourceID: 1,
+									Version:    "some-md5",
+								},
+								FirstOccurrence: true,
+							},
+						},
+						"some-other-input": db.InputResult{
+							ResolveError: db.NoSatisfiableBuilds,
+						},
+					}, false, false, nil)
+
+					fakeJob.InputMappingVersionsReturns(map[string]atc.Version{
+						"some-input": {"ref": "abc"},
+					}, nil)
+
+					fakeResource = new(dbfakes.FakeResource)
+					fakeResource.NameReturns("some-other-resource")
+					fakeResource.DisabledVersionsReturns([]atc.Version{{"ref": "def"}}, nil)
+					fakePipeline.ResourcesReturns(db.Resources{fakeResource}, nil)
+
+					fakeUpstreamJob = new(dbfakes.FakeJob)
+					fakeUpstreamJob.IDReturns(3)
+					fakeUpstreamJob.NameReturns("upstream-job")
+					fakePipeline.JobsReturns(db.Jobs{fakeJob, fakeUpstreamJob}, nil)
+
+					fakePipeline.JobReturns(fakeJob, true, nil)
+				})
+
+				It("computes the inputs without saving them", func() {
+					Expect(fakeAlgorithm.ComputeCallCount()).To(Equal(2))
+
+					_, job, inputs := fakeAlgorithm.ComputeArgsForCall(0)
+					Expect(job).To(Equal(fakeJob))
+					Expect(inputs).To(Equal(inputConfigs))
+
+					Expect(fakeJob.SaveNextInputMappingCallCount()).To(BeZero())
+				})
+
+				It("resolves the failed input against each of its passed constraints", func() {
+					_, _, inputs := fakeAlgorithm.ComputeArgsForCall(1)
+					Expect(inputs).To(Equal(db.InputConfigs{inputConfigs[1]}))
+				})
+
+				It("returns 200 with the explanation", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`{
+						"resolved": false,
+						"inputs": [
+							{
+								"name": "some-input",
+								"resource": "some-resource",
+								"trigger": true,
+								"version": {"ref": "abc"},
+								"first_occurrence": true
+							},
+							{
+								"name": "some-other-input",
+								"resource": "some-other-resource",
+								"trigger": false,
+								"passed": ["upstream-job"],
+								"disabled_versions": [{"ref": "def"}],
+								"resolve_error": "no satisfiable builds from passed jobs found for set of inputs",
+								"failed_passed": ["upstream-job"]
+							}
+						],
+						"paused": false,
+						"pipeline_paused": false,
+						"pending_builds": 0
+					}`))
+				})
+
+				Context("when the passed job is in another pipeline", func() {
+					BeforeEach(func() {
+						inputConfigs[1].ExternalPassed = map[int]db.ExternalPassedJob{
+							3: {Name: "other-pipeline/upstream-job", ResourceID: 5},
+						}
+						fakeJob.AlgorithmInputsReturns(inputConfigs, nil)
+
+						fakePipeline.JobsReturns(db.Jobs{fakeJob}, nil)
+					})
+
+					It("names the job with its pipeline", func() {
+						var explanation atc.SchedulingExplanation
+						err := json.NewDecoder(response.Body).Decode(&explanation)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(explanation.Inputs[1].FailedPassed).To(Equal([]string{"other-pipeline/upstream-job"}))
+					})
+				})
+
+				Context("when the job and pipeline are paused", func() {
+					BeforeEach(func() {
+						fakeJob.PausedReturns(true)
+						fakePipeline.PausedReturns(true)
+					})
+
+					It("explains that builds will not be started", func() {
+						var explanation atc.SchedulingExplanation
+						err := json.NewDecoder(response.Body).Decode(&explanation)
+						Expect(err).NotTo(HaveOccurred())
+
+						Expect(explanation.BlockedBy).To(Equal([]string{
+							"pipeline is paused",
+							"job is paused",
+						}))
+					})
+				})
+
+				Context("when the job has max in flight", func() {
+					var runningBuild, pendingBuild *dbfakes.FakeBuild
+
+					BeforeEach(func() {
+						fakeJob.ConfigReturns(atc.JobConfig{
+							Name:         "some-job",
+							SerialGroups: []string{"some-group"},
+						}, nil)
+
+						runningBuild = new(dbfakes.FakeBuild)
+						runningBuild.IDReturns(1)
+						runningBuild.NameReturns("1")
+						runningBuild.JobNameReturns("other-job")
+
+						pendingBuild = new(dbfakes.FakeBuild)
+						pendingBuild.IDReturns(2)
+						pendingBuild.NameReturns("7")
+						pendingBuild.JobNameReturns("some-job")
+
+						fakeJob.GetPendingBuildsReturns([]db.Build{pendingBuild}, nil)
+					})
+
+					Context("when it has been reached", func() {
+						BeforeEach(func() {
+							fakeJob.InFlightBuildsReturns([]db.Build{runningBuild}, pendingBuild, true, nil)
+						})
+
+						It("explains that builds will not be started", func() {
+							var explanation atc.SchedulingExplanation
+							err := json.NewDecoder(response.Body).Decode(&explanation)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(explanation.MaxInFlight).To(Equal(1))
+							Expect(explanation.SerialGroups).To(Equal([]string{"some-group"}))
+							Expect(explanation.RunningBuilds).To(HaveLen(1))
+							Expect(explanation.RunningBuilds[0].JobName).To(Equal("other-job"))
+							Expect(explanation.PendingBuilds).To(Equal(1))
+							Expect(explanation.BlockedBy).To(Equal([]string{"max in flight of 1 reached"}))
+						})
+					})
+
+					Context("when another job's build is next in the serial group", func() {
+						var otherPendingBuild *dbfakes.FakeBuild
+
+						BeforeEach(func() {
+							otherPendingBuild = new(dbfakes.FakeBuild)
+							otherPendingBuild.IDReturns(3)
+							otherPendingBuild.NameReturns("4")
+							otherPendingBuild.JobNameReturns("other-job")
+
+							fakeJob.InFlightBuildsReturns(nil, otherPendingBuild, true, nil)
+						})
+
+						It("explains that builds are waiting on the serial group", func() {
+							var explanation atc.SchedulingExplanation
+							err := json.NewDecoder(response.Body).Decode(&explanation)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(explanation.BlockedBy).To(Equal([]string{"waiting for build 4 of job other-job in serial group"}))
+						})
+					})
+
+					Context("when the job's build is next", func() {
+						BeforeEach(func() {
+							fakeJob.InFlightBuildsReturns(nil, pendingBuild, true, nil)
+						})
+
+						It("is not blocked", func() {
+							var explanation atc.SchedulingExplanation
+							err := json.NewDecoder(response.Body).Decode(&explanation)
+							Expect(err).NotTo(HaveOccurred())
+
+							Expect(explanation.BlockedBy).To(BeEmpty())
+						})
+					})
+				})
+
+				Context("when computing the inputs fails", func() {
+					BeforeEach(func() {
+						fakeAlgorithm.ComputeReturns(nil, false, false, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+
+				Context("when getting the in flight builds fails", func() {
+					BeforeEach(func() {
+						fakeJob.ConfigReturns(atc.JobConfig{Name: "some-job", Serial: true}, nil)
+						fakeJob.InFlightBuildsReturns(nil, nil, false, errors.New("nope"))
+					})
+
+					It("returns 500", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+		})
+	})
+
 	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", func() {
 		var response *http.Response
 
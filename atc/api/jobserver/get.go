@@ -46,12 +46,16 @@ func (s *Server) GetJob(pipeline db.Pipeline) http.Handler {
 			return
 		}
 
-		teamName := r.FormValue(":team_name")
+		config, err := job.Config()
+		if err != nil {
+			logger.Error("could-not-get-job-config", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
 
-		w.Header().Set("Content-Type", "application/json")
-		w.WriteHeader(http.StatusOK)
+		teamName := r.FormValue(":team_name")
 
-		err = json.NewEncoder(w).Encode(present.Job(
+		presentedJob := present.Job(
 			teamName,
 			job,
 			inputs,
@@ -59,7 +63,21 @@ func (s *Server) GetJob(pipeline db.Pipeline) http.Handler {
 			finished,
 			next,
 			nil,
-		))
+		)
+
+		if config.Schedule != nil && !job.Paused() && !pipeline.Paused() {
+			schedule, err := config.Schedule.Parse()
+			if err != nil {
+				logger.Error("could-not-parse-job-schedule", err)
+			} else {
+				presentedJob.NextScheduledTime = schedule.Next(job.ScheduleLastFired()).Unix()
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(presentedJob)
 		if err != nil {
 			logger.Error("failed-to-encode-job", err)
 			w.WriteHeader(http.StatusInternalServerError)
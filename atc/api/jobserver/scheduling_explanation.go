@@ -0,0 +1,233 @@
+package jobserver
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"sort"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// GetJobSchedulingExplanation computes the job's next build inputs in the same
+// way as the scheduler, without saving them, and reports why the job's pending
+// builds would or would not be started.
+func (s *Server) GetJobSchedulingExplanation(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("get-job-scheduling-explanation")
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		jobName := r.FormValue(":job_name")
+
+		job, found, err := pipeline.Job(jobName)
+		if err != nil {
+			logger.Error("failed-to-get-job", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		explanation, err := s.explainInputs(r.Context(), pipeline, job)
+		if err != nil {
+			logger.Error("failed-to-explain-inputs", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		err = s.explainBuildStarter(pipeline, job, &explanation)
+		if err != nil {
+			logger.Error("failed-to-explain-build-starter", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+
+		err = json.NewEncoder(w).Encode(explanation)
+		if err != nil {
+			logger.Error("failed-to-encode-explanation", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) explainInputs(ctx context.Context, pipeline db.Pipeline, job db.Job) (atc.SchedulingExplanation, error) {
+	jobInputs, err := job.Inputs()
+	if err != nil {
+		return atc.SchedulingExplanation{}, fmt.Errorf("get inputs: %w", err)
+	}
+
+	inputConfigs, err := job.AlgorithmInputs()
+	if err != nil {
+		return atc.SchedulingExplanation{}, fmt.Errorf("get algorithm inputs: %w", err)
+	}
+
+	inputMapping, resolved, _, err := s.algorithm.Compute(ctx, job, inputConfigs)
+	if err != nil {
+		return atc.SchedulingExplanation{}, fmt.Errorf("compute inputs: %w", err)
+	}
+
+	versions, err := job.InputMappingVersions(inputMapping)
+	if err != nil {
+		return atc.SchedulingExplanation{}, fmt.Errorf("get input versions: %w", err)
+	}
+
+	resources, err := pipeline.Resources()
+	if err != nil {
+		return atc.SchedulingExplanation{}, fmt.Errorf("get resources: %w", err)
+	}
+
+	jobs, err := pipeline.Jobs()
+	if err != nil {
+		return atc.SchedulingExplanation{}, fmt.Errorf("get jobs: %w", err)
+	}
+
+	jobNames := map[int]string{}
+	for _, j := range jobs {
+		jobNames[j.ID()] = j.Name()
+	}
+
+	explanation := atc.SchedulingExplanation{
+		Resolved: resolved,
+		Inputs:   []atc.InputExplanation{},
+	}
+
+	for _, jobInput := range jobInputs {
+		input := atc.InputExplanation{
+			Name:     jobInput.Name,
+			Resource: jobInput.Resource,
+			Trigger:  jobInput.Trigger,
+			Passed:   jobInput.Passed,
+		}
+
+		var inputConfig db.InputConfig
+		for _, config := range inputConfigs {
+			if config.Name == jobInput.Name {
+				inputConfig = config
+				break
+			}
+		}
+
+		input.PinnedVersion = inputConfig.PinnedVersion
+
+		result, found := inputMapping[jobInput.Name]
+		if found && result.Input != nil {
+			input.Version = versions[jobInput.Name]
+			input.FirstOccurrence = result.Input.FirstOccurrence
+		}
+
+		if found && result.ResolveError != "" {
+			input.ResolveError = string(result.ResolveError)
+
+			if resource, found := resources.Lookup(jobInput.Resource); found {
+				input.DisabledVersions, err = resource.DisabledVersions()
+				if err != nil {
+					return atc.SchedulingExplanation{}, fmt.Errorf("get disabled versions: %w", err)
+				}
+			}
+
+			input.FailedPassed, err = s.failedPassedConstraints(ctx, job, inputConfig, jobNames)
+			if err != nil {
+				return atc.SchedulingExplanation{}, err
+			}
+		}
+
+		explanation.Inputs = append(explanation.Inputs, input)
+	}
+
+	return explanation, nil
+}
+
+// failedPassedConstraints resolves the input against each of its passed
+// constraints individually, returning the names of the jobs whose constraint
+// cannot be satisfied on its own. If none are returned but the input still
+// failed to resolve, it is the combination of constraints (possibly with other
+// inputs sharing the same passed jobs) that cannot be satisfied.
+func (s *Server) failedPassedConstraints(ctx context.Context, job db.Job, inputConfig db.InputConfig, jobNames map[int]string) ([]string, error) {
+	var failed []string
+
+	for passedJobID := range inputConfig.Passed {
+		constrained := inputConfig
+		constrained.Passed = db.JobSet{passedJobID: true}
+
+		_, resolved, _, err := s.algorithm.Compute(ctx, job, db.InputConfigs{constrained})
+		if err != nil {
+			return nil, fmt.Errorf("compute passed constraint: %w", err)
+		}
+
+		if !resolved {
+			name, found := jobNames[passedJobID]
+			if !found {
+				name = inputConfig.ExternalPassed[passedJobID].Name
+			}
+
+			failed = append(failed, name)
+		}
+	}
+
+	sort.Strings(failed)
+
+	return failed, nil
+}
+
+func (s *Server) explainBuildStarter(pipeline db.Pipeline, job db.Job, explanation *atc.SchedulingExplanation) error {
+	config, err := job.Config()
+	if err != nil {
+		return fmt.Errorf("get config: %w", err)
+	}
+
+	pendingBuilds, err := job.GetPendingBuilds()
+	if err != nil {
+		return fmt.Errorf("get pending builds: %w", err)
+	}
+
+	explanation.Paused = job.Paused()
+	explanation.PipelinePaused = pipeline.Paused()
+	explanation.MaxInFlight = config.MaxInFlight()
+	explanation.PendingBuilds = len(pendingBuilds)
+
+	if explanation.PipelinePaused {
+		explanation.BlockedBy = append(explanation.BlockedBy, "pipeline is paused")
+	}
+
+	if explanation.Paused {
+		explanation.BlockedBy = append(explanation.BlockedBy, "job is paused")
+	}
+
+	if explanation.MaxInFlight == 0 {
+		return nil
+	}
+
+	explanation.SerialGroups = config.SerialGroups
+	if len(explanation.SerialGroups) == 0 {
+		explanation.SerialGroups = []string{config.Name}
+	}
+
+	running, nextPending, found, err := job.InFlightBuilds()
+	if err != nil {
+		return fmt.Errorf("get in flight builds: %w", err)
+	}
+
+	for _, build := range running {
+		explanation.RunningBuilds = append(explanation.RunningBuilds, present.Build(build))
+	}
+
+	if found {
+		presented := present.Build(nextPending)
+		explanation.NextPendingBuild = &presented
+	}
+
+	if len(running) >= explanation.MaxInFlight {
+		explanation.BlockedBy = append(explanation.BlockedBy, fmt.Sprintf("max in flight of %d reached", explanation.MaxInFlight))
+	} else if found && len(pendingBuilds) > 0 && nextPending.ID() != pendingBuilds[0].ID() {
+		explanation.BlockedBy = append(explanation.BlockedBy, fmt.Sprintf("waiting for build %s of job %s in serial group", nextPending.Name(), nextPending.JobName()))
+	}
+
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/concourse/concourse/atc/api/auth"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/scheduler"
 )
 
 type Server struct {
@@ -15,6 +16,7 @@ type Server struct {
 	secretManager creds.Secrets
 	jobFactory    db.JobFactory
 	checkFactory  db.CheckFactory
+	algorithm     scheduler.Algorithm
 }
 
 func NewServer(
@@ -23,6 +25,7 @@ func NewServer(
 	secretManager creds.Secrets,
 	jobFactory db.JobFactory,
 	checkFactory db.CheckFactory,
+	algorithm scheduler.Algorithm,
 ) *Server {
 	return &Server{
 		logger:        logger,
@@ -31,5 +34,6 @@ func NewServer(
 		secretManager: secretManager,
 		jobFactory:    jobFactory,
 		checkFactory:  checkFactory,
+		algorithm:     algorithm,
 	}
 }
@@ -0,0 +1,397 @@
+package api_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Notifications API", func() {
+	var (
+		response *http.Response
+		fakeTeam *dbfakes.FakeTeam
+	)
+
+	BeforeEach(func() {
+		fakeTeam = new(dbfakes.FakeTeam)
+		dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+	})
+
+	Describe("GET /api/v1/teams/:team_name/notifications", func() {
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/notifications")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(fakeTeam.NotificationSubscriptionsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeTeam.NotificationSubscriptionsCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when getting the subscriptions succeeds", func() {
+				BeforeEach(func() {
+					fakeTeam.NotificationSubscriptionsReturns([]atc.NotificationSubscription{
+						{
+							Name:   "some-subscription",
+							URL:    "https://example.com/hook",
+							Events: []atc.NotificationEvent{atc.NotificationBuildFinished},
+						},
+					}, nil)
+				})
+
+				It("returns the subscriptions", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response).To(IncludeHeaderEntries(map[string]string{
+						"Content-Type": "application/json",
+					}))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`[
+						{
+							"name": "some-subscription",
+							"url": "https://example.com/hook",
+							"events": ["build_finished"]
+						}
+					]`))
+				})
+			})
+
+			Context("when getting the subscriptions fails", func() {
+				BeforeEach(func() {
+					fakeTeam.NotificationSubscriptionsReturns(nil, errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/notifications/:notification_name", func() {
+		var body string
+
+		BeforeEach(func() {
+			body = `{
+				"url": "https://example.com/hook",
+				"secret": "some-secret",
+				"events": ["build_started", "resource_check_failed"]
+			}`
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/some-team/notifications/some-subscription", bytes.NewBufferString(body))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeTeam.SaveNotificationSubscriptionCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("saves the subscription under the name in the url", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+				Expect(fakeTeam.SaveNotificationSubscriptionCallCount()).To(Equal(1))
+				Expect(fakeTeam.SaveNotificationSubscriptionArgsForCall(0)).To(Equal(atc.NotificationSubscription{
+					Name:   "some-subscription",
+					URL:    "https://example.com/hook",
+					Secret: "some-secret",
+					Events: []atc.NotificationEvent{
+						atc.NotificationBuildStarted,
+						atc.NotificationResourceCheckFailed,
+					},
+				}))
+			})
+
+			Context("when the subscription is invalid", func() {
+				BeforeEach(func() {
+					body = `{
+						"url": "https://example.com/hook",
+						"secret": "some-secret",
+						"events": ["build_exploded"]
+					}`
+				})
+
+				It("returns 400 with the reason", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					reason, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(reason)).To(ContainSubstring("unknown event 'build_exploded'"))
+
+					Expect(fakeTeam.SaveNotificationSubscriptionCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the request is malformed", func() {
+				BeforeEach(func() {
+					body = `{`
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when saving the subscription fails", func() {
+				BeforeEach(func() {
+					fakeTeam.SaveNotificationSubscriptionReturns(errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("DELETE /api/v1/teams/:team_name/notifications/:notification_name", func() {
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("DELETE", server.URL+"/api/v1/teams/some-team/notifications/some-subscription", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the subscription exists", func() {
+				BeforeEach(func() {
+					fakeTeam.DestroyNotificationSubscriptionReturns(true, nil)
+				})
+
+				It("destroys it", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+					Expect(fakeTeam.DestroyNotificationSubscriptionArgsForCall(0)).To(Equal("some-subscription"))
+				})
+			})
+
+			Context("when the subscription does not exist", func() {
+				BeforeEach(func() {
+					fakeTeam.DestroyNotificationSubscriptionReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/notifications/:notification_name/deliveries", func() {
+		var query string
+
+		BeforeEach(func() {
+			query = ""
+		})
+
+		JustBeforeEach(func() {
+			var err error
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/notifications/some-subscription/deliveries" + query)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the subscription exists", func() {
+				BeforeEach(func() {
+					fakeTeam.NotificationDeliveriesReturns([]atc.NotificationDelivery{
+						{
+							ID:             3,
+							Event:          atc.NotificationBuildFinished,
+							Status:         atc.NotificationDeliveryFailed,
+							Attempts:       5,
+							ResponseStatus: 500,
+							LastError:      "unexpected response: 500 Internal Server Error",
+							CreatedAt:      123,
+						},
+					}, true, nil)
+				})
+
+				It("returns its deliveries", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`[
+						{
+							"id": 3,
+							"event": "build_finished",
+							"status": "failed",
+							"attempts": 5,
+							"response_status": 500,
+							"last_error": "unexpected response: 500 Internal Server Error",
+							"created_at": 123
+						}
+					]`))
+
+					name, limit := fakeTeam.NotificationDeliveriesArgsForCall(0)
+					Expect(name).To(Equal("some-subscription"))
+					Expect(limit).To(Equal(50))
+				})
+
+				Context("when a limit is given", func() {
+					BeforeEach(func() {
+						query = "?limit=5"
+					})
+
+					It("passes it through", func() {
+						_, limit := fakeTeam.NotificationDeliveriesArgsForCall(0)
+						Expect(limit).To(Equal(5))
+					})
+				})
+
+				Context("when the limit is invalid", func() {
+					BeforeEach(func() {
+						query = "?limit=-1"
+					})
+
+					It("returns 400", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeTeam.NotificationDeliveriesCallCount()).To(BeZero())
+					})
+				})
+			})
+
+			Context("when the subscription does not exist", func() {
+				BeforeEach(func() {
+					fakeTeam.NotificationDeliveriesReturns(nil, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+		})
+	})
+
+	Describe("PUT /api/v1/teams/:team_name/notifications/:notification_name/deliveries/:delivery_id/redeliver", func() {
+		var deliveryID string
+
+		BeforeEach(func() {
+			deliveryID = "3"
+		})
+
+		JustBeforeEach(func() {
+			req, err := http.NewRequest("PUT", server.URL+"/api/v1/teams/some-team/notifications/some-subscription/deliveries/"+deliveryID+"/redeliver", nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(req)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeTeam.RedeliverNotificationCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the delivery exists", func() {
+				BeforeEach(func() {
+					fakeTeam.RedeliverNotificationReturns(true, nil)
+				})
+
+				It("queues it to be delivered again", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNoContent))
+
+					name, id := fakeTeam.RedeliverNotificationArgsForCall(0)
+					Expect(name).To(Equal("some-subscription"))
+					Expect(id).To(Equal(3))
+				})
+			})
+
+			Context("when the delivery does not exist", func() {
+				BeforeEach(func() {
+					fakeTeam.RedeliverNotificationReturns(false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the delivery id is invalid", func() {
+				BeforeEach(func() {
+					deliveryID = "nope"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(fakeTeam.RedeliverNotificationCallCount()).To(BeZero())
+				})
+			})
+		})
+	})
+})
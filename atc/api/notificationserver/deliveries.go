@@ -0,0 +1,73 @@
+package notificationserver
+
+import (
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+const defaultDeliveriesLimit = 50
+
+func (s *Server) ListNotificationDeliveries(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("list-notification-deliveries")
+
+		limit := defaultDeliveriesLimit
+		if limitStr := r.FormValue("limit"); limitStr != "" {
+			var err error
+			limit, err = strconv.Atoi(limitStr)
+			if err != nil || limit <= 0 {
+				http.Error(w, "invalid limit", http.StatusBadRequest)
+				return
+			}
+		}
+
+		deliveries, found, err := team.NotificationDeliveries(r.FormValue(":notification_name"), limit)
+		if err != nil {
+			logger.Error("failed-to-get-notification-deliveries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(deliveries)
+		if err != nil {
+			logger.Error("failed-to-encode-notification-deliveries", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) RedeliverNotification(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("redeliver-notification")
+
+		deliveryID, err := strconv.Atoi(r.FormValue(":delivery_id"))
+		if err != nil {
+			http.Error(w, "invalid delivery id", http.StatusBadRequest)
+			return
+		}
+
+		redelivered, err := team.RedeliverNotification(r.FormValue(":notification_name"), deliveryID)
+		if err != nil {
+			logger.Error("failed-to-redeliver-notification", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !redelivered {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,27 @@
+package notificationserver
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) DestroyNotificationSubscription(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("destroy-notification-subscription")
+
+		destroyed, err := team.DestroyNotificationSubscription(r.FormValue(":notification_name"))
+		if err != nil {
+			logger.Error("failed-to-destroy-notification-subscription", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !destroyed {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,29 @@
+package notificationserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListNotificationSubscriptions(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("list-notification-subscriptions")
+
+		subscriptions, err := team.NotificationSubscriptions()
+		if err != nil {
+			logger.Error("failed-to-get-notification-subscriptions", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(subscriptions)
+		if err != nil {
+			logger.Error("failed-to-encode-notification-subscriptions", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,41 @@
+package notificationserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) SetNotificationSubscription(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("set-notification-subscription")
+
+		var subscription atc.NotificationSubscription
+		err := json.NewDecoder(r.Body).Decode(&subscription)
+		if err != nil {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			http.Error(w, "malformed request: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		subscription.Name = r.FormValue(":notification_name")
+
+		err = subscription.Validate()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = team.SaveNotificationSubscription(subscription)
+		if err != nil {
+			logger.Error("failed-to-save-notification-subscription", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
@@ -0,0 +1,281 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pipeline Config History API", func() {
+	var (
+		response   *http.Response
+		fakeTeam   *dbfakes.FakeTeam
+		dbPipeline *dbfakes.FakePipeline
+	)
+
+	BeforeEach(func() {
+		fakeTeam = new(dbfakes.FakeTeam)
+		dbPipeline = new(dbfakes.FakePipeline)
+
+		dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+		fakeTeam.PipelineReturns(dbPipeline, true, nil)
+	})
+
+	get := func(path string) {
+		var err error
+		response, err = client.Get(server.URL + "/api/v1/teams/a-team/pipelines/a-pipeline/config/history" + path)
+		Expect(err).NotTo(HaveOccurred())
+	}
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/config/history", func() {
+		JustBeforeEach(func() {
+			get("")
+		})
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the history can be loaded", func() {
+				BeforeEach(func() {
+					dbPipeline.ConfigHistoryReturns([]atc.PipelineConfigRevision{
+						{Revision: 2, CreatedBy: "some-user", CreatedAt: 200},
+						{Revision: 1, BuildID: 42, CreatedAt: 100},
+					}, nil)
+				})
+
+				It("returns the revisions", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(response).To(IncludeHeaderEntries(map[string]string{
+						"Content-Type": "application/json",
+					}))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`[
+						{"revision": 2, "created_by": "some-user", "created_at": 200},
+						{"revision": 1, "build_id": 42, "created_at": 100}
+					]`))
+				})
+			})
+
+			Context("when loading the history fails", func() {
+				BeforeEach(func() {
+					dbPipeline.ConfigHistoryReturns(nil, errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/config/history/:revision", func() {
+		var revision string
+
+		BeforeEach(func() {
+			revision = "1"
+		})
+
+		JustBeforeEach(func() {
+			get("/" + revision)
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the revision exists", func() {
+				BeforeEach(func() {
+					dbPipeline.ConfigRevisionReturns(atc.PipelineConfigRevision{
+						Revision:  1,
+						CreatedBy: "some-user",
+						CreatedAt: 100,
+						Config: &atc.Config{
+							Resources: atc.ResourceConfigs{
+								{Name: "some-resource", Type: "some-type"},
+							},
+						},
+					}, true, nil)
+				})
+
+				It("returns the revision with its config", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(dbPipeline.ConfigRevisionArgsForCall(0)).To(Equal(1))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`{
+						"revision": 1,
+						"created_by": "some-user",
+						"created_at": 100,
+						"config": {
+							"resources": [{"name": "some-resource", "type": "some-type", "source": null}]
+						}
+					}`))
+				})
+			})
+
+			Context("when the revision does not exist", func() {
+				BeforeEach(func() {
+					dbPipeline.ConfigRevisionReturns(atc.PipelineConfigRevision{}, false, nil)
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the revision is not a number", func() {
+				BeforeEach(func() {
+					revision = "latest"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbPipeline.ConfigRevisionCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when loading the revision fails", func() {
+				BeforeEach(func() {
+					dbPipeline.ConfigRevisionReturns(atc.PipelineConfigRevision{}, false, errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/config/history/:revision/diff", func() {
+		var query string
+
+		BeforeEach(func() {
+			query = ""
+
+			dbPipeline.ConfigRevisionStub = func(revision int) (atc.PipelineConfigRevision, bool, error) {
+				switch revision {
+				case 1:
+					return atc.PipelineConfigRevision{
+						Revision: 1,
+						Config: &atc.Config{
+							Resources: atc.ResourceConfigs{
+								{Name: "some-resource", Type: "some-type"},
+							},
+						},
+					}, true, nil
+				case 2:
+					return atc.PipelineConfigRevision{
+						Revision: 2,
+						Config: &atc.Config{
+							Resources: atc.ResourceConfigs{
+								{Name: "some-resource", Type: "some-type"},
+								{Name: "another-resource", Type: "some-type"},
+							},
+						},
+					}, true, nil
+				}
+
+				return atc.PipelineConfigRevision{}, false, nil
+			}
+		})
+
+		JustBeforeEach(func() {
+			get("/2/diff" + query)
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("diffs the revision against the one before it", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response).To(IncludeHeaderEntries(map[string]string{
+					"Content-Type": "text/plain",
+				}))
+
+				Expect(dbPipeline.ConfigRevisionCallCount()).To(Equal(2))
+				Expect(dbPipeline.ConfigRevisionArgsForCall(0)).To(Equal(2))
+				Expect(dbPipeline.ConfigRevisionArgsForCall(1)).To(Equal(1))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(body)).To(ContainSubstring("resource another-resource has been added:"))
+				Expect(string(body)).ToNot(ContainSubstring("some-resource"))
+				Expect(string(body)).ToNot(ContainSubstring("\x1b["))
+				Expect(string(body)).ToNot(ContainSubstring("\b"))
+				Expect(string(body)).To(ContainSubstring("+ name: another-resource"))
+			})
+
+			Context("when diffing against a revision that does not exist", func() {
+				BeforeEach(func() {
+					query = "?against=3"
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the other revision is malformed", func() {
+				BeforeEach(func() {
+					query = "?against=previous"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when diffing against no revision", func() {
+				BeforeEach(func() {
+					query = "?against=0"
+				})
+
+				It("shows everything in the revision as added", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(string(body)).To(ContainSubstring("resource some-resource has been added:"))
+					Expect(string(body)).To(ContainSubstring("resource another-resource has been added:"))
+				})
+			})
+		})
+	})
+})
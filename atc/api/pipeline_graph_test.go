@@ -0,0 +1,183 @@
+package api_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/testhelpers"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pipeline Graph API", func() {
+	var (
+		response   *http.Response
+		query      string
+		fakeTeam   *dbfakes.FakeTeam
+		dbPipeline *dbfakes.FakePipeline
+	)
+
+	BeforeEach(func() {
+		query = ""
+
+		fakeTeam = new(dbfakes.FakeTeam)
+		dbPipeline = new(dbfakes.FakePipeline)
+
+		dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+		fakeTeam.PipelineReturns(dbPipeline, true, nil)
+
+		dbPipeline.ConfigReturns(atc.Config{
+			Resources: atc.ResourceConfigs{
+				{Name: "repo", Type: "git"},
+			},
+			Jobs: atc.JobConfigs{
+				{
+					Name: "build",
+					PlanSequence: []atc.Step{
+						{Config: &atc.GetStep{Name: "repo", Trigger: true}},
+					},
+				},
+				{
+					Name: "deploy",
+					PlanSequence: []atc.Step{
+						{Config: &atc.GetStep{Name: "repo", Passed: []string{"build"}}},
+					},
+				},
+			},
+		}, nil)
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		response, err = client.Get(server.URL + "/api/v1/teams/a-team/pipelines/a-pipeline/graph" + query)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/graph", func() {
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			Context("when the pipeline is private", func() {
+				It("returns 403", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				})
+			})
+
+			Context("when the pipeline is public", func() {
+				BeforeEach(func() {
+					dbPipeline.PublicReturns(true)
+				})
+
+				It("returns 200", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+				})
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("returns the whole graph", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response).To(IncludeHeaderEntries(map[string]string{
+					"Content-Type": "application/json",
+				}))
+
+				body, err := ioutil.ReadAll(response.Body)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(body).To(MatchJSON(`{
+					"nodes": [
+						{"id": "job:build", "kind": "job", "name": "build"},
+						{"id": "job:deploy", "kind": "job", "name": "deploy"},
+						{"id": "resource:repo", "kind": "resource", "name": "repo", "resource_type": "git"}
+					],
+					"edges": [
+						{"source": "resource:repo", "target": "job:build", "resource": "repo", "trigger": true},
+						{"source": "job:build", "target": "job:deploy", "resource": "repo", "trigger": false}
+					]
+				}`))
+			})
+
+			Context("when traversing from a job", func() {
+				BeforeEach(func() {
+					query = "?from=build&direction=downstream"
+				})
+
+				It("returns the part of the graph downstream of it", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`{
+						"nodes": [
+							{"id": "job:build", "kind": "job", "name": "build"},
+							{"id": "job:deploy", "kind": "job", "name": "deploy"}
+						],
+						"edges": [
+							{"source": "job:build", "target": "job:deploy", "resource": "repo", "trigger": false}
+						]
+					}`))
+				})
+			})
+
+			Context("when traversing upstream from a job", func() {
+				BeforeEach(func() {
+					query = "?from=build&direction=upstream"
+				})
+
+				It("returns the part of the graph upstream of it", func() {
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`{
+						"nodes": [
+							{"id": "job:build", "kind": "job", "name": "build"},
+							{"id": "resource:repo", "kind": "resource", "name": "repo", "resource_type": "git"}
+						],
+						"edges": [
+							{"source": "resource:repo", "target": "job:build", "resource": "repo", "trigger": true}
+						]
+					}`))
+				})
+			})
+
+			Context("when traversing from a job which does not exist", func() {
+				BeforeEach(func() {
+					query = "?from=bogus"
+				})
+
+				It("returns 404", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusNotFound))
+				})
+			})
+
+			Context("when the direction is invalid", func() {
+				BeforeEach(func() {
+					query = "?from=build&direction=sideways"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbPipeline.ConfigCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when getting the config fails", func() {
+				BeforeEach(func() {
+					dbPipeline.ConfigReturns(atc.Config{}, errors.New("disaster"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
+})
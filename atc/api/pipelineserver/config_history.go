@@ -0,0 +1,128 @@
+package pipelineserver
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListPipelineConfigHistory(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("list-pipeline-config-history")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		history, err := pipeline.ConfigHistory()
+		if err != nil {
+			logger.Error("failed-to-get-config-history", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(history)
+		if err != nil {
+			logger.Error("failed-to-encode-config-history", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) GetPipelineConfigRevision(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("get-pipeline-config-revision")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		revision, err := strconv.Atoi(r.FormValue(":revision"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		configRevision, found, err := pipeline.ConfigRevision(revision)
+		if err != nil {
+			logger.Error("failed-to-get-config-revision", err, lager.Data{"revision": revision})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(configRevision)
+		if err != nil {
+			logger.Error("failed-to-encode-config-revision", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+// DiffPipelineConfigRevisions renders the changes made by a revision as plain
+// text. By default the revision is compared with the one before it; the
+// 'against' query parameter compares it with any other revision instead.
+func (s *Server) DiffPipelineConfigRevisions(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("diff-pipeline-config-revisions")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		revision, err := strconv.Atoi(r.FormValue(":revision"))
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		against := revision - 1
+		if r.FormValue("against") != "" {
+			against, err = strconv.Atoi(r.FormValue("against"))
+			if err != nil {
+				http.Error(w, "malformed 'against' revision", http.StatusBadRequest)
+				return
+			}
+		}
+
+		newer, found, err := pipeline.ConfigRevision(revision)
+		if err != nil {
+			logger.Error("failed-to-get-config-revision", err, lager.Data{"revision": revision})
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !found {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		// the first revision is compared with an empty config, so that the
+		// diff shows everything it introduced
+		older := atc.PipelineConfigRevision{Config: &atc.Config{}}
+		if against > 0 {
+			older, found, err = pipeline.ConfigRevision(against)
+			if err != nil {
+				logger.Error("failed-to-get-config-revision", err, lager.Data{"revision": against})
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+
+			if !found {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+		}
+
+		diff := new(bytes.Buffer)
+		older.Config.Diff(atc.PlainWriter(diff), *newer.Config)
+
+		w.Header().Set("Content-Type", "text/plain")
+
+		_, err = w.Write(diff.Bytes())
+		if err != nil {
+			logger.Error("failed-to-write-diff", err)
+		}
+	})
+}
@@ -0,0 +1,58 @@
+package pipelineserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// GetPipelineGraph returns the dependency graph of the pipeline's jobs and
+// resources. Given a job in the 'from' query parameter, only the part of the
+// graph upstream or downstream of it is returned, according to 'direction'.
+func (s *Server) GetPipelineGraph(pipeline db.Pipeline) http.Handler {
+	logger := s.logger.Session("get-pipeline-graph")
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		from := r.URL.Query().Get("from")
+
+		direction := r.URL.Query().Get("direction")
+		switch direction {
+		case "":
+			direction = atc.PipelineGraphDownstream
+		case atc.PipelineGraphUpstream, atc.PipelineGraphDownstream:
+		default:
+			http.Error(w, fmt.Sprintf("invalid direction '%s'", direction), http.StatusBadRequest)
+			return
+		}
+
+		config, err := pipeline.Config()
+		if err != nil {
+			logger.Error("failed-to-get-config", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		graph := atc.NewPipelineGraph(config)
+
+		if from != "" {
+			id := atc.PipelineGraphJobID(from)
+			if _, found := graph.Node(id); !found {
+				w.WriteHeader(http.StatusNotFound)
+				return
+			}
+
+			graph = graph.Traverse(id, direction)
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(graph)
+		if err != nil {
+			logger.Error("failed-to-encode-graph", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
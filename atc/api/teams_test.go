@@ -5,6 +5,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net/http"
 	"time"
@@ -15,6 +16,7 @@ import (
 	. "github.com/concourse/concourse/atc/testhelpers"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/vito/go-sse/sse"
 )
 
 func jsonEncode(object interface{}) *bytes.Buffer {
@@ -82,6 +84,7 @@ var _ = Describe("Teams API", func() {
 					"groups": []string{}, "users": []string{"local:username"},
 				},
 			})
+			fakeTeamThree.QuotaReturns(atc.TeamQuota{MaxActiveTasks: 4})
 
 			fakeAccess.IsAuthorizedReturnsOnCall(0, true)
 			fakeAccess.IsAuthorizedReturnsOnCall(1, false)
@@ -106,7 +109,8 @@ var _ = Describe("Teams API", func() {
  					{
  						"id": 22,
  						"name": "predators",
-						"auth": { "owner":{"users":["local:username"],"groups":[]}}
+						"auth": { "owner":{"users":["local:username"],"groups":[]}},
+						"quota": { "max_active_tasks": 4 }
  					}
  				]`))
 			})
@@ -272,6 +276,23 @@ var _ = Describe("Teams API", func() {
 						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
 					})
 				})
+
+				It("leaves the quota alone when none is given", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeTeam.UpdateQuotaCallCount()).To(Equal(0))
+				})
+
+				Context("when the quota is invalid", func() {
+					BeforeEach(func() {
+						atcTeam.Quota = &atc.TeamQuota{MaxActiveTasks: -1}
+					})
+
+					It("does not update the team", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+						Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
+						Expect(fakeTeam.UpdateQuotaCallCount()).To(Equal(0))
+					})
+				})
 				Context("when provider auth is empty", func() {
 					BeforeEach(func() {
 						atcTeam = atc.Team{}
@@ -314,6 +335,32 @@ var _ = Describe("Teams API", func() {
 
 			authorizedTeamTests()
 
+			Context("when the team exists and a quota is given", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+					atcTeam.Quota = &atc.TeamQuota{MaxActiveTasks: 2, MaxActiveContainers: 10}
+				})
+
+				It("updates the quota", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeTeam.UpdateQuotaCallCount()).To(Equal(1))
+					Expect(fakeTeam.UpdateQuotaArgsForCall(0)).To(Equal(atc.TeamQuota{
+						MaxActiveTasks:      2,
+						MaxActiveContainers: 10,
+					}))
+				})
+
+				Context("when updating the quota fails", func() {
+					BeforeEach(func() {
+						fakeTeam.UpdateQuotaReturns(errors.New("nope"))
+					})
+
+					It("returns 500 Internal Server error", func() {
+						Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+					})
+				})
+			})
+
 			Context("when the team is not found", func() {
 				BeforeEach(func() {
 					dbTeamFactory.FindTeamReturns(nil, false, nil)
@@ -382,6 +429,33 @@ var _ = Describe("Teams API", func() {
 
 			authorizedTeamTests()
 
+			Context("when the team exists and a different quota is given", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+					fakeTeam.QuotaReturns(atc.TeamQuota{MaxActiveTasks: 2})
+					atcTeam.Quota = &atc.TeamQuota{MaxActiveTasks: 20}
+				})
+
+				It("returns 403 without updating the team", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(0))
+					Expect(fakeTeam.UpdateQuotaCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when the team exists and its current quota is given", func() {
+				BeforeEach(func() {
+					dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+					fakeTeam.QuotaReturns(atc.TeamQuota{MaxActiveTasks: 2})
+					atcTeam.Quota = &atc.TeamQuota{MaxActiveTasks: 2}
+				})
+
+				It("updates the team", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+					Expect(fakeTeam.UpdateProviderAuthCallCount()).To(Equal(1))
+				})
+			})
+
 			Context("when the team is not found", func() {
 				BeforeEach(func() {
 					dbTeamFactory.FindTeamReturns(nil, false, nil)
@@ -396,6 +470,136 @@ var _ = Describe("Teams API", func() {
 		})
 	})
 
+	Describe("PUT /api/v1/teams", func() {
+		var (
+			setRequest atc.SetTeamsRequest
+			response   *http.Response
+		)
+
+		BeforeEach(func() {
+			setRequest = atc.SetTeamsRequest{
+				Teams: atc.Teams{
+					{
+						Name: "some-team",
+						Auth: atc.TeamAuth{
+							"owner": {"users": []string{"local:some-user"}},
+						},
+					},
+				},
+				DeleteUnlisted: true,
+			}
+		})
+
+		JustBeforeEach(func() {
+			request, err := http.NewRequest("PUT", server.URL+"/api/v1/teams", jsonEncode(setRequest))
+			Expect(err).NotTo(HaveOccurred())
+
+			response, err = client.Do(request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the requester is an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAdminReturns(true)
+			})
+
+			Context("when the teams are set", func() {
+				BeforeEach(func() {
+					dbTeamFactory.SetTeamsReturns(db.TeamChanges{
+						Created: []string{"some-team"},
+						Deleted: []string{"some-other-team"},
+					}, nil)
+				})
+
+				It("returns 200 OK with the changes", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`{
+						"created": ["some-team"],
+						"deleted": ["some-other-team"]
+					}`))
+				})
+
+				It("sets the teams in one go", func() {
+					Expect(dbTeamFactory.SetTeamsCallCount()).To(Equal(1))
+					teams, deleteUnlisted := dbTeamFactory.SetTeamsArgsForCall(0)
+					Expect(teams).To(Equal(setRequest.Teams))
+					Expect(deleteUnlisted).To(BeTrue())
+				})
+
+				It("notifies the cacher", func() {
+					Expect(dbTeamFactory.NotifyCacherCallCount()).To(Equal(1))
+				})
+			})
+
+			Context("when a team is configured twice", func() {
+				BeforeEach(func() {
+					setRequest.Teams = append(setRequest.Teams, setRequest.Teams[0])
+				})
+
+				It("returns 400 Bad Request without setting anything", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+					Expect(body).To(MatchJSON(`{
+						"errors": ["team 'some-team' is configured more than once"]
+					}`))
+
+					Expect(dbTeamFactory.SetTeamsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when a team's auth is empty", func() {
+				BeforeEach(func() {
+					setRequest.Teams[0].Auth = atc.TeamAuth{}
+				})
+
+				It("returns 400 Bad Request without setting anything", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+					Expect(dbTeamFactory.SetTeamsCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when it would delete the last admin team", func() {
+				BeforeEach(func() {
+					dbTeamFactory.SetTeamsReturns(db.TeamChanges{}, db.ErrLastAdminTeam)
+				})
+
+				It("returns 403 Forbidden", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+					Expect(dbTeamFactory.NotifyCacherCallCount()).To(Equal(0))
+				})
+			})
+
+			Context("when setting the teams fails", func() {
+				BeforeEach(func() {
+					dbTeamFactory.SetTeamsReturns(db.TeamChanges{}, errors.New("disaster"))
+				})
+
+				It("returns 500 Internal Server Error", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+
+		Context("when the requester is not an admin", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+				fakeAccess.IsAdminReturns(false)
+			})
+
+			It("returns 403 Forbidden", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(dbTeamFactory.SetTeamsCallCount()).To(Equal(0))
+			})
+		})
+	})
+
 	Describe("DELETE /api/v1/teams/:team_name", func() {
 		var request *http.Request
 		var response *http.Response
@@ -789,4 +993,155 @@ var _ = Describe("Teams API", func() {
 			})
 		})
 	})
+	Describe("GET /api/v1/teams/:team_name/events", func() {
+		var (
+			response    *http.Response
+			queryParams string
+
+			fakeEvents *dbfakes.FakeBuildLifecycleSource
+		)
+
+		lifecycleEvent := func(eventType atc.BuildLifecycleEventType, id int, pipelineName string, jobName string) db.BuildLifecycleEvent {
+			build := new(dbfakes.FakeBuild)
+			build.IDReturns(id)
+			build.NameReturns("1")
+			build.TeamNameReturns("some-team")
+			build.PipelineIDReturns(1)
+			build.PipelineNameReturns(pipelineName)
+			build.JobNameReturns(jobName)
+			build.StatusReturns(db.BuildStatusStarted)
+
+			return db.BuildLifecycleEvent{Type: eventType, Build: build}
+		}
+
+		BeforeEach(func() {
+			queryParams = ""
+
+			fakeEvents = new(dbfakes.FakeBuildLifecycleSource)
+			fakeEvents.NextReturnsOnCall(0, lifecycleEvent(atc.BuildCreated, 1, "some-pipeline", "some-job"), nil)
+			fakeEvents.NextReturnsOnCall(1, lifecycleEvent(atc.BuildStarted, 2, "other-pipeline", "some-job"), nil)
+			fakeEvents.NextReturnsOnCall(2, lifecycleEvent(atc.BuildFinished, 3, "some-pipeline", "other-job"), nil)
+			fakeEvents.NextReturns(db.BuildLifecycleEvent{}, db.ErrBuildLifecycleStreamClosed)
+
+			fakeTeam.NameReturns("some-team")
+			fakeTeam.BuildLifecycleEventsReturns(fakeEvents, nil)
+			dbTeamFactory.FindTeamReturns(fakeTeam, true, nil)
+		})
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/some-team/events" + queryParams)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		readEvents := func() []atc.BuildLifecycleEvent {
+			reader := sse.NewReadCloser(response.Body)
+
+			var events []atc.BuildLifecycleEvent
+			for {
+				ev, err := reader.Next()
+				if err == io.EOF {
+					return events
+				}
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ev.Name).To(Equal("event"))
+
+				var lifecycleEvent atc.BuildLifecycleEvent
+				Expect(json.Unmarshal(ev.Data, &lifecycleEvent)).To(Succeed())
+
+				events = append(events, lifecycleEvent)
+			}
+		}
+
+		Context("when not authenticated", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(false)
+			})
+
+			It("returns 401", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusUnauthorized))
+				Expect(fakeTeam.BuildLifecycleEventsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when not authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(false)
+			})
+
+			It("returns 403", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusForbidden))
+				Expect(fakeTeam.BuildLifecycleEventsCallCount()).To(Equal(0))
+			})
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			It("streams the lifecycle events of the team's builds", func() {
+				Expect(response.StatusCode).To(Equal(http.StatusOK))
+				Expect(response.Header.Get("Content-Type")).To(Equal("text/event-stream; charset=utf-8"))
+
+				events := readEvents()
+				Expect(events).To(HaveLen(3))
+
+				Expect(events[0].Type).To(Equal(atc.BuildCreated))
+				Expect(events[0].Build.ID).To(Equal(1))
+				Expect(events[0].Build.TeamName).To(Equal("some-team"))
+				Expect(events[0].Build.PipelineName).To(Equal("some-pipeline"))
+				Expect(events[0].Build.JobName).To(Equal("some-job"))
+				Expect(events[0].Build.Status).To(Equal(atc.StatusStarted))
+
+				Expect(events[1].Type).To(Equal(atc.BuildStarted))
+				Expect(events[2].Type).To(Equal(atc.BuildFinished))
+			})
+
+			It("closes the stream once done", func() {
+				readEvents()
+				Eventually(fakeEvents.CloseCallCount).Should(Equal(1))
+			})
+
+			Context("when filtering by pipeline", func() {
+				BeforeEach(func() {
+					queryParams = "?pipeline=some-pipeline"
+				})
+
+				It("only streams the events of the pipeline's builds", func() {
+					events := readEvents()
+					Expect(events).To(HaveLen(2))
+					Expect(events[0].Build.ID).To(Equal(1))
+					Expect(events[1].Build.ID).To(Equal(3))
+				})
+			})
+
+			Context("when filtering by pipeline and job", func() {
+				BeforeEach(func() {
+					queryParams = "?pipeline=some-pipeline&pipeline=other-pipeline&job=some-job"
+				})
+
+				It("only streams the events of the matching builds", func() {
+					events := readEvents()
+					Expect(events).To(HaveLen(2))
+					Expect(events[0].Build.ID).To(Equal(1))
+					Expect(events[1].Build.ID).To(Equal(2))
+				})
+			})
+
+			Context("when the events can not be streamed", func() {
+				BeforeEach(func() {
+					fakeTeam.BuildLifecycleEventsReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
 })
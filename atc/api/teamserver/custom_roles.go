@@ -0,0 +1,104 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListCustomRoles(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hLog := s.logger.Session("list-custom-roles")
+
+		roles := team.CustomRoles()
+		if roles == nil {
+			roles = atc.CustomRoles{}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err := json.NewEncoder(w).Encode(roles)
+		if err != nil {
+			hLog.Error("failed-to-encode-custom-roles", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
+
+func (s *Server) SetCustomRole(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hLog := s.logger.Session("set-custom-role")
+
+		var role atc.CustomRole
+		err := json.NewDecoder(r.Body).Decode(&role)
+		if err != nil {
+			hLog.Info("malformed-request", lager.Data{"error": err.Error()})
+			http.Error(w, "malformed request: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		role.Name = r.FormValue(":role_name")
+
+		// a custom role may not shadow a built-in role, as team auth configs
+		// could then no longer tell them apart
+		switch role.Name {
+		case accessor.OwnerRole, accessor.MemberRole, accessor.OperatorRole, accessor.ViewerRole:
+			http.Error(w, fmt.Sprintf("'%s' is a built-in role", role.Name), http.StatusBadRequest)
+			return
+		}
+
+		err = role.Validate()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		err = team.SaveCustomRole(role)
+		if err != nil {
+			hLog.Error("failed-to-save-custom-role", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		err = s.teamFactory.NotifyCacher()
+		if err != nil {
+			hLog.Error("failed-to-notify-cacher", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
+
+func (s *Server) DestroyCustomRole(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		hLog := s.logger.Session("destroy-custom-role")
+
+		destroyed, err := team.DestroyCustomRole(r.FormValue(":role_name"))
+		if err != nil {
+			hLog.Error("failed-to-destroy-custom-role", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !destroyed {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		err = s.teamFactory.NotifyCacher()
+		if err != nil {
+			hLog.Error("failed-to-notify-cacher", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
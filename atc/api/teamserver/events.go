@@ -0,0 +1,120 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/present"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/vito/go-sse/sse"
+)
+
+// TeamEvents streams the lifecycle events of the team's builds as
+// Server-Sent Events. The events may be narrowed down to the builds of the
+// given pipelines and jobs.
+func (s *Server) TeamEvents(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("team-events", lager.Data{"team": team.Name()})
+
+		filter := buildLifecycleFilter{
+			pipelines: r.URL.Query()[atc.TeamEventsQueryPipeline],
+			jobs:      r.URL.Query()[atc.TeamEventsQueryJob],
+		}
+
+		events, err := team.BuildLifecycleEvents()
+		if err != nil {
+			logger.Error("failed-to-get-build-lifecycle-events", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		done := make(chan struct{})
+		defer close(done)
+
+		go func() {
+			select {
+			case <-r.Context().Done():
+			case <-done:
+			}
+
+			// also interrupts a pending call to Next
+			db.Close(events)
+		}()
+
+		w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+		w.Header().Add("Cache-Control", "no-cache, no-store, must-revalidate")
+		w.Header().Add("X-Accel-Buffering", "no")
+		w.WriteHeader(http.StatusOK)
+
+		flusher := w.(http.Flusher)
+		flusher.Flush()
+
+		for id := 0; ; {
+			ev, err := events.Next()
+			if err != nil {
+				if err != db.ErrBuildLifecycleStreamClosed {
+					logger.Error("failed-to-get-next-build-lifecycle-event", err)
+				}
+
+				return
+			}
+
+			if !filter.matches(ev.Build) {
+				continue
+			}
+
+			payload, err := json.Marshal(atc.BuildLifecycleEvent{
+				Type:  ev.Type,
+				Build: present.Build(ev.Build),
+			})
+			if err != nil {
+				logger.Error("failed-to-marshal-build-lifecycle-event", err)
+				return
+			}
+
+			err = sse.Event{
+				ID:   fmt.Sprintf("%d", id),
+				Name: "event",
+				Data: payload,
+			}.Write(w)
+			if err != nil {
+				logger.Info("failed-to-write-event", lager.Data{"error": err.Error()})
+				return
+			}
+
+			flusher.Flush()
+
+			id++
+		}
+	})
+}
+
+type buildLifecycleFilter struct {
+	pipelines []string
+	jobs      []string
+}
+
+func (filter buildLifecycleFilter) matches(build db.Build) bool {
+	if len(filter.pipelines) > 0 && !contains(filter.pipelines, build.PipelineName()) {
+		return false
+	}
+
+	if len(filter.jobs) > 0 && !contains(filter.jobs, build.JobName()) {
+		return false
+	}
+
+	return true
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
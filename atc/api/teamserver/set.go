@@ -51,6 +51,12 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 
 	response := SetTeamResponse{}
 	if found {
+		if atcTeam.Quota != nil && *atcTeam.Quota != team.Quota() && !acc.IsAdmin() {
+			hLog.Info("non-admin-cannot-change-quota", lager.Data{"teamName": teamName})
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+
 		hLog.Debug("updating-credentials")
 		err = team.UpdateProviderAuth(atcTeam.Auth)
 		if err != nil {
@@ -59,6 +65,15 @@ func (s *Server) SetTeam(w http.ResponseWriter, r *http.Request) {
 			return
 		}
 
+		if atcTeam.Quota != nil {
+			err = team.UpdateQuota(*atcTeam.Quota)
+			if err != nil {
+				hLog.Error("failed-to-update-team-quota", err, lager.Data{"teamName": teamName})
+				w.WriteHeader(http.StatusInternalServerError)
+				return
+			}
+		}
+
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
 	} else if acc.IsAdmin() {
@@ -0,0 +1,93 @@
+package teamserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"code.cloudfoundry.org/lager"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type SetTeamsResponse struct {
+	Errors   []string            `json:"errors,omitempty"`
+	Warnings []atc.ConfigWarning `json:"warnings,omitempty"`
+	Created  []string            `json:"created,omitempty"`
+	Updated  []string            `json:"updated,omitempty"`
+	Deleted  []string            `json:"deleted,omitempty"`
+}
+
+func (s *Server) SetTeams(w http.ResponseWriter, r *http.Request) {
+	hLog := s.logger.Session("set-teams")
+
+	hLog.Debug("setting-teams")
+
+	var req atc.SetTeamsRequest
+	err := json.NewDecoder(r.Body).Decode(&req)
+	if err != nil {
+		hLog.Error("malformed-request", err)
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	response := SetTeamsResponse{}
+
+	err = req.Teams.Validate()
+	if err != nil {
+		response.Errors = append(response.Errors, err.Error())
+	}
+
+	for _, team := range req.Teams {
+		warning, err := atc.ValidateIdentifier(team.Name, "team")
+		if err != nil {
+			response.Errors = append(response.Errors, err.Error())
+		}
+		if warning != nil {
+			response.Warnings = append(response.Warnings, *warning)
+		}
+	}
+
+	if len(response.Errors) > 0 {
+		hLog.Info("invalid-teams", lager.Data{"errors": response.Errors})
+		s.writeSetTeamsResponse(w, http.StatusBadRequest, response)
+		return
+	}
+
+	changes, err := s.teamFactory.SetTeams(req.Teams, req.DeleteUnlisted)
+	if err != nil {
+		if err == db.ErrLastAdminTeam {
+			hLog.Info("would-delete-last-admin-team")
+			response.Errors = append(response.Errors, err.Error())
+			s.writeSetTeamsResponse(w, http.StatusForbidden, response)
+			return
+		}
+
+		hLog.Error("failed-to-set-teams", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	err = s.teamFactory.NotifyCacher()
+	if err != nil {
+		hLog.Error("failed-to-notify-cacher", err)
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+
+	response.Created = changes.Created
+	response.Updated = changes.Updated
+	response.Deleted = changes.Deleted
+
+	s.writeSetTeamsResponse(w, http.StatusOK, response)
+}
+
+func (s *Server) writeSetTeamsResponse(w http.ResponseWriter, status int, response SetTeamsResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	err := json.NewEncoder(w).Encode(response)
+	if err != nil {
+		s.logger.Error("failed-to-encode-response", err)
+	}
+}
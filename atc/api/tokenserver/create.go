@@ -0,0 +1,101 @@
+package tokenserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) CreateAPIToken(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("create-api-token")
+
+		acc := accessor.GetAccessor(r)
+		if acc.Claims().APIToken {
+			http.Error(w, "api tokens cannot be used to create api tokens", http.StatusForbidden)
+			return
+		}
+
+		var token atc.APIToken
+		err := json.NewDecoder(r.Body).Decode(&token)
+		if err != nil {
+			logger.Info("malformed-request", lager.Data{"error": err.Error()})
+			http.Error(w, "malformed request: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		if token.Name == "" {
+			http.Error(w, "token name must be specified", http.StatusBadRequest)
+			return
+		}
+
+		if token.ExpiresAt != 0 && token.ExpiresAt <= time.Now().Unix() {
+			http.Error(w, "token expiry must be in the future", http.StatusBadRequest)
+			return
+		}
+
+		var requiredRole string
+		switch token.Role {
+		case accessor.OwnerRole, accessor.MemberRole, accessor.OperatorRole, accessor.ViewerRole:
+			requiredRole = token.Role
+		default:
+			if _, found := team.CustomRoles().Lookup(token.Role); !found {
+				http.Error(w, fmt.Sprintf("unknown role '%s'", token.Role), http.StatusBadRequest)
+				return
+			}
+
+			// only owners may define custom roles, so only they may hand
+			// them out
+			requiredRole = accessor.OwnerRole
+		}
+
+		if !grants(acc, team.Name(), requiredRole) {
+			http.Error(w, fmt.Sprintf("you do not have the '%s' role on team '%s' yourself", token.Role, team.Name()), http.StatusForbidden)
+			return
+		}
+
+		token.CreatedBy = acc.UserInfo().DisplayUserId
+
+		created, err := s.apiTokens.CreateAPIToken(team.ID(), token)
+		if err != nil {
+			if err == db.ErrAPITokenAlreadyExists {
+				http.Error(w, fmt.Sprintf("token '%s' already exists", token.Name), http.StatusConflict)
+				return
+			}
+
+			logger.Error("failed-to-create-api-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+
+		err = json.NewEncoder(w).Encode(created)
+		if err != nil {
+			logger.Error("failed-to-encode-api-token", err)
+		}
+	})
+}
+
+// grants returns whether the requester holds at least the given role on the
+// team, so that tokens can't be used to escalate privileges.
+func grants(acc accessor.Access, teamName string, requiredRole string) bool {
+	if acc.IsAdmin() {
+		return true
+	}
+
+	for _, role := range acc.TeamRoles()[teamName] {
+		if accessor.RoleIncludes(role, requiredRole) {
+			return true
+		}
+	}
+
+	return false
+}
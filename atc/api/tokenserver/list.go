@@ -0,0 +1,29 @@
+package tokenserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) ListAPITokens(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("list-api-tokens")
+
+		tokens, err := s.apiTokens.APITokens(team.ID())
+		if err != nil {
+			logger.Error("failed-to-get-api-tokens", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+
+		err = json.NewEncoder(w).Encode(tokens)
+		if err != nil {
+			logger.Error("failed-to-encode-api-tokens", err)
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	})
+}
@@ -0,0 +1,33 @@
+package tokenserver
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc/api/accessor"
+	"github.com/concourse/concourse/atc/db"
+)
+
+func (s *Server) RevokeAPIToken(team db.Team) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		logger := s.logger.Session("revoke-api-token")
+
+		if accessor.GetAccessor(r).Claims().APIToken {
+			http.Error(w, "api tokens cannot be used to revoke api tokens", http.StatusForbidden)
+			return
+		}
+
+		revoked, err := s.apiTokens.RevokeAPIToken(team.ID(), r.FormValue(":token_name"))
+		if err != nil {
+			logger.Error("failed-to-revoke-api-token", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		if !revoked {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+	})
+}
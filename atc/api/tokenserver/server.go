@@ -0,0 +1,21 @@
+package tokenserver
+
+import (
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type Server struct {
+	logger    lager.Logger
+	apiTokens db.APITokenRepository
+}
+
+func NewServer(
+	logger lager.Logger,
+	apiTokens db.APITokenRepository,
+) *Server {
+	return &Server{
+		logger:    logger,
+		apiTokens: apiTokens,
+	}
+}
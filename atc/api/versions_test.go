@@ -1191,4 +1191,73 @@ var _ = Describe("Versions API", func() {
 			})
 		})
 	})
+
+	Describe("GET /api/v1/teams/:team_name/pipelines/:pipeline_name/resources/:resource_name/versions/:resource_version_id/causality", func() {
+		var response *http.Response
+		var stringVersionID string
+
+		JustBeforeEach(func() {
+			var err error
+
+			response, err = client.Get(server.URL + "/api/v1/teams/a-team/pipelines/a-pipeline/resources/some-resource/versions/" + stringVersionID + "/causality")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		BeforeEach(func() {
+			stringVersionID = "123"
+		})
+
+		Context("when authorized", func() {
+			BeforeEach(func() {
+				fakeAccess.IsAuthenticatedReturns(true)
+				fakeAccess.IsAuthorizedReturns(true)
+			})
+
+			Context("when the causality can be determined", func() {
+				BeforeEach(func() {
+					fakePipeline.CausalityReturns([]db.Cause{
+						{ResourceVersionID: 123, BuildID: 1},
+						{ResourceVersionID: 456, BuildID: 2},
+					}, nil)
+				})
+
+				It("looks up the given version ID", func() {
+					Expect(fakePipeline.CausalityCallCount()).To(Equal(1))
+					Expect(fakePipeline.CausalityArgsForCall(0)).To(Equal(123))
+				})
+
+				It("returns 200 with the builds caused by the version", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusOK))
+
+					body, err := ioutil.ReadAll(response.Body)
+					Expect(err).NotTo(HaveOccurred())
+
+					Expect(body).To(MatchJSON(`[
+						{"resource_version_id": 123, "build_id": 1},
+						{"resource_version_id": 456, "build_id": 2}
+					]`))
+				})
+			})
+
+			Context("when the version ID is malformed", func() {
+				BeforeEach(func() {
+					stringVersionID = "bogus"
+				})
+
+				It("returns 400", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusBadRequest))
+				})
+			})
+
+			Context("when determining the causality fails", func() {
+				BeforeEach(func() {
+					fakePipeline.CausalityReturns(nil, errors.New("nope"))
+				})
+
+				It("returns 500", func() {
+					Expect(response.StatusCode).To(Equal(http.StatusInternalServerError))
+				})
+			})
+		})
+	})
 })
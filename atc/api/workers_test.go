@@ -46,6 +46,11 @@ var _ = Describe("Workers API", func() {
 				teamWorker1.GardenAddrReturns(&gardenAddr1)
 				bcURL1 := "1.2.3.4:8888"
 				teamWorker1.BaggageclaimURLReturns(&bcURL1)
+				teamWorker1.ResourceUsageReturns(&atc.WorkerResourceUsage{
+					CPU:                 0.5,
+					MemoryUsedBytes:     512,
+					MemoryCapacityBytes: 1024,
+				})
 
 				teamWorker2 = new(dbfakes.FakeWorker)
 				gardenAddr2 := "5.6.7.8:7777"
@@ -87,6 +92,11 @@ var _ = Describe("Workers API", func() {
 						{
 							GardenAddr:      "1.2.3.4:7777",
 							BaggageclaimURL: "1.2.3.4:8888",
+							ResourceUsage: &atc.WorkerResourceUsage{
+								CPU:                 0.5,
+								MemoryUsedBytes:     512,
+								MemoryCapacityBytes: 1024,
+							},
 						},
 						{
 							GardenAddr:      "5.6.7.8:7777",
@@ -126,6 +136,11 @@ var _ = Describe("Workers API", func() {
 						{
 							GardenAddr:      "1.2.3.4:7777",
 							BaggageclaimURL: "1.2.3.4:8888",
+							ResourceUsage: &atc.WorkerResourceUsage{
+								CPU:                 0.5,
+								MemoryUsedBytes:     512,
+								MemoryCapacityBytes: 1024,
+							},
 						},
 						{
 							GardenAddr:      "5.6.7.8:7777",
@@ -0,0 +1,24 @@
+package atc
+
+// APITokenPrefix starts the value of every API token, which distinguishes
+// them from the access tokens issued when logging in.
+const APITokenPrefix = "concourse_"
+
+// APIToken is a long-lived token for automation to use in place of logging
+// in. It grants a single role on a single team, until it expires or is
+// revoked.
+type APIToken struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	TeamName  string `json:"team_name"`
+	Role      string `json:"role"`
+	CreatedBy string `json:"created_by,omitempty"`
+	CreatedAt int64  `json:"created_at"`
+
+	// ExpiresAt is zero if the token never expires.
+	ExpiresAt int64 `json:"expires_at,omitempty"`
+
+	// Token is the value of the token to authenticate with. As only a hash
+	// of it is stored, it is only known when the token is created.
+	Token string `json:"token,omitempty"`
+}
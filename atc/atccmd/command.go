@@ -29,6 +29,7 @@ import (
 	"github.com/concourse/concourse/atc/api/pipelineserver"
 	"github.com/concourse/concourse/atc/api/policychecker"
 	"github.com/concourse/concourse/atc/auditor"
+	"github.com/concourse/concourse/atc/blobstore"
 	"github.com/concourse/concourse/atc/builds"
 	"github.com/concourse/concourse/atc/component"
 	"github.com/concourse/concourse/atc/compression"
@@ -42,6 +43,7 @@ import (
 	"github.com/concourse/concourse/atc/gc"
 	"github.com/concourse/concourse/atc/lidar"
 	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/notifications"
 	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/resource"
 	"github.com/concourse/concourse/atc/scheduler"
@@ -86,6 +88,7 @@ import (
 	_ "github.com/concourse/concourse/atc/creds/conjur"
 	_ "github.com/concourse/concourse/atc/creds/credhub"
 	_ "github.com/concourse/concourse/atc/creds/dummy"
+	_ "github.com/concourse/concourse/atc/creds/file"
 	_ "github.com/concourse/concourse/atc/creds/kubernetes"
 	_ "github.com/concourse/concourse/atc/creds/secretsmanager"
 	_ "github.com/concourse/concourse/atc/creds/ssm"
@@ -110,7 +113,9 @@ type ATCCommand struct {
 type RunCommand struct {
 	Logger flag.Lager
 
-	varSourcePool creds.VarSourcePool
+	varSourcePool   creds.VarSourcePool
+	artifactStore   blobstore.Store
+	buildEventStore blobstore.Store
 
 	BindIP   flag.IP `long:"bind-ip"   default:"0.0.0.0" description:"IP address on which to listen for web traffic."`
 	BindPort uint16  `long:"bind-port" default:"8080"    description:"Port on which to listen for HTTP traffic."`
@@ -172,6 +177,10 @@ type RunCommand struct {
 
 	Tracing tracing.Config `group:"Tracing" namespace:"tracing"`
 
+	ArtifactStore blobstore.Config `group:"Artifact Store" namespace:"artifact-store"`
+
+	BuildEventStore blobstore.Config `group:"Build Event Store" namespace:"build-event-store"`
+
 	PolicyCheckers struct {
 		Filter policy.Filter
 	} `group:"Policy Checking"`
@@ -190,16 +199,25 @@ type RunCommand struct {
 	GC struct {
 		Interval time.Duration `long:"interval" default:"30s" description:"Interval on which to perform garbage collection."`
 
-		OneOffBuildGracePeriod time.Duration `long:"one-off-grace-period" default:"5m" description:"Period after which one-off build containers will be garbage-collected."`
-		MissingGracePeriod     time.Duration `long:"missing-grace-period" default:"5m" description:"Period after which to reap containers and volumes that were created but went missing from the worker."`
-		HijackGracePeriod      time.Duration `long:"hijack-grace-period" default:"5m" description:"Period after which hijacked containers will be garbage collected"`
-		FailedGracePeriod      time.Duration `long:"failed-grace-period" default:"120h" description:"Period after which failed containers will be garbage collected"`
-		CheckRecyclePeriod     time.Duration `long:"check-recycle-period" default:"1m" description:"Period after which to reap checks that are completed."`
-		VarSourceRecyclePeriod time.Duration `long:"var-source-recycle-period" default:"5m" description:"Period after which to reap var_sources that are not used."`
+		OneOffBuildGracePeriod  time.Duration `long:"one-off-grace-period" default:"5m" description:"Period after which one-off build containers will be garbage-collected."`
+		MissingGracePeriod      time.Duration `long:"missing-grace-period" default:"5m" description:"Period after which to reap containers and volumes that were created but went missing from the worker."`
+		HijackGracePeriod       time.Duration `long:"hijack-grace-period" default:"5m" description:"Period after which hijacked containers will be garbage collected"`
+		FailedGracePeriod       time.Duration `long:"failed-grace-period" default:"120h" description:"Period after which failed containers will be garbage collected"`
+		CheckRecyclePeriod      time.Duration `long:"check-recycle-period" default:"1m" description:"Period after which to reap checks that are completed."`
+		VarSourceRecyclePeriod  time.Duration `long:"var-source-recycle-period" default:"5m" description:"Period after which to reap var_sources that are not used."`
+		AuditEventRetention     time.Duration `long:"audit-event-retention" default:"720h" description:"Period after which recorded audit events are removed."`
+		OneOffArtifactRetention time.Duration `long:"one-off-artifact-retention" default:"168h" description:"Period after which the archived artifacts of builds which don't belong to a job, e.g. those run with fly execute, are removed."`
 	} `group:"Garbage Collection" namespace:"gc"`
 
 	BuildTrackerInterval time.Duration `long:"build-tracker-interval" default:"10s" description:"Interval on which to run build tracking."`
 
+	Notifications struct {
+		DeliveryInterval time.Duration `long:"delivery-interval" default:"10s" description:"Interval on which to deliver pending webhook notifications."`
+		Timeout          time.Duration `long:"timeout" default:"10s" description:"How long to wait for a webhook to respond to a notification before retrying it."`
+
+		AllowInternalTargets bool `long:"allow-internal-targets" description:"Allow webhooks on loopback, private and link-local addresses, e.g. services on the same network as the ATC."`
+	} `group:"Notifications" namespace:"notification"`
+
 	TelemetryOptIn bool `long:"telemetry-opt-in" hidden:"true" description:"Enable anonymous concourse version reporting."`
 
 	DefaultBuildLogsToRetain uint64 `long:"default-build-logs-to-retain" description:"Default build logs to retain, 0 means all"`
@@ -577,6 +595,15 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 
 	lockFactory := lock.NewLockFactory(lockConn, metric.LogLockAcquired, metric.LogLockReleased)
 
+	// the build event store is needed by the connections so that builds can
+	// stream their archived events
+	if cmd.BuildEventStore.IsConfigured() {
+		cmd.buildEventStore, err = cmd.BuildEventStore.NewStore()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	apiConn, err := cmd.constructDBConn(retryingDriverName, logger, cmd.APIMaxOpenConnections, cmd.APIMaxOpenConnections/2, "api", lockFactory)
 	if err != nil {
 		return nil, err
@@ -615,6 +642,13 @@ func (cmd *RunCommand) Runner(positionalArguments []string) (ifrit.Runner, error
 		clock.NewClock(),
 	)
 
+	if cmd.ArtifactStore.IsConfigured() {
+		cmd.artifactStore, err = cmd.ArtifactStore.NewStore()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	members, err := cmd.constructMembers(logger, reconfigurableSink, apiConn, workerConn, backendConn, gcConn, storage, lockFactory, secretManager)
 	if err != nil {
 		return nil, err
@@ -802,7 +836,7 @@ func (cmd *RunCommand) constructAPIMembers(
 		cmd.GardenRequestTimeout,
 	)
 
-	pool := worker.NewPool(workerProvider)
+	pool := worker.NewPool(workerProvider, db.NewTeamQuotaRepository(dbConn))
 
 	credsManagers := cmd.CredentialManagers
 	dbPipelineFactory := db.NewPipelineFactory(dbConn, lockFactory)
@@ -820,7 +854,13 @@ func (cmd *RunCommand) constructAPIMembers(
 	dbClock := db.NewClock()
 	dbWall := db.NewWall(dbConn, &dbClock)
 
-	tokenVerifier := cmd.constructTokenVerifier(dbAccessTokenFactory)
+	dbArchivedArtifactRepository := db.NewArchivedArtifactRepository(dbConn)
+	dbAuditEventRepository := db.NewAuditEventRepository(dbConn)
+	dbAPITokenRepository := db.NewAPITokenRepository(dbConn)
+
+	alg := algorithm.New(db.NewVersionsDB(dbConn, algorithmLimitRows, schedulerCache))
+
+	tokenVerifier := cmd.constructTokenVerifier(dbAccessTokenFactory, dbAPITokenRepository)
 
 	teamsCacher := accessor.NewTeamsCacher(
 		logger,
@@ -861,6 +901,10 @@ func (cmd *RunCommand) constructAPIMembers(
 		dbCheckFactory,
 		dbResourceConfigFactory,
 		userFactory,
+		dbArchivedArtifactRepository,
+		dbAuditEventRepository,
+		dbAPITokenRepository,
+		alg,
 		pool,
 		secretManager,
 		credsManagers,
@@ -1060,10 +1104,15 @@ func (cmd *RunCommand) backendComponents(
 		cmd.GardenRequestTimeout,
 	)
 
-	pool := worker.NewPool(workerProvider)
+	pool := worker.NewPool(workerProvider, db.NewTeamQuotaRepository(dbConn))
 	artifactStreamer := worker.NewArtifactStreamer(pool, compressionLib)
 	artifactSourcer := worker.NewArtifactSourcer(compressionLib, pool, cmd.FeatureFlags.EnableP2PVolumeStreaming, cmd.P2pVolumeStreamingTimeout, dbResourceCacheFactory)
 
+	var artifactArchiver worker.ArtifactArchiver
+	if cmd.artifactStore != nil {
+		artifactArchiver = worker.NewArtifactArchiver(pool, cmd.artifactStore, db.NewArchivedArtifactRepository(dbConn))
+	}
+
 	defaultLimits, err := cmd.parseDefaultLimits()
 	if err != nil {
 		return nil, err
@@ -1086,6 +1135,8 @@ func (cmd *RunCommand) backendComponents(
 		pool,
 		artifactStreamer,
 		artifactSourcer,
+		artifactArchiver,
+		db.NewTaskResultCacheFactory(dbConn),
 		resourceFactory,
 		dbWorkerFactory,
 		teamFactory,
@@ -1141,6 +1192,18 @@ func (cmd *RunCommand) backendComponents(
 				cmd.JobSchedulingMaxInFlight,
 			),
 		},
+		{
+			Component: atc.Component{
+				Name:     atc.ComponentCronScheduler,
+				Interval: 10 * time.Second,
+			},
+			Runnable: scheduler.NewCronRunner(
+				logger.Session("cron-scheduler"),
+				dbJobFactory,
+				dbCheckFactory,
+				clock.NewClock(),
+			),
+		},
 		{
 			Component: atc.Component{
 				Name:     atc.ComponentBuildTracker,
@@ -1168,6 +1231,18 @@ func (cmd *RunCommand) backendComponents(
 		},
 	}
 
+	components = append(components, RunnableComponent{
+		Component: atc.Component{
+			Name:     atc.ComponentNotificationDeliverer,
+			Interval: cmd.Notifications.DeliveryInterval,
+		},
+		Runnable: notifications.NewDeliverer(
+			db.NewNotificationQueue(dbConn),
+			notifications.NewClient(cmd.Notifications.Timeout, cmd.Notifications.AllowInternalTargets),
+			clock.NewClock(),
+		),
+	})
+
 	if syslogDrainConfigured {
 		components = append(components, RunnableComponent{
 			Component: atc.Component{
@@ -1202,6 +1277,7 @@ func (cmd *RunCommand) gcComponents(
 	dbResourceConfigFactory := db.NewResourceConfigFactory(gcConn, lockFactory)
 	dbPipelineLifecycle := db.NewPipelineLifecycle(gcConn, lockFactory)
 	dbCheckLifecycle := db.NewCheckLifecycle(gcConn)
+	dbAuditEventRepository := db.NewAuditEventRepository(gcConn)
 
 	dbVolumeRepository := db.NewVolumeRepository(gcConn)
 
@@ -1227,6 +1303,15 @@ func (cmd *RunCommand) gcComponents(
 		atc.ComponentCollectorPipelines:         gc.NewPipelineCollector(dbPipelineLifecycle),
 		atc.ComponentCollectorAccessTokens:      gc.NewAccessTokensCollector(dbAccessTokenLifecycle, jwt.DefaultLeeway),
 		atc.ComponentCollectorChecks:            gc.NewChecksCollector(dbCheckLifecycle),
+		atc.ComponentCollectorAuditEvents:       gc.NewAuditEventCollector(dbAuditEventRepository, cmd.GC.AuditEventRetention),
+	}
+
+	if cmd.artifactStore != nil {
+		collectors[atc.ComponentCollectorArchivedArtifacts] = gc.NewArchivedArtifactCollector(db.NewArchivedArtifactRepository(gcConn), cmd.artifactStore, cmd.GC.OneOffArtifactRetention)
+	}
+
+	if cmd.buildEventStore != nil {
+		collectors[atc.ComponentCollectorBuildEventArchive] = gc.NewBuildEventArchiveCollector(db.NewBuildEventArchive(gcConn, lockFactory, cmd.buildEventStore), 500)
 	}
 
 	var components []RunnableComponent
@@ -1611,6 +1696,10 @@ func (cmd *RunCommand) constructDBConn(
 		dbConn = db.Log(logger.Session("log-conn"), dbConn)
 	}
 
+	if cmd.buildEventStore != nil {
+		dbConn = db.WithBuildEventStore(dbConn, cmd.buildEventStore)
+	}
+
 	// Prepare
 	dbConn.SetMaxOpenConns(maxConns)
 	dbConn.SetMaxIdleConns(idleConns)
@@ -1666,6 +1755,8 @@ func (cmd *RunCommand) constructEngine(
 	workerPool worker.Pool,
 	artifactStreamer worker.ArtifactStreamer,
 	artifactSourcer worker.ArtifactSourcer,
+	artifactArchiver worker.ArtifactArchiver,
+	taskResultCaches db.TaskResultCacheFactory,
 	resourceFactory resource.ResourceFactory,
 	workerFactory db.WorkerFactory,
 	teamFactory db.TeamFactory,
@@ -1685,6 +1776,8 @@ func (cmd *RunCommand) constructEngine(
 				workerPool,
 				artifactStreamer,
 				artifactSourcer,
+				artifactArchiver,
+				taskResultCaches,
 				resourceFactory,
 				teamFactory,
 				buildFactory,
@@ -1842,7 +1935,7 @@ func (cmd *RunCommand) constructLoginHandler(
 	return skyserver.NewSkyHandler(skyServer), nil
 }
 
-func (cmd *RunCommand) constructTokenVerifier(accessTokenFactory db.AccessTokenFactory) accessor.TokenVerifier {
+func (cmd *RunCommand) constructTokenVerifier(accessTokenFactory db.AccessTokenFactory, apiTokenRepository db.APITokenRepository) accessor.TokenVerifier {
 
 	validClients := []string{flyClientID}
 	for clientId := range cmd.Auth.AuthFlags.Clients {
@@ -1852,7 +1945,7 @@ func (cmd *RunCommand) constructTokenVerifier(accessTokenFactory db.AccessTokenF
 	MiB := 1024 * 1024
 	claimsCacher := accessor.NewClaimsCacher(accessTokenFactory, 1*MiB)
 
-	return accessor.NewVerifier(claimsCacher, validClients)
+	return accessor.NewVerifier(claimsCacher, apiTokenRepository, validClients)
 }
 
 func (cmd *RunCommand) constructAPIHandler(
@@ -1871,6 +1964,10 @@ func (cmd *RunCommand) constructAPIHandler(
 	dbCheckFactory db.CheckFactory,
 	resourceConfigFactory db.ResourceConfigFactory,
 	dbUserFactory db.UserFactory,
+	dbArchivedArtifactRepository db.ArchivedArtifactRepository,
+	dbAuditEventRepository db.AuditEventRepository,
+	dbAPITokenRepository db.APITokenRepository,
+	alg scheduler.Algorithm,
 	workerPool worker.Pool,
 	secretManager creds.Secrets,
 	credsManagers creds.Managers,
@@ -1896,6 +1993,7 @@ func (cmd *RunCommand) constructAPIHandler(
 		cmd.Auditor.EnableTeamAuditLog,
 		cmd.Auditor.EnableWorkerAuditLog,
 		cmd.Auditor.EnableVolumeAuditLog,
+		dbAuditEventRepository,
 		logger,
 	)
 
@@ -1947,6 +2045,12 @@ func (cmd *RunCommand) constructAPIHandler(
 		dbCheckFactory,
 		resourceConfigFactory,
 		dbUserFactory,
+		dbArchivedArtifactRepository,
+		dbAuditEventRepository,
+		dbAPITokenRepository,
+
+		alg,
+		cmd.artifactStore,
 
 		buildserver.NewEventHandler,
 
@@ -0,0 +1,23 @@
+package atc
+
+// AuditEvent records a request made to the API: who made it, what it acted
+// on, and how it turned out.
+type AuditEvent struct {
+	ID     int    `json:"id"`
+	Time   int64  `json:"time"`
+	User   string `json:"user"`
+	Team   string `json:"team,omitempty"`
+	Action string `json:"action"`
+
+	// Target is the path of the API resource the request acted on.
+	Target string `json:"target"`
+
+	// Parameters are the query parameters given with the request. Request
+	// bodies are never recorded, as they may contain credentials.
+	Parameters map[string][]string `json:"parameters,omitempty"`
+
+	// Status is the HTTP status the request was responded to with. It is zero
+	// while the request is still being handled, e.g. for a hijacked container,
+	// or if the ATC stopped before responding.
+	Status int `json:"status"`
+}
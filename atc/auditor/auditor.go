@@ -3,9 +3,12 @@ package auditor
 import (
 	"fmt"
 	"net/http"
+	"net/url"
+	"strings"
 
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -21,9 +24,10 @@ func NewAuditor(
 	EnableTeamAuditLog bool,
 	EnableWorkerAuditLog bool,
 	EnableVolumeAuditLog bool,
+	auditEvents db.AuditEventRepository,
 	logger lager.Logger,
 ) *auditor {
-	return &auditor{
+	a := &auditor{
 		EnableBuildAuditLog:     EnableBuildAuditLog,
 		EnableContainerAuditLog: EnableContainerAuditLog,
 		EnableJobAuditLog:       EnableJobAuditLog,
@@ -33,12 +37,31 @@ func NewAuditor(
 		EnableTeamAuditLog:      EnableTeamAuditLog,
 		EnableWorkerAuditLog:    EnableWorkerAuditLog,
 		EnableVolumeAuditLog:    EnableVolumeAuditLog,
+		auditEvents:             auditEvents,
 		logger:                  logger,
+		queue:                   make(chan func(), queueSize),
 	}
+
+	go a.run()
+
+	return a
 }
 
+// queueSize is how many audit events, and statuses of them, may be waiting to
+// be written to the database. Once it is full further events are only logged.
+const queueSize = 1000
+
+// redactedParameters are query parameters which carry secrets, and so are
+// neither logged nor recorded.
+var redactedParameters = []string{"webhook_token"}
+
 type Auditor interface {
-	Audit(action string, userName string, r *http.Request)
+	// Audit logs and records the request before it is handled, so that
+	// long-lived requests such as hijacking a container are audited as soon as
+	// they start. The returned function is to be called with the status the
+	// request is responded to with. If it is never called, e.g. because the
+	// handler panicked, the event is left without a status.
+	Audit(action string, userName string, r *http.Request) func(status int)
 }
 
 type auditor struct {
@@ -51,7 +74,10 @@ type auditor struct {
 	EnableTeamAuditLog      bool
 	EnableWorkerAuditLog    bool
 	EnableVolumeAuditLog    bool
+	auditEvents             db.AuditEventRepository
 	logger                  lager.Logger
+
+	queue chan func()
 }
 
 func (a *auditor) ValidateAction(action string) bool {
@@ -62,14 +88,18 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.RerunJobBuild,
 		atc.ListBuilds,
 		atc.BuildEvents,
+		atc.StreamBuildEvents,
 		atc.BuildResources,
 		atc.AbortBuild,
+		atc.SetBuildApproval,
+		atc.GetBuildResourceUsage,
 		atc.GetBuildPreparation,
 		atc.ListBuildsWithVersionAsInput,
 		atc.ListBuildsWithVersionAsOutput,
 		atc.CreateArtifact,
 		atc.GetArtifact,
-		atc.ListBuildArtifacts:
+		atc.ListBuildArtifacts,
+		atc.GetBuildArtifact:
 		return a.EnableBuildAuditLog
 	case atc.ListContainers,
 		atc.GetContainer,
@@ -83,6 +113,7 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.ListJobs,
 		atc.ListJobBuilds,
 		atc.ListJobInputs,
+		atc.GetJobSchedulingExplanation,
 		atc.GetJobBuild,
 		atc.PauseJob,
 		atc.UnpauseJob,
@@ -104,7 +135,8 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.RenamePipeline,
 		atc.ListPipelineBuilds,
 		atc.CreatePipelineBuild,
-		atc.PipelineBadge:
+		atc.PipelineBadge,
+		atc.GetPipelineGraph:
 		return a.EnablePipelineAuditLog
 	case atc.ListAllResources,
 		atc.ListResources,
@@ -127,6 +159,9 @@ func (a *auditor) ValidateAction(action string) bool {
 	case
 		atc.SaveConfig,
 		atc.GetConfig,
+		atc.ListPipelineConfigHistory,
+		atc.GetPipelineConfigRevision,
+		atc.DiffPipelineConfigRevisions,
 		atc.GetCC,
 		atc.GetVersionsDB,
 		atc.ClearTaskCache,
@@ -136,6 +171,7 @@ func (a *auditor) ValidateAction(action string) bool {
 		atc.GetInfo,
 		atc.GetInfoCreds,
 		atc.ListActiveUsersSince,
+		atc.ListAuditEvents,
 		atc.GetUser,
 		atc.GetWall,
 		atc.SetWall,
@@ -143,9 +179,22 @@ func (a *auditor) ValidateAction(action string) bool {
 		return a.EnableSystemAuditLog
 	case atc.ListTeams,
 		atc.SetTeam,
+		atc.SetTeams,
 		atc.RenameTeam,
 		atc.DestroyTeam,
 		atc.ListTeamBuilds,
+		atc.TeamEvents,
+		atc.ListCustomRoles,
+		atc.SetCustomRole,
+		atc.DestroyCustomRole,
+		atc.ListAPITokens,
+		atc.CreateAPIToken,
+		atc.RevokeAPIToken,
+		atc.ListNotificationSubscriptions,
+		atc.SetNotificationSubscription,
+		atc.DestroyNotificationSubscription,
+		atc.ListNotificationDeliveries,
+		atc.RedeliverNotification,
 		atc.GetTeam:
 		return a.EnableTeamAuditLog
 	case atc.RegisterWorker,
@@ -165,9 +214,98 @@ func (a *auditor) ValidateAction(action string) bool {
 	}
 }
 
-func (a *auditor) Audit(action string, userName string, r *http.Request) {
+func (a *auditor) Audit(action string, userName string, r *http.Request) func(status int) {
 	err := r.ParseForm()
-	if err == nil && a.ValidateAction(action) {
-		a.logger.Info("audit", lager.Data{"action": action, "user": userName, "parameters": r.Form})
+	if err != nil || !a.ValidateAction(action) {
+		return func(int) {}
 	}
+
+	a.logger.Info("audit", lager.Data{"action": action, "user": userName, "parameters": redact(r.Form)})
+
+	query := r.URL.Query()
+
+	// route parameters are added to the query with a ':' prefix; they are
+	// already part of the target
+	var parameters map[string][]string
+	for name, values := range redact(query) {
+		if strings.HasPrefix(name, ":") {
+			continue
+		}
+
+		if parameters == nil {
+			parameters = map[string][]string{}
+		}
+
+		parameters[name] = values
+	}
+
+	event := atc.AuditEvent{
+		User:       userName,
+		Team:       query.Get(":team_name"),
+		Action:     action,
+		Target:     r.URL.Path,
+		Parameters: parameters,
+	}
+
+	// the id is only written and read by the queue's worker, which records
+	// the event before it gets to the status
+	var id int
+	var recorded bool
+
+	// recorded in the background so that the request isn't held up by the
+	// database
+	a.enqueue(func() {
+		var err error
+		id, err = a.auditEvents.Record(event)
+		if err != nil {
+			a.logger.Error("failed-to-record-audit-event", err)
+			return
+		}
+
+		recorded = true
+	})
+
+	return func(status int) {
+		a.logger.Info("audit-responded", lager.Data{"action": action, "user": userName, "status": status})
+
+		a.enqueue(func() {
+			if !recorded {
+				return
+			}
+
+			err := a.auditEvents.SetStatus(id, status)
+			if err != nil {
+				a.logger.Error("failed-to-record-audit-event-status", err)
+			}
+		})
+	}
+}
+
+func (a *auditor) enqueue(write func()) {
+	select {
+	case a.queue <- write:
+	default:
+		a.logger.Info("audit-queue-full")
+	}
+}
+
+func (a *auditor) run() {
+	for write := range a.queue {
+		write()
+	}
+}
+
+func redact(values url.Values) url.Values {
+	redacted := url.Values{}
+	for name, vs := range values {
+		redacted[name] = vs
+	}
+
+	for _, name := range redactedParameters {
+		if _, found := redacted[name]; found {
+			redacted[name] = []string{"redacted"}
+		}
+	}
+
+	return redacted
 }
@@ -1,15 +1,18 @@
 package auditor_test
 
 import (
+	"errors"
 	"net/http"
 
 	"code.cloudfoundry.org/lager/lagertest"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/auditor"
+	"github.com/concourse/concourse/atc/db/dbfakes"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
 )
 
 var _ = Describe("Audit", func() {
@@ -18,6 +21,7 @@ var _ = Describe("Audit", func() {
 		dummyAction             string
 		userName                string
 		logger                  *lagertest.TestLogger
+		fakeAuditEvents         *dbfakes.FakeAuditEventRepository
 		req                     *http.Request
 		EnableBuildAuditLog     bool
 		EnableContainerAuditLog bool
@@ -32,6 +36,7 @@ var _ = Describe("Audit", func() {
 
 	BeforeEach(func() {
 		userName = "test"
+		fakeAuditEvents = new(dbfakes.FakeAuditEventRepository)
 
 		var err error
 		req, err = http.NewRequest("GET", "localhost:8080", nil)
@@ -51,6 +56,7 @@ var _ = Describe("Audit", func() {
 			EnableTeamAuditLog,
 			EnableWorkerAuditLog,
 			EnableVolumeAuditLog,
+			fakeAuditEvents,
 			logger,
 		)
 	})
@@ -80,13 +86,94 @@ var _ = Describe("Audit", func() {
 		})
 		It("all routes are handled and does not panic", func() {
 			for _, route := range atc.Routes {
-				aud.Audit(route.Name, userName, req)
+				aud.Audit(route.Name, userName, req)(http.StatusOK)
 			}
 			logs := logger.Logs()
 			Expect(len(logs)).ToNot(Equal(0))
 		})
 	})
 
+	Describe("recording audit events", func() {
+		BeforeEach(func() {
+			EnableSystemAuditLog = true
+			EnableTeamAuditLog = true
+
+			var err error
+			req, err = http.NewRequest("PUT", "http://localhost:8080/api/v1/teams/some-team/roles/deployer?:team_name=some-team&:role_name=deployer&force=true", http.NoBody)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("when the action is audited", func() {
+			BeforeEach(func() {
+				fakeAuditEvents.RecordReturns(42, nil)
+			})
+
+			It("records the event before the request is responded to", func() {
+				aud.Audit(atc.SetCustomRole, userName, req)
+
+				Eventually(fakeAuditEvents.RecordCallCount).Should(Equal(1))
+				Expect(fakeAuditEvents.RecordArgsForCall(0)).To(Equal(atc.AuditEvent{
+					User:       userName,
+					Team:       "some-team",
+					Action:     atc.SetCustomRole,
+					Target:     "/api/v1/teams/some-team/roles/deployer",
+					Parameters: map[string][]string{"force": {"true"}},
+				}))
+
+				Consistently(fakeAuditEvents.SetStatusCallCount).Should(BeZero())
+			})
+
+			It("fills in the status once the request is responded to", func() {
+				responded := aud.Audit(atc.SetCustomRole, userName, req)
+				responded(http.StatusForbidden)
+
+				Eventually(fakeAuditEvents.SetStatusCallCount).Should(Equal(1))
+				id, code := fakeAuditEvents.SetStatusArgsForCall(0)
+				Expect(id).To(Equal(42))
+				Expect(code).To(Equal(http.StatusForbidden))
+			})
+
+			Context("when the request carries a webhook token", func() {
+				BeforeEach(func() {
+					EnableResourceAuditLog = true
+
+					var err error
+					req, err = http.NewRequest("POST", "http://localhost:8080/api/v1/teams/some-team/pipelines/some-pipeline/resources/some-resource/check/webhook?:team_name=some-team&webhook_token=sekrit", http.NoBody)
+					Expect(err).NotTo(HaveOccurred())
+				})
+
+				It("redacts the token", func() {
+					aud.Audit(atc.CheckResourceWebHook, userName, req)(http.StatusOK)
+
+					Eventually(fakeAuditEvents.RecordCallCount).Should(Equal(1))
+					Expect(fakeAuditEvents.RecordArgsForCall(0).Parameters).To(Equal(map[string][]string{
+						"webhook_token": {"redacted"},
+					}))
+					Expect(logger.Buffer()).NotTo(gbytes.Say("sekrit"))
+				})
+			})
+
+			Context("when recording the event fails", func() {
+				BeforeEach(func() {
+					fakeAuditEvents.RecordReturns(0, errors.New("disaster"))
+				})
+
+				It("logs the error", func() {
+					aud.Audit(atc.SetCustomRole, userName, req)(http.StatusOK)
+					Eventually(logger.LogMessages).Should(ContainElement("access_handler.failed-to-record-audit-event"))
+					Consistently(fakeAuditEvents.SetStatusCallCount).Should(BeZero())
+				})
+			})
+		})
+
+		Context("when the action is not audited", func() {
+			It("does not record an event", func() {
+				aud.Audit(atc.GetBuild, userName, req)(http.StatusOK)
+				Consistently(fakeAuditEvents.RecordCallCount).Should(BeZero())
+			})
+		})
+	})
+
 	Describe("EnableBuildAuditLog", func() {
 
 		Context("When EnableBuildAudit is false with a Build action", func() {
@@ -96,7 +183,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -110,7 +197,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -123,7 +210,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -136,7 +223,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -152,7 +239,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -166,7 +253,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -179,7 +266,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -192,7 +279,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -208,7 +295,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -222,7 +309,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -235,7 +322,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -248,7 +335,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -264,7 +351,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -278,7 +365,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -291,7 +378,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -304,7 +391,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -320,7 +407,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -334,7 +421,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -347,7 +434,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -360,7 +447,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -376,7 +463,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -390,7 +477,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -403,7 +490,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -416,7 +503,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -432,7 +519,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -446,7 +533,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -459,7 +546,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -472,7 +559,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -488,7 +575,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -502,7 +589,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -515,7 +602,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -528,7 +615,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -544,7 +631,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -558,7 +645,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Create a log including the action", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(logs[0].Data["action"]).To(Equal(dummyAction))
 			})
@@ -571,7 +658,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -584,7 +671,7 @@ var _ = Describe("Audit", func() {
 			})
 
 			It("Doesn't create a log", func() {
-				aud.Audit(dummyAction, userName, req)
+				aud.Audit(dummyAction, userName, req)(http.StatusOK)
 				logs := logger.Logs()
 				Expect(len(logs)).To(Equal(0))
 			})
@@ -9,30 +9,42 @@ import (
 )
 
 type FakeAuditor struct {
-	AuditStub        func(string, string, *http.Request)
+	AuditStub        func(string, string, *http.Request) func(status int)
 	auditMutex       sync.RWMutex
 	auditArgsForCall []struct {
 		arg1 string
 		arg2 string
 		arg3 *http.Request
 	}
+	auditReturns struct {
+		result1 func(status int)
+	}
+	auditReturnsOnCall map[int]struct {
+		result1 func(status int)
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
 
-func (fake *FakeAuditor) Audit(arg1 string, arg2 string, arg3 *http.Request) {
+func (fake *FakeAuditor) Audit(arg1 string, arg2 string, arg3 *http.Request) func(status int) {
 	fake.auditMutex.Lock()
+	ret, specificReturn := fake.auditReturnsOnCall[len(fake.auditArgsForCall)]
 	fake.auditArgsForCall = append(fake.auditArgsForCall, struct {
 		arg1 string
 		arg2 string
 		arg3 *http.Request
 	}{arg1, arg2, arg3})
 	stub := fake.AuditStub
+	fakeReturns := fake.auditReturns
 	fake.recordInvocation("Audit", []interface{}{arg1, arg2, arg3})
 	fake.auditMutex.Unlock()
 	if stub != nil {
-		fake.AuditStub(arg1, arg2, arg3)
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1
 	}
+	return fakeReturns.result1
 }
 
 func (fake *FakeAuditor) AuditCallCount() int {
@@ -41,7 +53,7 @@ func (fake *FakeAuditor) AuditCallCount() int {
 	return len(fake.auditArgsForCall)
 }
 
-func (fake *FakeAuditor) AuditCalls(stub func(string, string, *http.Request)) {
+func (fake *FakeAuditor) AuditCalls(stub func(string, string, *http.Request) func(status int)) {
 	fake.auditMutex.Lock()
 	defer fake.auditMutex.Unlock()
 	fake.AuditStub = stub
@@ -54,6 +66,29 @@ func (fake *FakeAuditor) AuditArgsForCall(i int) (string, string, *http.Request)
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
+func (fake *FakeAuditor) AuditReturns(result1 func(status int)) {
+	fake.auditMutex.Lock()
+	defer fake.auditMutex.Unlock()
+	fake.AuditStub = nil
+	fake.auditReturns = struct {
+		result1 func(status int)
+	}{result1}
+}
+
+func (fake *FakeAuditor) AuditReturnsOnCall(i int, result1 func(status int)) {
+	fake.auditMutex.Lock()
+	defer fake.auditMutex.Unlock()
+	fake.AuditStub = nil
+	if fake.auditReturnsOnCall == nil {
+		fake.auditReturnsOnCall = make(map[int]struct {
+			result1 func(status int)
+		})
+	}
+	fake.auditReturnsOnCall[i] = struct {
+		result1 func(status int)
+	}{result1}
+}
+
 func (fake *FakeAuditor) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -0,0 +1,97 @@
+package blobstore
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+)
+
+// Local stores blobs as files beneath a directory on the web node. It is only
+// suitable for single web node deployments, or when the directory is shared
+// between all web nodes.
+type Local struct {
+	Dir string `long:"local-dir" description:"Directory in which to store blobs. Must be shared between all web nodes."`
+}
+
+// IsConfigured identifies if a directory has been set
+func (l Local) IsConfigured() bool {
+	return l.Dir != ""
+}
+
+// Store returns a Store writing to the configured directory, creating it if
+// necessary.
+func (l Local) Store() (Store, error) {
+	err := os.MkdirAll(l.Dir, 0755)
+	if err != nil {
+		return nil, fmt.Errorf("create blob store directory: %w", err)
+	}
+
+	return &localStore{dir: l.Dir}, nil
+}
+
+type localStore struct {
+	dir string
+}
+
+func (store *localStore) Put(ctx context.Context, key string, contents io.Reader) error {
+	blobPath := store.path(key)
+
+	err := os.MkdirAll(filepath.Dir(blobPath), 0755)
+	if err != nil {
+		return err
+	}
+
+	// write to a temporary file first so that a partially written blob is
+	// never visible under its key
+	tmp, err := ioutil.TempFile(filepath.Dir(blobPath), ".blob-")
+	if err != nil {
+		return err
+	}
+
+	defer os.Remove(tmp.Name())
+
+	_, err = io.Copy(tmp, contents)
+	if err != nil {
+		tmp.Close()
+		return err
+	}
+
+	err = tmp.Close()
+	if err != nil {
+		return err
+	}
+
+	return os.Rename(tmp.Name(), blobPath)
+}
+
+func (store *localStore) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	file, err := os.Open(store.path(key))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrBlobNotFound
+		}
+
+		return nil, err
+	}
+
+	return file, nil
+}
+
+func (store *localStore) Delete(ctx context.Context, key string) error {
+	err := os.Remove(store.path(key))
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	return nil
+}
+
+// path cleans the key as if it were absolute so that it can never refer to a
+// file outside of the store's directory.
+func (store *localStore) path(key string) string {
+	return filepath.Join(store.dir, filepath.FromSlash(path.Clean("/"+key)))
+}
@@ -0,0 +1,81 @@
+package blobstore_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/concourse/concourse/atc/blobstore"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Local", func() {
+	var (
+		dir   string
+		store blobstore.Store
+		ctx   context.Context
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "blobstore")
+		Expect(err).ToNot(HaveOccurred())
+
+		store, err = blobstore.Local{Dir: filepath.Join(dir, "blobs")}.Store()
+		Expect(err).ToNot(HaveOccurred())
+
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	It("reads back what was put", func() {
+		Expect(store.Put(ctx, "builds/1/some-blob", strings.NewReader("some-contents"))).To(Succeed())
+
+		reader, err := store.Get(ctx, "builds/1/some-blob")
+		Expect(err).ToNot(HaveOccurred())
+		defer reader.Close()
+
+		Expect(ioutil.ReadAll(reader)).To(Equal([]byte("some-contents")))
+	})
+
+	It("overwrites existing blobs", func() {
+		Expect(store.Put(ctx, "some-blob", strings.NewReader("old"))).To(Succeed())
+		Expect(store.Put(ctx, "some-blob", strings.NewReader("new"))).To(Succeed())
+
+		reader, err := store.Get(ctx, "some-blob")
+		Expect(err).ToNot(HaveOccurred())
+		defer reader.Close()
+
+		Expect(ioutil.ReadAll(reader)).To(Equal([]byte("new")))
+	})
+
+	It("does not write outside of its directory", func() {
+		Expect(store.Put(ctx, "../../escaped", strings.NewReader("some-contents"))).To(Succeed())
+
+		Expect(filepath.Join(dir, "escaped")).ToNot(BeAnExistingFile())
+		Expect(filepath.Join(dir, "blobs", "escaped")).To(BeAnExistingFile())
+	})
+
+	It("returns ErrBlobNotFound for missing blobs", func() {
+		_, err := store.Get(ctx, "missing")
+		Expect(err).To(Equal(blobstore.ErrBlobNotFound))
+	})
+
+	It("deletes blobs", func() {
+		Expect(store.Put(ctx, "some-blob", strings.NewReader("some-contents"))).To(Succeed())
+		Expect(store.Delete(ctx, "some-blob")).To(Succeed())
+
+		_, err := store.Get(ctx, "some-blob")
+		Expect(err).To(Equal(blobstore.ErrBlobNotFound))
+	})
+
+	It("does not fail to delete missing blobs", func() {
+		Expect(store.Delete(ctx, "missing")).To(Succeed())
+	})
+})
@@ -0,0 +1,120 @@
+package blobstore
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+)
+
+// S3 stores blobs as objects in an S3-compatible bucket, e.g. AWS S3 or MinIO.
+type S3 struct {
+	Bucket          string `long:"s3-bucket"           description:"Name of the bucket in which to store blobs."`
+	Prefix          string `long:"s3-prefix"           description:"Prefix to prepend to the key of every stored object."`
+	Region          string `long:"s3-region"           description:"Region of the bucket." default:"us-east-1"`
+	Endpoint        string `long:"s3-endpoint"         description:"URL of an S3-compatible API to use instead of AWS S3."`
+	AccessKeyID     string `long:"s3-access-key"       description:"Access key ID. If not set, credentials are obtained from the environment."`
+	SecretAccessKey string `long:"s3-secret-key"       description:"Secret access key."`
+	SessionToken    string `long:"s3-session-token"    description:"Session token."`
+	ForcePathStyle  bool   `long:"s3-force-path-style" description:"Address the bucket as part of the path rather than the host name, as required by most S3-compatible APIs."`
+}
+
+// IsConfigured identifies if a bucket has been set
+func (s S3) IsConfigured() bool {
+	return s.Bucket != ""
+}
+
+// Store returns a Store writing to the configured bucket.
+func (s S3) Store() (Store, error) {
+	config := &aws.Config{
+		Region:           aws.String(s.Region),
+		S3ForcePathStyle: aws.Bool(s.ForcePathStyle),
+	}
+
+	if s.Endpoint != "" {
+		config.Endpoint = aws.String(s.Endpoint)
+	}
+
+	if s.AccessKeyID != "" {
+		config.Credentials = credentials.NewStaticCredentials(s.AccessKeyID, s.SecretAccessKey, s.SessionToken)
+	}
+
+	sess, err := session.NewSession(config)
+	if err != nil {
+		return nil, err
+	}
+
+	return &s3Store{
+		client:   s3.New(sess),
+		uploader: s3manager.NewUploader(sess),
+		bucket:   s.Bucket,
+		prefix:   s.Prefix,
+	}, nil
+}
+
+type s3Store struct {
+	client   *s3.S3
+	uploader *s3manager.Uploader
+	bucket   string
+	prefix   string
+}
+
+func (store *s3Store) Put(ctx context.Context, key string, contents io.Reader) error {
+	_, err := store.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(store.key(key)),
+		Body:   contents,
+	})
+	return err
+}
+
+func (store *s3Store) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	output, err := store.client.GetObjectWithContext(ctx, &s3.GetObjectInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(store.key(key)),
+	})
+	if err != nil {
+		if isS3NotFound(err) {
+			return nil, ErrBlobNotFound
+		}
+
+		return nil, err
+	}
+
+	return output.Body, nil
+}
+
+func (store *s3Store) Delete(ctx context.Context, key string) error {
+	_, err := store.client.DeleteObjectWithContext(ctx, &s3.DeleteObjectInput{
+		Bucket: aws.String(store.bucket),
+		Key:    aws.String(store.key(key)),
+	})
+	if err != nil && !isS3NotFound(err) {
+		return err
+	}
+
+	return nil
+}
+
+func (store *s3Store) key(key string) string {
+	return store.prefix + strings.TrimPrefix(key, "/")
+}
+
+func isS3NotFound(err error) bool {
+	if reqErr, ok := err.(awserr.RequestFailure); ok && reqErr.StatusCode() == http.StatusNotFound {
+		return true
+	}
+
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == s3.ErrCodeNoSuchKey {
+		return true
+	}
+
+	return false
+}
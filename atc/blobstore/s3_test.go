@@ -0,0 +1,126 @@
+package blobstore_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/concourse/concourse/atc/blobstore"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+// fakeS3 is a minimal stand-in for an S3-compatible API such as MinIO,
+// addressed with path-style requests.
+type fakeS3 struct {
+	lock    sync.Mutex
+	objects map[string][]byte
+}
+
+func (s3 *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s3.lock.Lock()
+	defer s3.lock.Unlock()
+
+	switch r.Method {
+	case http.MethodPut:
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+
+		s3.objects[r.URL.Path] = body
+	case http.MethodGet:
+		body, found := s3.objects[r.URL.Path]
+		if !found {
+			w.Header().Set("Content-Type", "application/xml")
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`<Error><Code>NoSuchKey</Code><Message>The specified key does not exist.</Message></Error>`))
+			return
+		}
+
+		w.Write(body)
+	case http.MethodDelete:
+		delete(s3.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (s3 *fakeS3) Objects() map[string][]byte {
+	s3.lock.Lock()
+	defer s3.lock.Unlock()
+
+	objects := map[string][]byte{}
+	for k, v := range s3.objects {
+		objects[k] = v
+	}
+
+	return objects
+}
+
+var _ = Describe("S3", func() {
+	var (
+		s3     *fakeS3
+		server *httptest.Server
+		store  blobstore.Store
+		ctx    context.Context
+	)
+
+	BeforeEach(func() {
+		s3 = &fakeS3{objects: map[string][]byte{}}
+		server = httptest.NewServer(s3)
+
+		var err error
+		store, err = blobstore.S3{
+			Bucket:          "some-bucket",
+			Prefix:          "concourse/",
+			Region:          "us-east-1",
+			Endpoint:        server.URL,
+			AccessKeyID:     "some-access-key",
+			SecretAccessKey: "some-secret-key",
+			ForcePathStyle:  true,
+		}.Store()
+		Expect(err).ToNot(HaveOccurred())
+
+		ctx = context.Background()
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	It("puts objects under the prefix in the bucket", func() {
+		Expect(store.Put(ctx, "builds/1/some-blob", strings.NewReader("some-contents"))).To(Succeed())
+
+		Expect(s3.Objects()).To(Equal(map[string][]byte{
+			"/some-bucket/concourse/builds/1/some-blob": []byte("some-contents"),
+		}))
+	})
+
+	It("reads back what was put", func() {
+		Expect(store.Put(ctx, "some-blob", strings.NewReader("some-contents"))).To(Succeed())
+
+		reader, err := store.Get(ctx, "some-blob")
+		Expect(err).ToNot(HaveOccurred())
+		defer reader.Close()
+
+		Expect(ioutil.ReadAll(reader)).To(Equal([]byte("some-contents")))
+	})
+
+	It("returns ErrBlobNotFound for missing objects", func() {
+		_, err := store.Get(ctx, "missing")
+		Expect(err).To(Equal(blobstore.ErrBlobNotFound))
+	})
+
+	It("deletes objects", func() {
+		Expect(store.Put(ctx, "some-blob", strings.NewReader("some-contents"))).To(Succeed())
+		Expect(store.Delete(ctx, "some-blob")).To(Succeed())
+
+		Expect(s3.Objects()).To(BeEmpty())
+	})
+})
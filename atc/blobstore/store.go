@@ -0,0 +1,45 @@
+package blobstore
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+
+// ErrBlobNotFound is returned by Get when no blob has been stored under the
+// requested key.
+var ErrBlobNotFound = errors.New("blob not found")
+
+// Store persists opaque blobs of data outside of the database, addressed by
+// slash-separated keys.
+//
+//counterfeiter:generate . Store
+type Store interface {
+	Put(ctx context.Context, key string, contents io.Reader) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type Config struct {
+	Local Local
+	S3    S3
+}
+
+// IsConfigured identifies whether any of the backends have been configured.
+func (c Config) IsConfigured() bool {
+	return c.Local.IsConfigured() || c.S3.IsConfigured()
+}
+
+// NewStore constructs a Store using the first configured backend.
+func (c Config) NewStore() (Store, error) {
+	switch {
+	case c.S3.IsConfigured():
+		return c.S3.Store()
+	case c.Local.IsConfigured():
+		return c.Local.Store()
+	}
+
+	return nil, errors.New("no blob store backend configured")
+}
@@ -0,0 +1,21 @@
+package blobstore_test
+
+import (
+	"github.com/concourse/concourse/atc/blobstore"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Config", func() {
+	It("is not configured by default", func() {
+		Expect(blobstore.Config{}.IsConfigured()).To(BeFalse())
+
+		_, err := blobstore.Config{}.NewStore()
+		Expect(err).To(HaveOccurred())
+	})
+
+	It("is configured by any backend", func() {
+		Expect(blobstore.Config{Local: blobstore.Local{Dir: "/some/dir"}}.IsConfigured()).To(BeTrue())
+		Expect(blobstore.Config{S3: blobstore.S3{Bucket: "some-bucket"}}.IsConfigured()).To(BeTrue())
+	})
+})
@@ -56,6 +56,22 @@ func (b Build) OneOff() bool {
 	return b.JobName == ""
 }
 
+type BuildLifecycleEventType string
+
+const (
+	BuildCreated       BuildLifecycleEventType = "created"
+	BuildStarted       BuildLifecycleEventType = "started"
+	BuildStatusChanged BuildLifecycleEventType = "status"
+	BuildFinished      BuildLifecycleEventType = "finished"
+)
+
+// BuildLifecycleEvent is sent on a team's event stream whenever one of its
+// builds is created or changes status.
+type BuildLifecycleEvent struct {
+	Type  BuildLifecycleEventType `json:"type"`
+	Build Build                   `json:"build"`
+}
+
 type BuildPreparationStatus string
 
 const (
@@ -75,3 +91,10 @@ type BuildPreparation struct {
 	InputsSatisfied     BuildPreparationStatus            `json:"inputs_satisfied"`
 	MissingInputReasons MissingInputReasons               `json:"missing_input_reasons"`
 }
+
+// BuildApproval is a user's decision on a build's wait_for_approval step.
+type BuildApproval struct {
+	Approved bool   `json:"approved"`
+	User     string `json:"user,omitempty"`
+	Comment  string `json:"comment,omitempty"`
+}
@@ -64,6 +64,7 @@ func (visitor *planVisitor) VisitTask(step *atc.TaskStep) error {
 		OutputMapping:     step.OutputMapping,
 		ImageArtifactName: step.ImageArtifactName,
 		Timeout:           step.Timeout,
+		CacheResult:       step.CacheResult,
 
 		VersionedResourceTypes: visitor.resourceTypes,
 	})
@@ -79,14 +80,32 @@ func (visitor *planVisitor) VisitRun(step *atc.RunStep) error {
 
 	object := prototype.Defaults.Merge(atc.Source(step.Params))
 
+	image := atc.ImageResource{
+		Name:   prototype.Name,
+		Type:   prototype.Type,
+		Source: prototype.Source,
+		Params: prototype.Params,
+		Tags:   prototype.Tags,
+	}
+	image.ApplySourceDefaults(visitor.resourceTypes)
+
 	visitor.plan = visitor.planFactory.NewPlan(atc.RunPlan{
 		Message:    step.Message,
 		Type:       step.Type,
 		Object:     atc.Params(object),
-		Privileged: step.Privileged,
+		Privileged: step.Privileged || prototype.Privileged,
 		Tags:       step.Tags,
 		Limits:     step.Limits,
 		Timeout:    step.Timeout,
+
+		Image: image,
+
+		Inputs:        step.Inputs,
+		Outputs:       step.Outputs,
+		InputMapping:  step.InputMapping,
+		OutputMapping: step.OutputMapping,
+
+		VersionedResourceTypes: visitor.resourceTypes,
 	})
 
 	return nil
@@ -292,6 +311,17 @@ func (visitor *planVisitor) VisitLoadVar(step *atc.LoadVarStep) error {
 	return nil
 }
 
+func (visitor *planVisitor) VisitApproval(step *atc.ApprovalStep) error {
+	visitor.plan = visitor.planFactory.NewPlan(atc.ApprovalPlan{
+		Name:    step.Name,
+		Roles:   step.Roles,
+		Users:   step.Users,
+		Timeout: step.Timeout,
+	})
+
+	return nil
+}
+
 func (visitor *planVisitor) VisitTry(step *atc.TryStep) error {
 	err := step.Step.Config.Visit(visitor)
 	if err != nil {
@@ -257,6 +257,7 @@ var factoryTests = []PlannerTest{
 			OutputMapping:     map[string]string{"specific": "generic"},
 			ImageArtifactName: "some-image",
 			Timeout:           "1h",
+			CacheResult:       true,
 		},
 
 		PlanJSON: `{
@@ -276,6 +277,7 @@ var factoryTests = []PlannerTest{
 				"output_mapping": {"specific": "generic"},
 				"image": "some-image",
 				"timeout": "1h",
+				"cache_result": true,
 				"resource_types": [
 					{
 						"name": "some-resource-type",
@@ -374,7 +376,64 @@ var factoryTests = []PlannerTest{
 				"privileged": true,
 				"tags": ["tag-1", "tag-2"],
 				"container_limits": {"cpu": 456, "memory": 2048},
-				"timeout": "1h"
+				"timeout": "1h",
+				"image": {
+					"name": "some-prototype",
+					"type": "some-base-resource-type",
+					"source": {"some": "prototype-source", "default-key": "default-value"}
+				},
+				"resource_types": [
+					{
+						"name": "some-resource-type",
+						"type": "some-base-resource-type",
+						"source": {"some": "type-source"},
+						"defaults": {"default-key":"default-value"},
+						"version": {"some": "type-version"}
+					}
+				]
+			}
+		}`,
+	},
+	{
+		Title: "run step with inputs and outputs",
+
+		Config: &atc.RunStep{
+			Message:       "some-message",
+			Type:          "some-prototype",
+			Inputs:        []string{"some-input"},
+			InputMapping:  map[string]string{"some-input": "some-artifact"},
+			Outputs:       []string{"some-output"},
+			OutputMapping: map[string]string{"some-output": "other-artifact"},
+		},
+
+		PlanJSON: `{
+			"id": "(unique)",
+			"run": {
+				"message": "some-message",
+				"type": "some-prototype",
+				"object": {
+					"default-key": "default-value",
+					"other-default-key": "other-default-value"
+				},
+				"privileged": false,
+				"image": {
+					"name": "some-prototype",
+					"type": "some-base-resource-type",
+					"source": {"some": "prototype-source", "default-key": "default-value"}
+				},
+				"inputs": ["some-input"],
+				"input_mapping": {"some-input": "some-artifact"},
+				"outputs": ["some-output"],
+				"output_mapping": {"some-output": "other-artifact"},
+				"resource_types": [
+					{
+						"name": "some-resource-type",
+						"type": "some-base-resource-type",
+						"source": {"some": "type-source"},
+						"defaults": {"default-key":"default-value"},
+						"version": {"some": "type-version"}
+					}
+				]
 			}
 		}`,
 	},
@@ -420,6 +479,26 @@ var factoryTests = []PlannerTest{
 			}
 		}`,
 	},
+	{
+		Title: "wait_for_approval step",
+
+		Config: &atc.ApprovalStep{
+			Name:    "some-approval",
+			Roles:   []string{"owner"},
+			Users:   []string{"github:some-user"},
+			Timeout: "1h",
+		},
+
+		PlanJSON: `{
+			"id": "(unique)",
+			"approval": {
+				"name": "some-approval",
+				"roles": ["owner"],
+				"users": ["github:some-user"],
+				"timeout": "1h"
+			}
+		}`,
+	},
 	{
 		Title: "try step",
 
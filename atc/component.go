@@ -4,11 +4,16 @@ import "time"
 
 const (
 	ComponentScheduler                  = "scheduler"
+	ComponentCronScheduler              = "cron_scheduler"
 	ComponentBuildTracker               = "tracker"
 	ComponentLidarScanner               = "scanner"
 	ComponentBuildReaper                = "reaper"
 	ComponentSyslogDrainer              = "drainer"
+	ComponentNotificationDeliverer      = "notification_deliverer"
 	ComponentCollectorAccessTokens      = "collector_access_tokens"
+	ComponentCollectorArchivedArtifacts = "collector_archived_artifacts"
+	ComponentCollectorAuditEvents       = "collector_audit_events"
+	ComponentCollectorBuildEventArchive = "collector_build_event_archive"
 	ComponentCollectorArtifacts         = "collector_artifacts"
 	ComponentCollectorBuilds            = "collector_builds"
 	ComponentCollectorCheckSessions     = "collector_check_sessions"
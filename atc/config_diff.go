@@ -34,45 +34,76 @@ func name(v interface{}) string {
 	return reflect.ValueOf(v).FieldByName("Name").String()
 }
 
+// PlainWriter wraps a writer so that config diffs are rendered to it without
+// color or terminal control characters, e.g. when they are served over the
+// API rather than shown in a terminal.
+func PlainWriter(w io.Writer) io.Writer {
+	return plainWriter{w}
+}
+
+type plainWriter struct {
+	io.Writer
+}
+
+type diffStyle struct {
+	plain bool
+}
+
+func (style diffStyle) color(s string, color string) string {
+	if style.plain {
+		return s
+	}
+
+	return ansi.Color(s, color)
+}
+
 func (diff Diff) Render(to io.Writer, label string) {
+	diff.render(to, label, diffStyle{})
+}
+
+func (diff Diff) render(to io.Writer, label string, style diffStyle) {
 
 	if diff.Before != nil && diff.After != nil {
-		fmt.Fprintf(to, ansi.Color("%s %s has changed:", "yellow")+"\n", label, name(diff.Before))
+		fmt.Fprintf(to, style.color("%s %s has changed:", "yellow")+"\n", label, name(diff.Before))
 
 		payloadA, _ := yaml.Marshal(diff.Before)
 		payloadB, _ := yaml.Marshal(diff.After)
 
-		renderDiff(to, string(payloadA), string(payloadB))
+		renderDiff(to, string(payloadA), string(payloadB), style)
 	} else if diff.Before != nil {
-		fmt.Fprintf(to, ansi.Color("%s %s has been removed:", "yellow")+"\n", label, name(diff.Before))
+		fmt.Fprintf(to, style.color("%s %s has been removed:", "yellow")+"\n", label, name(diff.Before))
 
 		payloadA, _ := yaml.Marshal(diff.Before)
 
-		renderDiff(to, string(payloadA), "")
+		renderDiff(to, string(payloadA), "", style)
 	} else {
-		fmt.Fprintf(to, ansi.Color("%s %s has been added:", "yellow")+"\n", label, name(diff.After))
+		fmt.Fprintf(to, style.color("%s %s has been added:", "yellow")+"\n", label, name(diff.After))
 
 		payloadB, _ := yaml.Marshal(diff.After)
 
-		renderDiff(to, "", string(payloadB))
+		renderDiff(to, "", string(payloadB), style)
 	}
 }
 
 func (diff DisplayDiff) Render(to io.Writer) {
+	diff.render(to, diffStyle{})
+}
+
+func (diff DisplayDiff) render(to io.Writer, style diffStyle) {
 	label := "display configuration"
 	if diff.Before != nil && diff.After != nil {
-		fmt.Fprintf(to, ansi.Color("%s has changed:", "yellow")+"\n", label)
+		fmt.Fprintf(to, style.color("%s has changed:", "yellow")+"\n", label)
 		payloadA, _ := yaml.Marshal(diff.Before)
 		payloadB, _ := yaml.Marshal(diff.After)
-		renderDiff(to, string(payloadA), string(payloadB))
+		renderDiff(to, string(payloadA), string(payloadB), style)
 	} else if diff.Before != nil {
-		fmt.Fprintf(to, ansi.Color("%s has been removed:", "yellow")+"\n", label)
+		fmt.Fprintf(to, style.color("%s has been removed:", "yellow")+"\n", label)
 		payloadA, _ := yaml.Marshal(diff.Before)
-		renderDiff(to, string(payloadA), "")
+		renderDiff(to, string(payloadA), "", style)
 	} else {
-		fmt.Fprintf(to, ansi.Color("%s has been added:", "yellow")+"\n", label)
+		fmt.Fprintf(to, style.color("%s has been added:", "yellow")+"\n", label)
 		payloadB, _ := yaml.Marshal(diff.After)
-		renderDiff(to, "", string(payloadB))
+		renderDiff(to, "", string(payloadB), style)
 	}
 }
 
@@ -151,6 +182,36 @@ func (index ResourceTypeIndex) FindEquivalent(obj interface{}) (interface{}, boo
 	return ResourceTypes(index).Lookup(name(obj))
 }
 
+type PrototypeIndex Prototypes
+
+func (index PrototypeIndex) Slice() []interface{} {
+	slice := make([]interface{}, len(index))
+	for i, object := range index {
+		slice[i] = object
+	}
+
+	return slice
+}
+
+func (index PrototypeIndex) FindEquivalent(obj interface{}) (interface{}, bool) {
+	return Prototypes(index).Lookup(name(obj))
+}
+
+type TeamIndex Teams
+
+func (index TeamIndex) Slice() []interface{} {
+	slice := make([]interface{}, len(index))
+	for i, object := range index {
+		slice[i] = object
+	}
+
+	return slice
+}
+
+func (index TeamIndex) FindEquivalent(obj interface{}) (interface{}, bool) {
+	return Teams(index).Lookup(name(obj))
+}
+
 func groupDiffIndices(oldIndex GroupIndex, newIndex GroupIndex) Diffs {
 	diffs := Diffs{}
 
@@ -242,8 +303,26 @@ func diffDisplay(oldDisplay, newDisplay *DisplayConfig) (DisplayDiff, bool) {
 	}, practicallyDifferent(oldDisplay, newDisplay)
 }
 
-func renderDiff(to io.Writer, a, b string) {
+func renderDiff(to io.Writer, a, b string, style diffStyle) {
 	diffs := difflib.Diff(strings.Split(a, "\n"), strings.Split(b, "\n"))
+
+	if style.plain {
+		// without a terminal to backspace over the indentation with, the
+		// markers are given their own column
+		for _, diff := range diffs {
+			switch diff.Delta {
+			case difflib.RightOnly:
+				fmt.Fprintf(to, "+ %s\n", diff.Payload)
+			case difflib.LeftOnly:
+				fmt.Fprintf(to, "- %s\n", diff.Payload)
+			case difflib.Common:
+				fmt.Fprintf(to, "  %s\n", diff.Payload)
+			}
+		}
+
+		return
+	}
+
 	indent := gexec.NewPrefixedWriter("\b\b", to)
 
 	for _, diff := range diffs {
@@ -274,9 +353,14 @@ func practicallyDifferent(a, b interface{}) bool {
 	return !bytes.Equal(marshalledA, marshalledB)
 }
 
+// Diff renders the changes from c to newConfig, returning whether there are
+// any. They are colored unless out was wrapped with PlainWriter.
 func (c Config) Diff(out io.Writer, newConfig Config) bool {
 	var diffExists bool
 
+	_, plain := out.(plainWriter)
+	style := diffStyle{plain: plain}
+
 	indent := gexec.NewPrefixedWriter("  ", out)
 
 	groupDiffs := groupDiffIndices(GroupIndex(c.Groups), GroupIndex(newConfig.Groups))
@@ -285,17 +369,17 @@ func (c Config) Diff(out io.Writer, newConfig Config) bool {
 		fmt.Fprintln(out, "groups:")
 
 		for _, diff := range groupDiffs {
-			diff.Render(indent, "group")
+			diff.render(indent, "group", style)
 		}
 	}
 
 	varSourceDiffs := diffIndices(VarSourceIndex(c.VarSources), VarSourceIndex(newConfig.VarSources))
 	if len(varSourceDiffs) > 0 {
 		diffExists = true
-		fmt.Println("variable source:")
+		fmt.Fprintln(out, "variable source:")
 
 		for _, diff := range varSourceDiffs {
-			diff.Render(indent, "variable source")
+			diff.render(indent, "variable source", style)
 		}
 	}
 
@@ -305,7 +389,7 @@ func (c Config) Diff(out io.Writer, newConfig Config) bool {
 		fmt.Fprintln(out, "resources:")
 
 		for _, diff := range resourceDiffs {
-			diff.Render(indent, "resource")
+			diff.render(indent, "resource", style)
 		}
 	}
 
@@ -315,7 +399,17 @@ func (c Config) Diff(out io.Writer, newConfig Config) bool {
 		fmt.Fprintln(out, "resource types:")
 
 		for _, diff := range resourceTypeDiffs {
-			diff.Render(indent, "resource type")
+			diff.render(indent, "resource type", style)
+		}
+	}
+
+	prototypeDiffs := diffIndices(PrototypeIndex(c.Prototypes), PrototypeIndex(newConfig.Prototypes))
+	if len(prototypeDiffs) > 0 {
+		diffExists = true
+		fmt.Fprintln(out, "prototypes:")
+
+		for _, diff := range prototypeDiffs {
+			diff.render(indent, "prototype", style)
 		}
 	}
 
@@ -325,15 +419,49 @@ func (c Config) Diff(out io.Writer, newConfig Config) bool {
 		fmt.Fprintln(out, "jobs:")
 
 		for _, diff := range jobDiffs {
-			diff.Render(indent, "job")
+			diff.render(indent, "job", style)
 		}
 	}
 
 	displayDiff, diff := diffDisplay(c.Display, newConfig.Display)
 	if diff {
 		diffExists = true
-		displayDiff.Render(indent)
+		displayDiff.render(indent, style)
 	}
 
 	return diffExists
 }
+
+// Diff renders the changes between the current teams and newTeams. IDs are
+// ignored, as are quotas with no limits.
+func (teams Teams) Diff(out io.Writer, newTeams Teams) bool {
+	indent := gexec.NewPrefixedWriter("  ", out)
+
+	teamDiffs := diffIndices(TeamIndex(comparableTeams(teams)), TeamIndex(comparableTeams(newTeams)))
+	if len(teamDiffs) == 0 {
+		return false
+	}
+
+	fmt.Fprintln(out, "teams:")
+
+	for _, diff := range teamDiffs {
+		diff.Render(indent, "team")
+	}
+
+	return true
+}
+
+func comparableTeams(teams Teams) Teams {
+	comparable := make(Teams, len(teams))
+	for i, team := range teams {
+		team.ID = 0
+
+		if team.Quota != nil && team.Quota.IsZero() {
+			team.Quota = nil
+		}
+
+		comparable[i] = team
+	}
+
+	return comparable
+}
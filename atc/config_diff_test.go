@@ -140,6 +140,79 @@ var _ = Describe("Config diff", func() {
 		})
 	})
 
+	Describe("variable source config", func() {
+		Context("when a var source is added", func() {
+			It("says config has been added", func() {
+				buffer := NewBuffer()
+				newConfig := Config{
+					VarSources: VarSourceConfigs{
+						{Name: "some-var-source", Type: "vault"},
+					},
+				}
+				diff := Config{}.Diff(buffer, newConfig)
+				Expect(diff).To(BeTrue())
+				Eventually(buffer).Should(Say("variable source:"))
+				Eventually(buffer).Should(Say("variable source some-var-source has been added:"))
+			})
+		})
+	})
+
+	Describe("prototype config", func() {
+		var prototype Prototype
+		BeforeEach(func() {
+			prototype = Prototype{
+				Name:   "some-prototype",
+				Type:   "registry-image",
+				Source: Source{"repository": "some-repository"},
+			}
+		})
+
+		Context("when a prototype is added", func() {
+			It("says config has been added", func() {
+				buffer := NewBuffer()
+				newConfig := Config{
+					Prototypes: Prototypes{prototype},
+				}
+				diff := Config{}.Diff(buffer, newConfig)
+				Expect(diff).To(BeTrue())
+				Eventually(buffer).Should(Say("prototypes:"))
+				Eventually(buffer).Should(Say("prototype some-prototype has been added:"))
+				Eventually(buffer).Should(Say(`\+.*name: some-prototype`))
+			})
+		})
+
+		Context("when a prototype changes", func() {
+			It("says config has changed", func() {
+				buffer := NewBuffer()
+				oldConfig := Config{
+					Prototypes: Prototypes{prototype},
+				}
+
+				prototype.Source = Source{"repository": "some-other-repository"}
+				newConfig := Config{
+					Prototypes: Prototypes{prototype},
+				}
+
+				diff := oldConfig.Diff(buffer, newConfig)
+				Expect(diff).To(BeTrue())
+				Eventually(buffer).Should(Say("prototype some-prototype has changed:"))
+				Eventually(buffer).Should(Say("-.*repository: some-repository"))
+				Eventually(buffer).Should(Say(`\+.*repository: some-other-repository`))
+			})
+		})
+
+		Context("when there is no prototype to change", func() {
+			It("says there are no changes to apply", func() {
+				config := Config{
+					Prototypes: Prototypes{prototype},
+				}
+
+				diff := config.Diff(GinkgoWriter, config)
+				Expect(diff).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("display config", func() {
 		var display DisplayConfig
 		BeforeEach(func() {
@@ -216,4 +289,65 @@ var _ = Describe("Config diff", func() {
 			})
 		})
 	})
+
+	Describe("teams", func() {
+		var team Team
+		BeforeEach(func() {
+			team = Team{
+				Name: "some-team",
+				Auth: TeamAuth{
+					"owner": {"users": []string{"local:some-user"}},
+				},
+			}
+		})
+
+		Context("when a team is added", func() {
+			It("says the team has been added", func() {
+				buffer := NewBuffer()
+				diff := Teams{}.Diff(buffer, Teams{team})
+				Expect(diff).To(BeTrue())
+				Eventually(buffer).Should(Say("teams:"))
+				Eventually(buffer).Should(Say("team some-team has been added:"))
+				Eventually(buffer).Should(Say(`\+.*- local:some-user`))
+			})
+		})
+
+		Context("when a team is removed", func() {
+			It("says the team has been removed", func() {
+				buffer := NewBuffer()
+				diff := Teams{team}.Diff(buffer, Teams{})
+				Expect(diff).To(BeTrue())
+				Eventually(buffer).Should(Say("team some-team has been removed:"))
+			})
+		})
+
+		Context("when a team's auth changes", func() {
+			It("says the team has changed", func() {
+				newTeam := team
+				newTeam.Auth = TeamAuth{
+					"owner": {"users": []string{"local:some-other-user"}},
+				}
+
+				buffer := NewBuffer()
+				diff := Teams{team}.Diff(buffer, Teams{newTeam})
+				Expect(diff).To(BeTrue())
+				Eventually(buffer).Should(Say("team some-team has changed:"))
+				Eventually(buffer).Should(Say("-.*- local:some-user"))
+				Eventually(buffer).Should(Say(`\+.*- local:some-other-user`))
+			})
+		})
+
+		Context("when only the IDs and empty quotas differ", func() {
+			It("says there are no changes to apply", func() {
+				existing := team
+				existing.ID = 42
+
+				newTeam := team
+				newTeam.Quota = &TeamQuota{}
+
+				diff := Teams{existing}.Diff(GinkgoWriter, Teams{newTeam})
+				Expect(diff).To(BeFalse())
+			})
+		})
+	})
 })
@@ -0,0 +1,296 @@
+// Package configinclude expands the reusable fragments a pipeline config
+// includes from other files.
+//
+// A pipeline config may list files to include at its top level:
+//
+//   include:
+//   - file: modules/unit.yml
+//     vars: {package: atc}
+//
+// Each included file is a fragment of a pipeline config, defining any of
+// groups, var_sources, resources, resource_types, prototypes and jobs. The
+// fragment is interpolated with the vars given to it before its contents are
+// appended to the including config, so that the same fragment may be
+// instantiated more than once with different vars. Fragments may include
+// other fragments themselves.
+package configinclude
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/vars"
+)
+
+// MaxDepth is how deeply includes may be nested.
+const MaxDepth = 10
+
+// Include instantiates the fragment in File with Vars.
+type Include struct {
+	File string                 `json:"file"`
+	Vars map[string]interface{} `json:"vars,omitempty"`
+}
+
+// Source reads the files included by a pipeline config.
+type Source interface {
+	// Join returns the path of a file included by the file at from.
+	Join(from string, path string) string
+
+	Read(path string) ([]byte, error)
+}
+
+// FileSource reads included files from the local filesystem, resolving
+// relative paths against the directory of the including file.
+type FileSource struct{}
+
+func (FileSource) Join(from string, path string) string {
+	if filepath.IsAbs(path) {
+		return path
+	}
+
+	return filepath.Join(filepath.Dir(from), filepath.FromSlash(path))
+}
+
+func (FileSource) Read(path string) ([]byte, error) {
+	return ioutil.ReadFile(path)
+}
+
+// mergedFields are the fields of a pipeline config which fragments may
+// contribute to, along with the label used for them in errors.
+var mergedFields = []struct {
+	Key   string
+	Label string
+}{
+	{"groups", "group"},
+	{"var_sources", "var source"},
+	{"resources", "resource"},
+	{"resource_types", "resource type"},
+	{"prototypes", "prototype"},
+	{"jobs", "job"},
+}
+
+// Resolve expands the includes of the config read from path, returning the
+// config with the contents of every included fragment merged in and the
+// include field removed. Configs which do not include anything are returned
+// as-is.
+//
+// Fragments are interpolated with the vars of their include, falling back to
+// params. Any vars left unresolved are kept so that they may be resolved
+// when the pipeline runs.
+func Resolve(config []byte, path string, source Source, params []vars.Variables) ([]byte, error) {
+	var skeleton struct {
+		Include interface{} `json:"include"`
+	}
+
+	err := yaml.Unmarshal(config, &skeleton)
+	if err != nil {
+		return nil, err
+	}
+
+	if skeleton.Include == nil {
+		return config, nil
+	}
+
+	resolver := resolver{
+		source: source,
+		params: params,
+		origin: map[string]map[string]string{},
+	}
+
+	merged, err := resolver.resolve(config, path, []string{path})
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := json.Marshal(merged)
+	if err != nil {
+		return nil, err
+	}
+
+	return yaml.JSONToYAML(payload)
+}
+
+type resolver struct {
+	source Source
+	params []vars.Variables
+
+	// origin tracks the file which defined each named item, keyed by field,
+	// to explain conflicts between fragments.
+	origin map[string]map[string]string
+}
+
+func (r resolver) resolve(config []byte, path string, stack []string) (map[string]interface{}, error) {
+	fields, err := decode(config)
+	if err != nil {
+		return nil, fmt.Errorf("parse %s: %w", path, err)
+	}
+
+	var includes []Include
+	if raw, found := fields["include"]; found {
+		payload, err := json.Marshal(raw)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(payload, &includes)
+		if err != nil {
+			return nil, fmt.Errorf("malformed include in %s: %w", path, err)
+		}
+
+		delete(fields, "include")
+	}
+
+	for _, field := range mergedFields {
+		err := r.record(field.Key, field.Label, fields[field.Key], path)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, include := range includes {
+		if include.File == "" {
+			return nil, fmt.Errorf("include in %s does not specify a file", path)
+		}
+
+		includedPath := r.source.Join(path, include.File)
+
+		for _, seen := range stack {
+			if seen == includedPath {
+				return nil, fmt.Errorf("include cycle: %s", strings.Join(append(stack, includedPath), " -> "))
+			}
+		}
+
+		if len(stack) > MaxDepth {
+			return nil, fmt.Errorf("includes nested too deeply in %s (max %d)", path, MaxDepth)
+		}
+
+		fragment, err := r.source.Read(includedPath)
+		if err != nil {
+			return nil, fmt.Errorf("read included file %s: %w", includedPath, err)
+		}
+
+		params := r.params
+		if len(include.Vars) > 0 {
+			params = append([]vars.Variables{vars.StaticVariables(include.Vars)}, r.params...)
+		}
+
+		fragment, err = vars.NewTemplateResolver(fragment, params).Resolve(false, false)
+		if err != nil {
+			return nil, fmt.Errorf("interpolate included file %s: %w", includedPath, err)
+		}
+
+		included, err := r.resolve(fragment, includedPath, append(stack, includedPath))
+		if err != nil {
+			return nil, err
+		}
+
+		for key := range included {
+			if !isMerged(key) {
+				return nil, fmt.Errorf("included file %s may not define '%s'", includedPath, key)
+			}
+		}
+
+		for _, field := range mergedFields {
+			items, _ := included[field.Key].([]interface{})
+			if len(items) == 0 {
+				continue
+			}
+
+			existing, _ := fields[field.Key].([]interface{})
+			fields[field.Key] = append(existing, items...)
+		}
+	}
+
+	return fields, nil
+}
+
+// record remembers which file defined each of the named items so that
+// fragments defining the same name as another file can be rejected, as can
+// fragments which are included more than once without varying their names.
+func (r resolver) record(key string, label string, value interface{}, path string) error {
+	if value == nil {
+		return nil
+	}
+
+	items, ok := value.([]interface{})
+	if !ok {
+		return fmt.Errorf("malformed %s in %s: expected a list", key, path)
+	}
+
+	if r.origin[key] == nil {
+		r.origin[key] = map[string]string{}
+	}
+
+	// names repeated within a single file are left to config validation
+	recorded := map[string]bool{}
+
+	for _, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		name, ok := obj["name"].(string)
+		if !ok {
+			continue
+		}
+
+		if recorded[name] {
+			continue
+		}
+
+		if definedIn, found := r.origin[key][name]; found {
+			if definedIn == path {
+				return fmt.Errorf("%s '%s' is defined more than once by %s, which is included repeatedly", label, name, path)
+			}
+
+			return fmt.Errorf("%s '%s' is defined in both %s and %s", label, name, definedIn, path)
+		}
+
+		recorded[name] = true
+		r.origin[key][name] = path
+	}
+
+	return nil
+}
+
+func isMerged(key string) bool {
+	for _, field := range mergedFields {
+		if field.Key == key {
+			return true
+		}
+	}
+
+	return false
+}
+
+// decode parses YAML into generic values, preserving numbers as they were
+// written rather than converting them to floats.
+func decode(config []byte) (map[string]interface{}, error) {
+	payload, err := yaml.YAMLToJSON(config)
+	if err != nil {
+		return nil, err
+	}
+
+	var fields map[string]interface{}
+
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+
+	err = decoder.Decode(&fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if fields == nil {
+		fields = map[string]interface{}{}
+	}
+
+	return fields, nil
+}
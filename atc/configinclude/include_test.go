@@ -0,0 +1,318 @@
+package configinclude_test
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/configinclude"
+	"github.com/concourse/concourse/vars"
+)
+
+type memorySource map[string]string
+
+func (source memorySource) Join(from string, file string) string {
+	return path.Join(path.Dir(from), file)
+}
+
+func (source memorySource) Read(file string) ([]byte, error) {
+	content, found := source[file]
+	if !found {
+		return nil, errors.New("file not found")
+	}
+
+	return []byte(content), nil
+}
+
+var _ = Describe("Resolve", func() {
+	var (
+		config []byte
+		source memorySource
+		params []vars.Variables
+
+		resolved    []byte
+		resolvedErr error
+	)
+
+	BeforeEach(func() {
+		source = memorySource{}
+		params = nil
+	})
+
+	JustBeforeEach(func() {
+		resolved, resolvedErr = configinclude.Resolve(config, "repo/ci/pipeline.yml", source, params)
+	})
+
+	resolvedConfig := func() atc.Config {
+		var config atc.Config
+		Expect(atc.UnmarshalConfig(resolved, &config)).To(Succeed())
+		return config
+	}
+
+	Context("when the config does not include anything", func() {
+		BeforeEach(func() {
+			config = []byte("# some comment\njobs: [{name: some-job}]\n")
+		})
+
+		It("returns the config as-is", func() {
+			Expect(resolvedErr).ToNot(HaveOccurred())
+			Expect(resolved).To(Equal(config))
+		})
+	})
+
+	Context("when the config includes a fragment", func() {
+		BeforeEach(func() {
+			config = []byte(`
+include:
+- file: modules/unit.yml
+  vars: {package: atc}
+
+resources:
+- name: repo
+  type: git
+  source: {uri: ((uri))}
+
+jobs:
+- name: some-job
+  plan: [{get: repo}]
+`)
+
+			source["repo/ci/modules/unit.yml"] = `
+resources:
+- name: ((package))-image
+  type: registry-image
+  source: {repository: concourse/unit, tag: ((tag))}
+
+jobs:
+- name: unit-((package))
+  max_in_flight: 3
+  plan:
+  - get: repo
+  - task: unit
+    file: repo/ci/tasks/unit.yml
+    params: {PACKAGE: ((package))}
+`
+		})
+
+		It("appends the contents of the fragment relative to the including file", func() {
+			Expect(resolvedErr).ToNot(HaveOccurred())
+
+			config := resolvedConfig()
+			Expect(config.Resources).To(HaveLen(2))
+			Expect(config.Resources[0].Name).To(Equal("repo"))
+			Expect(config.Resources[1].Name).To(Equal("atc-image"))
+
+			Expect(config.Jobs).To(HaveLen(2))
+			Expect(config.Jobs[0].Name).To(Equal("some-job"))
+			Expect(config.Jobs[1].Name).To(Equal("unit-atc"))
+			Expect(config.Jobs[1].MaxInFlight()).To(Equal(3))
+		})
+
+		It("removes the include field", func() {
+			var fields map[string]interface{}
+			Expect(yaml.Unmarshal(resolved, &fields)).To(Succeed())
+			Expect(fields).ToNot(HaveKey("include"))
+		})
+
+		It("leaves vars it can not resolve in place", func() {
+			Expect(resolvedErr).ToNot(HaveOccurred())
+
+			config := resolvedConfig()
+			Expect(config.Resources[0].Source).To(Equal(atc.Source{"uri": "((uri))"}))
+			Expect(config.Resources[1].Source).To(Equal(atc.Source{"repository": "concourse/unit", "tag": "((tag))"}))
+		})
+
+		Context("with params", func() {
+			BeforeEach(func() {
+				params = []vars.Variables{
+					vars.StaticVariables{"tag": "some-tag", "package": "ignored"},
+				}
+			})
+
+			It("falls back to the params for vars not given to the include", func() {
+				Expect(resolvedErr).ToNot(HaveOccurred())
+
+				config := resolvedConfig()
+				Expect(config.Resources[1].Name).To(Equal("atc-image"))
+				Expect(config.Resources[1].Source).To(Equal(atc.Source{"repository": "concourse/unit", "tag": "some-tag"}))
+			})
+		})
+
+		Context("when the fragment is included more than once", func() {
+			BeforeEach(func() {
+				config = []byte(`
+include:
+- file: modules/unit.yml
+  vars: {package: atc}
+- file: modules/unit.yml
+  vars: {package: fly}
+`)
+			})
+
+			It("instantiates it with each set of vars", func() {
+				Expect(resolvedErr).ToNot(HaveOccurred())
+
+				config := resolvedConfig()
+				Expect(config.Jobs).To(HaveLen(2))
+				Expect(config.Jobs[0].Name).To(Equal("unit-atc"))
+				Expect(config.Jobs[1].Name).To(Equal("unit-fly"))
+			})
+
+			Context("with the same vars", func() {
+				BeforeEach(func() {
+					config = []byte(`
+include:
+- file: modules/unit.yml
+  vars: {package: atc}
+- file: modules/unit.yml
+  vars: {package: atc}
+`)
+				})
+
+				It("errors", func() {
+					Expect(resolvedErr).To(MatchError("resource 'atc-image' is defined more than once by repo/ci/modules/unit.yml, which is included repeatedly"))
+				})
+			})
+		})
+
+		Context("when the fragment includes another fragment", func() {
+			BeforeEach(func() {
+				source["repo/ci/modules/unit.yml"] = `
+include:
+- file: ../shared/groups.yml
+  vars: {job: unit-((package))}
+`
+				source["repo/ci/shared/groups.yml"] = `
+groups:
+- name: all
+  jobs: [((job))]
+`
+			})
+
+			It("resolves it relative to the fragment", func() {
+				Expect(resolvedErr).ToNot(HaveOccurred())
+
+				config := resolvedConfig()
+				Expect(config.Groups).To(Equal(atc.GroupConfigs{
+					{Name: "all", Jobs: []string{"unit-atc"}},
+				}))
+			})
+		})
+
+		Context("when the fragment defines a name the including file defines", func() {
+			BeforeEach(func() {
+				source["repo/ci/modules/unit.yml"] = `
+jobs:
+- name: some-job
+`
+			})
+
+			It("errors", func() {
+				Expect(resolvedErr).To(MatchError("job 'some-job' is defined in both repo/ci/pipeline.yml and repo/ci/modules/unit.yml"))
+			})
+		})
+
+		Context("when the fragment defines fields other than those merged", func() {
+			BeforeEach(func() {
+				source["repo/ci/modules/unit.yml"] = `
+display:
+  background_image: some-image
+`
+			})
+
+			It("errors", func() {
+				Expect(resolvedErr).To(MatchError("included file repo/ci/modules/unit.yml may not define 'display'"))
+			})
+		})
+
+		Context("when the fragment is empty", func() {
+			BeforeEach(func() {
+				source["repo/ci/modules/unit.yml"] = ""
+			})
+
+			It("contributes nothing", func() {
+				Expect(resolvedErr).ToNot(HaveOccurred())
+				Expect(resolvedConfig().Jobs).To(HaveLen(1))
+			})
+		})
+
+		Context("when the fragment does not exist", func() {
+			BeforeEach(func() {
+				delete(source, "repo/ci/modules/unit.yml")
+			})
+
+			It("errors", func() {
+				Expect(resolvedErr).To(MatchError("read included file repo/ci/modules/unit.yml: file not found"))
+			})
+		})
+	})
+
+	Context("when includes form a cycle", func() {
+		BeforeEach(func() {
+			config = []byte(`include: [{file: a.yml}]`)
+			source["repo/ci/a.yml"] = `include: [{file: b.yml}]`
+			source["repo/ci/b.yml"] = `include: [{file: a.yml}]`
+		})
+
+		It("errors", func() {
+			Expect(resolvedErr).To(MatchError("include cycle: repo/ci/pipeline.yml -> repo/ci/a.yml -> repo/ci/b.yml -> repo/ci/a.yml"))
+		})
+	})
+
+	Context("when an include does not specify a file", func() {
+		BeforeEach(func() {
+			config = []byte(`include: [{vars: {a: b}}]`)
+		})
+
+		It("errors", func() {
+			Expect(resolvedErr).To(MatchError("include in repo/ci/pipeline.yml does not specify a file"))
+		})
+	})
+
+	Context("when the include is malformed", func() {
+		BeforeEach(func() {
+			config = []byte(`include: modules/unit.yml`)
+		})
+
+		It("errors", func() {
+			Expect(resolvedErr).To(MatchError(ContainSubstring("malformed include in repo/ci/pipeline.yml")))
+		})
+	})
+})
+
+var _ = Describe("FileSource", func() {
+	var tmpdir string
+
+	BeforeEach(func() {
+		var err error
+		tmpdir, err = ioutil.TempDir("", "config-include")
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpdir)).To(Succeed())
+	})
+
+	It("reads files relative to the including file", func() {
+		Expect(os.MkdirAll(filepath.Join(tmpdir, "modules"), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(tmpdir, "modules", "unit.yml"), []byte("jobs: [{name: unit}]"), 0644)).To(Succeed())
+
+		pipelinePath := filepath.Join(tmpdir, "pipeline.yml")
+
+		resolved, err := configinclude.Resolve([]byte("include: [{file: modules/unit.yml}]"), pipelinePath, configinclude.FileSource{}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(resolved)).To(MatchYAML("jobs: [{name: unit}]"))
+	})
+
+	It("reads absolute paths as-is", func() {
+		fragmentPath := filepath.Join(tmpdir, "unit.yml")
+		Expect(configinclude.FileSource{}.Join("/some/pipeline.yml", fragmentPath)).To(Equal(fragmentPath))
+	})
+})
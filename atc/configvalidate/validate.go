@@ -389,6 +389,16 @@ func validateJobs(c atc.Config) ([]atc.ConfigWarning, error) {
 			}
 		}
 
+		if job.Schedule != nil {
+			_, err := job.Schedule.Parse()
+			if err != nil {
+				errorMessages = append(
+					errorMessages,
+					fmt.Sprintf("%s.schedule has %s", identifier, err),
+				)
+			}
+		}
+
 		step := job.Step()
 
 		validator := atc.NewStepValidator(c, []string{identifier, ".plan"})
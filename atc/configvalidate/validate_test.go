@@ -1887,6 +1887,60 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a job's input's passed constraints reference a job in another pipeline", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.GetStep{
+							Name:   "some-resource",
+							Passed: []string{"other-pipeline/some-job", "other-pipeline/branch:feature/some-job"},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("does not return an error", func() {
+					Expect(errorMessages).To(HaveLen(0))
+				})
+			})
+
+			Context("when a job's input's passed constraints reference a job in a pipeline with an invalid name", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.GetStep{
+							Name:   "some-resource",
+							Passed: []string{"_other-pipeline/some-job"},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns a warning", func() {
+					Expect(errorMessages).To(HaveLen(0))
+					Expect(warnings).To(HaveLen(1))
+					Expect(warnings[0].Message).To(ContainSubstring("'_other-pipeline' is not a valid identifier"))
+				})
+			})
+
+			Context("when a job's input's passed constraints reference a job in another pipeline without a job name", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.GetStep{
+							Name:   "some-resource",
+							Passed: []string{"other-pipeline/"},
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].get(some-resource).passed: passed job 'other-pipeline/' should be formatted as <pipeline>/<job>"))
+				})
+			})
+
 			Context("when a load_var has no name or file defined", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, atc.Step{
@@ -1903,6 +1957,24 @@ var _ = Describe("ValidateConfig", func() {
 				})
 			})
 
+			Context("when a wait_for_approval has an invalid timeout", func() {
+				BeforeEach(func() {
+					job.PlanSequence = append(job.PlanSequence, atc.Step{
+						Config: &atc.ApprovalStep{
+							Name:    "some-approval",
+							Timeout: "nope",
+						},
+					})
+
+					config.Jobs = append(config.Jobs, job)
+				})
+
+				It("returns an error", func() {
+					Expect(errorMessages).To(HaveLen(1))
+					Expect(errorMessages[0]).To(ContainSubstring("jobs.some-other-job.plan.do[0].wait_for_approval(some-approval): invalid timeout 'nope'"))
+				})
+			})
+
 			Context("when two load_var steps have same name", func() {
 				BeforeEach(func() {
 					job.PlanSequence = append(job.PlanSequence, atc.Step{
@@ -2190,6 +2262,46 @@ var _ = Describe("ValidateConfig", func() {
 				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job has negative build_log_retention.days: -1"))
 			})
 		})
+
+		Context("when a job has a valid schedule", func() {
+			BeforeEach(func() {
+				config.Jobs[0].Schedule = &atc.JobSchedule{
+					Cron:     "0 2 * * 1-5",
+					Timezone: "America/Toronto",
+				}
+			})
+
+			It("does not return an error", func() {
+				Expect(errorMessages).To(HaveLen(0))
+			})
+		})
+
+		Context("when a job has an invalid cron expression", func() {
+			BeforeEach(func() {
+				config.Jobs[0].Schedule = &atc.JobSchedule{
+					Cron: "every tuesday",
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job.schedule has invalid cron expression 'every tuesday'"))
+			})
+		})
+
+		Context("when a job has an unknown schedule timezone", func() {
+			BeforeEach(func() {
+				config.Jobs[0].Schedule = &atc.JobSchedule{
+					Cron:     "@daily",
+					Timezone: "Mars/Olympus_Mons",
+				}
+			})
+
+			It("returns an error", func() {
+				Expect(errorMessages).To(HaveLen(1))
+				Expect(errorMessages[0]).To(ContainSubstring("jobs.some-job.schedule has invalid timezone 'Mars/Olympus_Mons'"))
+			})
+		})
 	})
 
 	Describe("validating display config", func() {
@@ -0,0 +1,117 @@
+package file
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/flag"
+)
+
+type Manager struct {
+	Path            string        `long:"path" description:"Path to a YAML file of credentials, nested by path segment."`
+	Key             flag.Cipher   `long:"key" description:"A 16 or 32 length key used to decrypt the credentials file. If not set, the file is read as plain YAML."`
+	LookupTemplates []string      `long:"lookup-templates" default:"/concourse/{{.Team}}/{{.Pipeline}}/{{.Secret}}" default:"/concourse/{{.Team}}/{{.Secret}}" description:"Path templates for credential lookup"`
+	ReloadInterval  time.Duration `long:"reload-interval" default:"10s" description:"How often to check the credentials file for changes."`
+
+	Store *Store
+
+	stop chan struct{}
+}
+
+func (manager *Manager) Init(log lager.Logger) error {
+	manager.Store = NewStore(manager.Path, manager.Key.AEAD)
+
+	err := manager.Store.Load()
+	if err != nil {
+		return fmt.Errorf("load credentials file: %w", err)
+	}
+
+	manager.stop = make(chan struct{})
+	go manager.Store.Watch(log, manager.ReloadInterval, manager.stop)
+
+	return nil
+}
+
+func (manager *Manager) MarshalJSON() ([]byte, error) {
+	health, err := manager.Health()
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(&map[string]interface{}{
+		"path":             manager.Path,
+		"encrypted":        manager.Key.AEAD != nil,
+		"lookup_templates": manager.LookupTemplates,
+		"reload_interval":  manager.ReloadInterval.String(),
+		"health":           health,
+	})
+}
+
+func (manager *Manager) IsConfigured() bool {
+	return manager.Path != ""
+}
+
+func (manager *Manager) Validate() error {
+	if manager.ReloadInterval <= 0 {
+		return errors.New("reload interval must be greater than zero")
+	}
+
+	for i, tmpl := range manager.LookupTemplates {
+		name := fmt.Sprintf("lookup-template-%d", i)
+		if _, err := creds.BuildSecretTemplate(name, tmpl); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (manager *Manager) Health() (*creds.HealthResponse, error) {
+	health := &creds.HealthResponse{
+		Method: "read",
+	}
+
+	if manager.Store == nil {
+		health.Error = "credentials file has not been loaded"
+		return health, nil
+	}
+
+	loadedAt, err := manager.Store.LastLoad()
+	if err != nil {
+		health.Error = err.Error()
+		return health, nil
+	}
+
+	health.Response = map[string]string{
+		"status":    "UP",
+		"loaded_at": loadedAt.Format(time.RFC3339),
+	}
+
+	return health, nil
+}
+
+func (manager *Manager) Close(logger lager.Logger) {
+	if manager.stop != nil {
+		close(manager.stop)
+		manager.stop = nil
+	}
+}
+
+func (manager *Manager) NewSecretsFactory(logger lager.Logger) (creds.SecretsFactory, error) {
+	templates := []*creds.SecretTemplate{}
+	for i, tmpl := range manager.LookupTemplates {
+		name := fmt.Sprintf("lookup-template-%d", i)
+		template, err := creds.BuildSecretTemplate(name, tmpl)
+		if err != nil {
+			return nil, err
+		}
+
+		templates = append(templates, template)
+	}
+
+	return NewSecretsFactory(manager.Store, templates), nil
+}
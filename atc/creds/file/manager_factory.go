@@ -0,0 +1,38 @@
+package file
+
+import (
+	"errors"
+
+	"github.com/concourse/concourse/atc/creds"
+	flags "github.com/jessevdk/go-flags"
+)
+
+type managerFactory struct{}
+
+func init() {
+	creds.Register("file", NewManagerFactory())
+}
+
+func NewManagerFactory() creds.ManagerFactory {
+	return &managerFactory{}
+}
+
+func (factory *managerFactory) AddConfig(group *flags.Group) creds.Manager {
+	manager := &Manager{}
+
+	subGroup, err := group.AddGroup("File Credential Management", "", manager)
+	if err != nil {
+		panic(err)
+	}
+
+	subGroup.Namespace = "file-creds"
+
+	return manager
+}
+
+// NewInstance refuses to configure the manager as a pipeline var source, as
+// that would let anyone who can set a pipeline read files off of the web
+// node.
+func (factory *managerFactory) NewInstance(config interface{}) (creds.Manager, error) {
+	return nil, errors.New("the file credential manager can only be configured on the web node")
+}
@@ -0,0 +1,154 @@
+package file_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/creds/file"
+	"github.com/concourse/concourse/vars"
+	flags "github.com/jessevdk/go-flags"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Manager", func() {
+	var (
+		dir     string
+		manager *file.Manager
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "file-creds")
+		Expect(err).ToNot(HaveOccurred())
+
+		credPath := filepath.Join(dir, "creds.yml")
+		err = ioutil.WriteFile(credPath, []byte(`
+concourse:
+  main:
+    some-pipeline:
+      foo: pipeline-foo
+    foo: team-foo
+    bar: team-bar
+`), 0600)
+		Expect(err).ToNot(HaveOccurred())
+
+		manager = &file.Manager{}
+		_, err = flags.ParseArgs(manager, []string{"--path", credPath})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		manager.Close(lagertest.NewTestLogger("test"))
+		os.RemoveAll(dir)
+	})
+
+	Describe("IsConfigured()", func() {
+		It("is configured when a path is given", func() {
+			Expect(manager.IsConfigured()).To(BeTrue())
+			Expect((&file.Manager{}).IsConfigured()).To(BeFalse())
+		})
+	})
+
+	Describe("Validate()", func() {
+		It("passes on default parameters", func() {
+			Expect(manager.Validate()).To(Succeed())
+		})
+
+		It("fails on an invalid lookup template", func() {
+			manager.LookupTemplates = []string{"/{{.Team}}/{{.Nope}}"}
+			Expect(manager.Validate()).ToNot(Succeed())
+		})
+
+		It("fails on a non-positive reload interval", func() {
+			manager.ReloadInterval = 0
+			Expect(manager.Validate()).ToNot(Succeed())
+		})
+	})
+
+	Describe("Health()", func() {
+		It("is unhealthy before the file has been loaded", func() {
+			health, err := manager.Health()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(health.Error).ToNot(BeEmpty())
+		})
+
+		It("is up once the file has been loaded", func() {
+			Expect(manager.Init(lagertest.NewTestLogger("test"))).To(Succeed())
+
+			health, err := manager.Health()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(health.Error).To(BeEmpty())
+			Expect(health.Response).To(HaveKeyWithValue("status", "UP"))
+		})
+	})
+
+	Describe("Init()", func() {
+		It("fails when the file can not be loaded", func() {
+			manager.Path = filepath.Join(dir, "missing.yml")
+			Expect(manager.Init(lagertest.NewTestLogger("test"))).ToNot(Succeed())
+		})
+
+		It("reloads the file when it changes", func() {
+			manager.ReloadInterval = 10 * time.Millisecond
+			Expect(manager.Init(lagertest.NewTestLogger("test"))).To(Succeed())
+
+			err := ioutil.WriteFile(manager.Path, []byte("concourse: {main: {foo: new-foo}}"), 0600)
+			Expect(err).ToNot(HaveOccurred())
+
+			future := time.Now().Add(time.Minute)
+			Expect(os.Chtimes(manager.Path, future, future)).To(Succeed())
+
+			Eventually(func() interface{} {
+				value, _ := manager.Store.Lookup("/concourse/main/foo")
+				return value
+			}).Should(Equal("new-foo"))
+		})
+	})
+
+	Describe("NewSecretsFactory()", func() {
+		var variables vars.Variables
+
+		BeforeEach(func() {
+			logger := lagertest.NewTestLogger("test")
+			Expect(manager.Init(logger)).To(Succeed())
+
+			factory, err := manager.NewSecretsFactory(logger)
+			Expect(err).ToNot(HaveOccurred())
+
+			variables = creds.NewVariables(factory.NewSecrets(), "main", "some-pipeline", false)
+		})
+
+		It("prefers pipeline scoped credentials", func() {
+			value, found, err := variables.Get(vars.Reference{Path: "foo"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("pipeline-foo"))
+		})
+
+		It("falls back to team scoped credentials", func() {
+			value, found, err := variables.Get(vars.Reference{Path: "bar"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("team-bar"))
+		})
+
+		It("does not find missing credentials", func() {
+			_, found, err := variables.Get(vars.Reference{Path: "baz"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("NewInstance()", func() {
+		It("refuses to be used as a var source", func() {
+			_, err := file.NewManagerFactory().NewInstance(map[string]interface{}{"path": "/etc/passwd"})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
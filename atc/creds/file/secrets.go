@@ -0,0 +1,58 @@
+package file
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/creds"
+)
+
+type SecretsFactory struct {
+	store     *Store
+	templates []*creds.SecretTemplate
+}
+
+func NewSecretsFactory(store *Store, templates []*creds.SecretTemplate) *SecretsFactory {
+	return &SecretsFactory{
+		store:     store,
+		templates: templates,
+	}
+}
+
+func (factory *SecretsFactory) NewSecrets() creds.Secrets {
+	return &Secrets{
+		store:     factory.store,
+		templates: factory.templates,
+	}
+}
+
+type Secrets struct {
+	store     *Store
+	templates []*creds.SecretTemplate
+}
+
+// NewSecretLookupPaths defines how variables will be searched in the credentials file
+func (secrets *Secrets) NewSecretLookupPaths(teamName string, pipelineName string, allowRootPath bool) []creds.SecretLookupPath {
+	lookupPaths := []creds.SecretLookupPath{}
+	for _, tmpl := range secrets.templates {
+		if lPath := creds.NewSecretLookupWithTemplate(tmpl, teamName, pipelineName); lPath != nil {
+			lookupPaths = append(lookupPaths, lPath)
+		}
+	}
+
+	if allowRootPath {
+		lookupPaths = append(lookupPaths, creds.NewSecretLookupWithPrefix("/"))
+	}
+
+	return lookupPaths
+}
+
+// Get retrieves the value of an individual secret. Secrets read from a file
+// never expire; changes are picked up when the file is reloaded.
+func (secrets *Secrets) Get(secretPath string) (interface{}, *time.Time, bool, error) {
+	value, found := secrets.store.Lookup(secretPath)
+	if !found {
+		return nil, nil, false, nil
+	}
+
+	return value, nil, true, nil
+}
@@ -0,0 +1,206 @@
+package file
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"sigs.k8s.io/yaml"
+)
+
+var ErrMalformedCiphertext = errors.New("malformed ciphertext")
+
+// Store holds the credentials tree read from a file, reloading it whenever
+// the file changes.
+//
+// The file is a YAML document whose nested keys make up the credential paths,
+// so that the value at concourse.main.foo is found at /concourse/main/foo.
+// When a key is given, the file holds the YAML encrypted by Encrypt instead.
+type Store struct {
+	path string
+	aead cipher.AEAD
+
+	lock     sync.RWMutex
+	tree     map[string]interface{}
+	modTime  time.Time
+	size     int64
+	loadedAt time.Time
+	loadErr  error
+}
+
+func NewStore(path string, aead cipher.AEAD) *Store {
+	return &Store{
+		path: path,
+		aead: aead,
+	}
+}
+
+// Load reads the file regardless of whether it has changed. If the file can
+// not be read, the previously loaded credentials are kept.
+func (store *Store) Load() error {
+	info, err := os.Stat(store.path)
+	if err != nil {
+		return store.recordError(err)
+	}
+
+	return store.load(info)
+}
+
+// Reload reads the file if its size or modification time has changed since it
+// was last loaded.
+func (store *Store) Reload() (bool, error) {
+	info, err := os.Stat(store.path)
+	if err != nil {
+		return false, store.recordError(err)
+	}
+
+	store.lock.RLock()
+	changed := !info.ModTime().Equal(store.modTime) || info.Size() != store.size
+	store.lock.RUnlock()
+
+	if !changed {
+		return false, nil
+	}
+
+	return true, store.load(info)
+}
+
+// Watch reloads the file every interval until stop is closed.
+func (store *Store) Watch(logger lager.Logger, interval time.Duration, stop <-chan struct{}) {
+	logger = logger.Session("watch", lager.Data{"path": store.path})
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			reloaded, err := store.Reload()
+			if err != nil {
+				logger.Error("failed-to-reload", err)
+			} else if reloaded {
+				logger.Info("reloaded")
+			}
+		case <-stop:
+			return
+		}
+	}
+}
+
+// LastLoad returns when the credentials were last loaded, along with the
+// error which occurred the last time the file was read, if any.
+func (store *Store) LastLoad() (time.Time, error) {
+	store.lock.RLock()
+	defer store.lock.RUnlock()
+
+	return store.loadedAt, store.loadErr
+}
+
+// Lookup returns the value found by following the segments of the given
+// slash-separated path through the credentials tree.
+func (store *Store) Lookup(secretPath string) (interface{}, bool) {
+	store.lock.RLock()
+	defer store.lock.RUnlock()
+
+	var value interface{} = store.tree
+	for _, segment := range strings.Split(secretPath, "/") {
+		if segment == "" {
+			continue
+		}
+
+		node, ok := value.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+
+		value, ok = node[segment]
+		if !ok {
+			return nil, false
+		}
+	}
+
+	if value == nil {
+		return nil, false
+	}
+
+	return value, true
+}
+
+func (store *Store) load(info os.FileInfo) error {
+	contents, err := ioutil.ReadFile(store.path)
+	if err != nil {
+		return store.recordError(err)
+	}
+
+	if store.aead != nil {
+		contents, err = Decrypt(store.aead, contents)
+		if err != nil {
+			return store.recordError(fmt.Errorf("decrypt: %w", err))
+		}
+	}
+
+	var tree map[string]interface{}
+	err = yaml.Unmarshal(contents, &tree)
+	if err != nil {
+		return store.recordError(fmt.Errorf("parse: %w", err))
+	}
+
+	store.lock.Lock()
+	store.tree = tree
+	store.modTime = info.ModTime()
+	store.size = info.Size()
+	store.loadedAt = time.Now()
+	store.loadErr = nil
+	store.lock.Unlock()
+
+	return nil
+}
+
+func (store *Store) recordError(err error) error {
+	store.lock.Lock()
+	store.loadErr = err
+	store.lock.Unlock()
+
+	return err
+}
+
+// Encrypt seals the plaintext with the given AEAD, returning the nonce
+// followed by the ciphertext, base64 encoded.
+func Encrypt(aead cipher.AEAD, plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+
+	sealed := aead.Seal(nonce, nonce, plaintext, nil)
+
+	encoded := make([]byte, base64.StdEncoding.EncodedLen(len(sealed)))
+	base64.StdEncoding.Encode(encoded, sealed)
+
+	return encoded, nil
+}
+
+// Decrypt opens contents produced by Encrypt.
+func Decrypt(aead cipher.AEAD, contents []byte) ([]byte, error) {
+	sealed, err := base64.StdEncoding.DecodeString(strings.TrimSpace(string(contents)))
+	if err != nil {
+		return nil, ErrMalformedCiphertext
+	}
+
+	if len(sealed) < aead.NonceSize() {
+		return nil, ErrMalformedCiphertext
+	}
+
+	nonce, ciphertext := sealed[:aead.NonceSize()], sealed[aead.NonceSize():]
+
+	return aead.Open(nil, nonce, ciphertext, nil)
+}
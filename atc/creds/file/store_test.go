@@ -0,0 +1,213 @@
+package file_test
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/concourse/concourse/atc/creds/file"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Store", func() {
+	var (
+		dir      string
+		credPath string
+		aead     cipher.AEAD
+
+		store *file.Store
+	)
+
+	writeCreds := func(contents string) {
+		data := []byte(contents)
+		if aead != nil {
+			var err error
+			data, err = file.Encrypt(aead, data)
+			Expect(err).ToNot(HaveOccurred())
+		}
+
+		err := ioutil.WriteFile(credPath, data, 0600)
+		Expect(err).ToNot(HaveOccurred())
+	}
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "file-creds")
+		Expect(err).ToNot(HaveOccurred())
+
+		credPath = filepath.Join(dir, "creds.yml")
+		aead = nil
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	JustBeforeEach(func() {
+		store = file.NewStore(credPath, aead)
+	})
+
+	Describe("Lookup", func() {
+		BeforeEach(func() {
+			writeCreds(`
+concourse:
+  main:
+    some-pipeline:
+      foo: pipeline-foo
+    foo: team-foo
+    complex:
+      user: some-user
+      password: some-password
+`)
+		})
+
+		JustBeforeEach(func() {
+			Expect(store.Load()).To(Succeed())
+		})
+
+		It("follows the path through the tree", func() {
+			value, found := store.Lookup("/concourse/main/some-pipeline/foo")
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("pipeline-foo"))
+
+			value, found = store.Lookup("/concourse/main/foo")
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal("team-foo"))
+		})
+
+		It("returns nested values as a whole", func() {
+			value, found := store.Lookup("/concourse/main/complex")
+			Expect(found).To(BeTrue())
+			Expect(value).To(Equal(map[string]interface{}{
+				"user":     "some-user",
+				"password": "some-password",
+			}))
+		})
+
+		It("does not find missing paths", func() {
+			_, found := store.Lookup("/concourse/main/bar")
+			Expect(found).To(BeFalse())
+
+			_, found = store.Lookup("/concourse/main/foo/bar")
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("Load", func() {
+		Context("when the file does not exist", func() {
+			It("errors", func() {
+				Expect(store.Load()).ToNot(Succeed())
+
+				_, err := store.LastLoad()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("when the file is not valid YAML", func() {
+			BeforeEach(func() {
+				writeCreds("- [")
+			})
+
+			It("errors", func() {
+				Expect(store.Load()).To(MatchError(ContainSubstring("parse")))
+			})
+		})
+
+		Context("when the file is encrypted", func() {
+			BeforeEach(func() {
+				block, err := aes.NewCipher([]byte("AES256Key-32Characters1234567890"))
+				Expect(err).ToNot(HaveOccurred())
+
+				aead, err = cipher.NewGCM(block)
+				Expect(err).ToNot(HaveOccurred())
+
+				writeCreds("foo: bar")
+			})
+
+			It("decrypts it", func() {
+				Expect(store.Load()).To(Succeed())
+
+				value, found := store.Lookup("/foo")
+				Expect(found).To(BeTrue())
+				Expect(value).To(Equal("bar"))
+			})
+
+			Context("when the key is wrong", func() {
+				JustBeforeEach(func() {
+					block, err := aes.NewCipher([]byte("AES256Key-32Characters0987654321"))
+					Expect(err).ToNot(HaveOccurred())
+
+					otherAEAD, err := cipher.NewGCM(block)
+					Expect(err).ToNot(HaveOccurred())
+
+					store = file.NewStore(credPath, otherAEAD)
+				})
+
+				It("errors", func() {
+					Expect(store.Load()).To(MatchError(ContainSubstring("decrypt")))
+				})
+			})
+		})
+	})
+
+	Describe("Reload", func() {
+		BeforeEach(func() {
+			writeCreds("foo: bar")
+		})
+
+		JustBeforeEach(func() {
+			Expect(store.Load()).To(Succeed())
+		})
+
+		Context("when the file has not changed", func() {
+			It("does not reload it", func() {
+				reloaded, err := store.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(reloaded).To(BeFalse())
+			})
+		})
+
+		Context("when the file has changed", func() {
+			JustBeforeEach(func() {
+				writeCreds("foo: baz")
+
+				future := time.Now().Add(time.Minute)
+				Expect(os.Chtimes(credPath, future, future)).To(Succeed())
+			})
+
+			It("picks up the new credentials", func() {
+				reloaded, err := store.Reload()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(reloaded).To(BeTrue())
+
+				value, _ := store.Lookup("/foo")
+				Expect(value).To(Equal("baz"))
+			})
+		})
+
+		Context("when the file has become invalid", func() {
+			JustBeforeEach(func() {
+				writeCreds("- [")
+
+				future := time.Now().Add(time.Minute)
+				Expect(os.Chtimes(credPath, future, future)).To(Succeed())
+			})
+
+			It("keeps the previous credentials", func() {
+				_, err := store.Reload()
+				Expect(err).To(HaveOccurred())
+
+				value, _ := store.Lookup("/foo")
+				Expect(value).To(Equal("bar"))
+
+				_, err = store.LastLoad()
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+})
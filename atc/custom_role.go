@@ -0,0 +1,107 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+	"path"
+)
+
+var (
+	ErrCustomRoleHasNoPermissions = errors.New("custom role must have at least one permission")
+)
+
+// CustomRole is a role defined by a team which permits a specific set of
+// actions, rather than the fixed set of one of the built-in roles. Users and
+// groups are given a custom role through the team's auth config, the same way
+// as they are given a built-in role.
+type CustomRole struct {
+	Name string `json:"name"`
+
+	// Permissions lists the names of the API actions the role may perform,
+	// e.g. CreateJobBuild.
+	Permissions []string `json:"permissions"`
+
+	// Pipelines limits the role to the pipelines whose names match any of
+	// these glob patterns. If it is set, actions which are not performed on a
+	// named pipeline are not permitted at all.
+	Pipelines []string `json:"pipelines,omitempty"`
+}
+
+func (role CustomRole) Validate() error {
+	if role.Name == "" {
+		return errors.New("custom role must have a name")
+	}
+
+	if len(role.Permissions) == 0 {
+		return ErrCustomRoleHasNoPermissions
+	}
+
+	for _, permission := range role.Permissions {
+		if !isAction(permission) {
+			return fmt.Errorf("unknown action '%s'", permission)
+		}
+	}
+
+	for _, pattern := range role.Pipelines {
+		_, err := path.Match(pattern, "")
+		if err != nil {
+			return fmt.Errorf("invalid pipeline pattern '%s': %w", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+// Permits returns whether the role may perform the action. pipelineName is
+// the name of the pipeline the action is performed on, if any.
+func (role CustomRole) Permits(action string, pipelineName string) bool {
+	permitted := false
+	for _, permission := range role.Permissions {
+		if permission == action {
+			permitted = true
+			break
+		}
+	}
+
+	if !permitted {
+		return false
+	}
+
+	if len(role.Pipelines) == 0 {
+		return true
+	}
+
+	if pipelineName == "" {
+		return false
+	}
+
+	for _, pattern := range role.Pipelines {
+		if matched, _ := path.Match(pattern, pipelineName); matched {
+			return true
+		}
+	}
+
+	return false
+}
+
+type CustomRoles []CustomRole
+
+func (roles CustomRoles) Lookup(name string) (CustomRole, bool) {
+	for _, role := range roles {
+		if role.Name == name {
+			return role, true
+		}
+	}
+
+	return CustomRole{}, false
+}
+
+func isAction(name string) bool {
+	for _, route := range Routes {
+		if route.Name == name {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,70 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CustomRole", func() {
+	var role atc.CustomRole
+
+	BeforeEach(func() {
+		role = atc.CustomRole{
+			Name:        "releaser",
+			Permissions: []string{atc.CreateJobBuild, atc.GetPipeline},
+			Pipelines:   []string{"release-*"},
+		}
+	})
+
+	Describe("Validate", func() {
+		It("accepts a valid role", func() {
+			Expect(role.Validate()).To(Succeed())
+		})
+
+		It("rejects a role without permissions", func() {
+			role.Permissions = nil
+			Expect(role.Validate()).To(Equal(atc.ErrCustomRoleHasNoPermissions))
+		})
+
+		It("rejects unknown actions", func() {
+			role.Permissions = []string{"LaunchMissiles"}
+			Expect(role.Validate()).To(MatchError("unknown action 'LaunchMissiles'"))
+		})
+
+		It("rejects malformed pipeline patterns", func() {
+			role.Pipelines = []string{"release-["}
+			Expect(role.Validate()).To(MatchError(ContainSubstring("invalid pipeline pattern 'release-['")))
+		})
+	})
+
+	Describe("Permits", func() {
+		It("permits its actions on matching pipelines", func() {
+			Expect(role.Permits(atc.CreateJobBuild, "release-1.0")).To(BeTrue())
+		})
+
+		It("does not permit other actions", func() {
+			Expect(role.Permits(atc.SaveConfig, "release-1.0")).To(BeFalse())
+		})
+
+		It("does not permit its actions on other pipelines", func() {
+			Expect(role.Permits(atc.CreateJobBuild, "deploy")).To(BeFalse())
+		})
+
+		It("does not permit actions which are not performed on a pipeline", func() {
+			Expect(role.Permits(atc.CreateJobBuild, "")).To(BeFalse())
+		})
+
+		Context("when the role is not limited to any pipelines", func() {
+			BeforeEach(func() {
+				role.Pipelines = nil
+			})
+
+			It("permits its actions anywhere", func() {
+				Expect(role.Permits(atc.CreateJobBuild, "deploy")).To(BeTrue())
+				Expect(role.Permits(atc.CreateJobBuild, "")).To(BeTrue())
+			})
+		})
+	})
+})
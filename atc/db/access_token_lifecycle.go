@@ -20,19 +20,25 @@ func NewAccessTokenLifecycle(conn Conn) AccessTokenLifecycle {
 	return &accessTokenLifecycle{conn}
 }
 
+// RemoveExpiredAccessTokens removes both the access tokens issued when
+// logging in and the API tokens which have expired.
 func (a accessTokenLifecycle) RemoveExpiredAccessTokens(leeway time.Duration) (int, error) {
-	res, err := sq.Delete("access_tokens").
-		Where(
-			sq.Expr(fmt.Sprintf("expires_at < now() - '%d seconds'::interval", int(leeway.Seconds()))),
-		).
-		RunWith(a.conn).
-		Exec()
-	if err != nil {
-		return 0, err
+	removed := 0
+	for _, table := range []string{"access_tokens", "api_tokens"} {
+		res, err := sq.Delete(table).
+			Where(
+				sq.Expr(fmt.Sprintf("expires_at < now() - '%d seconds'::interval", int(leeway.Seconds()))),
+			).
+			RunWith(a.conn).
+			Exec()
+		if err != nil {
+			return 0, err
+		}
+		n, err := res.RowsAffected()
+		if err != nil {
+			return 0, err
+		}
+		removed += int(n)
 	}
-	n, err := res.RowsAffected()
-	if err != nil {
-		return 0, err
-	}
-	return int(n), nil
+	return removed, nil
 }
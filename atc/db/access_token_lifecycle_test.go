@@ -3,6 +3,7 @@ package db_test
 import (
 	"time"
 
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"gopkg.in/square/go-jose.v2/jwt"
 
@@ -45,6 +46,46 @@ var _ = Describe("Access Token Lifecycle", func() {
 		Expect(found).To(BeTrue(), "active token was removed")
 	})
 
+	It("removes expired api tokens", func() {
+		repository := db.NewAPITokenRepository(dbConn)
+
+		expired, err := repository.CreateAPIToken(defaultTeam.ID(), atc.APIToken{
+			Name:      "expired-token",
+			Role:      "member",
+			ExpiresAt: now().Add(-24 * time.Hour).Unix(),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		active, err := repository.CreateAPIToken(defaultTeam.ID(), atc.APIToken{
+			Name:      "active-token",
+			Role:      "member",
+			ExpiresAt: now().Add(24 * time.Hour).Unix(),
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		eternal, err := repository.CreateAPIToken(defaultTeam.ID(), atc.APIToken{
+			Name: "eternal-token",
+			Role: "member",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		n, err := lifecycle.RemoveExpiredAccessTokens(0)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(n).To(Equal(1))
+
+		_, found, err := repository.FindAPIToken(expired.Token)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeFalse(), "expired token was not removed")
+
+		_, found, err = repository.FindAPIToken(active.Token)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue(), "active token was removed")
+
+		_, found, err = repository.FindAPIToken(eternal.Token)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue(), "token without expiry was removed")
+	})
+
 	It("respects the leeway for expiration time", func() {
 		By("having a token that is 24 hours old")
 		yesterday := jwt.NewNumericDate(now().Add(-24 * time.Hour))
@@ -0,0 +1,178 @@
+package db
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+var ErrAPITokenAlreadyExists = errors.New("an api token with the same name already exists for the team")
+
+//counterfeiter:generate . APITokenRepository
+type APITokenRepository interface {
+	// CreateAPIToken generates a new token for the team. The returned token
+	// is the only place its value can be found, as only a hash of it is
+	// stored.
+	CreateAPIToken(teamID int, token atc.APIToken) (atc.APIToken, error)
+
+	// FindAPIToken looks up the token with the given value, whether or not
+	// it has expired.
+	FindAPIToken(value string) (atc.APIToken, bool, error)
+
+	APITokens(teamID int) ([]atc.APIToken, error)
+	RevokeAPIToken(teamID int, name string) (bool, error)
+}
+
+type apiTokenRepository struct {
+	conn Conn
+}
+
+func NewAPITokenRepository(conn Conn) APITokenRepository {
+	return &apiTokenRepository{conn: conn}
+}
+
+var apiTokensQuery = psql.Select("a.id", "a.name", "t.name", "a.role", "a.created_by", "a.created_at", "a.expires_at").
+	From("api_tokens a").
+	Join("teams t ON t.id = a.team_id")
+
+func (repository *apiTokenRepository) CreateAPIToken(teamID int, token atc.APIToken) (atc.APIToken, error) {
+	secret := make([]byte, 32)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return atc.APIToken{}, err
+	}
+
+	value := atc.APITokenPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	var createdBy sql.NullString
+	if token.CreatedBy != "" {
+		createdBy = sql.NullString{String: token.CreatedBy, Valid: true}
+	}
+
+	var expiresAt pq.NullTime
+	if token.ExpiresAt != 0 {
+		expiresAt = pq.NullTime{Time: time.Unix(token.ExpiresAt, 0), Valid: true}
+	}
+
+	var id int
+	err = psql.Insert("api_tokens").
+		Columns("team_id", "name", "token_hash", "role", "created_by", "expires_at").
+		Values(teamID, token.Name, hashAPIToken(value), token.Role, createdBy, expiresAt).
+		Suffix("RETURNING id").
+		RunWith(repository.conn).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		if pqErr, ok := err.(*pq.Error); ok && pqErr.Code.Name() == pqUniqueViolationErrCode {
+			return atc.APIToken{}, ErrAPITokenAlreadyExists
+		}
+
+		return atc.APIToken{}, err
+	}
+
+	created, err := scanAPIToken(apiTokensQuery.
+		Where(sq.Eq{"a.id": id}).
+		RunWith(repository.conn).
+		QueryRow())
+	if err != nil {
+		return atc.APIToken{}, err
+	}
+
+	created.Token = value
+
+	return created, nil
+}
+
+func (repository *apiTokenRepository) FindAPIToken(value string) (atc.APIToken, bool, error) {
+	token, err := scanAPIToken(apiTokensQuery.
+		Where(sq.Eq{"a.token_hash": hashAPIToken(value)}).
+		RunWith(repository.conn).
+		QueryRow())
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.APIToken{}, false, nil
+		}
+
+		return atc.APIToken{}, false, err
+	}
+
+	return token, true, nil
+}
+
+func (repository *apiTokenRepository) APITokens(teamID int) ([]atc.APIToken, error) {
+	rows, err := apiTokensQuery.
+		Where(sq.Eq{"a.team_id": teamID}).
+		OrderBy("a.name").
+		RunWith(repository.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	tokens := []atc.APIToken{}
+	for rows.Next() {
+		token, err := scanAPIToken(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		tokens = append(tokens, token)
+	}
+
+	return tokens, nil
+}
+
+func (repository *apiTokenRepository) RevokeAPIToken(teamID int, name string) (bool, error) {
+	res, err := psql.Delete("api_tokens").
+		Where(sq.Eq{
+			"team_id": teamID,
+			"name":    name,
+		}).
+		RunWith(repository.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return n > 0, nil
+}
+
+func scanAPIToken(scan scannable) (atc.APIToken, error) {
+	var token atc.APIToken
+	var createdBy sql.NullString
+	var createdAt time.Time
+	var expiresAt pq.NullTime
+
+	err := scan.Scan(&token.ID, &token.Name, &token.TeamName, &token.Role, &createdBy, &createdAt, &expiresAt)
+	if err != nil {
+		return atc.APIToken{}, err
+	}
+
+	token.CreatedBy = createdBy.String
+	token.CreatedAt = createdAt.Unix()
+
+	if expiresAt.Valid {
+		token.ExpiresAt = expiresAt.Time.Unix()
+	}
+
+	return token, nil
+}
+
+func hashAPIToken(value string) string {
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(value)))
+}
@@ -0,0 +1,136 @@
+package db_test
+
+import (
+	"strings"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("APITokenRepository", func() {
+	var repository db.APITokenRepository
+
+	BeforeEach(func() {
+		repository = db.NewAPITokenRepository(dbConn)
+	})
+
+	Describe("CreateAPIToken", func() {
+		var (
+			token     atc.APIToken
+			expiresAt time.Time
+		)
+
+		BeforeEach(func() {
+			expiresAt = time.Now().Add(time.Hour).Truncate(time.Second)
+
+			var err error
+			token, err = repository.CreateAPIToken(defaultTeam.ID(), atc.APIToken{
+				Name:      "some-token",
+				Role:      "member",
+				CreatedBy: "some-user",
+				ExpiresAt: expiresAt.Unix(),
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the token along with its value", func() {
+			Expect(token.ID).ToNot(BeZero())
+			Expect(token.Name).To(Equal("some-token"))
+			Expect(token.TeamName).To(Equal(defaultTeam.Name()))
+			Expect(token.Role).To(Equal("member"))
+			Expect(token.CreatedBy).To(Equal("some-user"))
+			Expect(token.CreatedAt).To(BeNumerically("~", time.Now().Unix(), 60))
+			Expect(token.ExpiresAt).To(Equal(expiresAt.Unix()))
+			Expect(strings.HasPrefix(token.Token, atc.APITokenPrefix)).To(BeTrue())
+		})
+
+		It("does not store the value of the token", func() {
+			var count int
+			err := dbConn.QueryRow(`SELECT COUNT(*) FROM api_tokens WHERE token_hash = $1`, token.Token).Scan(&count)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(BeZero())
+		})
+
+		It("can be found by its value", func() {
+			found, ok, err := repository.FindAPIToken(token.Token)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ok).To(BeTrue())
+
+			token.Token = ""
+			Expect(found).To(Equal(token))
+		})
+
+		Context("when a token with the same name exists for the team", func() {
+			It("errors", func() {
+				_, err := repository.CreateAPIToken(defaultTeam.ID(), atc.APIToken{
+					Name: "some-token",
+					Role: "viewer",
+				})
+				Expect(err).To(Equal(db.ErrAPITokenAlreadyExists))
+			})
+		})
+	})
+
+	Describe("FindAPIToken", func() {
+		It("does not find unknown tokens", func() {
+			_, found, err := repository.FindAPIToken(atc.APITokenPrefix + "bogus")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("APITokens", func() {
+		BeforeEach(func() {
+			otherTeam, err := teamFactory.CreateTeam(atc.Team{Name: "other-team"})
+			Expect(err).ToNot(HaveOccurred())
+
+			for _, name := range []string{"b-token", "a-token"} {
+				_, err := repository.CreateAPIToken(defaultTeam.ID(), atc.APIToken{Name: name, Role: "viewer"})
+				Expect(err).ToNot(HaveOccurred())
+			}
+
+			_, err = repository.CreateAPIToken(otherTeam.ID(), atc.APIToken{Name: "other-token", Role: "viewer"})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the team's tokens by name, without their values", func() {
+			tokens, err := repository.APITokens(defaultTeam.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(tokens).To(HaveLen(2))
+			Expect(tokens[0].Name).To(Equal("a-token"))
+			Expect(tokens[1].Name).To(Equal("b-token"))
+			Expect(tokens[0].Token).To(BeEmpty())
+			Expect(tokens[0].ExpiresAt).To(BeZero())
+		})
+	})
+
+	Describe("RevokeAPIToken", func() {
+		var token atc.APIToken
+
+		BeforeEach(func() {
+			var err error
+			token, err = repository.CreateAPIToken(defaultTeam.ID(), atc.APIToken{Name: "some-token", Role: "viewer"})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("removes the token", func() {
+			revoked, err := repository.RevokeAPIToken(defaultTeam.ID(), "some-token")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(BeTrue())
+
+			_, found, err := repository.FindAPIToken(token.Token)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("returns false when the token does not exist", func() {
+			revoked, err := repository.RevokeAPIToken(defaultTeam.ID(), "bogus-token")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(revoked).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,165 @@
+package db
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// ArchivedArtifact is a task output that was archived to the artifact store
+// after its build step succeeded, so that it can be downloaded after the
+// build's volumes have been garbage collected.
+//
+//counterfeiter:generate . ArchivedArtifact
+type ArchivedArtifact interface {
+	ID() int
+	BuildID() int
+	Name() string
+	Key() string
+	Size() int64
+	CreatedAt() time.Time
+}
+
+type archivedArtifact struct {
+	id        int
+	buildID   int
+	name      string
+	key       string
+	size      int64
+	createdAt time.Time
+}
+
+func (a *archivedArtifact) ID() int              { return a.id }
+func (a *archivedArtifact) BuildID() int         { return a.buildID }
+func (a *archivedArtifact) Name() string         { return a.name }
+func (a *archivedArtifact) Key() string          { return a.key }
+func (a *archivedArtifact) Size() int64          { return a.size }
+func (a *archivedArtifact) CreatedAt() time.Time { return a.createdAt }
+
+//counterfeiter:generate . ArchivedArtifactRepository
+type ArchivedArtifactRepository interface {
+	SaveArchivedArtifact(buildID int, name string, key string, size int64) (ArchivedArtifact, error)
+	FindArchivedArtifact(buildID int, name string) (ArchivedArtifact, bool, error)
+
+	// ReapableArchivedArtifacts returns the artifacts of builds whose logs
+	// have been reaped, or which have been deleted altogether. Builds which
+	// don't belong to a job never have their logs reaped, so their artifacts
+	// are returned once the build finished longer than oneOffRetention ago.
+	ReapableArchivedArtifacts(oneOffRetention time.Duration) ([]ArchivedArtifact, error)
+	RemoveArchivedArtifact(id int) error
+}
+
+type archivedArtifactRepository struct {
+	conn Conn
+}
+
+func NewArchivedArtifactRepository(conn Conn) ArchivedArtifactRepository {
+	return &archivedArtifactRepository{
+		conn: conn,
+	}
+}
+
+var archivedArtifactsQuery = psql.Select(
+	"a.id",
+	"a.build_id",
+	"a.name",
+	"a.key",
+	"a.size",
+	"a.created_at",
+).From("archived_artifacts a")
+
+func (repository *archivedArtifactRepository) SaveArchivedArtifact(buildID int, name string, key string, size int64) (ArchivedArtifact, error) {
+	row := psql.Insert("archived_artifacts").
+		SetMap(map[string]interface{}{
+			"build_id": buildID,
+			"name":     name,
+			"key":      key,
+			"size":     size,
+		}).
+		Suffix(`
+			ON CONFLICT (build_id, name) DO UPDATE SET
+				key = EXCLUDED.key,
+				size = EXCLUDED.size,
+				created_at = now()
+			RETURNING id, build_id, name, key, size, created_at
+		`).
+		RunWith(repository.conn).
+		QueryRow()
+
+	return scanArchivedArtifact(row)
+}
+
+func (repository *archivedArtifactRepository) FindArchivedArtifact(buildID int, name string) (ArchivedArtifact, bool, error) {
+	row := archivedArtifactsQuery.
+		Where(sq.Eq{
+			"a.build_id": buildID,
+			"a.name":     name,
+		}).
+		RunWith(repository.conn).
+		QueryRow()
+
+	artifact, err := scanArchivedArtifact(row)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+
+	return artifact, true, nil
+}
+
+func (repository *archivedArtifactRepository) ReapableArchivedArtifacts(oneOffRetention time.Duration) ([]ArchivedArtifact, error) {
+	rows, err := archivedArtifactsQuery.
+		LeftJoin("builds b ON b.id = a.build_id").
+		Where(sq.Or{
+			sq.Eq{"b.id": nil},
+			sq.NotEq{"b.reap_time": nil},
+			sq.And{
+				sq.Eq{"b.job_id": nil},
+				sq.Eq{"b.completed": true},
+				sq.Expr(fmt.Sprintf("b.end_time < now() - '%d seconds'::interval", int(oneOffRetention.Seconds()))),
+			},
+		}).
+		OrderBy("a.id").
+		RunWith(repository.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	artifacts := []ArchivedArtifact{}
+	for rows.Next() {
+		artifact, err := scanArchivedArtifact(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		artifacts = append(artifacts, artifact)
+	}
+
+	return artifacts, nil
+}
+
+func (repository *archivedArtifactRepository) RemoveArchivedArtifact(id int) error {
+	_, err := psql.Delete("archived_artifacts").
+		Where(sq.Eq{"id": id}).
+		RunWith(repository.conn).
+		Exec()
+	return err
+}
+
+func scanArchivedArtifact(row scannable) (ArchivedArtifact, error) {
+	artifact := &archivedArtifact{}
+	err := row.Scan(&artifact.id, &artifact.buildID, &artifact.name, &artifact.key, &artifact.size, &artifact.createdAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return artifact, nil
+}
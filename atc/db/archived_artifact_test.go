@@ -0,0 +1,156 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ArchivedArtifactRepository", func() {
+	var (
+		repository db.ArchivedArtifactRepository
+		build      db.Build
+	)
+
+	BeforeEach(func() {
+		repository = db.NewArchivedArtifactRepository(dbConn)
+
+		var err error
+		build, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("SaveArchivedArtifact", func() {
+		It("can be found by build and name", func() {
+			saved, err := repository.SaveArchivedArtifact(build.ID(), "some-output", "some-key", 1024)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(saved.BuildID()).To(Equal(build.ID()))
+			Expect(saved.Name()).To(Equal("some-output"))
+			Expect(saved.Key()).To(Equal("some-key"))
+			Expect(saved.Size()).To(Equal(int64(1024)))
+
+			found, exists, err := repository.FindArchivedArtifact(build.ID(), "some-output")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(exists).To(BeTrue())
+			Expect(found.ID()).To(Equal(saved.ID()))
+		})
+
+		It("replaces an artifact with the same name", func() {
+			first, err := repository.SaveArchivedArtifact(build.ID(), "some-output", "some-key", 1024)
+			Expect(err).ToNot(HaveOccurred())
+
+			second, err := repository.SaveArchivedArtifact(build.ID(), "some-output", "some-other-key", 2048)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(second.ID()).To(Equal(first.ID()))
+			Expect(second.Key()).To(Equal("some-other-key"))
+			Expect(second.Size()).To(Equal(int64(2048)))
+		})
+	})
+
+	Describe("FindArchivedArtifact", func() {
+		It("does not find missing artifacts", func() {
+			_, found, err := repository.FindArchivedArtifact(build.ID(), "missing")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("ReapableArchivedArtifacts", func() {
+		var artifact db.ArchivedArtifact
+
+		BeforeEach(func() {
+			var err error
+			artifact, err = repository.SaveArchivedArtifact(build.ID(), "some-output", "some-key", 1024)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("does not return artifacts of retained builds", func() {
+			artifacts, err := repository.ReapableArchivedArtifacts(time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(artifacts).To(BeEmpty())
+		})
+
+		Context("when the build's events have been reaped", func() {
+			BeforeEach(func() {
+				err := defaultPipeline.DeleteBuildEventsByBuildIDs([]int{build.ID()})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the artifact", func() {
+				artifacts, err := repository.ReapableArchivedArtifacts(time.Hour)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(artifacts).To(HaveLen(1))
+				Expect(artifacts[0].ID()).To(Equal(artifact.ID()))
+			})
+
+			It("no longer returns the artifact once removed", func() {
+				Expect(repository.RemoveArchivedArtifact(artifact.ID())).To(Succeed())
+
+				artifacts, err := repository.ReapableArchivedArtifacts(time.Hour)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(artifacts).To(BeEmpty())
+			})
+		})
+
+		Context("when the build does not belong to a job", func() {
+			BeforeEach(func() {
+				var err error
+				build, err = defaultTeam.CreateOneOffBuild()
+				Expect(err).ToNot(HaveOccurred())
+
+				artifact, err = repository.SaveArchivedArtifact(build.ID(), "some-output", "some-key", 1024)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("does not return the artifact while the build is running", func() {
+				artifacts, err := repository.ReapableArchivedArtifacts(time.Hour)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(artifacts).To(BeEmpty())
+			})
+
+			Context("when the build finished within the retention period", func() {
+				BeforeEach(func() {
+					Expect(build.Finish(db.BuildStatusSucceeded)).To(Succeed())
+				})
+
+				It("does not return the artifact", func() {
+					artifacts, err := repository.ReapableArchivedArtifacts(time.Hour)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(artifacts).To(BeEmpty())
+				})
+			})
+
+			Context("when the build finished before the retention period", func() {
+				BeforeEach(func() {
+					Expect(build.Finish(db.BuildStatusSucceeded)).To(Succeed())
+
+					_, err := dbConn.Exec("UPDATE builds SET end_time = now() - '2 hours'::interval WHERE id = $1", build.ID())
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("returns the artifact", func() {
+					artifacts, err := repository.ReapableArchivedArtifacts(time.Hour)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(artifacts).To(HaveLen(1))
+					Expect(artifacts[0].ID()).To(Equal(artifact.ID()))
+				})
+			})
+		})
+
+		Context("when the build has been deleted", func() {
+			BeforeEach(func() {
+				_, err := dbConn.Exec("DELETE FROM builds WHERE id = $1", build.ID())
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the artifact", func() {
+				artifacts, err := repository.ReapableArchivedArtifacts(time.Hour)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(artifacts).To(HaveLen(1))
+				Expect(artifacts[0].ID()).To(Equal(artifact.ID()))
+			})
+		})
+	})
+})
@@ -0,0 +1,172 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// AuditEventFilter narrows down the events returned by
+// AuditEventRepository.Events. Each zero-valued field matches every event.
+type AuditEventFilter struct {
+	Team   string
+	User   string
+	Action string
+	Since  time.Time
+	Limit  int
+}
+
+//counterfeiter:generate . AuditEventRepository
+type AuditEventRepository interface {
+	// Record saves the event, returning its ID. Its ID and Time are assigned
+	// by the database. A zero Status is saved as not yet known.
+	Record(event atc.AuditEvent) (int, error)
+
+	// SetStatus fills in the status of an event recorded before its request
+	// was responded to.
+	SetStatus(id int, status int) error
+
+	// Events returns the events matching the filter, most recent first.
+	Events(filter AuditEventFilter) ([]atc.AuditEvent, error)
+
+	RemoveEventsOlderThan(retention time.Duration) (int, error)
+}
+
+type auditEventRepository struct {
+	conn Conn
+}
+
+func NewAuditEventRepository(conn Conn) AuditEventRepository {
+	return &auditEventRepository{conn: conn}
+}
+
+func (repository *auditEventRepository) Record(event atc.AuditEvent) (int, error) {
+	var parameters []byte
+	if len(event.Parameters) > 0 {
+		var err error
+		parameters, err = json.Marshal(event.Parameters)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	var teamName sql.NullString
+	if event.Team != "" {
+		teamName = sql.NullString{String: event.Team, Valid: true}
+	}
+
+	var status sql.NullInt64
+	if event.Status != 0 {
+		status = sql.NullInt64{Int64: int64(event.Status), Valid: true}
+	}
+
+	var id int
+	err := psql.Insert("audit_events").
+		Columns("user_name", "team_name", "action", "target", "parameters", "status").
+		Values(event.User, teamName, event.Action, event.Target, parameters, status).
+		Suffix("RETURNING id").
+		RunWith(repository.conn).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		return 0, err
+	}
+
+	return id, nil
+}
+
+func (repository *auditEventRepository) SetStatus(id int, status int) error {
+	_, err := psql.Update("audit_events").
+		Set("status", status).
+		Where(sq.Eq{"id": id}).
+		RunWith(repository.conn).
+		Exec()
+	return err
+}
+
+func (repository *auditEventRepository) Events(filter AuditEventFilter) ([]atc.AuditEvent, error) {
+	query := psql.Select("id", "created_at", "user_name", "team_name", "action", "target", "parameters", "status").
+		From("audit_events").
+		OrderBy("id DESC")
+
+	if filter.Team != "" {
+		query = query.Where(sq.Eq{"team_name": filter.Team})
+	}
+
+	if filter.User != "" {
+		query = query.Where(sq.Eq{"user_name": filter.User})
+	}
+
+	if filter.Action != "" {
+		query = query.Where(sq.Eq{"action": filter.Action})
+	}
+
+	if !filter.Since.IsZero() {
+		query = query.Where(sq.GtOrEq{"created_at": filter.Since})
+	}
+
+	if filter.Limit > 0 {
+		query = query.Limit(uint64(filter.Limit))
+	}
+
+	rows, err := query.
+		RunWith(repository.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	events := []atc.AuditEvent{}
+	for rows.Next() {
+		var event atc.AuditEvent
+		var createdAt time.Time
+		var teamName sql.NullString
+		var parameters []byte
+		var status sql.NullInt64
+
+		err := rows.Scan(&event.ID, &createdAt, &event.User, &teamName, &event.Action, &event.Target, &parameters, &status)
+		if err != nil {
+			return nil, err
+		}
+
+		event.Time = createdAt.Unix()
+		event.Team = teamName.String
+		event.Status = int(status.Int64)
+
+		if parameters != nil {
+			err = json.Unmarshal(parameters, &event.Parameters)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		events = append(events, event)
+	}
+
+	return events, nil
+}
+
+func (repository *auditEventRepository) RemoveEventsOlderThan(retention time.Duration) (int, error) {
+	res, err := psql.Delete("audit_events").
+		Where(
+			sq.Expr(fmt.Sprintf("created_at < now() - '%d seconds'::interval", int(retention.Seconds()))),
+		).
+		RunWith(repository.conn).
+		Exec()
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := res.RowsAffected()
+	if err != nil {
+		return 0, err
+	}
+
+	return int(n), nil
+}
@@ -0,0 +1,147 @@
+package db_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuditEventRepository", func() {
+	var repository db.AuditEventRepository
+
+	BeforeEach(func() {
+		repository = db.NewAuditEventRepository(dbConn)
+	})
+
+	Describe("Record", func() {
+		It("saves the event", func() {
+			id, err := repository.Record(atc.AuditEvent{
+				User:       "some-user",
+				Team:       "some-team",
+				Action:     atc.SaveConfig,
+				Target:     "/api/v1/teams/some-team/pipelines/some-pipeline/config",
+				Parameters: map[string][]string{"check_creds": {"true"}},
+				Status:     http.StatusOK,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			events, err := repository.Events(db.AuditEventFilter{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].ID).To(Equal(id))
+			Expect(events[0].Time).To(BeNumerically("~", time.Now().Unix(), 5))
+			Expect(events[0].User).To(Equal("some-user"))
+			Expect(events[0].Team).To(Equal("some-team"))
+			Expect(events[0].Action).To(Equal(atc.SaveConfig))
+			Expect(events[0].Target).To(Equal("/api/v1/teams/some-team/pipelines/some-pipeline/config"))
+			Expect(events[0].Parameters).To(Equal(map[string][]string{"check_creds": {"true"}}))
+			Expect(events[0].Status).To(Equal(http.StatusOK))
+		})
+
+		Context("when the request has not been responded to yet", func() {
+			It("saves the event without a status", func() {
+				_, err := repository.Record(atc.AuditEvent{User: "some-user", Action: atc.HijackContainer, Target: "/a"})
+				Expect(err).ToNot(HaveOccurred())
+
+				events, err := repository.Events(db.AuditEventFilter{})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(events).To(HaveLen(1))
+				Expect(events[0].Status).To(BeZero())
+			})
+		})
+	})
+
+	Describe("SetStatus", func() {
+		It("fills in the status of the event", func() {
+			id, err := repository.Record(atc.AuditEvent{User: "some-user", Action: atc.HijackContainer, Target: "/a"})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = repository.SetStatus(id, http.StatusOK)
+			Expect(err).ToNot(HaveOccurred())
+
+			events, err := repository.Events(db.AuditEventFilter{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Status).To(Equal(http.StatusOK))
+		})
+	})
+
+	Describe("Events", func() {
+		BeforeEach(func() {
+			for _, event := range []atc.AuditEvent{
+				{User: "some-user", Team: "some-team", Action: atc.SaveConfig, Target: "/a", Status: http.StatusOK},
+				{User: "other-user", Team: "some-team", Action: atc.CreateJobBuild, Target: "/b", Status: http.StatusForbidden},
+				{User: "some-user", Team: "other-team", Action: atc.CreateJobBuild, Target: "/c", Status: http.StatusOK},
+				{User: "some-user", Action: atc.SetWall, Target: "/d", Status: http.StatusOK},
+			} {
+				_, err := repository.Record(event)
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		targets := func(events []atc.AuditEvent) []string {
+			var targets []string
+			for _, event := range events {
+				targets = append(targets, event.Target)
+			}
+			return targets
+		}
+
+		It("returns every event, most recent first", func() {
+			events, err := repository.Events(db.AuditEventFilter{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(targets(events)).To(Equal([]string{"/d", "/c", "/b", "/a"}))
+		})
+
+		It("filters by team, user and action", func() {
+			events, err := repository.Events(db.AuditEventFilter{Team: "some-team"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(targets(events)).To(Equal([]string{"/b", "/a"}))
+
+			events, err = repository.Events(db.AuditEventFilter{User: "some-user", Action: atc.CreateJobBuild})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(targets(events)).To(Equal([]string{"/c"}))
+		})
+
+		It("filters by time", func() {
+			events, err := repository.Events(db.AuditEventFilter{Since: time.Now().Add(time.Hour)})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(BeEmpty())
+		})
+
+		It("limits the number of events", func() {
+			events, err := repository.Events(db.AuditEventFilter{Limit: 2})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(targets(events)).To(Equal([]string{"/d", "/c"}))
+		})
+	})
+
+	Describe("RemoveEventsOlderThan", func() {
+		BeforeEach(func() {
+			_, err := repository.Record(atc.AuditEvent{User: "some-user", Action: atc.SetWall, Target: "/old", Status: http.StatusOK})
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = dbConn.Exec(`UPDATE audit_events SET created_at = now() - interval '2 days'`)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = repository.Record(atc.AuditEvent{User: "some-user", Action: atc.SetWall, Target: "/new", Status: http.StatusOK})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("removes only the events older than the retention period", func() {
+			n, err := repository.RemoveEventsOlderThan(24 * time.Hour)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(n).To(Equal(1))
+
+			events, err := repository.Events(db.AuditEventFilter{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(events).To(HaveLen(1))
+			Expect(events[0].Target).To(Equal("/new"))
+		})
+	})
+})
@@ -1,6 +1,7 @@
 package db
 
 import (
+	"context"
 	"database/sql"
 	"encoding/json"
 	"errors"
@@ -179,7 +180,7 @@ type Build interface {
 
 	SetInterceptible(bool) error
 
-	Events(uint) (EventSource, error)
+	Events(context.Context, uint) (EventSource, error)
 	SaveEvent(event atc.Event) error
 
 	Artifacts() ([]WorkerArtifact, error)
@@ -197,6 +198,13 @@ type Build interface {
 	IsAborted() bool
 	AbortNotifier() (Notifier, error)
 
+	Approval(atc.PlanID) (atc.BuildApproval, bool, error)
+	SaveApproval(atc.PlanID, atc.BuildApproval) (bool, error)
+	ApprovalNotifier(atc.PlanID) (Notifier, error)
+
+	ResourceUsage() (atc.BuildResourceUsage, error)
+	SaveResourceUsage(atc.PlanID, atc.ResourceUsage) error
+
 	IsDrained() bool
 	SetDrained(bool) error
 
@@ -562,6 +570,11 @@ func (b *build) start(tx Tx, plan atc.Plan) (bool, error) {
 		return false, err
 	}
 
+	err = b.queueNotifications(tx, BuildStatusStarted, startTime, time.Time{})
+	if err != nil {
+		return false, err
+	}
+
 	return true, nil
 }
 
@@ -598,6 +611,11 @@ func (b *build) Finish(status BuildStatus) error {
 		return err
 	}
 
+	err = b.queueNotifications(tx, status, b.startTime, endTime)
+	if err != nil {
+		return err
+	}
+
 	_, err = tx.Exec(fmt.Sprintf(`
 		DROP SEQUENCE %s
 	`, buildEventSeq(b.id)))
@@ -889,6 +907,164 @@ func (b *build) AbortNotifier() (Notifier, error) {
 	})
 }
 
+// Approval returns the decision made on the build's wait_for_approval step,
+// if one has been made.
+func (b *build) Approval(planID atc.PlanID) (atc.BuildApproval, bool, error) {
+	var approval atc.BuildApproval
+	var comment sql.NullString
+	err := psql.Select("approved", "decided_by", "comment").
+		From("build_approvals").
+		Where(sq.Eq{
+			"build_id": b.id,
+			"plan_id":  string(planID),
+		}).
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&approval.Approved, &approval.User, &comment)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.BuildApproval{}, false, nil
+		}
+
+		return atc.BuildApproval{}, false, err
+	}
+
+	approval.Comment = comment.String
+
+	return approval, true, nil
+}
+
+// SaveApproval records the decision made on the build's wait_for_approval
+// step along with an event, and notifies the step. A step can only be decided
+// once; false is returned if a decision has already been made.
+func (b *build) SaveApproval(planID atc.PlanID, approval atc.BuildApproval) (bool, error) {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+
+	defer Rollback(tx)
+
+	result, err := psql.Insert("build_approvals").
+		Columns("build_id", "plan_id", "approved", "decided_by", "comment").
+		Values(b.id, string(planID), approval.Approved, approval.User, approval.Comment).
+		Suffix("ON CONFLICT (build_id, plan_id) DO NOTHING").
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	if rowsAffected == 0 {
+		return false, nil
+	}
+
+	err = b.saveEvent(tx, event.Approval{
+		Time:     time.Now().Unix(),
+		Origin:   event.Origin{ID: event.OriginID(planID)},
+		Approved: approval.Approved,
+		User:     approval.User,
+		Comment:  approval.Comment,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	err = b.conn.Bus().Notify(buildEventsChannel(b.id))
+	if err != nil {
+		return false, err
+	}
+
+	return true, b.conn.Bus().Notify(buildApprovalChannel(b.id))
+}
+
+func (b *build) ApprovalNotifier(planID atc.PlanID) (Notifier, error) {
+	return newConditionNotifier(b.conn.Bus(), buildApprovalChannel(b.id), func() (bool, error) {
+		_, decided, err := b.Approval(planID)
+		return decided, err
+	})
+}
+
+// ResourceUsage totals the resource usage reported by the build's task steps.
+func (b *build) ResourceUsage() (atc.BuildResourceUsage, error) {
+	usage := atc.BuildResourceUsage{BuildID: b.id}
+
+	var duration int64
+	err := psql.Select(
+		"COUNT(*)",
+		"COALESCE(SUM(cpu_usage), 0)",
+		"COALESCE(SUM(cpu_user), 0)",
+		"COALESCE(SUM(cpu_system), 0)",
+		"COALESCE(MAX(memory_peak), 0)",
+		"COALESCE(SUM(duration), 0)",
+	).
+		From("build_resource_usage").
+		Where(sq.Eq{"build_id": b.id}).
+		RunWith(b.conn).
+		QueryRow().
+		Scan(&usage.Steps, &usage.CPUUsage, &usage.CPUUser, &usage.CPUSystem, &usage.MemoryPeak, &duration)
+	if err != nil {
+		return atc.BuildResourceUsage{}, err
+	}
+
+	usage.Duration = time.Duration(duration)
+
+	return usage, nil
+}
+
+// SaveResourceUsage records the resource usage of one of the build's task
+// steps along with an event. A step which reports its usage again, e.g. after
+// being re-attached to, replaces its previous usage.
+func (b *build) SaveResourceUsage(planID atc.PlanID, usage atc.ResourceUsage) error {
+	tx, err := b.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Insert("build_resource_usage").
+		Columns("build_id", "plan_id", "cpu_usage", "cpu_user", "cpu_system", "memory_peak", "duration").
+		Values(b.id, string(planID), usage.CPUUsage, usage.CPUUser, usage.CPUSystem, usage.MemoryPeak, int64(usage.Duration)).
+		Suffix(`ON CONFLICT (build_id, plan_id) DO UPDATE SET
+			cpu_usage = EXCLUDED.cpu_usage,
+			cpu_user = EXCLUDED.cpu_user,
+			cpu_system = EXCLUDED.cpu_system,
+			memory_peak = EXCLUDED.memory_peak,
+			duration = EXCLUDED.duration`).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	err = b.saveEvent(tx, event.StepResourceUsage{
+		Time:   time.Now().Unix(),
+		Origin: event.Origin{ID: event.OriginID(planID)},
+		Usage:  usage,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+
+	return b.conn.Bus().Notify(buildEventsChannel(b.id))
+}
+
 func (b *build) SaveImageResourceVersion(rc UsedResourceCache) error {
 	var jobID sql.NullInt64
 	if b.jobID != 0 {
@@ -1088,7 +1264,7 @@ func (b *build) Preparation() (BuildPreparation, bool, error) {
 	return buildPreparation, true, nil
 }
 
-func (b *build) Events(from uint) (EventSource, error) {
+func (b *build) Events(ctx context.Context, from uint) (EventSource, error) {
 	notifier, err := newConditionNotifier(b.conn.Bus(), buildEventsChannel(b.id), func() (bool, error) {
 		return true, nil
 	})
@@ -1097,6 +1273,7 @@ func (b *build) Events(from uint) (EventSource, error) {
 	}
 
 	return newBuildEventSource(
+		ctx,
 		b.id,
 		b.eventsTable(),
 		b.conn,
@@ -1741,7 +1918,12 @@ func (b *build) SavePipeline(
 
 	jobID := newNullInt64(b.jobID)
 	buildID := newNullInt64(b.id)
-	pipelineID, isNewPipeline, err := savePipeline(tx, pipelineRef, config, from, initiallyPaused, teamID, jobID, buildID)
+	var createdBy sql.NullString
+	if b.createdBy != nil {
+		createdBy = newNullString(*b.createdBy)
+	}
+
+	pipelineID, isNewPipeline, err := savePipeline(tx, pipelineRef, config, from, initiallyPaused, teamID, jobID, buildID, createdBy)
 	if err != nil {
 		return nil, false, err
 	}
@@ -1773,6 +1955,14 @@ func newNullInt64(i int) sql.NullInt64 {
 	}
 }
 
+// newNullString returns a NULL for an empty string.
+func newNullString(s string) sql.NullString {
+	return sql.NullString{
+		Valid:  s != "",
+		String: s,
+	}
+}
+
 func createBuildEventSeq(tx Tx, buildid int) error {
 	_, err := tx.Exec(fmt.Sprintf(`
 		CREATE SEQUENCE %s MINVALUE 0
@@ -1931,10 +2121,14 @@ func (b *build) eventsTable() string {
 	if b.isForCheck() {
 		return "check_build_events"
 	}
-	if b.pipelineID != 0 {
-		return fmt.Sprintf("pipeline_build_events_%d", b.pipelineID)
+	return buildEventsTable(b.pipelineID, b.teamID)
+}
+
+func buildEventsTable(pipelineID int, teamID int) string {
+	if pipelineID != 0 {
+		return fmt.Sprintf("pipeline_build_events_%d", pipelineID)
 	}
-	return fmt.Sprintf("team_build_events_%d", b.teamID)
+	return fmt.Sprintf("team_build_events_%d", teamID)
 }
 
 func createBuild(tx Tx, build *build, vals map[string]interface{}) error {
@@ -2027,6 +2221,11 @@ func createStartedBuild(tx Tx, build *build, args startedBuildArgs) error {
 		return err
 	}
 
+	err = build.queueNotifications(tx, BuildStatusStarted, build.StartTime(), time.Time{})
+	if err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -2042,6 +2241,10 @@ func buildAbortChannel(buildID int) string {
 	return fmt.Sprintf("build_abort_%d", buildID)
 }
 
+func buildApprovalChannel(buildID int) string {
+	return fmt.Sprintf("build_approval_%d", buildID)
+}
+
 func latestCompletedNonRerunBuild(tx Tx, jobID int) (int, error) {
 	var latestNonRerunId int
 	err := latestCompletedBuildQuery.
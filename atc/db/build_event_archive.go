@@ -0,0 +1,248 @@
+package db
+
+import (
+	"compress/gzip"
+	"context"
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/blobstore"
+	"github.com/concourse/concourse/atc/db/lock"
+	"github.com/concourse/concourse/atc/event"
+)
+
+var ErrBuildEventStoreNotConfigured = errors.New("build events have been archived, but no build event store is configured")
+
+//counterfeiter:generate . BuildEventArchive
+type BuildEventArchive interface {
+	// ArchivableBuilds returns up to limit completed builds whose events
+	// are still in the database and have not been reaped.
+	ArchivableBuilds(limit int) ([]Build, error)
+
+	// ArchiveEvents moves the events of a completed build from its events
+	// table to the store.
+	ArchiveEvents(ctx context.Context, build Build) error
+
+	// ReapableArchivedEvents returns the IDs of builds whose archived events
+	// have been reaped, or which have been deleted altogether.
+	ReapableArchivedEvents() ([]int, error)
+
+	// RemoveArchivedEvents removes the archived events of a build from the
+	// store.
+	RemoveArchivedEvents(ctx context.Context, buildID int) error
+}
+
+type buildEventArchive struct {
+	conn        Conn
+	lockFactory lock.LockFactory
+	store       blobstore.Store
+}
+
+// WithBuildEventStore returns a connection whose builds stream their events
+// from the store once they have been archived, rather than from their events
+// table.
+func WithBuildEventStore(conn Conn, store blobstore.Store) Conn {
+	return &buildEventStoreConn{
+		Conn:  conn,
+		store: store,
+	}
+}
+
+type buildEventStoreConn struct {
+	Conn
+
+	store blobstore.Store
+}
+
+func (c *buildEventStoreConn) BuildEventStore() blobstore.Store {
+	return c.store
+}
+
+func NewBuildEventArchive(conn Conn, lockFactory lock.LockFactory, store blobstore.Store) BuildEventArchive {
+	return &buildEventArchive{
+		conn:        conn,
+		lockFactory: lockFactory,
+		store:       store,
+	}
+}
+
+func archivedBuildEventsKey(buildID int) string {
+	return fmt.Sprintf("builds/%d/events.json.gz", buildID)
+}
+
+func (archive *buildEventArchive) ArchivableBuilds(limit int) ([]Build, error) {
+	query := buildsQuery.
+		Where(sq.Eq{
+			"b.completed":        true,
+			"b.reap_time":        nil,
+			"b.resource_id":      nil,
+			"b.resource_type_id": nil,
+			"b.prototype_id":     nil,
+		}).
+		Where(sq.Expr("NOT EXISTS (SELECT 1 FROM archived_build_events e WHERE e.build_id = b.id)")).
+		OrderBy("b.id ASC").
+		Limit(uint64(limit))
+
+	return getBuilds(query, archive.conn, archive.lockFactory)
+}
+
+func (archive *buildEventArchive) ArchiveEvents(ctx context.Context, build Build) error {
+	table := buildEventsTable(build.PipelineID(), build.TeamID())
+	key := archivedBuildEventsKey(build.ID())
+
+	rows, err := psql.Select("event_id", "type", "version", "payload").
+		From(table).
+		Where(sq.Or{
+			sq.Eq{"build_id": build.ID()},
+			sq.Eq{"build_id_old": build.ID()},
+		}).
+		OrderBy("event_id ASC").
+		RunWith(archive.conn).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	reader, writer := io.Pipe()
+
+	go func() {
+		defer Close(rows)
+		writer.CloseWithError(writeArchivedBuildEvents(writer, rows))
+	}()
+
+	err = archive.store.Put(ctx, key, reader)
+
+	// unblock the writer in case the store gave up before reading everything
+	_ = reader.Close()
+
+	if err != nil {
+		return fmt.Errorf("store events: %w", err)
+	}
+
+	tx, err := archive.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	_, err = psql.Insert("archived_build_events").
+		Columns("build_id", "key").
+		Values(build.ID(), key).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Delete(table).
+		Where(sq.Or{
+			sq.Eq{"build_id": build.ID()},
+			sq.Eq{"build_id_old": build.ID()},
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (archive *buildEventArchive) ReapableArchivedEvents() ([]int, error) {
+	rows, err := psql.Select("e.build_id").
+		From("archived_build_events e").
+		LeftJoin("builds b ON b.id = e.build_id").
+		Where(sq.Or{
+			sq.Eq{"b.id": nil},
+			sq.NotEq{"b.reap_time": nil},
+		}).
+		OrderBy("e.build_id").
+		RunWith(archive.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	buildIDs := []int{}
+	for rows.Next() {
+		var buildID int
+		err := rows.Scan(&buildID)
+		if err != nil {
+			return nil, err
+		}
+
+		buildIDs = append(buildIDs, buildID)
+	}
+
+	return buildIDs, nil
+}
+
+func (archive *buildEventArchive) RemoveArchivedEvents(ctx context.Context, buildID int) error {
+	// remove the blob first so that it is never left behind without a record
+	// pointing to it
+	err := archive.store.Delete(ctx, archivedBuildEventsKey(buildID))
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Delete("archived_build_events").
+		Where(sq.Eq{"build_id": buildID}).
+		RunWith(archive.conn).
+		Exec()
+	return err
+}
+
+// archived build events are stored as gzipped, newline-delimited JSON
+// envelopes, in the order they were saved.
+func writeArchivedBuildEvents(w io.Writer, rows *sql.Rows) error {
+	gw := gzip.NewWriter(w)
+	encoder := json.NewEncoder(gw)
+	encoder.SetEscapeHTML(false)
+
+	for rows.Next() {
+		var id int
+		var t, v, p string
+		err := rows.Scan(&id, &t, &v, &p)
+		if err != nil {
+			return err
+		}
+
+		data := json.RawMessage(p)
+
+		err = encoder.Encode(event.Envelope{
+			Data:    &data,
+			Event:   atc.EventType(t),
+			Version: atc.EventVersion(v),
+			EventID: strconv.Itoa(id),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err := rows.Err()
+	if err != nil {
+		return err
+	}
+
+	return gw.Close()
+}
+
+func isBuildEventsArchived(conn Conn, buildID int) (bool, error) {
+	var archived bool
+	err := conn.QueryRow(`
+		SELECT EXISTS (
+			SELECT 1 FROM archived_build_events WHERE build_id = $1
+		)
+	`, buildID).Scan(&archived)
+	return archived, err
+}
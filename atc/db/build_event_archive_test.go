@@ -0,0 +1,201 @@
+package db_test
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc/blobstore"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/event"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildEventArchive", func() {
+	var (
+		storeDir string
+		store    blobstore.Store
+		archive  db.BuildEventArchive
+		build    db.Build
+	)
+
+	BeforeEach(func() {
+		var err error
+		storeDir, err = ioutil.TempDir("", "build-event-store")
+		Expect(err).ToNot(HaveOccurred())
+
+		store, err = blobstore.Local{Dir: storeDir}.Store()
+		Expect(err).ToNot(HaveOccurred())
+
+		archive = db.NewBuildEventArchive(dbConn, lockFactory, store)
+
+		created, err := defaultJob.CreateBuild(defaultBuildCreatedBy)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(created.SaveEvent(event.Log{Payload: "some <output>"})).To(Succeed())
+		Expect(created.Finish(db.BuildStatusSucceeded)).To(Succeed())
+
+		// load the build through a connection which knows about the store so
+		// that its archived events can be streamed
+		storeConn := db.WithBuildEventStore(dbConn, store)
+
+		var found bool
+		build, found, err = db.NewBuildFactory(storeConn, lockFactory, 5*time.Minute, 5*time.Minute).Build(created.ID())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(found).To(BeTrue())
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(storeDir)).To(Succeed())
+	})
+
+	Describe("ArchivableBuilds", func() {
+		It("returns completed builds", func() {
+			builds, err := archive.ArchivableBuilds(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(builds).To(HaveLen(1))
+			Expect(builds[0].ID()).To(Equal(build.ID()))
+		})
+
+		It("does not return running builds", func() {
+			_, err := defaultJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+
+			builds, err := archive.ArchivableBuilds(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(builds).To(HaveLen(1))
+		})
+
+		It("does not return builds which have been reaped", func() {
+			err := defaultPipeline.DeleteBuildEventsByBuildIDs([]int{build.ID()})
+			Expect(err).ToNot(HaveOccurred())
+
+			builds, err := archive.ArchivableBuilds(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(builds).To(BeEmpty())
+		})
+
+		It("does not return builds which have been archived", func() {
+			Expect(archive.ArchiveEvents(context.TODO(), build)).To(Succeed())
+
+			builds, err := archive.ArchivableBuilds(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(builds).To(BeEmpty())
+		})
+	})
+
+	Describe("ArchiveEvents", func() {
+		var expectedEvents []event.Envelope
+
+		BeforeEach(func() {
+			events, err := build.Events(context.TODO(), 0)
+			Expect(err).ToNot(HaveOccurred())
+
+			defer db.Close(events)
+
+			expectedEvents = nil
+			for {
+				ev, err := events.Next()
+				if err == db.ErrEndOfBuildEventStream {
+					break
+				}
+
+				Expect(err).ToNot(HaveOccurred())
+				expectedEvents = append(expectedEvents, ev)
+			}
+
+			Expect(expectedEvents).ToNot(BeEmpty())
+
+			Expect(archive.ArchiveEvents(context.TODO(), build)).To(Succeed())
+		})
+
+		It("removes the events from the database", func() {
+			var count int
+			err := dbConn.QueryRow("SELECT COUNT(*) FROM pipeline_build_events_"+strconv.Itoa(defaultPipeline.ID())+" WHERE build_id = $1", build.ID()).Scan(&count)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(BeZero())
+		})
+
+		It("streams the events from the store", func() {
+			events, err := build.Events(context.TODO(), 0)
+			Expect(err).ToNot(HaveOccurred())
+
+			defer db.Close(events)
+
+			for _, expected := range expectedEvents {
+				Expect(events.Next()).To(Equal(expected))
+			}
+
+			_, err = events.Next()
+			Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
+		})
+
+		It("streams the events from the given event", func() {
+			events, err := build.Events(context.TODO(), 1)
+			Expect(err).ToNot(HaveOccurred())
+
+			defer db.Close(events)
+
+			Expect(events.Next()).To(Equal(expectedEvents[1]))
+		})
+
+		Context("when no build event store is configured", func() {
+			BeforeEach(func() {
+				var found bool
+				var err error
+				build, found, err = buildFactory.Build(build.ID())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			It("errors", func() {
+				events, err := build.Events(context.TODO(), 0)
+				Expect(err).ToNot(HaveOccurred())
+
+				defer db.Close(events)
+
+				_, err = events.Next()
+				Expect(err).To(Equal(db.ErrBuildEventStoreNotConfigured))
+			})
+		})
+	})
+
+	Describe("ReapableArchivedEvents", func() {
+		BeforeEach(func() {
+			Expect(archive.ArchiveEvents(context.TODO(), build)).To(Succeed())
+		})
+
+		It("does not return events of retained builds", func() {
+			buildIDs, err := archive.ReapableArchivedEvents()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(buildIDs).To(BeEmpty())
+		})
+
+		Context("when the build's events have been reaped", func() {
+			BeforeEach(func() {
+				err := defaultPipeline.DeleteBuildEventsByBuildIDs([]int{build.ID()})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("returns the build", func() {
+				buildIDs, err := archive.ReapableArchivedEvents()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(buildIDs).To(Equal([]int{build.ID()}))
+			})
+
+			It("no longer returns the build once removed", func() {
+				Expect(archive.RemoveArchivedEvents(context.TODO(), build.ID())).To(Succeed())
+
+				buildIDs, err := archive.ReapableArchivedEvents()
+				Expect(err).ToNot(HaveOccurred())
+				Expect(buildIDs).To(BeEmpty())
+
+				_, err = store.Get(context.TODO(), "builds/"+strconv.Itoa(build.ID())+"/events.json.gz")
+				Expect(err).To(Equal(blobstore.ErrBlobNotFound))
+			})
+		})
+	})
+})
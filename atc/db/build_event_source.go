@@ -1,8 +1,11 @@
 package db
 
 import (
+	"compress/gzip"
+	"context"
 	"encoding/json"
 	"errors"
+	"io"
 	"strconv"
 	"sync"
 
@@ -21,6 +24,7 @@ type EventSource interface {
 }
 
 func newBuildEventSource(
+	ctx context.Context,
 	buildID int,
 	table string,
 	conn Conn,
@@ -43,7 +47,7 @@ func newBuildEventSource(
 	}
 
 	wg.Add(1)
-	go source.collectEvents(from)
+	go source.collectEvents(ctx, from)
 
 	return source
 }
@@ -83,7 +87,7 @@ func (source *buildEventSource) Close() error {
 	return source.notifier.Close()
 }
 
-func (source *buildEventSource) collectEvents(from uint) {
+func (source *buildEventSource) collectEvents(ctx context.Context, from uint) {
 	defer source.wg.Done()
 
 	batchSize := cap(source.events)
@@ -185,7 +189,23 @@ func (source *buildEventSource) collectEvents(from uint) {
 		}
 
 		if completed {
-			source.err = ErrEndOfBuildEventStream
+			// the build's events may have been offloaded to the build event
+			// store; this is checked only after finding no more events in the
+			// table, as they are removed from it in the same transaction as
+			// they are marked as archived
+			archived, err := isBuildEventsArchived(source.conn, source.buildID)
+			if err != nil {
+				source.err = err
+				close(source.events)
+				return
+			}
+
+			if archived {
+				source.err = source.collectArchivedEvents(ctx, cursor)
+			} else {
+				source.err = ErrEndOfBuildEventStream
+			}
+
 			close(source.events)
 			return
 		}
@@ -199,3 +219,51 @@ func (source *buildEventSource) collectEvents(from uint) {
 		}
 	}
 }
+
+func (source *buildEventSource) collectArchivedEvents(ctx context.Context, cursor int) error {
+	store := source.conn.BuildEventStore()
+	if store == nil {
+		return ErrBuildEventStoreNotConfigured
+	}
+
+	archive, err := store.Get(ctx, archivedBuildEventsKey(source.buildID))
+	if err != nil {
+		return err
+	}
+
+	defer archive.Close()
+
+	gr, err := gzip.NewReader(archive)
+	if err != nil {
+		return err
+	}
+
+	decoder := json.NewDecoder(gr)
+
+	for {
+		var ev event.Envelope
+		err := decoder.Decode(&ev)
+		if err != nil {
+			if err == io.EOF {
+				return ErrEndOfBuildEventStream
+			}
+
+			return err
+		}
+
+		id, err := strconv.Atoi(ev.EventID)
+		if err != nil {
+			return err
+		}
+
+		if id <= cursor {
+			continue
+		}
+
+		select {
+		case source.events <- ev:
+		case <-source.stop:
+			return ErrBuildEventStreamClosed
+		}
+	}
+}
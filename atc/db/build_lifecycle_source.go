@@ -0,0 +1,252 @@
+package db
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/lock"
+)
+
+var ErrBuildLifecycleStreamClosed = errors.New("build lifecycle stream closed")
+
+// outOfOrderWindow is how far below the greatest build id seen so far builds
+// are looked for again. Ids are allocated when a build is inserted, but the
+// transaction inserting it may commit after one which was allocated a greater
+// id, so a build can appear after builds with greater ids have been seen.
+const outOfOrderWindow = 1000
+
+type BuildLifecycleEvent struct {
+	Type  atc.BuildLifecycleEventType
+	Build Build
+}
+
+//counterfeiter:generate . BuildLifecycleSource
+
+// BuildLifecycleSource streams changes to the builds of a team as they are
+// created, started and finished. Changes which happen in quick succession may
+// be coalesced, e.g. a build which is started and finished before it is seen
+// again is only reported as finished.
+type BuildLifecycleSource interface {
+	Next() (BuildLifecycleEvent, error)
+	Close() error
+}
+
+func teamBuildsChannel(teamID int) string {
+	return fmt.Sprintf("team_builds_%d", teamID)
+}
+
+func newBuildLifecycleSource(
+	teamID int,
+	conn Conn,
+	lockFactory lock.LockFactory,
+	notifier Notifier,
+) (*buildLifecycleSource, error) {
+	source := &buildLifecycleSource{
+		teamID: teamID,
+
+		conn:        conn,
+		lockFactory: lockFactory,
+		notifier:    notifier,
+
+		seen:    map[int]bool{},
+		running: map[int]BuildStatus{},
+		stop:    make(chan struct{}),
+	}
+
+	// only changes from this point on are reported, so remember which builds
+	// exist already and which of them are yet to finish
+	err := psql.Select("COALESCE(MAX(id), 0)").
+		From("builds").
+		Where(sq.Eq{"team_id": teamID}).
+		RunWith(conn).
+		QueryRow().
+		Scan(&source.lastSeenID)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := psql.Select("id", "status").
+		From("builds").
+		Where(sq.Eq{
+			"team_id":          teamID,
+			"resource_id":      nil,
+			"resource_type_id": nil,
+			"prototype_id":     nil,
+		}).
+		Where(sq.Or{
+			sq.Eq{"status": []BuildStatus{BuildStatusPending, BuildStatusStarted}},
+			sq.Gt{"id": source.lastSeenID - outOfOrderWindow},
+		}).
+		Where(sq.LtOrEq{"id": source.lastSeenID}).
+		RunWith(conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	for rows.Next() {
+		var id int
+		var status BuildStatus
+		err := rows.Scan(&id, &status)
+		if err != nil {
+			return nil, err
+		}
+
+		if id > source.lastSeenID-outOfOrderWindow {
+			source.seen[id] = true
+		}
+
+		if status == BuildStatusPending || status == BuildStatusStarted {
+			source.running[id] = status
+		}
+	}
+
+	return source, nil
+}
+
+type buildLifecycleSource struct {
+	teamID int
+
+	conn        Conn
+	lockFactory lock.LockFactory
+	notifier    Notifier
+
+	lastSeenID int
+	seen       map[int]bool
+	running    map[int]BuildStatus
+	queued     []BuildLifecycleEvent
+
+	stop     chan struct{}
+	stopOnce sync.Once
+}
+
+func (source *buildLifecycleSource) Next() (BuildLifecycleEvent, error) {
+	for len(source.queued) == 0 {
+		select {
+		case <-source.stop:
+			return BuildLifecycleEvent{}, ErrBuildLifecycleStreamClosed
+		case <-source.notifier.Notify():
+		}
+
+		err := source.refresh()
+		if err != nil {
+			return BuildLifecycleEvent{}, err
+		}
+	}
+
+	ev := source.queued[0]
+	source.queued = source.queued[1:]
+
+	return ev, nil
+}
+
+func (source *buildLifecycleSource) Close() error {
+	var err error
+	source.stopOnce.Do(func() {
+		close(source.stop)
+		err = source.notifier.Close()
+	})
+
+	return err
+}
+
+// refresh queues events for the builds created since the last refresh and
+// for the builds that were running whose status has since changed.
+//
+// Builds within the window below the greatest id seen are scanned again for
+// any which were committed late, and are remembered so that they're only
+// reported as created once.
+func (source *buildLifecycleSource) refresh() error {
+	running := make([]int, 0, len(source.running))
+	for id := range source.running {
+		running = append(running, id)
+	}
+
+	windowStart := source.lastSeenID - outOfOrderWindow
+
+	rows, err := buildsQuery.
+		Where(sq.Eq{
+			"b.team_id":          source.teamID,
+			"b.resource_id":      nil,
+			"b.resource_type_id": nil,
+			"b.prototype_id":     nil,
+		}).
+		Where(sq.Or{
+			sq.Gt{"b.id": windowStart},
+			sq.Eq{"b.id": running},
+		}).
+		OrderBy("b.id ASC").
+		RunWith(source.conn).
+		Query()
+	if err != nil {
+		return err
+	}
+
+	defer Close(rows)
+
+	stillRunning := map[int]BuildStatus{}
+
+	for rows.Next() {
+		build := newEmptyBuild(source.conn, source.lockFactory)
+		err := scanBuild(build, rows, source.conn.EncryptionStrategy())
+		if err != nil {
+			return err
+		}
+
+		status := build.Status()
+
+		if build.ID() > windowStart && !source.seen[build.ID()] {
+			source.seen[build.ID()] = true
+			if build.ID() > source.lastSeenID {
+				source.lastSeenID = build.ID()
+			}
+
+			source.queue(atc.BuildCreated, build)
+
+			if status != BuildStatusPending {
+				source.queue(lifecycleEventType(status), build)
+			}
+		} else if previous, found := source.running[build.ID()]; found && previous != status {
+			source.queue(lifecycleEventType(status), build)
+		}
+
+		if status == BuildStatusPending || status == BuildStatusStarted {
+			stillRunning[build.ID()] = status
+		}
+	}
+
+	// builds which are no longer running, or which have been deleted, are
+	// forgotten
+	source.running = stillRunning
+
+	for id := range source.seen {
+		if id <= source.lastSeenID-outOfOrderWindow {
+			delete(source.seen, id)
+		}
+	}
+
+	return nil
+}
+
+func (source *buildLifecycleSource) queue(eventType atc.BuildLifecycleEventType, build Build) {
+	source.queued = append(source.queued, BuildLifecycleEvent{
+		Type:  eventType,
+		Build: build,
+	})
+}
+
+func lifecycleEventType(status BuildStatus) atc.BuildLifecycleEventType {
+	switch status {
+	case BuildStatusStarted:
+		return atc.BuildStarted
+	case BuildStatusSucceeded, BuildStatusFailed, BuildStatusErrored, BuildStatusAborted:
+		return atc.BuildFinished
+	default:
+		return atc.BuildStatusChanged
+	}
+}
@@ -53,7 +53,7 @@ var _ = Describe("Build", func() {
 			},
 		}
 
-		pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-build-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
+		pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "some-build-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, "")
 		Expect(err).ToNot(HaveOccurred())
 
 		job, found, err = pipeline.Job("some-job")
@@ -466,7 +466,7 @@ var _ = Describe("Build", func() {
 				Expect(found).To(BeTrue())
 				Expect(build.Status()).To(Equal(db.BuildStatusStarted))
 
-				events, err := build.Events(0)
+				events, err := build.Events(context.TODO(), 0)
 				Expect(err).NotTo(HaveOccurred())
 
 				defer db.Close(events)
@@ -644,7 +644,7 @@ var _ = Describe("Build", func() {
 			Expect(found).To(BeTrue())
 			Expect(build.Status()).To(Equal(db.BuildStatusSucceeded))
 
-			events, err := build.Events(0)
+			events, err := build.Events(context.TODO(), 0)
 			Expect(err).NotTo(HaveOccurred())
 
 			defer db.Close(events)
@@ -928,7 +928,7 @@ var _ = Describe("Build", func() {
 				Context("when the pipeline is not set by build", func() {
 					It("never gets archived", func() {
 						build, _ := defaultJob.CreateBuild(defaultBuildCreatedBy)
-						teamPipeline, _, _ := defaultTeam.SavePipeline(atc.PipelineRef{Name: "team-pipeline"}, defaultPipelineConfig, db.ConfigVersion(0), false)
+						teamPipeline, _, _ := defaultTeam.SavePipeline(atc.PipelineRef{Name: "team-pipeline"}, defaultPipelineConfig, db.ConfigVersion(0), false, "")
 						build.Finish(db.BuildStatusSucceeded)
 
 						teamPipeline.Reload()
@@ -1006,7 +1006,7 @@ var _ = Describe("Build", func() {
 					},
 				})
 
-				pipeline, _, err := defaultTeam.SavePipeline(defaultPipelineRef, config, defaultPipeline.ConfigVersion(), false)
+				pipeline, _, err := defaultTeam.SavePipeline(defaultPipelineRef, config, defaultPipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job, found, err := pipeline.Job(defaultJob.Name())
@@ -1093,10 +1093,149 @@ var _ = Describe("Build", func() {
 		})
 	})
 
+	Describe("Approvals", func() {
+		var approval atc.BuildApproval
+
+		BeforeEach(func() {
+			approval = atc.BuildApproval{
+				Approved: true,
+				User:     "some-user",
+				Comment:  "ship it",
+			}
+		})
+
+		It("does not find an approval before one is saved", func() {
+			_, found, err := build.Approval("some-plan")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+
+		It("saves the approval", func() {
+			saved, err := build.SaveApproval("some-plan", approval)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(saved).To(BeTrue())
+
+			found, exists, err := build.Approval("some-plan")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(exists).To(BeTrue())
+			Expect(found).To(Equal(approval))
+		})
+
+		It("records an event", func() {
+			_, err := build.SaveApproval("some-plan", approval)
+			Expect(err).NotTo(HaveOccurred())
+
+			events, err := build.Events(context.TODO(), 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			defer db.Close(events)
+
+			ev, err := events.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ev.Event).To(Equal(event.EventTypeApproval))
+		})
+
+		It("only allows a step to be decided once", func() {
+			_, err := build.SaveApproval("some-plan", approval)
+			Expect(err).NotTo(HaveOccurred())
+
+			saved, err := build.SaveApproval("some-plan", atc.BuildApproval{Approved: false, User: "some-other-user"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(saved).To(BeFalse())
+
+			found, _, err := build.Approval("some-plan")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(Equal(approval))
+		})
+
+		It("notifies the step once it is decided", func() {
+			notifier, err := build.ApprovalNotifier("some-plan")
+			Expect(err).NotTo(HaveOccurred())
+
+			defer notifier.Close()
+
+			Consistently(notifier.Notify()).ShouldNot(Receive())
+
+			_, err = build.SaveApproval("some-plan", approval)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(notifier.Notify()).Should(Receive())
+		})
+	})
+
+	Describe("ResourceUsage", func() {
+		It("is empty before any step reports its usage", func() {
+			usage, err := build.ResourceUsage()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage).To(Equal(atc.BuildResourceUsage{BuildID: build.ID()}))
+		})
+
+		It("totals the usage of each step", func() {
+			err := build.SaveResourceUsage("some-plan", atc.ResourceUsage{
+				CPUUsage:   300,
+				CPUUser:    200,
+				CPUSystem:  100,
+				MemoryPeak: 2048,
+				Duration:   time.Minute,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveResourceUsage("some-other-plan", atc.ResourceUsage{
+				CPUUsage:   30,
+				CPUUser:    20,
+				CPUSystem:  10,
+				MemoryPeak: 1024,
+				Duration:   time.Second,
+			})
+			Expect(err).NotTo(HaveOccurred())
+
+			usage, err := build.ResourceUsage()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage).To(Equal(atc.BuildResourceUsage{
+				BuildID: build.ID(),
+				Steps:   2,
+				ResourceUsage: atc.ResourceUsage{
+					CPUUsage:   330,
+					CPUUser:    220,
+					CPUSystem:  110,
+					MemoryPeak: 2048,
+					Duration:   time.Minute + time.Second,
+				},
+			}))
+		})
+
+		It("replaces the usage of a step which reports it again", func() {
+			err := build.SaveResourceUsage("some-plan", atc.ResourceUsage{CPUUsage: 100})
+			Expect(err).NotTo(HaveOccurred())
+
+			err = build.SaveResourceUsage("some-plan", atc.ResourceUsage{CPUUsage: 200})
+			Expect(err).NotTo(HaveOccurred())
+
+			usage, err := build.ResourceUsage()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(usage.Steps).To(Equal(1))
+			Expect(usage.CPUUsage).To(Equal(uint64(200)))
+		})
+
+		It("records an event", func() {
+			err := build.SaveResourceUsage("some-plan", atc.ResourceUsage{CPUUsage: 100})
+			Expect(err).NotTo(HaveOccurred())
+
+			events, err := build.Events(context.TODO(), 0)
+			Expect(err).NotTo(HaveOccurred())
+
+			defer db.Close(events)
+
+			ev, err := events.Next()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(ev.Event).To(Equal(event.EventTypeStepResourceUsage))
+		})
+	})
+
 	Describe("Events", func() {
 		It("saves and emits status events", func() {
 			By("allowing you to subscribe when no events have yet occurred")
-			events, err := build.Events(0)
+			events, err := build.Events(context.TODO(), 0)
 			Expect(err).NotTo(HaveOccurred())
 
 			defer db.Close(events)
@@ -1145,7 +1284,7 @@ var _ = Describe("Build", func() {
 			_, err = dbConn.Exec(`UPDATE build_events SET build_id_old = build_id, build_id = NULL WHERE build_id = $1`, build.ID())
 			Expect(err).NotTo(HaveOccurred())
 
-			events, err := build.Events(0)
+			events, err := build.Events(context.TODO(), 0)
 			Expect(err).NotTo(HaveOccurred())
 
 			defer db.Close(events)
@@ -1159,7 +1298,7 @@ var _ = Describe("Build", func() {
 	Describe("SaveEvent", func() {
 		It("saves and propagates events correctly", func() {
 			By("allowing you to subscribe when no events have yet occurred")
-			events, err := build.Events(0)
+			events, err := build.Events(context.TODO(), 0)
 			Expect(err).NotTo(HaveOccurred())
 
 			defer db.Close(events)
@@ -1184,7 +1323,7 @@ var _ = Describe("Build", func() {
 			}, "1")))
 
 			By("allowing you to subscribe from an offset")
-			eventsFrom1, err := build.Events(1)
+			eventsFrom1, err := build.Events(context.TODO(), 1)
 			Expect(err).NotTo(HaveOccurred())
 
 			defer db.Close(eventsFrom1)
@@ -1219,7 +1358,7 @@ var _ = Describe("Build", func() {
 			}, "2"))))
 
 			By("returning ErrBuildEventStreamClosed for Next calls after Close")
-			events3, err := build.Events(0)
+			events3, err := build.Events(context.TODO(), 0)
 			Expect(err).NotTo(HaveOccurred())
 
 			err = events3.Close()
@@ -1615,7 +1754,7 @@ var _ = Describe("Build", func() {
 							Name: "some-job",
 						},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job, found, err := createdPipeline.Job("some-job")
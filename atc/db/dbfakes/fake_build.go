@@ -2,6 +2,7 @@
 package dbfakes
 
 import (
+	"context"
 	"encoding/json"
 	"sync"
 	"time"
@@ -74,6 +75,34 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	ApprovalStub        func(atc.PlanID) (atc.BuildApproval, bool, error)
+	approvalMutex       sync.RWMutex
+	approvalArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	approvalReturns struct {
+		result1 atc.BuildApproval
+		result2 bool
+		result3 error
+	}
+	approvalReturnsOnCall map[int]struct {
+		result1 atc.BuildApproval
+		result2 bool
+		result3 error
+	}
+	ApprovalNotifierStub        func(atc.PlanID) (db.Notifier, error)
+	approvalNotifierMutex       sync.RWMutex
+	approvalNotifierArgsForCall []struct {
+		arg1 atc.PlanID
+	}
+	approvalNotifierReturns struct {
+		result1 db.Notifier
+		result2 error
+	}
+	approvalNotifierReturnsOnCall map[int]struct {
+		result1 db.Notifier
+		result2 error
+	}
 	ArtifactStub        func(int) (db.WorkerArtifact, error)
 	artifactMutex       sync.RWMutex
 	artifactArgsForCall []struct {
@@ -141,10 +170,11 @@ type FakeBuild struct {
 	endTimeReturnsOnCall map[int]struct {
 		result1 time.Time
 	}
-	EventsStub        func(uint) (db.EventSource, error)
+	EventsStub        func(context.Context, uint) (db.EventSource, error)
 	eventsMutex       sync.RWMutex
 	eventsArgsForCall []struct {
-		arg1 uint
+		arg1 context.Context
+		arg2 uint
 	}
 	eventsReturns struct {
 		result1 db.EventSource
@@ -528,6 +558,18 @@ type FakeBuild struct {
 	resourceTypeNameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	ResourceUsageStub        func() (atc.BuildResourceUsage, error)
+	resourceUsageMutex       sync.RWMutex
+	resourceUsageArgsForCall []struct {
+	}
+	resourceUsageReturns struct {
+		result1 atc.BuildResourceUsage
+		result2 error
+	}
+	resourceUsageReturnsOnCall map[int]struct {
+		result1 atc.BuildResourceUsage
+		result2 error
+	}
 	ResourcesStub        func() ([]db.BuildInput, []db.BuildOutput, error)
 	resourcesMutex       sync.RWMutex
 	resourcesArgsForCall []struct {
@@ -554,6 +596,20 @@ type FakeBuild struct {
 		result1 bool
 		result2 error
 	}
+	SaveApprovalStub        func(atc.PlanID, atc.BuildApproval) (bool, error)
+	saveApprovalMutex       sync.RWMutex
+	saveApprovalArgsForCall []struct {
+		arg1 atc.PlanID
+		arg2 atc.BuildApproval
+	}
+	saveApprovalReturns struct {
+		result1 bool
+		result2 error
+	}
+	saveApprovalReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	SaveEventStub        func(atc.Event) error
 	saveEventMutex       sync.RWMutex
 	saveEventArgsForCall []struct {
@@ -612,6 +668,18 @@ type FakeBuild struct {
 		result2 bool
 		result3 error
 	}
+	SaveResourceUsageStub        func(atc.PlanID, atc.ResourceUsage) error
+	saveResourceUsageMutex       sync.RWMutex
+	saveResourceUsageArgsForCall []struct {
+		arg1 atc.PlanID
+		arg2 atc.ResourceUsage
+	}
+	saveResourceUsageReturns struct {
+		result1 error
+	}
+	saveResourceUsageReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SchemaStub        func() string
 	schemaMutex       sync.RWMutex
 	schemaArgsForCall []struct {
@@ -989,6 +1057,137 @@ func (fake *FakeBuild) AdoptRerunInputsAndPipesReturnsOnCall(i int, result1 []db
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) Approval(arg1 atc.PlanID) (atc.BuildApproval, bool, error) {
+	fake.approvalMutex.Lock()
+	ret, specificReturn := fake.approvalReturnsOnCall[len(fake.approvalArgsForCall)]
+	fake.approvalArgsForCall = append(fake.approvalArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	stub := fake.ApprovalStub
+	fakeReturns := fake.approvalReturns
+	fake.recordInvocation("Approval", []interface{}{arg1})
+	fake.approvalMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeBuild) ApprovalCallCount() int {
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	return len(fake.approvalArgsForCall)
+}
+
+func (fake *FakeBuild) ApprovalCalls(stub func(atc.PlanID) (atc.BuildApproval, bool, error)) {
+	fake.approvalMutex.Lock()
+	defer fake.approvalMutex.Unlock()
+	fake.ApprovalStub = stub
+}
+
+func (fake *FakeBuild) ApprovalArgsForCall(i int) atc.PlanID {
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	argsForCall := fake.approvalArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) ApprovalReturns(result1 atc.BuildApproval, result2 bool, result3 error) {
+	fake.approvalMutex.Lock()
+	defer fake.approvalMutex.Unlock()
+	fake.ApprovalStub = nil
+	fake.approvalReturns = struct {
+		result1 atc.BuildApproval
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) ApprovalReturnsOnCall(i int, result1 atc.BuildApproval, result2 bool, result3 error) {
+	fake.approvalMutex.Lock()
+	defer fake.approvalMutex.Unlock()
+	fake.ApprovalStub = nil
+	if fake.approvalReturnsOnCall == nil {
+		fake.approvalReturnsOnCall = make(map[int]struct {
+			result1 atc.BuildApproval
+			result2 bool
+			result3 error
+		})
+	}
+	fake.approvalReturnsOnCall[i] = struct {
+		result1 atc.BuildApproval
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeBuild) ApprovalNotifier(arg1 atc.PlanID) (db.Notifier, error) {
+	fake.approvalNotifierMutex.Lock()
+	ret, specificReturn := fake.approvalNotifierReturnsOnCall[len(fake.approvalNotifierArgsForCall)]
+	fake.approvalNotifierArgsForCall = append(fake.approvalNotifierArgsForCall, struct {
+		arg1 atc.PlanID
+	}{arg1})
+	stub := fake.ApprovalNotifierStub
+	fakeReturns := fake.approvalNotifierReturns
+	fake.recordInvocation("ApprovalNotifier", []interface{}{arg1})
+	fake.approvalNotifierMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) ApprovalNotifierCallCount() int {
+	fake.approvalNotifierMutex.RLock()
+	defer fake.approvalNotifierMutex.RUnlock()
+	return len(fake.approvalNotifierArgsForCall)
+}
+
+func (fake *FakeBuild) ApprovalNotifierCalls(stub func(atc.PlanID) (db.Notifier, error)) {
+	fake.approvalNotifierMutex.Lock()
+	defer fake.approvalNotifierMutex.Unlock()
+	fake.ApprovalNotifierStub = stub
+}
+
+func (fake *FakeBuild) ApprovalNotifierArgsForCall(i int) atc.PlanID {
+	fake.approvalNotifierMutex.RLock()
+	defer fake.approvalNotifierMutex.RUnlock()
+	argsForCall := fake.approvalNotifierArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeBuild) ApprovalNotifierReturns(result1 db.Notifier, result2 error) {
+	fake.approvalNotifierMutex.Lock()
+	defer fake.approvalNotifierMutex.Unlock()
+	fake.ApprovalNotifierStub = nil
+	fake.approvalNotifierReturns = struct {
+		result1 db.Notifier
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ApprovalNotifierReturnsOnCall(i int, result1 db.Notifier, result2 error) {
+	fake.approvalNotifierMutex.Lock()
+	defer fake.approvalNotifierMutex.Unlock()
+	fake.ApprovalNotifierStub = nil
+	if fake.approvalNotifierReturnsOnCall == nil {
+		fake.approvalNotifierReturnsOnCall = make(map[int]struct {
+			result1 db.Notifier
+			result2 error
+		})
+	}
+	fake.approvalNotifierReturnsOnCall[i] = struct {
+		result1 db.Notifier
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Artifact(arg1 int) (db.WorkerArtifact, error) {
 	fake.artifactMutex.Lock()
 	ret, specificReturn := fake.artifactReturnsOnCall[len(fake.artifactArgsForCall)]
@@ -1324,18 +1523,19 @@ func (fake *FakeBuild) EndTimeReturnsOnCall(i int, result1 time.Time) {
 	}{result1}
 }
 
-func (fake *FakeBuild) Events(arg1 uint) (db.EventSource, error) {
+func (fake *FakeBuild) Events(arg1 context.Context, arg2 uint) (db.EventSource, error) {
 	fake.eventsMutex.Lock()
 	ret, specificReturn := fake.eventsReturnsOnCall[len(fake.eventsArgsForCall)]
 	fake.eventsArgsForCall = append(fake.eventsArgsForCall, struct {
-		arg1 uint
-	}{arg1})
+		arg1 context.Context
+		arg2 uint
+	}{arg1, arg2})
 	stub := fake.EventsStub
 	fakeReturns := fake.eventsReturns
-	fake.recordInvocation("Events", []interface{}{arg1})
+	fake.recordInvocation("Events", []interface{}{arg1, arg2})
 	fake.eventsMutex.Unlock()
 	if stub != nil {
-		return stub(arg1)
+		return stub(arg1, arg2)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2
@@ -1349,17 +1549,17 @@ func (fake *FakeBuild) EventsCallCount() int {
 	return len(fake.eventsArgsForCall)
 }
 
-func (fake *FakeBuild) EventsCalls(stub func(uint) (db.EventSource, error)) {
+func (fake *FakeBuild) EventsCalls(stub func(context.Context, uint) (db.EventSource, error)) {
 	fake.eventsMutex.Lock()
 	defer fake.eventsMutex.Unlock()
 	fake.EventsStub = stub
 }
 
-func (fake *FakeBuild) EventsArgsForCall(i int) uint {
+func (fake *FakeBuild) EventsArgsForCall(i int) (context.Context, uint) {
 	fake.eventsMutex.RLock()
 	defer fake.eventsMutex.RUnlock()
 	argsForCall := fake.eventsArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeBuild) EventsReturns(result1 db.EventSource, result2 error) {
@@ -3330,6 +3530,62 @@ func (fake *FakeBuild) ResourceTypeNameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeBuild) ResourceUsage() (atc.BuildResourceUsage, error) {
+	fake.resourceUsageMutex.Lock()
+	ret, specificReturn := fake.resourceUsageReturnsOnCall[len(fake.resourceUsageArgsForCall)]
+	fake.resourceUsageArgsForCall = append(fake.resourceUsageArgsForCall, struct {
+	}{})
+	stub := fake.ResourceUsageStub
+	fakeReturns := fake.resourceUsageReturns
+	fake.recordInvocation("ResourceUsage", []interface{}{})
+	fake.resourceUsageMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) ResourceUsageCallCount() int {
+	fake.resourceUsageMutex.RLock()
+	defer fake.resourceUsageMutex.RUnlock()
+	return len(fake.resourceUsageArgsForCall)
+}
+
+func (fake *FakeBuild) ResourceUsageCalls(stub func() (atc.BuildResourceUsage, error)) {
+	fake.resourceUsageMutex.Lock()
+	defer fake.resourceUsageMutex.Unlock()
+	fake.ResourceUsageStub = stub
+}
+
+func (fake *FakeBuild) ResourceUsageReturns(result1 atc.BuildResourceUsage, result2 error) {
+	fake.resourceUsageMutex.Lock()
+	defer fake.resourceUsageMutex.Unlock()
+	fake.ResourceUsageStub = nil
+	fake.resourceUsageReturns = struct {
+		result1 atc.BuildResourceUsage
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) ResourceUsageReturnsOnCall(i int, result1 atc.BuildResourceUsage, result2 error) {
+	fake.resourceUsageMutex.Lock()
+	defer fake.resourceUsageMutex.Unlock()
+	fake.ResourceUsageStub = nil
+	if fake.resourceUsageReturnsOnCall == nil {
+		fake.resourceUsageReturnsOnCall = make(map[int]struct {
+			result1 atc.BuildResourceUsage
+			result2 error
+		})
+	}
+	fake.resourceUsageReturnsOnCall[i] = struct {
+		result1 atc.BuildResourceUsage
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) Resources() ([]db.BuildInput, []db.BuildOutput, error) {
 	fake.resourcesMutex.Lock()
 	ret, specificReturn := fake.resourcesReturnsOnCall[len(fake.resourcesArgsForCall)]
@@ -3445,6 +3701,71 @@ func (fake *FakeBuild) ResourcesCheckedReturnsOnCall(i int, result1 bool, result
 	}{result1, result2}
 }
 
+func (fake *FakeBuild) SaveApproval(arg1 atc.PlanID, arg2 atc.BuildApproval) (bool, error) {
+	fake.saveApprovalMutex.Lock()
+	ret, specificReturn := fake.saveApprovalReturnsOnCall[len(fake.saveApprovalArgsForCall)]
+	fake.saveApprovalArgsForCall = append(fake.saveApprovalArgsForCall, struct {
+		arg1 atc.PlanID
+		arg2 atc.BuildApproval
+	}{arg1, arg2})
+	stub := fake.SaveApprovalStub
+	fakeReturns := fake.saveApprovalReturns
+	fake.recordInvocation("SaveApproval", []interface{}{arg1, arg2})
+	fake.saveApprovalMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeBuild) SaveApprovalCallCount() int {
+	fake.saveApprovalMutex.RLock()
+	defer fake.saveApprovalMutex.RUnlock()
+	return len(fake.saveApprovalArgsForCall)
+}
+
+func (fake *FakeBuild) SaveApprovalCalls(stub func(atc.PlanID, atc.BuildApproval) (bool, error)) {
+	fake.saveApprovalMutex.Lock()
+	defer fake.saveApprovalMutex.Unlock()
+	fake.SaveApprovalStub = stub
+}
+
+func (fake *FakeBuild) SaveApprovalArgsForCall(i int) (atc.PlanID, atc.BuildApproval) {
+	fake.saveApprovalMutex.RLock()
+	defer fake.saveApprovalMutex.RUnlock()
+	argsForCall := fake.saveApprovalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuild) SaveApprovalReturns(result1 bool, result2 error) {
+	fake.saveApprovalMutex.Lock()
+	defer fake.saveApprovalMutex.Unlock()
+	fake.SaveApprovalStub = nil
+	fake.saveApprovalReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeBuild) SaveApprovalReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.saveApprovalMutex.Lock()
+	defer fake.saveApprovalMutex.Unlock()
+	fake.SaveApprovalStub = nil
+	if fake.saveApprovalReturnsOnCall == nil {
+		fake.saveApprovalReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.saveApprovalReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeBuild) SaveEvent(arg1 atc.Event) error {
 	fake.saveEventMutex.Lock()
 	ret, specificReturn := fake.saveEventReturnsOnCall[len(fake.saveEventArgsForCall)]
@@ -3705,6 +4026,68 @@ func (fake *FakeBuild) SavePipelineReturnsOnCall(i int, result1 db.Pipeline, res
 	}{result1, result2, result3}
 }
 
+func (fake *FakeBuild) SaveResourceUsage(arg1 atc.PlanID, arg2 atc.ResourceUsage) error {
+	fake.saveResourceUsageMutex.Lock()
+	ret, specificReturn := fake.saveResourceUsageReturnsOnCall[len(fake.saveResourceUsageArgsForCall)]
+	fake.saveResourceUsageArgsForCall = append(fake.saveResourceUsageArgsForCall, struct {
+		arg1 atc.PlanID
+		arg2 atc.ResourceUsage
+	}{arg1, arg2})
+	stub := fake.SaveResourceUsageStub
+	fakeReturns := fake.saveResourceUsageReturns
+	fake.recordInvocation("SaveResourceUsage", []interface{}{arg1, arg2})
+	fake.saveResourceUsageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeBuild) SaveResourceUsageCallCount() int {
+	fake.saveResourceUsageMutex.RLock()
+	defer fake.saveResourceUsageMutex.RUnlock()
+	return len(fake.saveResourceUsageArgsForCall)
+}
+
+func (fake *FakeBuild) SaveResourceUsageCalls(stub func(atc.PlanID, atc.ResourceUsage) error) {
+	fake.saveResourceUsageMutex.Lock()
+	defer fake.saveResourceUsageMutex.Unlock()
+	fake.SaveResourceUsageStub = stub
+}
+
+func (fake *FakeBuild) SaveResourceUsageArgsForCall(i int) (atc.PlanID, atc.ResourceUsage) {
+	fake.saveResourceUsageMutex.RLock()
+	defer fake.saveResourceUsageMutex.RUnlock()
+	argsForCall := fake.saveResourceUsageArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeBuild) SaveResourceUsageReturns(result1 error) {
+	fake.saveResourceUsageMutex.Lock()
+	defer fake.saveResourceUsageMutex.Unlock()
+	fake.SaveResourceUsageStub = nil
+	fake.saveResourceUsageReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeBuild) SaveResourceUsageReturnsOnCall(i int, result1 error) {
+	fake.saveResourceUsageMutex.Lock()
+	defer fake.saveResourceUsageMutex.Unlock()
+	fake.SaveResourceUsageStub = nil
+	if fake.saveResourceUsageReturnsOnCall == nil {
+		fake.saveResourceUsageReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveResourceUsageReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeBuild) Schema() string {
 	fake.schemaMutex.Lock()
 	ret, specificReturn := fake.schemaReturnsOnCall[len(fake.schemaArgsForCall)]
@@ -4400,6 +4783,10 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.adoptInputsAndPipesMutex.RUnlock()
 	fake.adoptRerunInputsAndPipesMutex.RLock()
 	defer fake.adoptRerunInputsAndPipesMutex.RUnlock()
+	fake.approvalMutex.RLock()
+	defer fake.approvalMutex.RUnlock()
+	fake.approvalNotifierMutex.RLock()
+	defer fake.approvalNotifierMutex.RUnlock()
 	fake.artifactMutex.RLock()
 	defer fake.artifactMutex.RUnlock()
 	fake.artifactsMutex.RLock()
@@ -4486,10 +4873,14 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.resourceTypeIDMutex.RUnlock()
 	fake.resourceTypeNameMutex.RLock()
 	defer fake.resourceTypeNameMutex.RUnlock()
+	fake.resourceUsageMutex.RLock()
+	defer fake.resourceUsageMutex.RUnlock()
 	fake.resourcesMutex.RLock()
 	defer fake.resourcesMutex.RUnlock()
 	fake.resourcesCheckedMutex.RLock()
 	defer fake.resourcesCheckedMutex.RUnlock()
+	fake.saveApprovalMutex.RLock()
+	defer fake.saveApprovalMutex.RUnlock()
 	fake.saveEventMutex.RLock()
 	defer fake.saveEventMutex.RUnlock()
 	fake.saveImageResourceVersionMutex.RLock()
@@ -4498,6 +4889,8 @@ func (fake *FakeBuild) Invocations() map[string][][]interface{} {
 	defer fake.saveOutputMutex.RUnlock()
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
+	fake.saveResourceUsageMutex.RLock()
+	defer fake.saveResourceUsageMutex.RUnlock()
 	fake.schemaMutex.RLock()
 	defer fake.schemaMutex.RUnlock()
 	fake.setDrainedMutex.RLock()
@@ -8,6 +8,7 @@ import (
 	"sync"
 
 	"github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc/blobstore"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/encryption"
 )
@@ -39,6 +40,16 @@ type FakeConn struct {
 		result1 db.Tx
 		result2 error
 	}
+	BuildEventStoreStub        func() blobstore.Store
+	buildEventStoreMutex       sync.RWMutex
+	buildEventStoreArgsForCall []struct {
+	}
+	buildEventStoreReturns struct {
+		result1 blobstore.Store
+	}
+	buildEventStoreReturnsOnCall map[int]struct {
+		result1 blobstore.Store
+	}
 	BusStub        func() db.NotificationsBus
 	busMutex       sync.RWMutex
 	busArgsForCall []struct {
@@ -354,6 +365,59 @@ func (fake *FakeConn) BeginTxReturnsOnCall(i int, result1 db.Tx, result2 error)
 	}{result1, result2}
 }
 
+func (fake *FakeConn) BuildEventStore() blobstore.Store {
+	fake.buildEventStoreMutex.Lock()
+	ret, specificReturn := fake.buildEventStoreReturnsOnCall[len(fake.buildEventStoreArgsForCall)]
+	fake.buildEventStoreArgsForCall = append(fake.buildEventStoreArgsForCall, struct {
+	}{})
+	stub := fake.BuildEventStoreStub
+	fakeReturns := fake.buildEventStoreReturns
+	fake.recordInvocation("BuildEventStore", []interface{}{})
+	fake.buildEventStoreMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeConn) BuildEventStoreCallCount() int {
+	fake.buildEventStoreMutex.RLock()
+	defer fake.buildEventStoreMutex.RUnlock()
+	return len(fake.buildEventStoreArgsForCall)
+}
+
+func (fake *FakeConn) BuildEventStoreCalls(stub func() blobstore.Store) {
+	fake.buildEventStoreMutex.Lock()
+	defer fake.buildEventStoreMutex.Unlock()
+	fake.BuildEventStoreStub = stub
+}
+
+func (fake *FakeConn) BuildEventStoreReturns(result1 blobstore.Store) {
+	fake.buildEventStoreMutex.Lock()
+	defer fake.buildEventStoreMutex.Unlock()
+	fake.BuildEventStoreStub = nil
+	fake.buildEventStoreReturns = struct {
+		result1 blobstore.Store
+	}{result1}
+}
+
+func (fake *FakeConn) BuildEventStoreReturnsOnCall(i int, result1 blobstore.Store) {
+	fake.buildEventStoreMutex.Lock()
+	defer fake.buildEventStoreMutex.Unlock()
+	fake.BuildEventStoreStub = nil
+	if fake.buildEventStoreReturnsOnCall == nil {
+		fake.buildEventStoreReturnsOnCall = make(map[int]struct {
+			result1 blobstore.Store
+		})
+	}
+	fake.buildEventStoreReturnsOnCall[i] = struct {
+		result1 blobstore.Store
+	}{result1}
+}
+
 func (fake *FakeConn) Bus() db.NotificationsBus {
 	fake.busMutex.Lock()
 	ret, specificReturn := fake.busReturnsOnCall[len(fake.busArgsForCall)]
@@ -1312,6 +1376,8 @@ func (fake *FakeConn) Invocations() map[string][][]interface{} {
 	defer fake.beginMutex.RUnlock()
 	fake.beginTxMutex.RLock()
 	defer fake.beginTxMutex.RUnlock()
+	fake.buildEventStoreMutex.RLock()
+	defer fake.buildEventStoreMutex.RUnlock()
 	fake.busMutex.RLock()
 	defer fake.busMutex.RUnlock()
 	fake.closeMutex.RLock()
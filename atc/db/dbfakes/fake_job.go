@@ -124,6 +124,22 @@ type FakeJob struct {
 		result1 db.Build
 		result2 error
 	}
+	CreateScheduledBuildStub        func(string, time.Time) (db.Build, bool, error)
+	createScheduledBuildMutex       sync.RWMutex
+	createScheduledBuildArgsForCall []struct {
+		arg1 string
+		arg2 time.Time
+	}
+	createScheduledBuildReturns struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}
+	createScheduledBuildReturnsOnCall map[int]struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}
 	DisableManualTriggerStub        func() bool
 	disableManualTriggerMutex       sync.RWMutex
 	disableManualTriggerArgsForCall []struct {
@@ -227,6 +243,35 @@ type FakeJob struct {
 	iDReturnsOnCall map[int]struct {
 		result1 int
 	}
+	InFlightBuildsStub        func() ([]db.Build, db.Build, bool, error)
+	inFlightBuildsMutex       sync.RWMutex
+	inFlightBuildsArgsForCall []struct {
+	}
+	inFlightBuildsReturns struct {
+		result1 []db.Build
+		result2 db.Build
+		result3 bool
+		result4 error
+	}
+	inFlightBuildsReturnsOnCall map[int]struct {
+		result1 []db.Build
+		result2 db.Build
+		result3 bool
+		result4 error
+	}
+	InputMappingVersionsStub        func(db.InputMapping) (map[string]atc.Version, error)
+	inputMappingVersionsMutex       sync.RWMutex
+	inputMappingVersionsArgsForCall []struct {
+		arg1 db.InputMapping
+	}
+	inputMappingVersionsReturns struct {
+		result1 map[string]atc.Version
+		result2 error
+	}
+	inputMappingVersionsReturnsOnCall map[int]struct {
+		result1 map[string]atc.Version
+		result2 error
+	}
 	InputsStub        func() ([]atc.JobInput, error)
 	inputsMutex       sync.RWMutex
 	inputsArgsForCall []struct {
@@ -416,6 +461,16 @@ type FakeJob struct {
 		result1 bool
 		result2 error
 	}
+	ScheduleLastFiredStub        func() time.Time
+	scheduleLastFiredMutex       sync.RWMutex
+	scheduleLastFiredArgsForCall []struct {
+	}
+	scheduleLastFiredReturns struct {
+		result1 time.Time
+	}
+	scheduleLastFiredReturnsOnCall map[int]struct {
+		result1 time.Time
+	}
 	ScheduleRequestedTimeStub        func() time.Time
 	scheduleRequestedTimeMutex       sync.RWMutex
 	scheduleRequestedTimeArgsForCall []struct {
@@ -1012,6 +1067,74 @@ func (fake *FakeJob) CreateBuildReturnsOnCall(i int, result1 db.Build, result2 e
 	}{result1, result2}
 }
 
+func (fake *FakeJob) CreateScheduledBuild(arg1 string, arg2 time.Time) (db.Build, bool, error) {
+	fake.createScheduledBuildMutex.Lock()
+	ret, specificReturn := fake.createScheduledBuildReturnsOnCall[len(fake.createScheduledBuildArgsForCall)]
+	fake.createScheduledBuildArgsForCall = append(fake.createScheduledBuildArgsForCall, struct {
+		arg1 string
+		arg2 time.Time
+	}{arg1, arg2})
+	stub := fake.CreateScheduledBuildStub
+	fakeReturns := fake.createScheduledBuildReturns
+	fake.recordInvocation("CreateScheduledBuild", []interface{}{arg1, arg2})
+	fake.createScheduledBuildMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeJob) CreateScheduledBuildCallCount() int {
+	fake.createScheduledBuildMutex.RLock()
+	defer fake.createScheduledBuildMutex.RUnlock()
+	return len(fake.createScheduledBuildArgsForCall)
+}
+
+func (fake *FakeJob) CreateScheduledBuildCalls(stub func(string, time.Time) (db.Build, bool, error)) {
+	fake.createScheduledBuildMutex.Lock()
+	defer fake.createScheduledBuildMutex.Unlock()
+	fake.CreateScheduledBuildStub = stub
+}
+
+func (fake *FakeJob) CreateScheduledBuildArgsForCall(i int) (string, time.Time) {
+	fake.createScheduledBuildMutex.RLock()
+	defer fake.createScheduledBuildMutex.RUnlock()
+	argsForCall := fake.createScheduledBuildArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeJob) CreateScheduledBuildReturns(result1 db.Build, result2 bool, result3 error) {
+	fake.createScheduledBuildMutex.Lock()
+	defer fake.createScheduledBuildMutex.Unlock()
+	fake.CreateScheduledBuildStub = nil
+	fake.createScheduledBuildReturns = struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeJob) CreateScheduledBuildReturnsOnCall(i int, result1 db.Build, result2 bool, result3 error) {
+	fake.createScheduledBuildMutex.Lock()
+	defer fake.createScheduledBuildMutex.Unlock()
+	fake.CreateScheduledBuildStub = nil
+	if fake.createScheduledBuildReturnsOnCall == nil {
+		fake.createScheduledBuildReturnsOnCall = make(map[int]struct {
+			result1 db.Build
+			result2 bool
+			result3 error
+		})
+	}
+	fake.createScheduledBuildReturnsOnCall[i] = struct {
+		result1 db.Build
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeJob) DisableManualTrigger() bool {
 	fake.disableManualTriggerMutex.Lock()
 	ret, specificReturn := fake.disableManualTriggerReturnsOnCall[len(fake.disableManualTriggerArgsForCall)]
@@ -1515,6 +1638,132 @@ func (fake *FakeJob) IDReturnsOnCall(i int, result1 int) {
 	}{result1}
 }
 
+func (fake *FakeJob) InFlightBuilds() ([]db.Build, db.Build, bool, error) {
+	fake.inFlightBuildsMutex.Lock()
+	ret, specificReturn := fake.inFlightBuildsReturnsOnCall[len(fake.inFlightBuildsArgsForCall)]
+	fake.inFlightBuildsArgsForCall = append(fake.inFlightBuildsArgsForCall, struct {
+	}{})
+	stub := fake.InFlightBuildsStub
+	fakeReturns := fake.inFlightBuildsReturns
+	fake.recordInvocation("InFlightBuilds", []interface{}{})
+	fake.inFlightBuildsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3, ret.result4
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3, fakeReturns.result4
+}
+
+func (fake *FakeJob) InFlightBuildsCallCount() int {
+	fake.inFlightBuildsMutex.RLock()
+	defer fake.inFlightBuildsMutex.RUnlock()
+	return len(fake.inFlightBuildsArgsForCall)
+}
+
+func (fake *FakeJob) InFlightBuildsCalls(stub func() ([]db.Build, db.Build, bool, error)) {
+	fake.inFlightBuildsMutex.Lock()
+	defer fake.inFlightBuildsMutex.Unlock()
+	fake.InFlightBuildsStub = stub
+}
+
+func (fake *FakeJob) InFlightBuildsReturns(result1 []db.Build, result2 db.Build, result3 bool, result4 error) {
+	fake.inFlightBuildsMutex.Lock()
+	defer fake.inFlightBuildsMutex.Unlock()
+	fake.InFlightBuildsStub = nil
+	fake.inFlightBuildsReturns = struct {
+		result1 []db.Build
+		result2 db.Build
+		result3 bool
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeJob) InFlightBuildsReturnsOnCall(i int, result1 []db.Build, result2 db.Build, result3 bool, result4 error) {
+	fake.inFlightBuildsMutex.Lock()
+	defer fake.inFlightBuildsMutex.Unlock()
+	fake.InFlightBuildsStub = nil
+	if fake.inFlightBuildsReturnsOnCall == nil {
+		fake.inFlightBuildsReturnsOnCall = make(map[int]struct {
+			result1 []db.Build
+			result2 db.Build
+			result3 bool
+			result4 error
+		})
+	}
+	fake.inFlightBuildsReturnsOnCall[i] = struct {
+		result1 []db.Build
+		result2 db.Build
+		result3 bool
+		result4 error
+	}{result1, result2, result3, result4}
+}
+
+func (fake *FakeJob) InputMappingVersions(arg1 db.InputMapping) (map[string]atc.Version, error) {
+	fake.inputMappingVersionsMutex.Lock()
+	ret, specificReturn := fake.inputMappingVersionsReturnsOnCall[len(fake.inputMappingVersionsArgsForCall)]
+	fake.inputMappingVersionsArgsForCall = append(fake.inputMappingVersionsArgsForCall, struct {
+		arg1 db.InputMapping
+	}{arg1})
+	stub := fake.InputMappingVersionsStub
+	fakeReturns := fake.inputMappingVersionsReturns
+	fake.recordInvocation("InputMappingVersions", []interface{}{arg1})
+	fake.inputMappingVersionsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeJob) InputMappingVersionsCallCount() int {
+	fake.inputMappingVersionsMutex.RLock()
+	defer fake.inputMappingVersionsMutex.RUnlock()
+	return len(fake.inputMappingVersionsArgsForCall)
+}
+
+func (fake *FakeJob) InputMappingVersionsCalls(stub func(db.InputMapping) (map[string]atc.Version, error)) {
+	fake.inputMappingVersionsMutex.Lock()
+	defer fake.inputMappingVersionsMutex.Unlock()
+	fake.InputMappingVersionsStub = stub
+}
+
+func (fake *FakeJob) InputMappingVersionsArgsForCall(i int) db.InputMapping {
+	fake.inputMappingVersionsMutex.RLock()
+	defer fake.inputMappingVersionsMutex.RUnlock()
+	argsForCall := fake.inputMappingVersionsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeJob) InputMappingVersionsReturns(result1 map[string]atc.Version, result2 error) {
+	fake.inputMappingVersionsMutex.Lock()
+	defer fake.inputMappingVersionsMutex.Unlock()
+	fake.InputMappingVersionsStub = nil
+	fake.inputMappingVersionsReturns = struct {
+		result1 map[string]atc.Version
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeJob) InputMappingVersionsReturnsOnCall(i int, result1 map[string]atc.Version, result2 error) {
+	fake.inputMappingVersionsMutex.Lock()
+	defer fake.inputMappingVersionsMutex.Unlock()
+	fake.InputMappingVersionsStub = nil
+	if fake.inputMappingVersionsReturnsOnCall == nil {
+		fake.inputMappingVersionsReturnsOnCall = make(map[int]struct {
+			result1 map[string]atc.Version
+			result2 error
+		})
+	}
+	fake.inputMappingVersionsReturnsOnCall[i] = struct {
+		result1 map[string]atc.Version
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeJob) Inputs() ([]atc.JobInput, error) {
 	fake.inputsMutex.Lock()
 	ret, specificReturn := fake.inputsReturnsOnCall[len(fake.inputsArgsForCall)]
@@ -2463,6 +2712,59 @@ func (fake *FakeJob) ScheduleBuildReturnsOnCall(i int, result1 bool, result2 err
 	}{result1, result2}
 }
 
+func (fake *FakeJob) ScheduleLastFired() time.Time {
+	fake.scheduleLastFiredMutex.Lock()
+	ret, specificReturn := fake.scheduleLastFiredReturnsOnCall[len(fake.scheduleLastFiredArgsForCall)]
+	fake.scheduleLastFiredArgsForCall = append(fake.scheduleLastFiredArgsForCall, struct {
+	}{})
+	stub := fake.ScheduleLastFiredStub
+	fakeReturns := fake.scheduleLastFiredReturns
+	fake.recordInvocation("ScheduleLastFired", []interface{}{})
+	fake.scheduleLastFiredMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeJob) ScheduleLastFiredCallCount() int {
+	fake.scheduleLastFiredMutex.RLock()
+	defer fake.scheduleLastFiredMutex.RUnlock()
+	return len(fake.scheduleLastFiredArgsForCall)
+}
+
+func (fake *FakeJob) ScheduleLastFiredCalls(stub func() time.Time) {
+	fake.scheduleLastFiredMutex.Lock()
+	defer fake.scheduleLastFiredMutex.Unlock()
+	fake.ScheduleLastFiredStub = stub
+}
+
+func (fake *FakeJob) ScheduleLastFiredReturns(result1 time.Time) {
+	fake.scheduleLastFiredMutex.Lock()
+	defer fake.scheduleLastFiredMutex.Unlock()
+	fake.ScheduleLastFiredStub = nil
+	fake.scheduleLastFiredReturns = struct {
+		result1 time.Time
+	}{result1}
+}
+
+func (fake *FakeJob) ScheduleLastFiredReturnsOnCall(i int, result1 time.Time) {
+	fake.scheduleLastFiredMutex.Lock()
+	defer fake.scheduleLastFiredMutex.Unlock()
+	fake.ScheduleLastFiredStub = nil
+	if fake.scheduleLastFiredReturnsOnCall == nil {
+		fake.scheduleLastFiredReturnsOnCall = make(map[int]struct {
+			result1 time.Time
+		})
+	}
+	fake.scheduleLastFiredReturnsOnCall[i] = struct {
+		result1 time.Time
+	}{result1}
+}
+
 func (fake *FakeJob) ScheduleRequestedTime() time.Time {
 	fake.scheduleRequestedTimeMutex.Lock()
 	ret, specificReturn := fake.scheduleRequestedTimeReturnsOnCall[len(fake.scheduleRequestedTimeArgsForCall)]
@@ -2930,6 +3232,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.configMutex.RUnlock()
 	fake.createBuildMutex.RLock()
 	defer fake.createBuildMutex.RUnlock()
+	fake.createScheduledBuildMutex.RLock()
+	defer fake.createScheduledBuildMutex.RUnlock()
 	fake.disableManualTriggerMutex.RLock()
 	defer fake.disableManualTriggerMutex.RUnlock()
 	fake.ensurePendingBuildExistsMutex.RLock()
@@ -2948,6 +3252,10 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.hasNewInputsMutex.RUnlock()
 	fake.iDMutex.RLock()
 	defer fake.iDMutex.RUnlock()
+	fake.inFlightBuildsMutex.RLock()
+	defer fake.inFlightBuildsMutex.RUnlock()
+	fake.inputMappingVersionsMutex.RLock()
+	defer fake.inputMappingVersionsMutex.RUnlock()
 	fake.inputsMutex.RLock()
 	defer fake.inputsMutex.RUnlock()
 	fake.maxInFlightMutex.RLock()
@@ -2982,6 +3290,8 @@ func (fake *FakeJob) Invocations() map[string][][]interface{} {
 	defer fake.saveNextInputMappingMutex.RUnlock()
 	fake.scheduleBuildMutex.RLock()
 	defer fake.scheduleBuildMutex.RUnlock()
+	fake.scheduleLastFiredMutex.RLock()
+	defer fake.scheduleLastFiredMutex.RUnlock()
 	fake.scheduleRequestedTimeMutex.RLock()
 	defer fake.scheduleRequestedTimeMutex.RUnlock()
 	fake.setHasNewInputsMutex.RLock()
@@ -136,6 +136,18 @@ type FakeResource struct {
 	disableVersionReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DisabledVersionsStub        func() ([]atc.Version, error)
+	disabledVersionsMutex       sync.RWMutex
+	disabledVersionsArgsForCall []struct {
+	}
+	disabledVersionsReturns struct {
+		result1 []atc.Version
+		result2 error
+	}
+	disabledVersionsReturnsOnCall map[int]struct {
+		result1 []atc.Version
+		result2 error
+	}
 	EnableVersionStub        func(int) error
 	enableVersionMutex       sync.RWMutex
 	enableVersionArgsForCall []struct {
@@ -1108,6 +1120,62 @@ func (fake *FakeResource) DisableVersionReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeResource) DisabledVersions() ([]atc.Version, error) {
+	fake.disabledVersionsMutex.Lock()
+	ret, specificReturn := fake.disabledVersionsReturnsOnCall[len(fake.disabledVersionsArgsForCall)]
+	fake.disabledVersionsArgsForCall = append(fake.disabledVersionsArgsForCall, struct {
+	}{})
+	stub := fake.DisabledVersionsStub
+	fakeReturns := fake.disabledVersionsReturns
+	fake.recordInvocation("DisabledVersions", []interface{}{})
+	fake.disabledVersionsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeResource) DisabledVersionsCallCount() int {
+	fake.disabledVersionsMutex.RLock()
+	defer fake.disabledVersionsMutex.RUnlock()
+	return len(fake.disabledVersionsArgsForCall)
+}
+
+func (fake *FakeResource) DisabledVersionsCalls(stub func() ([]atc.Version, error)) {
+	fake.disabledVersionsMutex.Lock()
+	defer fake.disabledVersionsMutex.Unlock()
+	fake.DisabledVersionsStub = stub
+}
+
+func (fake *FakeResource) DisabledVersionsReturns(result1 []atc.Version, result2 error) {
+	fake.disabledVersionsMutex.Lock()
+	defer fake.disabledVersionsMutex.Unlock()
+	fake.DisabledVersionsStub = nil
+	fake.disabledVersionsReturns = struct {
+		result1 []atc.Version
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeResource) DisabledVersionsReturnsOnCall(i int, result1 []atc.Version, result2 error) {
+	fake.disabledVersionsMutex.Lock()
+	defer fake.disabledVersionsMutex.Unlock()
+	fake.DisabledVersionsStub = nil
+	if fake.disabledVersionsReturnsOnCall == nil {
+		fake.disabledVersionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.Version
+			result2 error
+		})
+	}
+	fake.disabledVersionsReturnsOnCall[i] = struct {
+		result1 []atc.Version
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeResource) EnableVersion(arg1 int) error {
 	fake.enableVersionMutex.Lock()
 	ret, specificReturn := fake.enableVersionReturnsOnCall[len(fake.enableVersionArgsForCall)]
@@ -2864,6 +2932,8 @@ func (fake *FakeResource) Invocations() map[string][][]interface{} {
 	defer fake.currentPinnedVersionMutex.RUnlock()
 	fake.disableVersionMutex.RLock()
 	defer fake.disableVersionMutex.RUnlock()
+	fake.disabledVersionsMutex.RLock()
+	defer fake.disabledVersionsMutex.RUnlock()
 	fake.enableVersionMutex.RLock()
 	defer fake.enableVersionMutex.RUnlock()
 	fake.findVersionMutex.RLock()
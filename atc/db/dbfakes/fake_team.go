@@ -32,6 +32,18 @@ type FakeTeam struct {
 	authReturnsOnCall map[int]struct {
 		result1 atc.TeamAuth
 	}
+	BuildLifecycleEventsStub        func() (db.BuildLifecycleSource, error)
+	buildLifecycleEventsMutex       sync.RWMutex
+	buildLifecycleEventsArgsForCall []struct {
+	}
+	buildLifecycleEventsReturns struct {
+		result1 db.BuildLifecycleSource
+		result2 error
+	}
+	buildLifecycleEventsReturnsOnCall map[int]struct {
+		result1 db.BuildLifecycleSource
+		result2 error
+	}
 	BuildsStub        func(db.Page) ([]db.Build, db.Pagination, error)
 	buildsMutex       sync.RWMutex
 	buildsArgsForCall []struct {
@@ -99,6 +111,16 @@ type FakeTeam struct {
 		result1 db.Build
 		result2 error
 	}
+	CustomRolesStub        func() atc.CustomRoles
+	customRolesMutex       sync.RWMutex
+	customRolesArgsForCall []struct {
+	}
+	customRolesReturns struct {
+		result1 atc.CustomRoles
+	}
+	customRolesReturnsOnCall map[int]struct {
+		result1 atc.CustomRoles
+	}
 	DeleteStub        func() error
 	deleteMutex       sync.RWMutex
 	deleteArgsForCall []struct {
@@ -109,6 +131,32 @@ type FakeTeam struct {
 	deleteReturnsOnCall map[int]struct {
 		result1 error
 	}
+	DestroyCustomRoleStub        func(string) (bool, error)
+	destroyCustomRoleMutex       sync.RWMutex
+	destroyCustomRoleArgsForCall []struct {
+		arg1 string
+	}
+	destroyCustomRoleReturns struct {
+		result1 bool
+		result2 error
+	}
+	destroyCustomRoleReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	DestroyNotificationSubscriptionStub        func(string) (bool, error)
+	destroyNotificationSubscriptionMutex       sync.RWMutex
+	destroyNotificationSubscriptionArgsForCall []struct {
+		arg1 string
+	}
+	destroyNotificationSubscriptionReturns struct {
+		result1 bool
+		result2 error
+	}
+	destroyNotificationSubscriptionReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	FindCheckContainersStub        func(lager.Logger, atc.PipelineRef, string, creds.Secrets, creds.VarSourcePool) ([]db.Container, map[int]time.Time, error)
 	findCheckContainersMutex       sync.RWMutex
 	findCheckContainersArgsForCall []struct {
@@ -276,6 +324,34 @@ type FakeTeam struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	NotificationDeliveriesStub        func(string, int) ([]atc.NotificationDelivery, bool, error)
+	notificationDeliveriesMutex       sync.RWMutex
+	notificationDeliveriesArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	notificationDeliveriesReturns struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}
+	notificationDeliveriesReturnsOnCall map[int]struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}
+	NotificationSubscriptionsStub        func() ([]atc.NotificationSubscription, error)
+	notificationSubscriptionsMutex       sync.RWMutex
+	notificationSubscriptionsArgsForCall []struct {
+	}
+	notificationSubscriptionsReturns struct {
+		result1 []atc.NotificationSubscription
+		result2 error
+	}
+	notificationSubscriptionsReturnsOnCall map[int]struct {
+		result1 []atc.NotificationSubscription
+		result2 error
+	}
 	OrderPipelinesStub        func([]string) error
 	orderPipelinesMutex       sync.RWMutex
 	orderPipelinesArgsForCall []struct {
@@ -353,6 +429,30 @@ type FakeTeam struct {
 		result1 []db.Pipeline
 		result2 error
 	}
+	QuotaStub        func() atc.TeamQuota
+	quotaMutex       sync.RWMutex
+	quotaArgsForCall []struct {
+	}
+	quotaReturns struct {
+		result1 atc.TeamQuota
+	}
+	quotaReturnsOnCall map[int]struct {
+		result1 atc.TeamQuota
+	}
+	RedeliverNotificationStub        func(string, int) (bool, error)
+	redeliverNotificationMutex       sync.RWMutex
+	redeliverNotificationArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	redeliverNotificationReturns struct {
+		result1 bool
+		result2 error
+	}
+	redeliverNotificationReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	RenameStub        func(string) error
 	renameMutex       sync.RWMutex
 	renameArgsForCall []struct {
@@ -378,13 +478,36 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
-	SavePipelineStub        func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)
+	SaveCustomRoleStub        func(atc.CustomRole) error
+	saveCustomRoleMutex       sync.RWMutex
+	saveCustomRoleArgsForCall []struct {
+		arg1 atc.CustomRole
+	}
+	saveCustomRoleReturns struct {
+		result1 error
+	}
+	saveCustomRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SaveNotificationSubscriptionStub        func(atc.NotificationSubscription) error
+	saveNotificationSubscriptionMutex       sync.RWMutex
+	saveNotificationSubscriptionArgsForCall []struct {
+		arg1 atc.NotificationSubscription
+	}
+	saveNotificationSubscriptionReturns struct {
+		result1 error
+	}
+	saveNotificationSubscriptionReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SavePipelineStub        func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool, string) (db.Pipeline, bool, error)
 	savePipelineMutex       sync.RWMutex
 	savePipelineArgsForCall []struct {
 		arg1 atc.PipelineRef
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 bool
+		arg5 string
 	}
 	savePipelineReturns struct {
 		result1 db.Pipeline
@@ -421,6 +544,17 @@ type FakeTeam struct {
 	updateProviderAuthReturnsOnCall map[int]struct {
 		result1 error
 	}
+	UpdateQuotaStub        func(atc.TeamQuota) error
+	updateQuotaMutex       sync.RWMutex
+	updateQuotaArgsForCall []struct {
+		arg1 atc.TeamQuota
+	}
+	updateQuotaReturns struct {
+		result1 error
+	}
+	updateQuotaReturnsOnCall map[int]struct {
+		result1 error
+	}
 	WorkersStub        func() ([]db.Worker, error)
 	workersMutex       sync.RWMutex
 	workersArgsForCall []struct {
@@ -543,6 +677,62 @@ func (fake *FakeTeam) AuthReturnsOnCall(i int, result1 atc.TeamAuth) {
 	}{result1}
 }
 
+func (fake *FakeTeam) BuildLifecycleEvents() (db.BuildLifecycleSource, error) {
+	fake.buildLifecycleEventsMutex.Lock()
+	ret, specificReturn := fake.buildLifecycleEventsReturnsOnCall[len(fake.buildLifecycleEventsArgsForCall)]
+	fake.buildLifecycleEventsArgsForCall = append(fake.buildLifecycleEventsArgsForCall, struct {
+	}{})
+	stub := fake.BuildLifecycleEventsStub
+	fakeReturns := fake.buildLifecycleEventsReturns
+	fake.recordInvocation("BuildLifecycleEvents", []interface{}{})
+	fake.buildLifecycleEventsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) BuildLifecycleEventsCallCount() int {
+	fake.buildLifecycleEventsMutex.RLock()
+	defer fake.buildLifecycleEventsMutex.RUnlock()
+	return len(fake.buildLifecycleEventsArgsForCall)
+}
+
+func (fake *FakeTeam) BuildLifecycleEventsCalls(stub func() (db.BuildLifecycleSource, error)) {
+	fake.buildLifecycleEventsMutex.Lock()
+	defer fake.buildLifecycleEventsMutex.Unlock()
+	fake.BuildLifecycleEventsStub = stub
+}
+
+func (fake *FakeTeam) BuildLifecycleEventsReturns(result1 db.BuildLifecycleSource, result2 error) {
+	fake.buildLifecycleEventsMutex.Lock()
+	defer fake.buildLifecycleEventsMutex.Unlock()
+	fake.BuildLifecycleEventsStub = nil
+	fake.buildLifecycleEventsReturns = struct {
+		result1 db.BuildLifecycleSource
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) BuildLifecycleEventsReturnsOnCall(i int, result1 db.BuildLifecycleSource, result2 error) {
+	fake.buildLifecycleEventsMutex.Lock()
+	defer fake.buildLifecycleEventsMutex.Unlock()
+	fake.BuildLifecycleEventsStub = nil
+	if fake.buildLifecycleEventsReturnsOnCall == nil {
+		fake.buildLifecycleEventsReturnsOnCall = make(map[int]struct {
+			result1 db.BuildLifecycleSource
+			result2 error
+		})
+	}
+	fake.buildLifecycleEventsReturnsOnCall[i] = struct {
+		result1 db.BuildLifecycleSource
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Builds(arg1 db.Page) ([]db.Build, db.Pagination, error) {
 	fake.buildsMutex.Lock()
 	ret, specificReturn := fake.buildsReturnsOnCall[len(fake.buildsArgsForCall)]
@@ -853,6 +1043,59 @@ func (fake *FakeTeam) CreateStartedBuildReturnsOnCall(i int, result1 db.Build, r
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CustomRoles() atc.CustomRoles {
+	fake.customRolesMutex.Lock()
+	ret, specificReturn := fake.customRolesReturnsOnCall[len(fake.customRolesArgsForCall)]
+	fake.customRolesArgsForCall = append(fake.customRolesArgsForCall, struct {
+	}{})
+	stub := fake.CustomRolesStub
+	fakeReturns := fake.customRolesReturns
+	fake.recordInvocation("CustomRoles", []interface{}{})
+	fake.customRolesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) CustomRolesCallCount() int {
+	fake.customRolesMutex.RLock()
+	defer fake.customRolesMutex.RUnlock()
+	return len(fake.customRolesArgsForCall)
+}
+
+func (fake *FakeTeam) CustomRolesCalls(stub func() atc.CustomRoles) {
+	fake.customRolesMutex.Lock()
+	defer fake.customRolesMutex.Unlock()
+	fake.CustomRolesStub = stub
+}
+
+func (fake *FakeTeam) CustomRolesReturns(result1 atc.CustomRoles) {
+	fake.customRolesMutex.Lock()
+	defer fake.customRolesMutex.Unlock()
+	fake.CustomRolesStub = nil
+	fake.customRolesReturns = struct {
+		result1 atc.CustomRoles
+	}{result1}
+}
+
+func (fake *FakeTeam) CustomRolesReturnsOnCall(i int, result1 atc.CustomRoles) {
+	fake.customRolesMutex.Lock()
+	defer fake.customRolesMutex.Unlock()
+	fake.CustomRolesStub = nil
+	if fake.customRolesReturnsOnCall == nil {
+		fake.customRolesReturnsOnCall = make(map[int]struct {
+			result1 atc.CustomRoles
+		})
+	}
+	fake.customRolesReturnsOnCall[i] = struct {
+		result1 atc.CustomRoles
+	}{result1}
+}
+
 func (fake *FakeTeam) Delete() error {
 	fake.deleteMutex.Lock()
 	ret, specificReturn := fake.deleteReturnsOnCall[len(fake.deleteArgsForCall)]
@@ -906,6 +1149,134 @@ func (fake *FakeTeam) DeleteReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) DestroyCustomRole(arg1 string) (bool, error) {
+	fake.destroyCustomRoleMutex.Lock()
+	ret, specificReturn := fake.destroyCustomRoleReturnsOnCall[len(fake.destroyCustomRoleArgsForCall)]
+	fake.destroyCustomRoleArgsForCall = append(fake.destroyCustomRoleArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DestroyCustomRoleStub
+	fakeReturns := fake.destroyCustomRoleReturns
+	fake.recordInvocation("DestroyCustomRole", []interface{}{arg1})
+	fake.destroyCustomRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DestroyCustomRoleCallCount() int {
+	fake.destroyCustomRoleMutex.RLock()
+	defer fake.destroyCustomRoleMutex.RUnlock()
+	return len(fake.destroyCustomRoleArgsForCall)
+}
+
+func (fake *FakeTeam) DestroyCustomRoleCalls(stub func(string) (bool, error)) {
+	fake.destroyCustomRoleMutex.Lock()
+	defer fake.destroyCustomRoleMutex.Unlock()
+	fake.DestroyCustomRoleStub = stub
+}
+
+func (fake *FakeTeam) DestroyCustomRoleArgsForCall(i int) string {
+	fake.destroyCustomRoleMutex.RLock()
+	defer fake.destroyCustomRoleMutex.RUnlock()
+	argsForCall := fake.destroyCustomRoleArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DestroyCustomRoleReturns(result1 bool, result2 error) {
+	fake.destroyCustomRoleMutex.Lock()
+	defer fake.destroyCustomRoleMutex.Unlock()
+	fake.DestroyCustomRoleStub = nil
+	fake.destroyCustomRoleReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DestroyCustomRoleReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.destroyCustomRoleMutex.Lock()
+	defer fake.destroyCustomRoleMutex.Unlock()
+	fake.DestroyCustomRoleStub = nil
+	if fake.destroyCustomRoleReturnsOnCall == nil {
+		fake.destroyCustomRoleReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.destroyCustomRoleReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DestroyNotificationSubscription(arg1 string) (bool, error) {
+	fake.destroyNotificationSubscriptionMutex.Lock()
+	ret, specificReturn := fake.destroyNotificationSubscriptionReturnsOnCall[len(fake.destroyNotificationSubscriptionArgsForCall)]
+	fake.destroyNotificationSubscriptionArgsForCall = append(fake.destroyNotificationSubscriptionArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DestroyNotificationSubscriptionStub
+	fakeReturns := fake.destroyNotificationSubscriptionReturns
+	fake.recordInvocation("DestroyNotificationSubscription", []interface{}{arg1})
+	fake.destroyNotificationSubscriptionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DestroyNotificationSubscriptionCallCount() int {
+	fake.destroyNotificationSubscriptionMutex.RLock()
+	defer fake.destroyNotificationSubscriptionMutex.RUnlock()
+	return len(fake.destroyNotificationSubscriptionArgsForCall)
+}
+
+func (fake *FakeTeam) DestroyNotificationSubscriptionCalls(stub func(string) (bool, error)) {
+	fake.destroyNotificationSubscriptionMutex.Lock()
+	defer fake.destroyNotificationSubscriptionMutex.Unlock()
+	fake.DestroyNotificationSubscriptionStub = stub
+}
+
+func (fake *FakeTeam) DestroyNotificationSubscriptionArgsForCall(i int) string {
+	fake.destroyNotificationSubscriptionMutex.RLock()
+	defer fake.destroyNotificationSubscriptionMutex.RUnlock()
+	argsForCall := fake.destroyNotificationSubscriptionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DestroyNotificationSubscriptionReturns(result1 bool, result2 error) {
+	fake.destroyNotificationSubscriptionMutex.Lock()
+	defer fake.destroyNotificationSubscriptionMutex.Unlock()
+	fake.DestroyNotificationSubscriptionStub = nil
+	fake.destroyNotificationSubscriptionReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DestroyNotificationSubscriptionReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.destroyNotificationSubscriptionMutex.Lock()
+	defer fake.destroyNotificationSubscriptionMutex.Unlock()
+	fake.DestroyNotificationSubscriptionStub = nil
+	if fake.destroyNotificationSubscriptionReturnsOnCall == nil {
+		fake.destroyNotificationSubscriptionReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.destroyNotificationSubscriptionReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) FindCheckContainers(arg1 lager.Logger, arg2 atc.PipelineRef, arg3 string, arg4 creds.Secrets, arg5 creds.VarSourcePool) ([]db.Container, map[int]time.Time, error) {
 	fake.findCheckContainersMutex.Lock()
 	ret, specificReturn := fake.findCheckContainersReturnsOnCall[len(fake.findCheckContainersArgsForCall)]
@@ -1675,6 +2046,130 @@ func (fake *FakeTeam) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeTeam) NotificationDeliveries(arg1 string, arg2 int) ([]atc.NotificationDelivery, bool, error) {
+	fake.notificationDeliveriesMutex.Lock()
+	ret, specificReturn := fake.notificationDeliveriesReturnsOnCall[len(fake.notificationDeliveriesArgsForCall)]
+	fake.notificationDeliveriesArgsForCall = append(fake.notificationDeliveriesArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.NotificationDeliveriesStub
+	fakeReturns := fake.notificationDeliveriesReturns
+	fake.recordInvocation("NotificationDeliveries", []interface{}{arg1, arg2})
+	fake.notificationDeliveriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) NotificationDeliveriesCallCount() int {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	return len(fake.notificationDeliveriesArgsForCall)
+}
+
+func (fake *FakeTeam) NotificationDeliveriesCalls(stub func(string, int) ([]atc.NotificationDelivery, bool, error)) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = stub
+}
+
+func (fake *FakeTeam) NotificationDeliveriesArgsForCall(i int) (string, int) {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	argsForCall := fake.notificationDeliveriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) NotificationDeliveriesReturns(result1 []atc.NotificationDelivery, result2 bool, result3 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	fake.notificationDeliveriesReturns = struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) NotificationDeliveriesReturnsOnCall(i int, result1 []atc.NotificationDelivery, result2 bool, result3 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	if fake.notificationDeliveriesReturnsOnCall == nil {
+		fake.notificationDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []atc.NotificationDelivery
+			result2 bool
+			result3 error
+		})
+	}
+	fake.notificationDeliveriesReturnsOnCall[i] = struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) NotificationSubscriptions() ([]atc.NotificationSubscription, error) {
+	fake.notificationSubscriptionsMutex.Lock()
+	ret, specificReturn := fake.notificationSubscriptionsReturnsOnCall[len(fake.notificationSubscriptionsArgsForCall)]
+	fake.notificationSubscriptionsArgsForCall = append(fake.notificationSubscriptionsArgsForCall, struct {
+	}{})
+	stub := fake.NotificationSubscriptionsStub
+	fakeReturns := fake.notificationSubscriptionsReturns
+	fake.recordInvocation("NotificationSubscriptions", []interface{}{})
+	fake.notificationSubscriptionsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) NotificationSubscriptionsCallCount() int {
+	fake.notificationSubscriptionsMutex.RLock()
+	defer fake.notificationSubscriptionsMutex.RUnlock()
+	return len(fake.notificationSubscriptionsArgsForCall)
+}
+
+func (fake *FakeTeam) NotificationSubscriptionsCalls(stub func() ([]atc.NotificationSubscription, error)) {
+	fake.notificationSubscriptionsMutex.Lock()
+	defer fake.notificationSubscriptionsMutex.Unlock()
+	fake.NotificationSubscriptionsStub = stub
+}
+
+func (fake *FakeTeam) NotificationSubscriptionsReturns(result1 []atc.NotificationSubscription, result2 error) {
+	fake.notificationSubscriptionsMutex.Lock()
+	defer fake.notificationSubscriptionsMutex.Unlock()
+	fake.NotificationSubscriptionsStub = nil
+	fake.notificationSubscriptionsReturns = struct {
+		result1 []atc.NotificationSubscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) NotificationSubscriptionsReturnsOnCall(i int, result1 []atc.NotificationSubscription, result2 error) {
+	fake.notificationSubscriptionsMutex.Lock()
+	defer fake.notificationSubscriptionsMutex.Unlock()
+	fake.NotificationSubscriptionsStub = nil
+	if fake.notificationSubscriptionsReturnsOnCall == nil {
+		fake.notificationSubscriptionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.NotificationSubscription
+			result2 error
+		})
+	}
+	fake.notificationSubscriptionsReturnsOnCall[i] = struct {
+		result1 []atc.NotificationSubscription
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) OrderPipelines(arg1 []string) error {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -2054,6 +2549,124 @@ func (fake *FakeTeam) PublicPipelinesReturnsOnCall(i int, result1 []db.Pipeline,
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) Quota() atc.TeamQuota {
+	fake.quotaMutex.Lock()
+	ret, specificReturn := fake.quotaReturnsOnCall[len(fake.quotaArgsForCall)]
+	fake.quotaArgsForCall = append(fake.quotaArgsForCall, struct {
+	}{})
+	stub := fake.QuotaStub
+	fakeReturns := fake.quotaReturns
+	fake.recordInvocation("Quota", []interface{}{})
+	fake.quotaMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) QuotaCallCount() int {
+	fake.quotaMutex.RLock()
+	defer fake.quotaMutex.RUnlock()
+	return len(fake.quotaArgsForCall)
+}
+
+func (fake *FakeTeam) QuotaCalls(stub func() atc.TeamQuota) {
+	fake.quotaMutex.Lock()
+	defer fake.quotaMutex.Unlock()
+	fake.QuotaStub = stub
+}
+
+func (fake *FakeTeam) QuotaReturns(result1 atc.TeamQuota) {
+	fake.quotaMutex.Lock()
+	defer fake.quotaMutex.Unlock()
+	fake.QuotaStub = nil
+	fake.quotaReturns = struct {
+		result1 atc.TeamQuota
+	}{result1}
+}
+
+func (fake *FakeTeam) QuotaReturnsOnCall(i int, result1 atc.TeamQuota) {
+	fake.quotaMutex.Lock()
+	defer fake.quotaMutex.Unlock()
+	fake.QuotaStub = nil
+	if fake.quotaReturnsOnCall == nil {
+		fake.quotaReturnsOnCall = make(map[int]struct {
+			result1 atc.TeamQuota
+		})
+	}
+	fake.quotaReturnsOnCall[i] = struct {
+		result1 atc.TeamQuota
+	}{result1}
+}
+
+func (fake *FakeTeam) RedeliverNotification(arg1 string, arg2 int) (bool, error) {
+	fake.redeliverNotificationMutex.Lock()
+	ret, specificReturn := fake.redeliverNotificationReturnsOnCall[len(fake.redeliverNotificationArgsForCall)]
+	fake.redeliverNotificationArgsForCall = append(fake.redeliverNotificationArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.RedeliverNotificationStub
+	fakeReturns := fake.redeliverNotificationReturns
+	fake.recordInvocation("RedeliverNotification", []interface{}{arg1, arg2})
+	fake.redeliverNotificationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) RedeliverNotificationCallCount() int {
+	fake.redeliverNotificationMutex.RLock()
+	defer fake.redeliverNotificationMutex.RUnlock()
+	return len(fake.redeliverNotificationArgsForCall)
+}
+
+func (fake *FakeTeam) RedeliverNotificationCalls(stub func(string, int) (bool, error)) {
+	fake.redeliverNotificationMutex.Lock()
+	defer fake.redeliverNotificationMutex.Unlock()
+	fake.RedeliverNotificationStub = stub
+}
+
+func (fake *FakeTeam) RedeliverNotificationArgsForCall(i int) (string, int) {
+	fake.redeliverNotificationMutex.RLock()
+	defer fake.redeliverNotificationMutex.RUnlock()
+	argsForCall := fake.redeliverNotificationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) RedeliverNotificationReturns(result1 bool, result2 error) {
+	fake.redeliverNotificationMutex.Lock()
+	defer fake.redeliverNotificationMutex.Unlock()
+	fake.RedeliverNotificationStub = nil
+	fake.redeliverNotificationReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RedeliverNotificationReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.redeliverNotificationMutex.Lock()
+	defer fake.redeliverNotificationMutex.Unlock()
+	fake.RedeliverNotificationStub = nil
+	if fake.redeliverNotificationReturnsOnCall == nil {
+		fake.redeliverNotificationReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.redeliverNotificationReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Rename(arg1 string) error {
 	fake.renameMutex.Lock()
 	ret, specificReturn := fake.renameReturnsOnCall[len(fake.renameArgsForCall)]
@@ -2180,7 +2793,129 @@ func (fake *FakeTeam) RenamePipelineReturnsOnCall(i int, result1 bool, result2 e
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) SavePipeline(arg1 atc.PipelineRef, arg2 atc.Config, arg3 db.ConfigVersion, arg4 bool) (db.Pipeline, bool, error) {
+func (fake *FakeTeam) SaveCustomRole(arg1 atc.CustomRole) error {
+	fake.saveCustomRoleMutex.Lock()
+	ret, specificReturn := fake.saveCustomRoleReturnsOnCall[len(fake.saveCustomRoleArgsForCall)]
+	fake.saveCustomRoleArgsForCall = append(fake.saveCustomRoleArgsForCall, struct {
+		arg1 atc.CustomRole
+	}{arg1})
+	stub := fake.SaveCustomRoleStub
+	fakeReturns := fake.saveCustomRoleReturns
+	fake.recordInvocation("SaveCustomRole", []interface{}{arg1})
+	fake.saveCustomRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SaveCustomRoleCallCount() int {
+	fake.saveCustomRoleMutex.RLock()
+	defer fake.saveCustomRoleMutex.RUnlock()
+	return len(fake.saveCustomRoleArgsForCall)
+}
+
+func (fake *FakeTeam) SaveCustomRoleCalls(stub func(atc.CustomRole) error) {
+	fake.saveCustomRoleMutex.Lock()
+	defer fake.saveCustomRoleMutex.Unlock()
+	fake.SaveCustomRoleStub = stub
+}
+
+func (fake *FakeTeam) SaveCustomRoleArgsForCall(i int) atc.CustomRole {
+	fake.saveCustomRoleMutex.RLock()
+	defer fake.saveCustomRoleMutex.RUnlock()
+	argsForCall := fake.saveCustomRoleArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SaveCustomRoleReturns(result1 error) {
+	fake.saveCustomRoleMutex.Lock()
+	defer fake.saveCustomRoleMutex.Unlock()
+	fake.SaveCustomRoleStub = nil
+	fake.saveCustomRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SaveCustomRoleReturnsOnCall(i int, result1 error) {
+	fake.saveCustomRoleMutex.Lock()
+	defer fake.saveCustomRoleMutex.Unlock()
+	fake.SaveCustomRoleStub = nil
+	if fake.saveCustomRoleReturnsOnCall == nil {
+		fake.saveCustomRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveCustomRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SaveNotificationSubscription(arg1 atc.NotificationSubscription) error {
+	fake.saveNotificationSubscriptionMutex.Lock()
+	ret, specificReturn := fake.saveNotificationSubscriptionReturnsOnCall[len(fake.saveNotificationSubscriptionArgsForCall)]
+	fake.saveNotificationSubscriptionArgsForCall = append(fake.saveNotificationSubscriptionArgsForCall, struct {
+		arg1 atc.NotificationSubscription
+	}{arg1})
+	stub := fake.SaveNotificationSubscriptionStub
+	fakeReturns := fake.saveNotificationSubscriptionReturns
+	fake.recordInvocation("SaveNotificationSubscription", []interface{}{arg1})
+	fake.saveNotificationSubscriptionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SaveNotificationSubscriptionCallCount() int {
+	fake.saveNotificationSubscriptionMutex.RLock()
+	defer fake.saveNotificationSubscriptionMutex.RUnlock()
+	return len(fake.saveNotificationSubscriptionArgsForCall)
+}
+
+func (fake *FakeTeam) SaveNotificationSubscriptionCalls(stub func(atc.NotificationSubscription) error) {
+	fake.saveNotificationSubscriptionMutex.Lock()
+	defer fake.saveNotificationSubscriptionMutex.Unlock()
+	fake.SaveNotificationSubscriptionStub = stub
+}
+
+func (fake *FakeTeam) SaveNotificationSubscriptionArgsForCall(i int) atc.NotificationSubscription {
+	fake.saveNotificationSubscriptionMutex.RLock()
+	defer fake.saveNotificationSubscriptionMutex.RUnlock()
+	argsForCall := fake.saveNotificationSubscriptionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SaveNotificationSubscriptionReturns(result1 error) {
+	fake.saveNotificationSubscriptionMutex.Lock()
+	defer fake.saveNotificationSubscriptionMutex.Unlock()
+	fake.SaveNotificationSubscriptionStub = nil
+	fake.saveNotificationSubscriptionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SaveNotificationSubscriptionReturnsOnCall(i int, result1 error) {
+	fake.saveNotificationSubscriptionMutex.Lock()
+	defer fake.saveNotificationSubscriptionMutex.Unlock()
+	fake.SaveNotificationSubscriptionStub = nil
+	if fake.saveNotificationSubscriptionReturnsOnCall == nil {
+		fake.saveNotificationSubscriptionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.saveNotificationSubscriptionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SavePipeline(arg1 atc.PipelineRef, arg2 atc.Config, arg3 db.ConfigVersion, arg4 bool, arg5 string) (db.Pipeline, bool, error) {
 	fake.savePipelineMutex.Lock()
 	ret, specificReturn := fake.savePipelineReturnsOnCall[len(fake.savePipelineArgsForCall)]
 	fake.savePipelineArgsForCall = append(fake.savePipelineArgsForCall, struct {
@@ -2188,13 +2923,14 @@ func (fake *FakeTeam) SavePipeline(arg1 atc.PipelineRef, arg2 atc.Config, arg3 d
 		arg2 atc.Config
 		arg3 db.ConfigVersion
 		arg4 bool
-	}{arg1, arg2, arg3, arg4})
+		arg5 string
+	}{arg1, arg2, arg3, arg4, arg5})
 	stub := fake.SavePipelineStub
 	fakeReturns := fake.savePipelineReturns
-	fake.recordInvocation("SavePipeline", []interface{}{arg1, arg2, arg3, arg4})
+	fake.recordInvocation("SavePipeline", []interface{}{arg1, arg2, arg3, arg4, arg5})
 	fake.savePipelineMutex.Unlock()
 	if stub != nil {
-		return stub(arg1, arg2, arg3, arg4)
+		return stub(arg1, arg2, arg3, arg4, arg5)
 	}
 	if specificReturn {
 		return ret.result1, ret.result2, ret.result3
@@ -2208,17 +2944,17 @@ func (fake *FakeTeam) SavePipelineCallCount() int {
 	return len(fake.savePipelineArgsForCall)
 }
 
-func (fake *FakeTeam) SavePipelineCalls(stub func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool) (db.Pipeline, bool, error)) {
+func (fake *FakeTeam) SavePipelineCalls(stub func(atc.PipelineRef, atc.Config, db.ConfigVersion, bool, string) (db.Pipeline, bool, error)) {
 	fake.savePipelineMutex.Lock()
 	defer fake.savePipelineMutex.Unlock()
 	fake.SavePipelineStub = stub
 }
 
-func (fake *FakeTeam) SavePipelineArgsForCall(i int) (atc.PipelineRef, atc.Config, db.ConfigVersion, bool) {
+func (fake *FakeTeam) SavePipelineArgsForCall(i int) (atc.PipelineRef, atc.Config, db.ConfigVersion, bool, string) {
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	argsForCall := fake.savePipelineArgsForCall[i]
-	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4, argsForCall.arg5
 }
 
 func (fake *FakeTeam) SavePipelineReturns(result1 db.Pipeline, result2 bool, result3 error) {
@@ -2376,6 +3112,67 @@ func (fake *FakeTeam) UpdateProviderAuthReturnsOnCall(i int, result1 error) {
 	}{result1}
 }
 
+func (fake *FakeTeam) UpdateQuota(arg1 atc.TeamQuota) error {
+	fake.updateQuotaMutex.Lock()
+	ret, specificReturn := fake.updateQuotaReturnsOnCall[len(fake.updateQuotaArgsForCall)]
+	fake.updateQuotaArgsForCall = append(fake.updateQuotaArgsForCall, struct {
+		arg1 atc.TeamQuota
+	}{arg1})
+	stub := fake.UpdateQuotaStub
+	fakeReturns := fake.updateQuotaReturns
+	fake.recordInvocation("UpdateQuota", []interface{}{arg1})
+	fake.updateQuotaMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) UpdateQuotaCallCount() int {
+	fake.updateQuotaMutex.RLock()
+	defer fake.updateQuotaMutex.RUnlock()
+	return len(fake.updateQuotaArgsForCall)
+}
+
+func (fake *FakeTeam) UpdateQuotaCalls(stub func(atc.TeamQuota) error) {
+	fake.updateQuotaMutex.Lock()
+	defer fake.updateQuotaMutex.Unlock()
+	fake.UpdateQuotaStub = stub
+}
+
+func (fake *FakeTeam) UpdateQuotaArgsForCall(i int) atc.TeamQuota {
+	fake.updateQuotaMutex.RLock()
+	defer fake.updateQuotaMutex.RUnlock()
+	argsForCall := fake.updateQuotaArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) UpdateQuotaReturns(result1 error) {
+	fake.updateQuotaMutex.Lock()
+	defer fake.updateQuotaMutex.Unlock()
+	fake.UpdateQuotaStub = nil
+	fake.updateQuotaReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) UpdateQuotaReturnsOnCall(i int, result1 error) {
+	fake.updateQuotaMutex.Lock()
+	defer fake.updateQuotaMutex.Unlock()
+	fake.UpdateQuotaStub = nil
+	if fake.updateQuotaReturnsOnCall == nil {
+		fake.updateQuotaReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.updateQuotaReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) Workers() ([]db.Worker, error) {
 	fake.workersMutex.Lock()
 	ret, specificReturn := fake.workersReturnsOnCall[len(fake.workersArgsForCall)]
@@ -2439,6 +3236,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.adminMutex.RUnlock()
 	fake.authMutex.RLock()
 	defer fake.authMutex.RUnlock()
+	fake.buildLifecycleEventsMutex.RLock()
+	defer fake.buildLifecycleEventsMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
 	fake.buildsWithTimeMutex.RLock()
@@ -2449,8 +3248,14 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.createOneOffBuildMutex.RUnlock()
 	fake.createStartedBuildMutex.RLock()
 	defer fake.createStartedBuildMutex.RUnlock()
+	fake.customRolesMutex.RLock()
+	defer fake.customRolesMutex.RUnlock()
 	fake.deleteMutex.RLock()
 	defer fake.deleteMutex.RUnlock()
+	fake.destroyCustomRoleMutex.RLock()
+	defer fake.destroyCustomRoleMutex.RUnlock()
+	fake.destroyNotificationSubscriptionMutex.RLock()
+	defer fake.destroyNotificationSubscriptionMutex.RUnlock()
 	fake.findCheckContainersMutex.RLock()
 	defer fake.findCheckContainersMutex.RUnlock()
 	fake.findContainerByHandleMutex.RLock()
@@ -2475,6 +3280,10 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.isContainerWithinTeamMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	fake.notificationSubscriptionsMutex.RLock()
+	defer fake.notificationSubscriptionsMutex.RUnlock()
 	fake.orderPipelinesMutex.RLock()
 	defer fake.orderPipelinesMutex.RUnlock()
 	fake.orderPipelinesWithinGroupMutex.RLock()
@@ -2487,16 +3296,26 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.privateAndPublicBuildsMutex.RUnlock()
 	fake.publicPipelinesMutex.RLock()
 	defer fake.publicPipelinesMutex.RUnlock()
+	fake.quotaMutex.RLock()
+	defer fake.quotaMutex.RUnlock()
+	fake.redeliverNotificationMutex.RLock()
+	defer fake.redeliverNotificationMutex.RUnlock()
 	fake.renameMutex.RLock()
 	defer fake.renameMutex.RUnlock()
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
+	fake.saveCustomRoleMutex.RLock()
+	defer fake.saveCustomRoleMutex.RUnlock()
+	fake.saveNotificationSubscriptionMutex.RLock()
+	defer fake.saveNotificationSubscriptionMutex.RUnlock()
 	fake.savePipelineMutex.RLock()
 	defer fake.savePipelineMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
 	fake.updateProviderAuthMutex.RLock()
 	defer fake.updateProviderAuthMutex.RUnlock()
+	fake.updateQuotaMutex.RLock()
+	defer fake.updateQuotaMutex.RUnlock()
 	fake.workersMutex.RLock()
 	defer fake.workersMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
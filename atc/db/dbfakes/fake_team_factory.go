@@ -92,6 +92,20 @@ type FakeTeamFactory struct {
 	notifyResourceScannerReturnsOnCall map[int]struct {
 		result1 error
 	}
+	SetTeamsStub        func(atc.Teams, bool) (db.TeamChanges, error)
+	setTeamsMutex       sync.RWMutex
+	setTeamsArgsForCall []struct {
+		arg1 atc.Teams
+		arg2 bool
+	}
+	setTeamsReturns struct {
+		result1 db.TeamChanges
+		result2 error
+	}
+	setTeamsReturnsOnCall map[int]struct {
+		result1 db.TeamChanges
+		result2 error
+	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
 }
@@ -506,6 +520,71 @@ func (fake *FakeTeamFactory) NotifyResourceScannerReturnsOnCall(i int, result1 e
 	}{result1}
 }
 
+func (fake *FakeTeamFactory) SetTeams(arg1 atc.Teams, arg2 bool) (db.TeamChanges, error) {
+	fake.setTeamsMutex.Lock()
+	ret, specificReturn := fake.setTeamsReturnsOnCall[len(fake.setTeamsArgsForCall)]
+	fake.setTeamsArgsForCall = append(fake.setTeamsArgsForCall, struct {
+		arg1 atc.Teams
+		arg2 bool
+	}{arg1, arg2})
+	stub := fake.SetTeamsStub
+	fakeReturns := fake.setTeamsReturns
+	fake.recordInvocation("SetTeams", []interface{}{arg1, arg2})
+	fake.setTeamsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeamFactory) SetTeamsCallCount() int {
+	fake.setTeamsMutex.RLock()
+	defer fake.setTeamsMutex.RUnlock()
+	return len(fake.setTeamsArgsForCall)
+}
+
+func (fake *FakeTeamFactory) SetTeamsCalls(stub func(atc.Teams, bool) (db.TeamChanges, error)) {
+	fake.setTeamsMutex.Lock()
+	defer fake.setTeamsMutex.Unlock()
+	fake.SetTeamsStub = stub
+}
+
+func (fake *FakeTeamFactory) SetTeamsArgsForCall(i int) (atc.Teams, bool) {
+	fake.setTeamsMutex.RLock()
+	defer fake.setTeamsMutex.RUnlock()
+	argsForCall := fake.setTeamsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeamFactory) SetTeamsReturns(result1 db.TeamChanges, result2 error) {
+	fake.setTeamsMutex.Lock()
+	defer fake.setTeamsMutex.Unlock()
+	fake.SetTeamsStub = nil
+	fake.setTeamsReturns = struct {
+		result1 db.TeamChanges
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeamFactory) SetTeamsReturnsOnCall(i int, result1 db.TeamChanges, result2 error) {
+	fake.setTeamsMutex.Lock()
+	defer fake.setTeamsMutex.Unlock()
+	fake.SetTeamsStub = nil
+	if fake.setTeamsReturnsOnCall == nil {
+		fake.setTeamsReturnsOnCall = make(map[int]struct {
+			result1 db.TeamChanges
+			result2 error
+		})
+	}
+	fake.setTeamsReturnsOnCall[i] = struct {
+		result1 db.TeamChanges
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeamFactory) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
@@ -523,6 +602,8 @@ func (fake *FakeTeamFactory) Invocations() map[string][][]interface{} {
 	defer fake.notifyCacherMutex.RUnlock()
 	fake.notifyResourceScannerMutex.RLock()
 	defer fake.notifyResourceScannerMutex.RUnlock()
+	fake.setTeamsMutex.RLock()
+	defer fake.setTeamsMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
 	for key, value := range fake.invocations {
 		copiedInvocations[key] = value
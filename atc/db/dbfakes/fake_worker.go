@@ -261,6 +261,16 @@ type FakeWorker struct {
 	resourceTypesReturnsOnCall map[int]struct {
 		result1 []atc.WorkerResourceType
 	}
+	ResourceUsageStub        func() *atc.WorkerResourceUsage
+	resourceUsageMutex       sync.RWMutex
+	resourceUsageArgsForCall []struct {
+	}
+	resourceUsageReturns struct {
+		result1 *atc.WorkerResourceUsage
+	}
+	resourceUsageReturnsOnCall map[int]struct {
+		result1 *atc.WorkerResourceUsage
+	}
 	RetireStub        func() error
 	retireMutex       sync.RWMutex
 	retireArgsForCall []struct {
@@ -1598,6 +1608,59 @@ func (fake *FakeWorker) ResourceTypesReturnsOnCall(i int, result1 []atc.WorkerRe
 	}{result1}
 }
 
+func (fake *FakeWorker) ResourceUsage() *atc.WorkerResourceUsage {
+	fake.resourceUsageMutex.Lock()
+	ret, specificReturn := fake.resourceUsageReturnsOnCall[len(fake.resourceUsageArgsForCall)]
+	fake.resourceUsageArgsForCall = append(fake.resourceUsageArgsForCall, struct {
+	}{})
+	stub := fake.ResourceUsageStub
+	fakeReturns := fake.resourceUsageReturns
+	fake.recordInvocation("ResourceUsage", []interface{}{})
+	fake.resourceUsageMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeWorker) ResourceUsageCallCount() int {
+	fake.resourceUsageMutex.RLock()
+	defer fake.resourceUsageMutex.RUnlock()
+	return len(fake.resourceUsageArgsForCall)
+}
+
+func (fake *FakeWorker) ResourceUsageCalls(stub func() *atc.WorkerResourceUsage) {
+	fake.resourceUsageMutex.Lock()
+	defer fake.resourceUsageMutex.Unlock()
+	fake.ResourceUsageStub = stub
+}
+
+func (fake *FakeWorker) ResourceUsageReturns(result1 *atc.WorkerResourceUsage) {
+	fake.resourceUsageMutex.Lock()
+	defer fake.resourceUsageMutex.Unlock()
+	fake.ResourceUsageStub = nil
+	fake.resourceUsageReturns = struct {
+		result1 *atc.WorkerResourceUsage
+	}{result1}
+}
+
+func (fake *FakeWorker) ResourceUsageReturnsOnCall(i int, result1 *atc.WorkerResourceUsage) {
+	fake.resourceUsageMutex.Lock()
+	defer fake.resourceUsageMutex.Unlock()
+	fake.ResourceUsageStub = nil
+	if fake.resourceUsageReturnsOnCall == nil {
+		fake.resourceUsageReturnsOnCall = make(map[int]struct {
+			result1 *atc.WorkerResourceUsage
+		})
+	}
+	fake.resourceUsageReturnsOnCall[i] = struct {
+		result1 *atc.WorkerResourceUsage
+	}{result1}
+}
+
 func (fake *FakeWorker) Retire() error {
 	fake.retireMutex.Lock()
 	ret, specificReturn := fake.retireReturnsOnCall[len(fake.retireArgsForCall)]
@@ -2018,6 +2081,8 @@ func (fake *FakeWorker) Invocations() map[string][][]interface{} {
 	defer fake.resourceCertsMutex.RUnlock()
 	fake.resourceTypesMutex.RLock()
 	defer fake.resourceTypesMutex.RUnlock()
+	fake.resourceUsageMutex.RLock()
+	defer fake.resourceUsageMutex.RUnlock()
 	fake.retireMutex.RLock()
 	defer fake.retireMutex.RUnlock()
 	fake.startTimeMutex.RLock()
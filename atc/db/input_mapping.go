@@ -12,6 +12,7 @@ const (
 	LatestVersionNotFound ResolutionFailure = "latest version of resource not found"
 	VersionNotFound       ResolutionFailure = "version of resource not found"
 	NoSatisfiableBuilds   ResolutionFailure = "no satisfiable builds from passed jobs found for set of inputs"
+	PassedJobNotFound     ResolutionFailure = "passed job no longer exists"
 )
 
 type PinnedVersionNotFound struct {
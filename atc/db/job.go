@@ -28,6 +28,34 @@ type InputConfig struct {
 	PinnedVersion   atc.Version
 	ResourceID      int
 	JobID           int
+
+	// ExternalPassed holds the passed jobs which belong to other pipelines,
+	// keyed by job ID.
+	ExternalPassed map[int]ExternalPassedJob
+
+	// MissingPassed holds the names of passed jobs which have been deleted
+	// since the config was saved, e.g. along with their pipeline. The input
+	// cannot be satisfied while any remain.
+	MissingPassed []string
+}
+
+// ExternalPassedJob is a passed job in another pipeline. Its builds record
+// their versions against the resources of its own pipeline, so ResourceID is
+// the resource it uses with the same config as the input's resource, or 0 if
+// there is none.
+type ExternalPassedJob struct {
+	Name       string
+	ResourceID int
+}
+
+// PassedResourceID returns the ID of the resource which the passed job's
+// builds record the input's versions against.
+func (cfg InputConfig) PassedResourceID(passedJobID int) int {
+	if external, found := cfg.ExternalPassed[passedJobID]; found {
+		return external.ResourceID
+	}
+
+	return cfg.ResourceID
 }
 
 func (cfgs InputConfigs) String() string {
@@ -64,6 +92,7 @@ type Job interface {
 	Tags() []string
 	Public() bool
 	ScheduleRequestedTime() time.Time
+	ScheduleLastFired() time.Time
 	MaxInFlight() int
 	DisableManualTrigger() bool
 
@@ -79,6 +108,7 @@ type Job interface {
 
 	ScheduleBuild(Build) (bool, error)
 	CreateBuild(createdBy string) (Build, error)
+	CreateScheduledBuild(createdBy string, lastFired time.Time) (Build, bool, error)
 	RerunBuild(build Build, createdBy string) (Build, error)
 
 	RequestSchedule() error
@@ -95,6 +125,9 @@ type Job interface {
 	GetNextBuildInputs() ([]BuildInput, error)
 	GetFullNextBuildInputs() ([]BuildInput, bool, error)
 	SaveNextInputMapping(inputMapping InputMapping, inputsDetermined bool) error
+	InputMappingVersions(inputMapping InputMapping) (map[string]atc.Version, error)
+
+	InFlightBuilds() ([]Build, Build, bool, error)
 
 	ClearTaskCache(string, string) (int64, error)
 
@@ -104,7 +137,7 @@ type Job interface {
 	HasNewInputs() bool
 }
 
-var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.public", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.instance_vars", "p.team_id", "t.name", "j.nonce", "j.tags", "j.has_new_inputs", "j.schedule_requested", "j.max_in_flight", "j.disable_manual_trigger").
+var jobsQuery = psql.Select("j.id", "j.name", "j.config", "j.paused", "j.public", "j.first_logged_build_id", "j.pipeline_id", "p.name", "p.instance_vars", "p.team_id", "t.name", "j.nonce", "j.tags", "j.has_new_inputs", "j.schedule_requested", "j.schedule_last_fired", "j.max_in_flight", "j.disable_manual_trigger").
 	From("jobs j, pipelines p").
 	LeftJoin("teams t ON p.team_id = t.id").
 	Where(sq.Expr("j.pipeline_id = p.id"))
@@ -132,6 +165,7 @@ type job struct {
 	tags                  []string
 	hasNewInputs          bool
 	scheduleRequestedTime time.Time
+	scheduleLastFired     time.Time
 	maxInFlight           int
 	disableManualTrigger  bool
 
@@ -193,6 +227,7 @@ func (j *job) TeamName() string                 { return j.teamName }
 func (j *job) Tags() []string                   { return j.tags }
 func (j *job) HasNewInputs() bool               { return j.hasNewInputs }
 func (j *job) ScheduleRequestedTime() time.Time { return j.scheduleRequestedTime }
+func (j *job) ScheduleLastFired() time.Time     { return j.scheduleLastFired }
 func (j *job) MaxInFlight() int                 { return j.maxInFlight }
 func (j *job) DisableManualTrigger() bool       { return j.disableManualTrigger }
 
@@ -223,7 +258,7 @@ func (j *job) Config() (atc.JobConfig, error) {
 }
 
 func (j *job) AlgorithmInputs() (InputConfigs, error) {
-	rows, err := psql.Select("ji.name", "ji.resource_id", "array_agg(ji.passed_job_id)", "ji.version", "rp.version", "ji.trigger").
+	rows, err := psql.Select("ji.name", "ji.resource_id", "array_agg(ji.passed_job_id)", missingPassedJobNames("ji"), "ji.version", "rp.version", "ji.trigger").
 		From("job_inputs ji").
 		LeftJoin("resource_pins rp ON rp.resource_id = ji.resource_id").
 		Where(sq.Eq{
@@ -239,12 +274,13 @@ func (j *job) AlgorithmInputs() (InputConfigs, error) {
 	var inputs InputConfigs
 	for rows.Next() {
 		var passedJobs []sql.NullInt64
+		var missingPassed []string
 		var configVersionString, pinnedVersionString sql.NullString
 		var inputName string
 		var resourceID int
 		var trigger bool
 
-		err = rows.Scan(&inputName, &resourceID, pq.Array(&passedJobs), &configVersionString, &pinnedVersionString, &trigger)
+		err = rows.Scan(&inputName, &resourceID, pq.Array(&passedJobs), pq.Array(&missingPassed), &configVersionString, &pinnedVersionString, &trigger)
 		if err != nil {
 			return nil, err
 		}
@@ -289,17 +325,121 @@ func (j *job) AlgorithmInputs() (InputConfigs, error) {
 			inputConfig.Passed = passed
 		}
 
+		if len(missingPassed) > 0 {
+			inputConfig.MissingPassed = missingPassed
+		}
+
 		inputs = append(inputs, inputConfig)
 	}
 
+	externalPassed, err := j.externalPassedJobs()
+	if err != nil {
+		return nil, err
+	}
+
+	for i, input := range inputs {
+		inputs[i].ExternalPassed = externalPassed[input.Name]
+	}
+
 	return inputs, nil
 }
 
+// externalPassedJobs returns the passed jobs of the job's inputs which belong
+// to other pipelines, keyed by input name and then job ID. Versions are shared
+// across pipelines through resource configs, so the passed job's resource is
+// the one in its pipeline with the same config as the input's resource.
+func (j *job) externalPassedJobs() (map[string]map[int]ExternalPassedJob, error) {
+	rows, err := psql.Select("ji.name", "jp.id", "jp.name", "pp.name", "pp.instance_vars").
+		Column(`(
+			SELECT min(pr.id)
+			FROM resources pr
+			WHERE pr.pipeline_id = jp.pipeline_id
+			AND pr.resource_config_id = r.resource_config_id
+			AND (
+				EXISTS (SELECT 1 FROM job_inputs pji WHERE pji.job_id = jp.id AND pji.resource_id = pr.id)
+				OR EXISTS (SELECT 1 FROM job_outputs pjo WHERE pjo.job_id = jp.id AND pjo.resource_id = pr.id)
+			)
+		)`).
+		From("job_inputs ji").
+		Join("resources r ON r.id = ji.resource_id").
+		Join("jobs jp ON jp.id = ji.passed_job_id").
+		Join("pipelines pp ON pp.id = jp.pipeline_id").
+		Where(sq.Eq{
+			"ji.job_id": j.id,
+		}).
+		Where(sq.NotEq{
+			"jp.pipeline_id": j.pipelineID,
+		}).
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	externalPassed := map[string]map[int]ExternalPassedJob{}
+	for rows.Next() {
+		var inputName, jobName, pipelineName string
+		var jobID int
+		var instanceVars sql.NullString
+		var resourceID sql.NullInt64
+
+		err = rows.Scan(&inputName, &jobID, &jobName, &pipelineName, &instanceVars, &resourceID)
+		if err != nil {
+			return nil, err
+		}
+
+		name, err := passedJobName(jobName, sql.NullString{Valid: true, String: pipelineName}, instanceVars)
+		if err != nil {
+			return nil, err
+		}
+
+		if externalPassed[inputName] == nil {
+			externalPassed[inputName] = map[int]ExternalPassedJob{}
+		}
+
+		externalPassed[inputName][jobID] = ExternalPassedJob{
+			Name:       name,
+			ResourceID: int(resourceID.Int64),
+		}
+	}
+
+	return externalPassed, nil
+}
+
+// missingPassedJobNames aggregates the names of an input's passed jobs which
+// no longer exist. Their rows are kept with a NULL passed_job_id when the job
+// is deleted so that the constraint is not silently dropped.
+func missingPassedJobNames(jobInputs string) string {
+	return fmt.Sprintf("array_remove(array_agg(CASE WHEN %[1]s.passed_job_id IS NULL THEN %[1]s.passed_job_name END), NULL)", jobInputs)
+}
+
+// passedJobName returns the name of a passed job as it is written in a
+// config, prefixed with its pipeline when it belongs to another pipeline.
+func passedJobName(jobName string, pipelineName sql.NullString, instanceVars sql.NullString) (string, error) {
+	passedJob := atc.PassedJob{Job: jobName}
+
+	if pipelineName.Valid {
+		passedJob.Pipeline.Name = pipelineName.String
+
+		if instanceVars.Valid {
+			err := json.Unmarshal([]byte(instanceVars.String), &passedJob.Pipeline.InstanceVars)
+			if err != nil {
+				return "", err
+			}
+		}
+	}
+
+	return passedJob.String(), nil
+}
+
 func (j *job) Inputs() ([]atc.JobInput, error) {
-	rows, err := psql.Select("ji.name", "r.name", "array_agg(p.name ORDER BY p.id)", "ji.trigger", "ji.version").
+	rows, err := psql.Select("ji.name", "r.name", "array_agg(p.name ORDER BY p.id)", "array_agg(pp.name ORDER BY p.id)", "array_agg(pp.instance_vars ORDER BY p.id)", missingPassedJobNames("ji"), "ji.trigger", "ji.version").
 		From("job_inputs ji").
 		Join("resources r ON r.id = ji.resource_id").
 		LeftJoin("jobs p ON p.id = ji.passed_job_id").
+		LeftJoin("pipelines pp ON pp.id = p.pipeline_id AND pp.id <> ?", j.pipelineID).
 		Where(sq.Eq{
 			"ji.job_id": j.id,
 		}).
@@ -312,12 +452,13 @@ func (j *job) Inputs() ([]atc.JobInput, error) {
 
 	var inputs []atc.JobInput
 	for rows.Next() {
-		var passedString []sql.NullString
+		var passedString, passedPipelines, passedInstanceVars []sql.NullString
+		var missingPassed []string
 		var versionString sql.NullString
 		var inputName, resourceName string
 		var trigger bool
 
-		err = rows.Scan(&inputName, &resourceName, pq.Array(&passedString), &trigger, &versionString)
+		err = rows.Scan(&inputName, &resourceName, pq.Array(&passedString), pq.Array(&passedPipelines), pq.Array(&passedInstanceVars), pq.Array(&missingPassed), &trigger, &versionString)
 		if err != nil {
 			return nil, err
 		}
@@ -332,12 +473,19 @@ func (j *job) Inputs() ([]atc.JobInput, error) {
 		}
 
 		var passed []string
-		for _, s := range passedString {
+		for i, s := range passedString {
 			if s.Valid {
-				passed = append(passed, s.String)
+				name, err := passedJobName(s.String, passedPipelines[i], passedInstanceVars[i])
+				if err != nil {
+					return nil, err
+				}
+
+				passed = append(passed, name)
 			}
 		}
 
+		passed = append(passed, missingPassed...)
+
 		inputs = append(inputs, atc.JobInput{
 			Name:     inputName,
 			Resource: resourceName,
@@ -779,6 +927,66 @@ func (j *job) CreateBuild(createdBy string) (Build, error) {
 
 	defer Rollback(tx)
 
+	build, err := j.createManuallyTriggeredBuild(tx, createdBy)
+	if err != nil {
+		return nil, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, err
+	}
+
+	return build, nil
+}
+
+// CreateScheduledBuild creates a build for the job's schedule in the same way
+// as CreateBuild, but only if no other scheduled build has been created since
+// lastFired. This prevents a schedule from firing twice when several ATCs
+// evaluate it at the same time.
+func (j *job) CreateScheduledBuild(createdBy string, lastFired time.Time) (Build, bool, error) {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer Rollback(tx)
+
+	result, err := psql.Update("jobs").
+		Set("schedule_last_fired", sq.Expr("now()")).
+		Where(sq.Eq{
+			"id":                  j.id,
+			"schedule_last_fired": lastFired,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return nil, false, err
+	}
+
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return nil, false, err
+	}
+
+	if rowsAffected == 0 {
+		return nil, false, nil
+	}
+
+	build, err := j.createManuallyTriggeredBuild(tx, createdBy)
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, false, err
+	}
+
+	return build, true, nil
+}
+
+func (j *job) createManuallyTriggeredBuild(tx Tx, createdBy string) (Build, error) {
 	buildName, err := j.getNewBuildName(tx)
 	if err != nil {
 		return nil, err
@@ -813,11 +1021,6 @@ func (j *job) CreateBuild(createdBy string) (Build, error) {
 		return nil, err
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		return nil, err
-	}
-
 	return build, nil
 }
 
@@ -937,6 +1140,82 @@ func (j *job) AcquireSchedulingLock(logger lager.Logger) (lock.Lock, bool, error
 	)
 }
 
+// InputMappingVersions looks up the versions chosen for each resolved input in
+// the input mapping, e.g. one that has been computed but not saved.
+func (j *job) InputMappingVersions(inputMapping InputMapping) (map[string]atc.Version, error) {
+	versions := map[string]atc.Version{}
+
+	for inputName, input := range inputMapping {
+		if input.Input == nil {
+			continue
+		}
+
+		var versionBlob string
+		err := psql.Select("v.version").
+			From("resource_config_versions v").
+			Join("resources r ON r.resource_config_scope_id = v.resource_config_scope_id").
+			Where(sq.Eq{
+				"v.version_md5": input.Input.Version,
+				"r.id":          input.Input.ResourceID,
+			}).
+			RunWith(j.conn).
+			QueryRow().
+			Scan(&versionBlob)
+		if err != nil {
+			if err == sql.ErrNoRows {
+				continue
+			}
+
+			return nil, err
+		}
+
+		var version atc.Version
+		err = json.Unmarshal([]byte(versionBlob), &version)
+		if err != nil {
+			return nil, err
+		}
+
+		versions[inputName] = version
+	}
+
+	return versions, nil
+}
+
+// InFlightBuilds returns the builds that are currently running in any of the
+// job's serial groups, along with the next pending build across those serial
+// groups, which is the only build that may be scheduled once max in flight is
+// no longer reached.
+func (j *job) InFlightBuilds() ([]Build, Build, bool, error) {
+	tx, err := j.conn.Begin()
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	defer Rollback(tx)
+
+	serialGroups, err := j.getSerialGroups(tx)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	running, err := j.getRunningBuildsBySerialGroup(tx, serialGroups)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	nextPending, found, err := j.getNextPendingBuildBySerialGroup(tx, serialGroups)
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return nil, nil, false, err
+	}
+
+	return running, nextPending, found, nil
+}
+
 func (j *job) isMaxInFlightReached(tx Tx, buildID int) (bool, error) {
 	if j.maxInFlight == 0 {
 		return false, nil
@@ -1380,7 +1659,7 @@ func scanJob(j *job, row scannable) error {
 		pipelineInstanceVars sql.NullString
 	)
 
-	err := row.Scan(&j.id, &j.name, &config, &j.paused, &j.public, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &pipelineInstanceVars, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &j.scheduleRequestedTime, &j.maxInFlight, &j.disableManualTrigger)
+	err := row.Scan(&j.id, &j.name, &config, &j.paused, &j.public, &j.firstLoggedBuildID, &j.pipelineID, &j.pipelineName, &pipelineInstanceVars, &j.teamID, &j.teamName, &nonce, pq.Array(&j.tags), &j.hasNewInputs, &j.scheduleRequestedTime, &j.scheduleLastFired, &j.maxInFlight, &j.disableManualTrigger)
 	if err != nil {
 		return err
 	}
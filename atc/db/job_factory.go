@@ -21,6 +21,7 @@ type JobFactory interface {
 	VisibleJobs([]string) ([]atc.JobSummary, error)
 	AllActiveJobs() ([]atc.JobSummary, error)
 	JobsToSchedule() (SchedulerJobs, error)
+	ScheduledJobs() (Jobs, error)
 }
 
 type jobFactory struct {
@@ -75,6 +76,27 @@ func (resources SchedulerResources) Lookup(name string) (*SchedulerResource, boo
 	return nil, false
 }
 
+// ScheduledJobs returns the active jobs configured with a schedule, excluding
+// those which are paused or belong to a paused pipeline.
+func (j *jobFactory) ScheduledJobs() (Jobs, error) {
+	rows, err := jobsQuery.
+		Where(sq.NotEq{
+			"j.schedule": nil,
+		}).
+		Where(sq.Eq{
+			"j.active": true,
+			"j.paused": false,
+			"p.paused": false,
+		}).
+		RunWith(j.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	return scanJobs(j.conn, j.lockFactory, rows)
+}
+
 func (j *jobFactory) JobsToSchedule() (SchedulerJobs, error) {
 	tx, err := j.conn.Begin()
 	if err != nil {
@@ -413,13 +435,14 @@ func (d dashboardFactory) constructJobsForDashboard() ([]atc.JobSummary, error)
 }
 
 func (d dashboardFactory) fetchJobInputs() (map[int][]atc.JobInputSummary, error) {
-	rows, err := psql.Select("j.id", "i.name", "r.name", "array_agg(jp.name ORDER BY jp.id)", "i.trigger").
+	rows, err := psql.Select("j.id", "i.name", "r.name", "array_agg(jp.name ORDER BY jp.id)", "array_agg(jpp.name ORDER BY jp.id)", "array_agg(jpp.instance_vars ORDER BY jp.id)", missingPassedJobNames("i"), "i.trigger").
 		From("job_inputs i").
 		Join("jobs j ON j.id = i.job_id").
 		Join("pipelines p ON p.id = j.pipeline_id").
 		Join("teams tm ON tm.id = p.team_id").
 		Join("resources r ON r.id = i.resource_id").
 		LeftJoin("jobs jp ON jp.id = i.passed_job_id").
+		LeftJoin("pipelines jpp ON jpp.id = jp.pipeline_id AND jpp.id <> j.pipeline_id").
 		Where(sq.Eq{
 			"j.active": true,
 		}).
@@ -434,23 +457,31 @@ func (d dashboardFactory) fetchJobInputs() (map[int][]atc.JobInputSummary, error
 
 	jobInputs := make(map[int][]atc.JobInputSummary)
 	for rows.Next() {
-		var passedString []sql.NullString
+		var passedString, passedPipelines, passedInstanceVars []sql.NullString
+		var missingPassed []string
 		var inputName, resourceName string
 		var jobID int
 		var trigger bool
 
-		err = rows.Scan(&jobID, &inputName, &resourceName, pq.Array(&passedString), &trigger)
+		err = rows.Scan(&jobID, &inputName, &resourceName, pq.Array(&passedString), pq.Array(&passedPipelines), pq.Array(&passedInstanceVars), pq.Array(&missingPassed), &trigger)
 		if err != nil {
 			return nil, err
 		}
 
 		var passed []string
-		for _, s := range passedString {
+		for i, s := range passedString {
 			if s.Valid {
-				passed = append(passed, s.String)
+				name, err := passedJobName(s.String, passedPipelines[i], passedInstanceVars[i])
+				if err != nil {
+					return nil, err
+				}
+
+				passed = append(passed, name)
 			}
 		}
 
+		passed = append(passed, missingPassed...)
+
 		jobInputs[jobID] = append(jobInputs[jobID], atc.JobInputSummary{
 			Name:     inputName,
 			Resource: resourceName,
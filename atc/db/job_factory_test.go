@@ -100,7 +100,7 @@ var _ = Describe("JobFactory", func() {
 						Type: "some-type",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(publicPipeline.Expose()).To(Succeed())
 
@@ -128,7 +128,7 @@ var _ = Describe("JobFactory", func() {
 						Type: "some-type",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -364,7 +364,7 @@ var _ = Describe("JobFactory", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -390,7 +390,7 @@ var _ = Describe("JobFactory", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -415,7 +415,7 @@ var _ = Describe("JobFactory", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -447,7 +447,7 @@ var _ = Describe("JobFactory", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -465,7 +465,7 @@ var _ = Describe("JobFactory", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job2, found, err = pipeline2.Job("job-fake")
@@ -476,7 +476,7 @@ var _ = Describe("JobFactory", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake-two"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job3, found, err = pipeline3.Job("job-fake-two")
@@ -505,7 +505,7 @@ var _ = Describe("JobFactory", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -533,7 +533,7 @@ var _ = Describe("JobFactory", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -544,7 +544,7 @@ var _ = Describe("JobFactory", func() {
 				err = job1.RequestSchedule()
 				Expect(err).ToNot(HaveOccurred())
 
-				_, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{}, pipeline1.ConfigVersion(), false)
+				_, _, err = defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, atc.Config{}, pipeline1.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -561,7 +561,7 @@ var _ = Describe("JobFactory", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				var found bool
@@ -590,7 +590,7 @@ var _ = Describe("JobFactory", func() {
 						Jobs: atc.JobConfigs{
 							{Name: "job-name"},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					var found bool
@@ -639,7 +639,7 @@ var _ = Describe("JobFactory", func() {
 								Name: "unused-resource",
 							},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					var found bool
@@ -712,7 +712,7 @@ var _ = Describe("JobFactory", func() {
 								Type: "some-type",
 							},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					pipeline2, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-2"}, atc.Config{
@@ -744,7 +744,7 @@ var _ = Describe("JobFactory", func() {
 								Type: "other-type",
 							},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					var found bool
@@ -836,7 +836,7 @@ var _ = Describe("JobFactory", func() {
 								Name: "unused-resource",
 							},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					var found bool
@@ -896,7 +896,7 @@ var _ = Describe("JobFactory", func() {
 								Name: "unused-resource",
 							},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					var found bool
@@ -937,7 +937,7 @@ var _ = Describe("JobFactory", func() {
 								Type: "other-type",
 							},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					var found bool
@@ -978,7 +978,7 @@ var _ = Describe("JobFactory", func() {
 								Type: "other-type",
 							},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					pipeline2, _, err := defaultTeam.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-2"}, atc.Config{
@@ -995,7 +995,7 @@ var _ = Describe("JobFactory", func() {
 								Type: "other-type-2",
 							},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					var found bool
@@ -1079,7 +1079,7 @@ var _ = Describe("JobFactory", func() {
 								Type: "other-type",
 							},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					var found bool
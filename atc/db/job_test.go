@@ -8,9 +8,11 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbtest"
+	"github.com/concourse/concourse/atc/scheduler/algorithm"
 	"github.com/concourse/concourse/tracing"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	gocache "github.com/patrickmn/go-cache"
 	"go.opentelemetry.io/otel/oteltest"
 )
 
@@ -100,7 +102,7 @@ var _ = Describe("Job", func() {
 					Type: "some-type",
 				},
 			},
-		}, db.ConfigVersion(0), false)
+		}, db.ConfigVersion(0), false, "")
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 
@@ -217,7 +219,7 @@ var _ = Describe("Job", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "some-job"},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 
@@ -445,7 +447,7 @@ var _ = Describe("Job", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -753,7 +755,7 @@ var _ = Describe("Job", func() {
 							Type: "some-type",
 						},
 					},
-				}, pipeline.ConfigVersion(), false)
+				}, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 			})
 		}
@@ -838,7 +840,7 @@ var _ = Describe("Job", func() {
 							Type: "some-type",
 						},
 					},
-				}, pipeline.ConfigVersion(), false)
+				}, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 			})
 		}
@@ -873,7 +875,7 @@ var _ = Describe("Job", func() {
 								Name: "some-job",
 							},
 						},
-					}, db.ConfigVersion(0), false)
+					}, db.ConfigVersion(0), false, "")
 					Expect(err).ToNot(HaveOccurred())
 					Expect(created).To(BeTrue())
 
@@ -1686,7 +1688,7 @@ var _ = Describe("Job", func() {
 				},
 			}
 			var err error
-			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false)
+			otherPipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-other-pipeline"}, pipelineConfig, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			build1DB, err = job.CreateBuild(defaultBuildCreatedBy)
@@ -2212,6 +2214,131 @@ var _ = Describe("Job", func() {
 			})
 		})
 
+		Context("when the input has a passed constraint on a job in another pipeline", func() {
+			var upstreamPipeline db.Pipeline
+			var upstreamJob db.Job
+			var upstreamResource db.Resource
+
+			BeforeEach(func() {
+				scenario = dbtest.Setup(
+					builder.WithTeam("cross-pipeline-team"),
+				)
+
+				var err error
+				upstreamPipeline, _, err = scenario.Team.SavePipeline(atc.PipelineRef{Name: "upstream-pipeline"}, atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name: "upstream-job",
+							PlanSequence: []atc.Step{
+								{
+									Config: &atc.PutStep{
+										Name: "upstream-resource",
+									},
+								},
+							},
+						},
+					},
+					Resources: atc.ResourceConfigs{
+						{
+							Name:   "upstream-resource",
+							Type:   dbtest.BaseResourceType,
+							Source: atc.Source{"some": "source"},
+						},
+					},
+				}, db.ConfigVersion(0), false, "")
+				Expect(err).ToNot(HaveOccurred())
+
+				scenario.Run(
+					builder.WithPipeline(atc.Config{
+						Jobs: atc.JobConfigs{
+							{
+								Name: "some-job",
+								PlanSequence: []atc.Step{
+									{
+										Config: &atc.GetStep{
+											Name:     "some-input",
+											Resource: "some-resource",
+											Passed:   []string{"upstream-pipeline/upstream-job"},
+										},
+									},
+								},
+							},
+						},
+						Resources: atc.ResourceConfigs{
+							{
+								Name:   "some-resource",
+								Type:   dbtest.BaseResourceType,
+								Source: atc.Source{"some": "source"},
+							},
+						},
+					}),
+					builder.WithResourceVersions("some-resource"),
+				)
+
+				var found bool
+				upstreamJob, found, err = upstreamPipeline.Job("upstream-job")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				upstreamResource, found, err = upstreamPipeline.Resource("upstream-resource")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+
+				resourceConfig, err := resourceConfigFactory.FindOrCreateResourceConfig(upstreamResource.Type(), upstreamResource.Source(), atc.VersionedResourceTypes{})
+				Expect(err).ToNot(HaveOccurred())
+
+				scope, err := resourceConfig.FindOrCreateScope(upstreamResource)
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(upstreamResource.SetResourceConfigScope(scope)).To(Succeed())
+			})
+
+			It("returns the resource the passed job uses with the same config", func() {
+				Expect(inputs).To(Equal(db.InputConfigs{
+					{
+						Name:       "some-input",
+						JobID:      scenario.Job("some-job").ID(),
+						ResourceID: scenario.Resource("some-resource").ID(),
+						Passed: db.JobSet{
+							upstreamJob.ID(): true,
+						},
+						ExternalPassed: map[int]db.ExternalPassedJob{
+							upstreamJob.ID(): {
+								Name:       "upstream-pipeline/upstream-job",
+								ResourceID: upstreamResource.ID(),
+							},
+						},
+					},
+				}))
+			})
+
+			Context("when the upstream pipeline is destroyed", func() {
+				BeforeEach(func() {
+					Expect(upstreamPipeline.Destroy()).To(Succeed())
+				})
+
+				It("keeps the passed constraint so that the input does not resolve", func() {
+					Expect(inputs).To(Equal(db.InputConfigs{
+						{
+							Name:          "some-input",
+							JobID:         scenario.Job("some-job").ID(),
+							ResourceID:    scenario.Resource("some-resource").ID(),
+							MissingPassed: []string{"upstream-pipeline/upstream-job"},
+						},
+					}))
+
+					mapping, resolved, _, err := algorithm.New(db.NewVersionsDB(dbConn, 100, gocache.New(10*time.Second, 10*time.Second))).Compute(context.TODO(), scenario.Job("some-job"), inputs)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(resolved).To(BeFalse())
+					Expect(mapping).To(Equal(db.InputMapping{
+						"some-input": db.InputResult{
+							ResolveError: db.PassedJobNotFound,
+						},
+					}))
+				})
+			})
+		})
+
 		Context("when the input is pinned through the get step", func() {
 			BeforeEach(func() {
 				scenario = dbtest.Setup(
@@ -2572,7 +2699,7 @@ var _ = Describe("Job", func() {
 						Type: "some-type",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -2610,6 +2737,76 @@ var _ = Describe("Job", func() {
 				},
 			}))
 		})
+
+		Context("when an input has a passed constraint on a job in another pipeline", func() {
+			BeforeEach(func() {
+				_, _, err := team.SavePipeline(atc.PipelineRef{
+					Name:         "upstream-pipeline",
+					InstanceVars: atc.InstanceVars{"branch": "feature"},
+				}, atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name: "upstream-job",
+							PlanSequence: []atc.Step{
+								{
+									Config: &atc.PutStep{
+										Name: "some-resource",
+									},
+								},
+							},
+						},
+					},
+					Resources: atc.ResourceConfigs{
+						{
+							Name: "some-resource",
+							Type: "some-type",
+						},
+					},
+				}, db.ConfigVersion(0), false, "")
+				Expect(err).ToNot(HaveOccurred())
+
+				crossPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "cross-pipeline"}, atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name: "some-job",
+							PlanSequence: []atc.Step{
+								{
+									Config: &atc.GetStep{
+										Name:   "some-resource",
+										Passed: []string{"upstream-pipeline/branch:feature/upstream-job"},
+									},
+								},
+							},
+						},
+					},
+					Resources: atc.ResourceConfigs{
+						{
+							Name: "some-resource",
+							Type: "some-type",
+						},
+					},
+				}, db.ConfigVersion(0), false, "")
+				Expect(err).ToNot(HaveOccurred())
+
+				var found bool
+				inputsJob, found, err = crossPipeline.Job("some-job")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+			})
+
+			It("returns the passed job prefixed with its pipeline", func() {
+				inputs, err := inputsJob.Inputs()
+				Expect(err).ToNot(HaveOccurred())
+
+				Expect(inputs).To(Equal([]atc.JobInput{
+					{
+						Name:     "some-resource",
+						Resource: "some-resource",
+						Passed:   []string{"upstream-pipeline/branch:feature/upstream-job"},
+					},
+				}))
+			})
+		})
 	})
 
 	Describe("Outputs", func() {
@@ -2677,7 +2874,7 @@ var _ = Describe("Job", func() {
 						Type: "some-type",
 					},
 				},
-			}, db.ConfigVersion(0), false)
+			}, db.ConfigVersion(0), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			var found bool
@@ -0,0 +1,86 @@
+package migration_test
+
+import (
+	"database/sql"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Keep job inputs of deleted passed jobs", func() {
+	const preMigrationVersion = 1626220800
+	const postMigrationVersion = 1626307200
+
+	var (
+		db *sql.DB
+	)
+
+	Context("Up", func() {
+		It("names the passed jobs the way they are written in a passed constraint", func() {
+			db = postgresRunner.OpenDBAtVersion(preMigrationVersion)
+
+			_, err := db.Exec(`
+			INSERT INTO teams(id, name) VALUES
+			(1, 'some-team')
+			`)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = db.Exec(`
+			INSERT INTO pipelines(id, team_id, name, instance_vars) VALUES
+			(1, 1, 'pipeline1', NULL),
+			(2, 1, 'pipeline2', NULL),
+			(3, 1, 'pipeline3', '{"branch": "main", "version": 1}'::jsonb)
+			`)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = db.Exec(`
+			INSERT INTO jobs(id, pipeline_id, name, config) VALUES
+			(1, 1, 'job1', '{}'),
+			(2, 1, 'job2', '{}'),
+			(3, 2, 'job1', '{}'),
+			(4, 3, 'job1', '{}')
+			`)
+			Expect(err).NotTo(HaveOccurred())
+
+			var resourceID int
+			err = db.QueryRow(`INSERT INTO resources(name, pipeline_id, config, active, type) VALUES('resource-1', 1, '{"type": "some-type"}', true, 'some-type') RETURNING id`).Scan(&resourceID)
+			Expect(err).NotTo(HaveOccurred())
+
+			_, err = db.Exec(`
+			INSERT INTO job_inputs(name, job_id, resource_id, passed_job_id) VALUES
+			('input-1', 2, $1, NULL),
+			('input-2', 2, $1, 1),
+			('input-3', 2, $1, 3),
+			('input-4', 2, $1, 4)
+			`, resourceID)
+			Expect(err).NotTo(HaveOccurred())
+
+			db.Close()
+
+			db = postgresRunner.OpenDBAtVersion(postMigrationVersion)
+
+			rows, err := db.Query(`SELECT name, passed_job_name FROM job_inputs ORDER BY name`)
+			Expect(err).NotTo(HaveOccurred())
+
+			passedJobNames := map[string]sql.NullString{}
+			for rows.Next() {
+				var name string
+				var passedJobName sql.NullString
+
+				err := rows.Scan(&name, &passedJobName)
+				Expect(err).NotTo(HaveOccurred())
+
+				passedJobNames[name] = passedJobName
+			}
+
+			_ = db.Close()
+
+			Expect(passedJobNames).To(Equal(map[string]sql.NullString{
+				"input-1": {},
+				"input-2": {Valid: true, String: "job1"},
+				"input-3": {Valid: true, String: "pipeline2/job1"},
+				"input-4": {Valid: true, String: "pipeline3/branch:main,version:1/job1"},
+			}))
+		})
+	})
+})
@@ -0,0 +1,3 @@
+ALTER TABLE jobs
+    DROP COLUMN schedule_last_fired,
+    DROP COLUMN schedule;
@@ -0,0 +1,3 @@
+ALTER TABLE jobs
+    ADD COLUMN schedule jsonb,
+    ADD COLUMN schedule_last_fired timestamp with time zone DEFAULT now() NOT NULL;
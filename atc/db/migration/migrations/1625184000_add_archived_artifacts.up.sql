@@ -0,0 +1,14 @@
+CREATE TABLE archived_artifacts (
+    id serial PRIMARY KEY,
+    build_id integer NOT NULL,
+    name text NOT NULL,
+    key text NOT NULL,
+    size bigint NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+-- build_id intentionally does not reference builds: the blob must be removed
+-- from the artifact store before the row is, so rows for deleted builds are
+-- left for the collector to clean up.
+CREATE UNIQUE INDEX archived_artifacts_build_id_name_uniq
+    ON archived_artifacts (build_id, name);
@@ -0,0 +1,3 @@
+-- events which have been offloaded to the build event store are not restored
+-- into the events tables; those builds will appear to have no events.
+DROP TABLE archived_build_events;
@@ -0,0 +1,9 @@
+CREATE TABLE archived_build_events (
+    build_id integer PRIMARY KEY,
+    key text NOT NULL,
+    created_at timestamp with time zone DEFAULT now() NOT NULL
+);
+
+-- build_id intentionally does not reference builds: the blob must be removed
+-- from the build event store before the row is, so rows for deleted builds
+-- are left for the collector to clean up.
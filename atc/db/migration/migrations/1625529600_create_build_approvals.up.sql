@@ -0,0 +1,9 @@
+CREATE TABLE build_approvals (
+  build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+  plan_id text NOT NULL,
+  approved boolean NOT NULL,
+  decided_by text NOT NULL,
+  comment text,
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  PRIMARY KEY (build_id, plan_id)
+);
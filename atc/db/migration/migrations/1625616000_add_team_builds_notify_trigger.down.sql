@@ -0,0 +1,5 @@
+DROP TRIGGER IF EXISTS team_builds_update_notify_trigger ON builds;
+
+DROP TRIGGER IF EXISTS team_builds_insert_notify_trigger ON builds;
+
+DROP FUNCTION IF EXISTS notify_team_builds();
@@ -0,0 +1,12 @@
+CREATE OR REPLACE FUNCTION notify_team_builds() RETURNS TRIGGER AS $$
+BEGIN
+        IF NEW.resource_id IS NULL AND NEW.resource_type_id IS NULL AND NEW.prototype_id IS NULL THEN
+                PERFORM pg_notify('team_builds_' || NEW.team_id, '');
+        END IF;
+        RETURN NULL;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER team_builds_insert_notify_trigger AFTER INSERT ON builds FOR EACH ROW EXECUTE PROCEDURE notify_team_builds();
+
+CREATE TRIGGER team_builds_update_notify_trigger AFTER UPDATE OF status ON builds FOR EACH ROW WHEN (OLD.status IS DISTINCT FROM NEW.status) EXECUTE PROCEDURE notify_team_builds();
@@ -0,0 +1,28 @@
+CREATE TABLE notification_subscriptions (
+  id serial PRIMARY KEY,
+  team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+  name text NOT NULL,
+  url text NOT NULL,
+  events jsonb NOT NULL,
+  secret text NOT NULL,
+  nonce text,
+  UNIQUE (team_id, name)
+);
+
+CREATE TABLE notification_deliveries (
+  id bigserial PRIMARY KEY,
+  subscription_id integer NOT NULL REFERENCES notification_subscriptions (id) ON DELETE CASCADE,
+  event text NOT NULL,
+  payload jsonb NOT NULL,
+  status text NOT NULL DEFAULT 'pending',
+  attempts integer NOT NULL DEFAULT 0,
+  response_status integer,
+  last_error text,
+  next_attempt_at timestamp with time zone NOT NULL DEFAULT now(),
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  delivered_at timestamp with time zone
+);
+
+CREATE INDEX notification_deliveries_subscription_id_idx ON notification_deliveries (subscription_id);
+
+CREATE INDEX notification_deliveries_pending_idx ON notification_deliveries (next_attempt_at) WHERE status = 'pending';
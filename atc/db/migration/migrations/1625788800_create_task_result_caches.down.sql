@@ -0,0 +1,4 @@
+ALTER TABLE volumes
+  DROP COLUMN task_result_cache_id;
+
+DROP TABLE task_result_caches;
@@ -0,0 +1,15 @@
+CREATE TABLE task_result_caches (
+  id serial PRIMARY KEY,
+  job_id integer NOT NULL REFERENCES jobs (id) ON DELETE CASCADE,
+  step_name text NOT NULL,
+  key text NOT NULL,
+  build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+  outputs jsonb NOT NULL,
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  UNIQUE (job_id, step_name)
+);
+
+ALTER TABLE volumes
+  ADD COLUMN task_result_cache_id integer REFERENCES task_result_caches (id) ON DELETE SET NULL;
+
+CREATE INDEX volumes_task_result_cache_id ON volumes (task_result_cache_id);
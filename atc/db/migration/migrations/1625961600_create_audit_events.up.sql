@@ -0,0 +1,13 @@
+CREATE TABLE audit_events (
+  id bigserial PRIMARY KEY,
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  user_name text NOT NULL,
+  team_name text,
+  action text NOT NULL,
+  target text NOT NULL,
+  parameters jsonb,
+  status integer
+);
+
+CREATE INDEX audit_events_created_at_idx ON audit_events (created_at);
+CREATE INDEX audit_events_team_name_idx ON audit_events (team_name);
@@ -0,0 +1,13 @@
+CREATE TABLE api_tokens (
+  id serial PRIMARY KEY,
+  team_id integer NOT NULL REFERENCES teams (id) ON DELETE CASCADE,
+  name text NOT NULL,
+  token_hash text NOT NULL UNIQUE,
+  role text NOT NULL,
+  created_by text,
+  created_at timestamp with time zone NOT NULL DEFAULT now(),
+  expires_at timestamp with time zone,
+  UNIQUE (team_id, name)
+);
+
+CREATE INDEX api_tokens_expires_at_idx ON api_tokens (expires_at);
@@ -0,0 +1,10 @@
+CREATE TABLE build_resource_usage (
+  build_id integer NOT NULL REFERENCES builds (id) ON DELETE CASCADE,
+  plan_id text NOT NULL,
+  cpu_usage bigint NOT NULL,
+  cpu_user bigint NOT NULL,
+  cpu_system bigint NOT NULL,
+  memory_peak bigint NOT NULL,
+  duration bigint NOT NULL,
+  PRIMARY KEY (build_id, plan_id)
+);
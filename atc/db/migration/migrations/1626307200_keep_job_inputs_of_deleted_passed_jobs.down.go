@@ -0,0 +1,25 @@
+package migrations
+
+func (m *migrations) Down_1626307200() error {
+	tx := m.Tx
+
+	_, err := tx.Exec("DELETE FROM job_inputs WHERE passed_job_id IS NULL AND passed_job_name IS NOT NULL")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		ALTER TABLE job_inputs
+		  DROP CONSTRAINT job_inputs_passed_job_id_fkey,
+		  ADD CONSTRAINT job_inputs_passed_job_id_fkey FOREIGN KEY (passed_job_id) REFERENCES jobs (id) ON DELETE CASCADE`)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("ALTER TABLE job_inputs DROP COLUMN passed_job_name")
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package migrations
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	"github.com/concourse/concourse/atc"
+)
+
+type V7PassedJob struct {
+	ID           int
+	Name         string
+	PipelineName string
+	InstanceVars sql.NullString
+}
+
+func (m *migrations) Up_1626307200() error {
+	tx := m.Tx
+
+	_, err := tx.Exec("ALTER TABLE job_inputs ADD COLUMN passed_job_name text")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		UPDATE job_inputs ji
+		SET passed_job_name = jp.name
+		FROM jobs j, jobs jp
+		WHERE j.id = ji.job_id
+		AND jp.id = ji.passed_job_id
+		AND jp.pipeline_id = j.pipeline_id`)
+	if err != nil {
+		return err
+	}
+
+	// jobs in other pipelines are named the way they are written in a passed
+	// constraint, which for instanced pipelines includes their instance vars
+	rows, err := tx.Query(`
+		SELECT DISTINCT jp.id, jp.name, p.name, p.instance_vars
+		FROM job_inputs ji
+		JOIN jobs jp ON jp.id = ji.passed_job_id
+		JOIN pipelines p ON p.id = jp.pipeline_id
+		WHERE ji.passed_job_name IS NULL`)
+	if err != nil {
+		return err
+	}
+
+	var passedJobs []V7PassedJob
+	for rows.Next() {
+		var passedJob V7PassedJob
+		err = rows.Scan(&passedJob.ID, &passedJob.Name, &passedJob.PipelineName, &passedJob.InstanceVars)
+		if err != nil {
+			return err
+		}
+
+		passedJobs = append(passedJobs, passedJob)
+	}
+
+	err = rows.Close()
+	if err != nil {
+		return err
+	}
+
+	for _, passedJob := range passedJobs {
+		name := atc.PassedJob{
+			Pipeline: atc.PipelineRef{Name: passedJob.PipelineName},
+			Job:      passedJob.Name,
+		}
+
+		if passedJob.InstanceVars.Valid {
+			err = json.Unmarshal([]byte(passedJob.InstanceVars.String), &name.Pipeline.InstanceVars)
+			if err != nil {
+				return err
+			}
+		}
+
+		_, err = tx.Exec(`
+			UPDATE job_inputs
+			SET passed_job_name = $1
+			WHERE passed_job_id = $2
+			AND passed_job_name IS NULL`, name.String(), passedJob.ID)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err = tx.Exec(`
+		ALTER TABLE job_inputs
+		  DROP CONSTRAINT job_inputs_passed_job_id_fkey,
+		  ADD CONSTRAINT job_inputs_passed_job_id_fkey FOREIGN KEY (passed_job_id) REFERENCES jobs (id) ON DELETE SET NULL`)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,397 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+	"github.com/lib/pq"
+)
+
+// PendingNotification is a notification which is due to be delivered to a
+// subscription.
+type PendingNotification struct {
+	ID       int
+	Event    atc.NotificationEvent
+	URL      string
+	Secret   string
+	Payload  []byte
+	Attempts int
+}
+
+//counterfeiter:generate . NotificationQueue
+
+// NotificationQueue hands out the notifications which are due to be
+// delivered and records the outcome of each attempt.
+type NotificationQueue interface {
+	Pending(limit int) ([]PendingNotification, error)
+
+	Delivered(id int, responseStatus int) error
+
+	// Failed records a failed delivery attempt. The notification is attempted
+	// again at retryAt, or given up on if retryAt is zero.
+	Failed(id int, responseStatus int, reason string, retryAt time.Time) error
+}
+
+type notificationQueue struct {
+	conn Conn
+}
+
+func NewNotificationQueue(conn Conn) NotificationQueue {
+	return &notificationQueue{conn: conn}
+}
+
+func (queue *notificationQueue) Pending(limit int) ([]PendingNotification, error) {
+	rows, err := psql.Select("d.id", "d.event", "d.payload", "d.attempts", "s.url", "s.secret", "s.nonce").
+		From("notification_deliveries d").
+		Join("notification_subscriptions s ON s.id = d.subscription_id").
+		Where(sq.Eq{"d.status": atc.NotificationDeliveryPending}).
+		Where(sq.Expr("d.next_attempt_at <= now()")).
+		OrderBy("d.id ASC").
+		Limit(uint64(limit)).
+		RunWith(queue.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	es := queue.conn.EncryptionStrategy()
+
+	var notifications []PendingNotification
+	for rows.Next() {
+		var notification PendingNotification
+		var secret string
+		var nonce sql.NullString
+
+		err := rows.Scan(&notification.ID, &notification.Event, &notification.Payload, &notification.Attempts, &notification.URL, &secret, &nonce)
+		if err != nil {
+			return nil, err
+		}
+
+		var noncense *string
+		if nonce.Valid {
+			noncense = &nonce.String
+		}
+
+		decryptedSecret, err := es.Decrypt(secret, noncense)
+		if err != nil {
+			return nil, err
+		}
+
+		notification.Secret = string(decryptedSecret)
+
+		notifications = append(notifications, notification)
+	}
+
+	return notifications, nil
+}
+
+func (queue *notificationQueue) Delivered(id int, responseStatus int) error {
+	_, err := psql.Update("notification_deliveries").
+		Set("status", atc.NotificationDeliverySucceeded).
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("response_status", responseStatus).
+		Set("last_error", nil).
+		Set("delivered_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": id}).
+		RunWith(queue.conn).
+		Exec()
+	return err
+}
+
+func (queue *notificationQueue) Failed(id int, responseStatus int, reason string, retryAt time.Time) error {
+	update := psql.Update("notification_deliveries").
+		Set("attempts", sq.Expr("attempts + 1")).
+		Set("response_status", sql.NullInt64{Int64: int64(responseStatus), Valid: responseStatus != 0}).
+		Set("last_error", reason)
+
+	if retryAt.IsZero() {
+		update = update.Set("status", atc.NotificationDeliveryFailed)
+	} else {
+		update = update.Set("next_attempt_at", retryAt)
+	}
+
+	_, err := update.
+		Where(sq.Eq{"id": id}).
+		RunWith(queue.conn).
+		Exec()
+	return err
+}
+
+func (t *team) NotificationSubscriptions() ([]atc.NotificationSubscription, error) {
+	rows, err := psql.Select("name", "url", "events").
+		From("notification_subscriptions").
+		Where(sq.Eq{"team_id": t.id}).
+		OrderBy("name ASC").
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	subscriptions := []atc.NotificationSubscription{}
+	for rows.Next() {
+		var subscription atc.NotificationSubscription
+		var events []byte
+
+		err := rows.Scan(&subscription.Name, &subscription.URL, &events)
+		if err != nil {
+			return nil, err
+		}
+
+		err = json.Unmarshal(events, &subscription.Events)
+		if err != nil {
+			return nil, err
+		}
+
+		subscriptions = append(subscriptions, subscription)
+	}
+
+	return subscriptions, nil
+}
+
+func (t *team) SaveNotificationSubscription(subscription atc.NotificationSubscription) error {
+	events, err := json.Marshal(subscription.Events)
+	if err != nil {
+		return err
+	}
+
+	encryptedSecret, nonce, err := t.conn.EncryptionStrategy().Encrypt([]byte(subscription.Secret))
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("notification_subscriptions").
+		Columns("team_id", "name", "url", "events", "secret", "nonce").
+		Values(t.id, subscription.Name, subscription.URL, string(events), encryptedSecret, nonce).
+		Suffix(`
+			ON CONFLICT (team_id, name) DO UPDATE SET
+				url = EXCLUDED.url,
+				events = EXCLUDED.events,
+				secret = EXCLUDED.secret,
+				nonce = EXCLUDED.nonce
+		`).
+		RunWith(t.conn).
+		Exec()
+	return err
+}
+
+func (t *team) DestroyNotificationSubscription(name string) (bool, error) {
+	result, err := psql.Delete("notification_subscriptions").
+		Where(sq.Eq{
+			"team_id": t.id,
+			"name":    name,
+		}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+// NotificationDeliveries returns the most recent deliveries to the named
+// subscription, newest first. It returns false if there is no such
+// subscription.
+func (t *team) NotificationDeliveries(name string, limit int) ([]atc.NotificationDelivery, bool, error) {
+	subscriptionID, found, err := t.notificationSubscriptionID(name)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if !found {
+		return nil, false, nil
+	}
+
+	rows, err := psql.Select("id", "event", "status", "attempts", "response_status", "last_error", "created_at", "delivered_at", "payload").
+		From("notification_deliveries").
+		Where(sq.Eq{"subscription_id": subscriptionID}).
+		OrderBy("id DESC").
+		Limit(uint64(limit)).
+		RunWith(t.conn).
+		Query()
+	if err != nil {
+		return nil, false, err
+	}
+
+	defer Close(rows)
+
+	deliveries := []atc.NotificationDelivery{}
+	for rows.Next() {
+		var delivery atc.NotificationDelivery
+		var responseStatus sql.NullInt64
+		var lastError sql.NullString
+		var createdAt time.Time
+		var deliveredAt pq.NullTime
+		var payload []byte
+
+		err := rows.Scan(&delivery.ID, &delivery.Event, &delivery.Status, &delivery.Attempts, &responseStatus, &lastError, &createdAt, &deliveredAt, &payload)
+		if err != nil {
+			return nil, false, err
+		}
+
+		delivery.ResponseStatus = int(responseStatus.Int64)
+		delivery.LastError = lastError.String
+		delivery.CreatedAt = createdAt.Unix()
+
+		if deliveredAt.Valid {
+			delivery.DeliveredAt = deliveredAt.Time.Unix()
+		}
+
+		err = json.Unmarshal(payload, &delivery.Payload)
+		if err != nil {
+			return nil, false, err
+		}
+
+		deliveries = append(deliveries, delivery)
+	}
+
+	return deliveries, true, nil
+}
+
+// RedeliverNotification queues a delivery to the named subscription to be
+// sent again, regardless of whether it was delivered before.
+func (t *team) RedeliverNotification(name string, deliveryID int) (bool, error) {
+	subscriptionID, found, err := t.notificationSubscriptionID(name)
+	if err != nil {
+		return false, err
+	}
+
+	if !found {
+		return false, nil
+	}
+
+	result, err := psql.Update("notification_deliveries").
+		Set("status", atc.NotificationDeliveryPending).
+		Set("attempts", 0).
+		Set("next_attempt_at", sq.Expr("now()")).
+		Where(sq.Eq{
+			"id":              deliveryID,
+			"subscription_id": subscriptionID,
+		}).
+		RunWith(t.conn).
+		Exec()
+	if err != nil {
+		return false, err
+	}
+
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+
+	return affected > 0, nil
+}
+
+func (t *team) notificationSubscriptionID(name string) (int, bool, error) {
+	var id int
+	err := psql.Select("id").
+		From("notification_subscriptions").
+		Where(sq.Eq{
+			"team_id": t.id,
+			"name":    name,
+		}).
+		RunWith(t.conn).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, false, nil
+		}
+
+		return 0, false, err
+	}
+
+	return id, true, nil
+}
+
+// queueNotifications queues a notification for each of the team's
+// subscriptions to the event, as part of the transaction which caused it.
+func queueNotifications(tx Tx, teamID int, notification atc.Notification) error {
+	payload, err := json.Marshal(notification)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`
+		INSERT INTO notification_deliveries (subscription_id, event, payload)
+		SELECT id, $2, $3
+		FROM notification_subscriptions
+		WHERE team_id = $1
+		AND events @> jsonb_build_array($2::text)
+	`, teamID, string(notification.Event), string(payload))
+	return err
+}
+
+// queueNotifications queues the notifications for the build having reached
+// the given status. Check builds are only notified of when they fail.
+func (b *build) queueNotifications(tx Tx, status BuildStatus, startTime time.Time, endTime time.Time) error {
+	build := atc.NotificationBuild{
+		ID:                   b.id,
+		Name:                 b.name,
+		Status:               atc.BuildStatus(status),
+		PipelineName:         b.pipelineName,
+		PipelineInstanceVars: b.pipelineInstanceVars,
+		JobName:              b.jobName,
+	}
+
+	if !startTime.IsZero() {
+		build.StartTime = startTime.Unix()
+	}
+
+	if !endTime.IsZero() {
+		build.EndTime = endTime.Unix()
+	}
+
+	notification := atc.Notification{
+		Time:     build.StartTime,
+		TeamName: b.teamName,
+		Build:    build,
+	}
+
+	if build.EndTime != 0 {
+		notification.Time = build.EndTime
+	}
+
+	if b.isForCheck() {
+		if b.resourceID == 0 || (status != BuildStatusFailed && status != BuildStatusErrored) {
+			return nil
+		}
+
+		notification.Event = atc.NotificationResourceCheckFailed
+		notification.Resource = &atc.NotificationResource{
+			Name:                 b.resourceName,
+			PipelineName:         b.pipelineName,
+			PipelineInstanceVars: b.pipelineInstanceVars,
+		}
+
+		return queueNotifications(tx, b.teamID, notification)
+	}
+
+	switch status {
+	case BuildStatusStarted:
+		notification.Event = atc.NotificationBuildStarted
+	case BuildStatusSucceeded, BuildStatusFailed:
+		notification.Event = atc.NotificationBuildFinished
+	case BuildStatusErrored:
+		notification.Event = atc.NotificationBuildErrored
+	case BuildStatusAborted:
+		notification.Event = atc.NotificationBuildAborted
+	default:
+		return nil
+	}
+
+	return queueNotifications(tx, b.teamID, notification)
+}
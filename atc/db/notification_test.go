@@ -0,0 +1,249 @@
+package db_test
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Notifications", func() {
+	var queue db.NotificationQueue
+
+	BeforeEach(func() {
+		queue = db.NewNotificationQueue(dbConn)
+
+		err := defaultTeam.SaveNotificationSubscription(atc.NotificationSubscription{
+			Name:   "some-subscription",
+			URL:    "https://example.com/hook",
+			Secret: "some-secret",
+			Events: []atc.NotificationEvent{
+				atc.NotificationBuildStarted,
+				atc.NotificationBuildFinished,
+				atc.NotificationResourceCheckFailed,
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("subscriptions", func() {
+		It("lists the team's subscriptions without their secrets", func() {
+			subscriptions, err := defaultTeam.NotificationSubscriptions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(subscriptions).To(Equal([]atc.NotificationSubscription{
+				{
+					Name: "some-subscription",
+					URL:  "https://example.com/hook",
+					Events: []atc.NotificationEvent{
+						atc.NotificationBuildStarted,
+						atc.NotificationBuildFinished,
+						atc.NotificationResourceCheckFailed,
+					},
+				},
+			}))
+		})
+
+		It("updates a subscription saved with the same name", func() {
+			err := defaultTeam.SaveNotificationSubscription(atc.NotificationSubscription{
+				Name:   "some-subscription",
+				URL:    "https://example.com/other-hook",
+				Secret: "other-secret",
+				Events: []atc.NotificationEvent{atc.NotificationBuildErrored},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			subscriptions, err := defaultTeam.NotificationSubscriptions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(subscriptions).To(HaveLen(1))
+			Expect(subscriptions[0].URL).To(Equal("https://example.com/other-hook"))
+			Expect(subscriptions[0].Events).To(Equal([]atc.NotificationEvent{atc.NotificationBuildErrored}))
+		})
+
+		It("destroys subscriptions", func() {
+			destroyed, err := defaultTeam.DestroyNotificationSubscription("some-subscription")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(destroyed).To(BeTrue())
+
+			subscriptions, err := defaultTeam.NotificationSubscriptions()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(subscriptions).To(BeEmpty())
+
+			destroyed, err = defaultTeam.DestroyNotificationSubscription("some-subscription")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(destroyed).To(BeFalse())
+		})
+	})
+
+	Describe("queueing", func() {
+		It("queues notifications for the events the team is subscribed to", func() {
+			build, err := defaultJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = build.Start(atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = build.Finish(db.BuildStatusFailed)
+			Expect(err).ToNot(HaveOccurred())
+
+			pending, err := queue.Pending(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pending).To(HaveLen(2))
+
+			Expect(pending[0].Event).To(Equal(atc.NotificationBuildStarted))
+			Expect(pending[0].URL).To(Equal("https://example.com/hook"))
+			Expect(pending[0].Secret).To(Equal("some-secret"))
+			Expect(pending[1].Event).To(Equal(atc.NotificationBuildFinished))
+
+			reloaded, err := build.Reload()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reloaded).To(BeTrue())
+
+			var notification atc.Notification
+			err = json.Unmarshal(pending[1].Payload, &notification)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(notification).To(Equal(atc.Notification{
+				Event:    atc.NotificationBuildFinished,
+				Time:     build.EndTime().Unix(),
+				TeamName: defaultTeam.Name(),
+				Build: atc.NotificationBuild{
+					ID:                   build.ID(),
+					Name:                 build.Name(),
+					Status:               atc.StatusFailed,
+					PipelineName:         defaultPipeline.Name(),
+					PipelineInstanceVars: defaultPipeline.InstanceVars(),
+					JobName:              defaultJob.Name(),
+					StartTime:            build.StartTime().Unix(),
+					EndTime:              build.EndTime().Unix(),
+				},
+			}))
+		})
+
+		It("does not queue notifications for events the team is not subscribed to", func() {
+			build, err := defaultJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+
+			err = build.Finish(db.BuildStatusAborted)
+			Expect(err).ToNot(HaveOccurred())
+
+			pending, err := queue.Pending(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pending).To(BeEmpty())
+		})
+
+		It("only queues notifications for check builds which fail", func() {
+			build, _, err := defaultResource.CreateBuild(context.TODO(), false, atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = build.Finish(db.BuildStatusErrored)
+			Expect(err).ToNot(HaveOccurred())
+
+			succeeded, _, err := defaultResource.CreateBuild(context.TODO(), true, atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = succeeded.Finish(db.BuildStatusSucceeded)
+			Expect(err).ToNot(HaveOccurred())
+
+			pending, err := queue.Pending(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(pending).To(HaveLen(1))
+			Expect(pending[0].Event).To(Equal(atc.NotificationResourceCheckFailed))
+
+			var notification atc.Notification
+			err = json.Unmarshal(pending[0].Payload, &notification)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(notification.Build.ID).To(Equal(build.ID()))
+			Expect(notification.Resource).To(Equal(&atc.NotificationResource{
+				Name:                 defaultResource.Name(),
+				PipelineName:         defaultPipeline.Name(),
+				PipelineInstanceVars: defaultPipeline.InstanceVars(),
+			}))
+		})
+	})
+
+	Describe("delivery", func() {
+		var pending db.PendingNotification
+
+		BeforeEach(func() {
+			build, err := defaultJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, err = build.Start(atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+
+			notifications, err := queue.Pending(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(notifications).To(HaveLen(1))
+
+			pending = notifications[0]
+		})
+
+		It("stops handing out delivered notifications", func() {
+			err := queue.Delivered(pending.ID, 200)
+			Expect(err).ToNot(HaveOccurred())
+
+			notifications, err := queue.Pending(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(notifications).To(BeEmpty())
+
+			deliveries, found, err := defaultTeam.NotificationDeliveries("some-subscription", 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(deliveries).To(HaveLen(1))
+			Expect(deliveries[0].Status).To(Equal(atc.NotificationDeliverySucceeded))
+			Expect(deliveries[0].Attempts).To(Equal(1))
+			Expect(deliveries[0].ResponseStatus).To(Equal(200))
+			Expect(deliveries[0].DeliveredAt).ToNot(BeZero())
+		})
+
+		It("hands out failed notifications again once they are due to be retried", func() {
+			err := queue.Failed(pending.ID, 500, "500 Internal Server Error", time.Now().Add(time.Hour))
+			Expect(err).ToNot(HaveOccurred())
+
+			notifications, err := queue.Pending(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(notifications).To(BeEmpty())
+
+			err = queue.Failed(pending.ID, 0, "connection refused", time.Now().Add(-time.Second))
+			Expect(err).ToNot(HaveOccurred())
+
+			notifications, err = queue.Pending(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(notifications).To(HaveLen(1))
+			Expect(notifications[0].Attempts).To(Equal(2))
+		})
+
+		It("gives up on notifications which are not to be retried, until they are redelivered", func() {
+			err := queue.Failed(pending.ID, 404, "404 Not Found", time.Time{})
+			Expect(err).ToNot(HaveOccurred())
+
+			deliveries, _, err := defaultTeam.NotificationDeliveries("some-subscription", 10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deliveries[0].Status).To(Equal(atc.NotificationDeliveryFailed))
+			Expect(deliveries[0].LastError).To(Equal("404 Not Found"))
+
+			notifications, err := queue.Pending(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(notifications).To(BeEmpty())
+
+			redelivered, err := defaultTeam.RedeliverNotification("some-subscription", pending.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(redelivered).To(BeTrue())
+
+			notifications, err = queue.Pending(10)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(notifications).To(HaveLen(1))
+			Expect(notifications[0].Attempts).To(BeZero())
+		})
+
+		It("does not redeliver notifications of other subscriptions", func() {
+			redelivered, err := defaultTeam.RedeliverNotification("other-subscription", pending.ID)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(redelivered).To(BeFalse())
+		})
+	})
+})
@@ -11,6 +11,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc/blobstore"
 	"github.com/concourse/concourse/atc/db/encryption"
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/db/migration"
@@ -23,6 +24,10 @@ type Conn interface {
 	Bus() NotificationsBus
 	EncryptionStrategy() encryption.Strategy
 
+	// BuildEventStore is the store which the events of completed builds are
+	// offloaded to. It is nil when no build event store is configured.
+	BuildEventStore() blobstore.Store
+
 	Ping() error
 	Driver() driver.Driver
 
@@ -130,6 +135,10 @@ func (db *db) EncryptionStrategy() encryption.Strategy {
 	return db.encryption
 }
 
+func (db *db) BuildEventStore() blobstore.Store {
+	return nil
+}
+
 func (db *db) Close() error {
 	var errs error
 	dbErr := db.DB.Close()
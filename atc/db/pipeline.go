@@ -11,6 +11,7 @@ import (
 	"code.cloudfoundry.org/lager"
 
 	sq "github.com/Masterminds/squirrel"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 
 	"github.com/concourse/concourse/atc"
@@ -66,6 +67,8 @@ type Pipeline interface {
 	Display() *atc.DisplayConfig
 	ConfigVersion() ConfigVersion
 	Config() (atc.Config, error)
+	ConfigHistory() ([]atc.PipelineConfigRevision, error)
+	ConfigRevision(revision int) (atc.PipelineConfigRevision, bool, error)
 	Public() bool
 	Paused() bool
 	Archived() bool
@@ -74,7 +77,7 @@ type Pipeline interface {
 	CheckPaused() (bool, error)
 	Reload() (bool, error)
 
-	Causality(versionedResourceID int) ([]Cause, error)
+	Causality(resourceConfigVersionID int) ([]Cause, error)
 	ResourceVersion(resourceConfigVersionID int) (atc.ResourceVersion, bool, error)
 
 	GetBuildsWithVersionAsInput(int, int) ([]Build, error)
@@ -192,47 +195,59 @@ func (p *pipeline) Paused() bool                     { return p.paused }
 func (p *pipeline) Archived() bool                   { return p.archived }
 func (p *pipeline) LastUpdated() time.Time           { return p.lastUpdated }
 
-// IMPORTANT: This method is broken with the new resource config versions changes
-func (p *pipeline) Causality(versionedResourceID int) ([]Cause, error) {
+// Causality returns the builds which the resource config version was an input
+// to, followed by every build downstream of them through passed constraints
+// which used the same version. Downstream builds may belong to other pipelines
+// of the team, in which case the version is the one in the scope of their own
+// resource.
+func (p *pipeline) Causality(resourceConfigVersionID int) ([]Cause, error) {
 	rows, err := p.conn.Query(`
-		WITH RECURSIVE causality(versioned_resource_id, build_id) AS (
-				SELECT bi.versioned_resource_id, bi.build_id
-				FROM build_inputs bi
-				WHERE bi.versioned_resource_id = $1
+		WITH RECURSIVE version AS (
+				SELECT v.version_md5, v.resource_config_scope_id
+				FROM resource_config_versions v
+				WHERE v.id = $1
+		), causality(resource_id, build_id) AS (
+				SELECT bi.resource_id, bi.build_id
+				FROM build_resource_config_version_inputs bi
+				INNER JOIN resources r ON r.id = bi.resource_id
+				INNER JOIN version v ON v.resource_config_scope_id = r.resource_config_scope_id
+				WHERE r.pipeline_id = $2
+				AND bi.version_md5 = v.version_md5
 			UNION
-				SELECT bi.versioned_resource_id, bi.build_id
-				FROM causality t
-				INNER JOIN build_outputs bo ON bo.build_id = t.build_id
-				INNER JOIN build_inputs bi ON bi.versioned_resource_id = bo.versioned_resource_id
-				INNER JOIN builds b ON b.id = bi.build_id
-				AND NOT EXISTS (
-					SELECT 1
-					FROM build_outputs obo
-					INNER JOIN builds ob ON ob.id = obo.build_id
-					WHERE obo.build_id < bi.build_id
-					AND ob.job_id = b.job_id
-					AND obo.versioned_resource_id = bi.versioned_resource_id
-				)
+				SELECT bi.resource_id, bi.build_id
+				FROM causality c
+				INNER JOIN resources cr ON cr.id = c.resource_id
+				INNER JOIN build_pipes bp ON bp.from_build_id = c.build_id
+				INNER JOIN build_resource_config_version_inputs bi ON bi.build_id = bp.to_build_id
+				INNER JOIN resources r ON r.id = bi.resource_id
+				WHERE r.resource_config_id = cr.resource_config_id
+				AND bi.version_md5 = (SELECT version_md5 FROM version)
 		)
-		SELECT c.versioned_resource_id, c.build_id
+		SELECT DISTINCT rcv.id, c.build_id, b.start_time
 		FROM causality c
 		INNER JOIN builds b ON b.id = c.build_id
-		ORDER BY b.start_time ASC, c.versioned_resource_id ASC
-	`, versionedResourceID)
+		INNER JOIN resources r ON r.id = c.resource_id
+		INNER JOIN resource_config_versions rcv ON rcv.resource_config_scope_id = r.resource_config_scope_id
+		WHERE rcv.version_md5 = (SELECT version_md5 FROM version)
+		ORDER BY b.start_time ASC, c.build_id ASC
+	`, resourceConfigVersionID, p.id)
 	if err != nil {
 		return nil, err
 	}
 
+	defer Close(rows)
+
 	var causality []Cause
 	for rows.Next() {
-		var vrID, buildID int
-		err := rows.Scan(&vrID, &buildID)
+		var rcvID, buildID int
+		var startTime pq.NullTime
+		err := rows.Scan(&rcvID, &buildID, &startTime)
 		if err != nil {
 			return nil, err
 		}
 
 		causality = append(causality, Cause{
-			ResourceVersionID: vrID,
+			ResourceVersionID: rcvID,
 			BuildID:           buildID,
 		})
 	}
@@ -0,0 +1,128 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// recordPipelineConfig saves the config as the pipeline's next revision. It
+// must be called in the same transaction as the config is saved in, which
+// holds a lock on the pipeline's row so that revisions are numbered in order.
+func recordPipelineConfig(tx Tx, pipelineID int, config atc.Config, createdBy sql.NullString, buildID sql.NullInt64) error {
+	payload, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	encryptedPayload, nonce, err := tx.EncryptionStrategy().Encrypt(payload)
+	if err != nil {
+		return err
+	}
+
+	var revision int
+	err = psql.Select("COALESCE(MAX(revision), 0) + 1").
+		From("pipeline_config_history").
+		Where(sq.Eq{"pipeline_id": pipelineID}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&revision)
+	if err != nil {
+		return err
+	}
+
+	_, err = psql.Insert("pipeline_config_history").
+		Columns("pipeline_id", "revision", "config", "nonce", "created_by", "build_id").
+		Values(pipelineID, revision, encryptedPayload, nonce, createdBy, buildID).
+		RunWith(tx).
+		Exec()
+	return err
+}
+
+// ConfigHistory returns every revision of the pipeline's config, most recent
+// first. The configs themselves are left out; see ConfigRevision.
+func (p *pipeline) ConfigHistory() ([]atc.PipelineConfigRevision, error) {
+	rows, err := psql.Select("revision", "created_by", "build_id", "created_at").
+		From("pipeline_config_history").
+		Where(sq.Eq{"pipeline_id": p.id}).
+		OrderBy("revision DESC").
+		RunWith(p.conn).
+		Query()
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	revisions := []atc.PipelineConfigRevision{}
+	for rows.Next() {
+		var revision atc.PipelineConfigRevision
+		var createdBy sql.NullString
+		var buildID sql.NullInt64
+		var createdAt time.Time
+
+		err := rows.Scan(&revision.Revision, &createdBy, &buildID, &createdAt)
+		if err != nil {
+			return nil, err
+		}
+
+		revision.CreatedBy = createdBy.String
+		revision.BuildID = int(buildID.Int64)
+		revision.CreatedAt = createdAt.Unix()
+
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, nil
+}
+
+func (p *pipeline) ConfigRevision(number int) (atc.PipelineConfigRevision, bool, error) {
+	var revision atc.PipelineConfigRevision
+	var payload string
+	var nonce, createdBy sql.NullString
+	var buildID sql.NullInt64
+	var createdAt time.Time
+
+	err := psql.Select("revision", "config", "nonce", "created_by", "build_id", "created_at").
+		From("pipeline_config_history").
+		Where(sq.Eq{
+			"pipeline_id": p.id,
+			"revision":    number,
+		}).
+		RunWith(p.conn).
+		QueryRow().
+		Scan(&revision.Revision, &payload, &nonce, &createdBy, &buildID, &createdAt)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return atc.PipelineConfigRevision{}, false, nil
+		}
+
+		return atc.PipelineConfigRevision{}, false, err
+	}
+
+	var noncense *string
+	if nonce.Valid {
+		noncense = &nonce.String
+	}
+
+	decryptedPayload, err := p.conn.EncryptionStrategy().Decrypt(payload, noncense)
+	if err != nil {
+		return atc.PipelineConfigRevision{}, false, err
+	}
+
+	var config atc.Config
+	err = json.Unmarshal(decryptedPayload, &config)
+	if err != nil {
+		return atc.PipelineConfigRevision{}, false, err
+	}
+
+	revision.CreatedBy = createdBy.String
+	revision.BuildID = int(buildID.Int64)
+	revision.CreatedAt = createdAt.Unix()
+	revision.Config = &config
+
+	return revision, true, nil
+}
@@ -0,0 +1,108 @@
+package db_test
+
+import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Pipeline config history", func() {
+	var updatedConfig atc.Config
+
+	BeforeEach(func() {
+		updatedConfig = defaultPipelineConfig
+		updatedConfig.Resources = append(atc.ResourceConfigs{
+			{
+				Name:   "another-resource",
+				Type:   "some-base-resource-type",
+				Source: atc.Source{"some": "other-source"},
+			},
+		}, defaultPipelineConfig.Resources...)
+	})
+
+	Context("when the pipeline is first saved", func() {
+		It("records the config as the first revision", func() {
+			history, err := defaultPipeline.ConfigHistory()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(history).To(HaveLen(1))
+			Expect(history[0].Revision).To(Equal(1))
+			Expect(history[0].CreatedAt).To(BeNumerically("~", time.Now().Unix(), 60))
+			Expect(history[0].Config).To(BeNil())
+		})
+	})
+
+	Context("when the pipeline is saved by a user", func() {
+		BeforeEach(func() {
+			_, _, err := defaultTeam.SavePipeline(defaultPipelineRef, updatedConfig, defaultPipeline.ConfigVersion(), false, "some-user")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("records a new revision with the author, most recent first", func() {
+			history, err := defaultPipeline.ConfigHistory()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(history).To(HaveLen(2))
+			Expect(history[0].Revision).To(Equal(2))
+			Expect(history[0].CreatedBy).To(Equal("some-user"))
+			Expect(history[0].BuildID).To(BeZero())
+			Expect(history[1].Revision).To(Equal(1))
+		})
+
+		It("can look up the config of each revision", func() {
+			revision, found, err := defaultPipeline.ConfigRevision(1)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(*revision.Config).To(Equal(defaultPipelineConfig))
+
+			revision, found, err = defaultPipeline.ConfigRevision(2)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(revision.CreatedBy).To(Equal("some-user"))
+			Expect(*revision.Config).To(Equal(updatedConfig))
+		})
+	})
+
+	Context("when the pipeline is saved by a set_pipeline step", func() {
+		var build db.Build
+
+		BeforeEach(func() {
+			var err error
+			build, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
+			Expect(err).ToNot(HaveOccurred())
+
+			_, _, err = build.SavePipeline(defaultPipelineRef, defaultTeam.ID(), updatedConfig, defaultPipeline.ConfigVersion(), false)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("records the build that set it", func() {
+			history, err := defaultPipeline.ConfigHistory()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(history).To(HaveLen(2))
+			Expect(history[0].BuildID).To(Equal(build.ID()))
+			Expect(history[0].CreatedBy).To(Equal(defaultBuildCreatedBy))
+		})
+	})
+
+	Context("when the revision does not exist", func() {
+		It("returns false", func() {
+			_, found, err := defaultPipeline.ConfigRevision(42)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Context("when the pipeline is destroyed", func() {
+		It("removes its history", func() {
+			err := defaultPipeline.Destroy()
+			Expect(err).ToNot(HaveOccurred())
+
+			var count int
+			err = dbConn.QueryRow(`SELECT COUNT(*) FROM pipeline_config_history WHERE pipeline_id = $1`, defaultPipeline.ID()).Scan(&count)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(count).To(BeZero())
+		})
+	})
+})
@@ -34,7 +34,7 @@ var _ = Describe("Pipeline Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "job-name"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline1.Reload()).To(BeTrue())
 
@@ -42,7 +42,7 @@ var _ = Describe("Pipeline Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "job-fake"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline2.Reload()).To(BeTrue())
 
@@ -50,7 +50,7 @@ var _ = Describe("Pipeline Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "job-fake-two"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline3.Expose()).To(Succeed())
 			Expect(pipeline3.Reload()).To(BeTrue())
@@ -59,7 +59,7 @@ var _ = Describe("Pipeline Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "job-name"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline4.Reload()).To(BeTrue())
 		})
@@ -139,7 +139,7 @@ var _ = Describe("Pipeline Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "job-fake"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline2.Reload()).To(BeTrue())
 
@@ -147,7 +147,7 @@ var _ = Describe("Pipeline Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "job-fake-two"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline3.Expose()).To(Succeed())
 			Expect(pipeline3.Reload()).To(BeTrue())
@@ -156,7 +156,7 @@ var _ = Describe("Pipeline Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "job-name"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline1.Expose()).To(Succeed())
 			Expect(pipeline1.Reload()).To(BeTrue())
@@ -165,7 +165,7 @@ var _ = Describe("Pipeline Factory", func() {
 				Jobs: atc.JobConfigs{
 					{Name: "job-name"},
 				},
-			}, db.ConfigVersion(1), false)
+			}, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(pipeline4.Reload()).To(BeTrue())
 
@@ -1,6 +1,7 @@
 package db_test
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"time"
@@ -173,7 +174,7 @@ var _ = Describe("Pipeline", func() {
 			},
 		}
 		var created bool
-		pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, db.ConfigVersion(0), false)
+		pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, db.ConfigVersion(0), false, "")
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
 
@@ -1337,7 +1338,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).ToNot(HaveOccurred())
 
 			By("deleting events for build 1")
-			events1, err := build1DB.Events(0)
+			events1, err := build1DB.Events(context.TODO(), 0)
 			Expect(err).ToNot(HaveOccurred())
 			defer db.Close(events1)
 
@@ -1345,7 +1346,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
 
 			By("preserving events for build 2")
-			events2, err := build2DB.Events(0)
+			events2, err := build2DB.Events(context.TODO(), 0)
 			Expect(err).ToNot(HaveOccurred())
 			defer db.Close(events2)
 
@@ -1362,7 +1363,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
 
 			By("deleting events for build 3")
-			events3, err := build3DB.Events(0)
+			events3, err := build3DB.Events(context.TODO(), 0)
 			Expect(err).ToNot(HaveOccurred())
 			defer db.Close(events3)
 
@@ -1370,7 +1371,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).To(Equal(db.ErrEndOfBuildEventStream))
 
 			By("being unflapped by build 4, which had no events at the time")
-			events4, err := build4DB.Events(0)
+			events4, err := build4DB.Events(context.TODO(), 0)
 			Expect(err).ToNot(HaveOccurred())
 			defer db.Close(events4)
 
@@ -1706,6 +1707,99 @@ var _ = Describe("Pipeline", func() {
 		})
 	})
 
+	Describe("Causality", func() {
+		var (
+			scenario        *dbtest.Scenario
+			upstreamBuild   db.Build
+			downstreamBuild db.Build
+			unrelatedBuild  db.Build
+		)
+
+		BeforeEach(func() {
+			scenario = dbtest.Setup(
+				builder.WithPipeline(atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name: "upstream-job",
+							PlanSequence: []atc.Step{
+								{
+									Config: &atc.GetStep{
+										Name: "some-resource",
+									},
+								},
+							},
+						},
+						{
+							Name: "downstream-job",
+							PlanSequence: []atc.Step{
+								{
+									Config: &atc.GetStep{
+										Name:   "some-resource",
+										Passed: []string{"upstream-job"},
+									},
+								},
+							},
+						},
+					},
+					Resources: atc.ResourceConfigs{
+						{
+							Name:   "some-resource",
+							Type:   dbtest.BaseResourceType,
+							Source: atc.Source{"some": "source"},
+						},
+					},
+				}),
+				builder.WithResourceVersions(
+					"some-resource",
+					atc.Version{"version": "v1"},
+					atc.Version{"version": "v2"},
+				),
+			)
+
+			scenario.Run(
+				builder.WithJobBuild(&upstreamBuild, "upstream-job", dbtest.JobInputs{
+					{
+						Name:    "some-resource",
+						Version: atc.Version{"version": "v1"},
+					},
+				}, dbtest.JobOutputs{}),
+				builder.WithJobBuild(&unrelatedBuild, "upstream-job", dbtest.JobInputs{
+					{
+						Name:    "some-resource",
+						Version: atc.Version{"version": "v2"},
+					},
+				}, dbtest.JobOutputs{}),
+			)
+
+			scenario.Run(
+				builder.WithJobBuild(&downstreamBuild, "downstream-job", dbtest.JobInputs{
+					{
+						Name:         "some-resource",
+						Version:      atc.Version{"version": "v1"},
+						PassedBuilds: []db.Build{upstreamBuild},
+					},
+				}, dbtest.JobOutputs{}),
+			)
+		})
+
+		It("returns the builds which used the version through passed constraints", func() {
+			rcv := scenario.ResourceVersion("some-resource", atc.Version{"version": "v1"})
+
+			causality, err := scenario.Pipeline.Causality(rcv.ID())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(causality).To(Equal([]db.Cause{
+				{ResourceVersionID: rcv.ID(), BuildID: upstreamBuild.ID()},
+				{ResourceVersionID: rcv.ID(), BuildID: downstreamBuild.ID()},
+			}))
+		})
+
+		It("returns nothing for a version which does not exist", func() {
+			causality, err := scenario.Pipeline.Causality(scenario.ResourceVersion("some-resource", atc.Version{"version": "v2"}).ID() + 100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(causality).To(BeEmpty())
+		})
+	})
+
 	Describe("Builds", func() {
 		var expectedBuilds []db.Build
 
@@ -1799,7 +1893,7 @@ var _ = Describe("Pipeline", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			events, err := startedBuild.Events(0)
+			events, err := startedBuild.Events(context.TODO(), 0)
 			Expect(err).NotTo(HaveOccurred())
 
 			defer db.Close(events)
@@ -1942,7 +2036,7 @@ var _ = Describe("Pipeline", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = pipeline.Job("some-job")
@@ -1962,7 +2056,7 @@ var _ = Describe("Pipeline", func() {
 				Expect(found).To(BeTrue())
 			}
 
-			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "another-pipeline"}, config, db.ConfigVersion(1), false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "another-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			otherJob, found, err := otherPipeline.Job("some-job")
@@ -2118,7 +2212,7 @@ var _ = Describe("Pipeline", func() {
 				})
 
 				var created bool
-				pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, pipeline.ConfigVersion(), false)
+				pipeline, created, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline"}, pipelineConfig, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(created).To(BeFalse())
 			})
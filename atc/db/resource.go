@@ -53,6 +53,7 @@ type Resource interface {
 	BuildSummary() *atc.BuildSummary
 
 	Versions(page Page, versionFilter atc.Version) ([]atc.ResourceVersion, Pagination, bool, error)
+	DisabledVersions() ([]atc.Version, error)
 	FindVersion(filter atc.Version) (ResourceConfigVersion, bool, error) // Only used in tests!!
 	UpdateMetadata(atc.Version, ResourceConfigMetadataFields) (bool, error)
 
@@ -592,6 +593,42 @@ func (r *resource) Versions(page Page, versionFilter atc.Version) ([]atc.Resourc
 	return rvs, pagination, true, nil
 }
 
+func (r *resource) DisabledVersions() ([]atc.Version, error) {
+	rows, err := r.conn.Query(`
+		SELECT v.version
+		FROM resource_config_versions v, resource_disabled_versions d, resources r
+		WHERE r.id = $1
+		AND d.resource_id = r.id
+		AND v.resource_config_scope_id = r.resource_config_scope_id
+		AND v.version_md5 = d.version_md5
+		ORDER BY v.check_order DESC
+	`, r.id)
+	if err != nil {
+		return nil, err
+	}
+
+	defer Close(rows)
+
+	versions := []atc.Version{}
+	for rows.Next() {
+		var versionBlob string
+		err = rows.Scan(&versionBlob)
+		if err != nil {
+			return nil, err
+		}
+
+		var version atc.Version
+		err = json.Unmarshal([]byte(versionBlob), &version)
+		if err != nil {
+			return nil, err
+		}
+
+		versions = append(versions, version)
+	}
+
+	return versions, nil
+}
+
 func (r *resource) EnableVersion(rcvID int) error {
 	return r.toggleVersion(rcvID, true)
 }
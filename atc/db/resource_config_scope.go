@@ -316,6 +316,7 @@ func requestScheduleForJobsUsingResourceConfigScope(tx Tx, rcsID int) error {
 		Where(sq.Eq{
 			"r.resource_config_scope_id": rcsID,
 			"j.passed_job_id":            nil,
+			"j.passed_job_name":          nil,
 		}).
 		OrderBy("j.job_id DESC").
 		RunWith(tx).
@@ -75,6 +75,7 @@ var _ = Describe("Resource", func() {
 			},
 			0,
 			false,
+			"",
 		)
 		Expect(err).ToNot(HaveOccurred())
 		Expect(created).To(BeTrue())
@@ -365,6 +366,7 @@ var _ = Describe("Resource", func() {
 				config,
 				0,
 				false,
+				"",
 			)
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
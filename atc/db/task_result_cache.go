@@ -0,0 +1,207 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// TaskResultCache is the result of a successful run of a task step which can
+// be reused by later builds of the job, so long as the task's cache key is the
+// same.
+type TaskResultCache struct {
+	ID      int
+	BuildID int
+
+	// Outputs maps the names of the task's outputs to the handles of the
+	// volumes holding them.
+	Outputs map[string]string
+}
+
+//counterfeiter:generate . TaskResultCacheFactory
+
+// TaskResultCacheFactory stores the results of task steps which are run with
+// cache_result. Only the latest result of each step of a job is kept; saving
+// a new one releases the volumes of the previous one for garbage collection.
+type TaskResultCacheFactory interface {
+	// Find returns the result saved for the key, so long as all of its output
+	// volumes are still around.
+	Find(jobID int, stepName string, key string) (TaskResultCache, bool, error)
+
+	Save(jobID int, stepName string, key string, buildID int, outputs map[string]string) error
+}
+
+type taskResultCacheFactory struct {
+	conn Conn
+}
+
+func NewTaskResultCacheFactory(conn Conn) TaskResultCacheFactory {
+	return &taskResultCacheFactory{
+		conn: conn,
+	}
+}
+
+func (f *taskResultCacheFactory) Find(jobID int, stepName string, key string) (TaskResultCache, bool, error) {
+	var cache TaskResultCache
+	var outputs []byte
+	err := psql.Select("id", "build_id", "outputs").
+		From("task_result_caches").
+		Where(sq.Eq{
+			"job_id":    jobID,
+			"step_name": stepName,
+			"key":       key,
+		}).
+		RunWith(f.conn).
+		QueryRow().
+		Scan(&cache.ID, &cache.BuildID, &outputs)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return TaskResultCache{}, false, nil
+		}
+
+		return TaskResultCache{}, false, err
+	}
+
+	err = json.Unmarshal(outputs, &cache.Outputs)
+	if err != nil {
+		return TaskResultCache{}, false, err
+	}
+
+	rows, err := psql.Select("handle").
+		From("volumes").
+		Where(sq.Eq{
+			"task_result_cache_id": cache.ID,
+			"state":                VolumeStateCreated,
+		}).
+		RunWith(f.conn).
+		Query()
+	if err != nil {
+		return TaskResultCache{}, false, err
+	}
+
+	defer Close(rows)
+
+	handles := map[string]bool{}
+	for rows.Next() {
+		var handle string
+		err := rows.Scan(&handle)
+		if err != nil {
+			return TaskResultCache{}, false, err
+		}
+
+		handles[handle] = true
+	}
+
+	for _, handle := range cache.Outputs {
+		if !handles[handle] {
+			return TaskResultCache{}, false, nil
+		}
+	}
+
+	return cache, true, nil
+}
+
+func (f *taskResultCacheFactory) Save(jobID int, stepName string, key string, buildID int, outputs map[string]string) error {
+	payload, err := json.Marshal(outputs)
+	if err != nil {
+		return err
+	}
+
+	tx, err := f.conn.Begin()
+	if err != nil {
+		return err
+	}
+
+	defer Rollback(tx)
+
+	// the volumes of the previous result are released for gc by the foreign
+	// key
+	_, err = psql.Delete("task_result_caches").
+		Where(sq.Eq{
+			"job_id":    jobID,
+			"step_name": stepName,
+		}).
+		RunWith(tx).
+		Exec()
+	if err != nil {
+		return err
+	}
+
+	var id int
+	err = psql.Insert("task_result_caches").
+		Columns("job_id", "step_name", "key", "build_id", "outputs").
+		Values(jobID, stepName, key, buildID, string(payload)).
+		Suffix("RETURNING id").
+		RunWith(tx).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		return err
+	}
+
+	handles := []string{}
+	for _, handle := range outputs {
+		handles = append(handles, handle)
+	}
+
+	if len(handles) > 0 {
+		result, err := psql.Update("volumes").
+			Set("task_result_cache_id", id).
+			Where(sq.Eq{
+				"handle": handles,
+				"state":  VolumeStateCreated,
+			}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return err
+		}
+
+		affected, err := result.RowsAffected()
+		if err != nil {
+			return err
+		}
+
+		if affected != int64(len(handles)) {
+			return ErrVolumeMissing
+		}
+	}
+
+	return tx.Commit()
+}
+
+func removeUnusedTaskResultCaches(tx Tx, pipelineID int, jobConfigs []atc.JobConfig) error {
+	steps := make(map[string][]string)
+	for _, jobConfig := range jobConfigs {
+		_ = jobConfig.StepConfig().Visit(atc.StepRecursor{
+			OnTask: func(step *atc.TaskStep) error {
+				if step.CacheResult {
+					steps[jobConfig.Name] = append(steps[jobConfig.Name], step.Name)
+				}
+				return nil
+			},
+		})
+	}
+
+	query := sq.Or{}
+	for _, jobConfig := range jobConfigs {
+		query = append(query, sq.And{sq.Eq{"j.name": jobConfig.Name}, sq.NotEq{"trc.step_name": steps[jobConfig.Name]}})
+	}
+
+	_, err := psql.Delete("task_result_caches trc USING jobs j").
+		Where(
+			sq.Or{
+				query,
+				sq.Eq{
+					"j.active": false,
+				},
+			}).
+		Where(sq.Expr("j.id = trc.job_id")).
+		Where(sq.Eq{"j.pipeline_id": pipelineID}).
+		RunWith(tx).
+		Exec()
+
+	return err
+}
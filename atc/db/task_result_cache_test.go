@@ -0,0 +1,127 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TaskResultCacheFactory", func() {
+	var (
+		factory db.TaskResultCacheFactory
+		build   db.Build
+		volume  db.CreatedVolume
+	)
+
+	BeforeEach(func() {
+		factory = db.NewTaskResultCacheFactory(dbConn)
+
+		var err error
+		build, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
+		Expect(err).ToNot(HaveOccurred())
+
+		creatingVolume, err := volumeRepository.CreateVolume(defaultTeam.ID(), defaultWorker.Name(), db.VolumeTypeContainer)
+		Expect(err).ToNot(HaveOccurred())
+
+		volume, err = creatingVolume.Created()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("Find", func() {
+		Context("when no result has been saved", func() {
+			It("returns not found", func() {
+				_, found, err := factory.Find(defaultJob.ID(), "some-step", "some-key")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when a result has been saved", func() {
+			BeforeEach(func() {
+				err := factory.Save(defaultJob.ID(), "some-step", "some-key", build.ID(), map[string]string{
+					"some-output": volume.Handle(),
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("finds it by key", func() {
+				cache, found, err := factory.Find(defaultJob.ID(), "some-step", "some-key")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(cache.BuildID).To(Equal(build.ID()))
+				Expect(cache.Outputs).To(Equal(map[string]string{"some-output": volume.Handle()}))
+			})
+
+			It("does not find it by another key", func() {
+				_, found, err := factory.Find(defaultJob.ID(), "some-step", "some-other-key")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			It("keeps the output volumes from being garbage collected", func() {
+				orphaned, err := volumeRepository.GetOrphanedVolumes()
+				Expect(err).ToNot(HaveOccurred())
+
+				var handles []string
+				for _, v := range orphaned {
+					handles = append(handles, v.Handle())
+				}
+
+				Expect(handles).ToNot(ContainElement(volume.Handle()))
+			})
+
+			Context("when an output volume is being destroyed", func() {
+				BeforeEach(func() {
+					_, err := volume.Destroying()
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("returns not found", func() {
+					_, found, err := factory.Find(defaultJob.ID(), "some-step", "some-key")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeFalse())
+				})
+			})
+
+			Context("when a newer result is saved", func() {
+				BeforeEach(func() {
+					err := factory.Save(defaultJob.ID(), "some-step", "some-new-key", build.ID(), map[string]string{})
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("replaces the previous result", func() {
+					_, found, err := factory.Find(defaultJob.ID(), "some-step", "some-key")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeFalse())
+
+					_, found, err = factory.Find(defaultJob.ID(), "some-step", "some-new-key")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+				})
+
+				It("releases the previous result's volumes", func() {
+					orphaned, err := volumeRepository.GetOrphanedVolumes()
+					Expect(err).ToNot(HaveOccurred())
+
+					var handles []string
+					for _, v := range orphaned {
+						handles = append(handles, v.Handle())
+					}
+
+					Expect(handles).To(ContainElement(volume.Handle()))
+				})
+			})
+		})
+	})
+
+	Describe("Save", func() {
+		Context("when an output volume does not exist", func() {
+			It("returns ErrVolumeMissing", func() {
+				err := factory.Save(defaultJob.ID(), "some-step", "some-key", build.ID(), map[string]string{
+					"some-output": "bogus-handle",
+				})
+				Expect(err).To(Equal(db.ErrVolumeMissing))
+			})
+		})
+	})
+})
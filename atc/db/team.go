@@ -27,6 +27,12 @@ func (e ErrPipelineNotFound) Error() string {
 	return fmt.Sprintf("pipeline '%s' not found", atc.PipelineRef(e))
 }
 
+type ErrPassedJobNotFound atc.PassedJob
+
+func (e ErrPassedJobNotFound) Error() string {
+	return fmt.Sprintf("job '%s' in passed constraints not found", atc.PassedJob(e))
+}
+
 //counterfeiter:generate . Team
 type Team interface {
 	ID() int
@@ -34,6 +40,8 @@ type Team interface {
 	Admin() bool
 
 	Auth() atc.TeamAuth
+	Quota() atc.TeamQuota
+	CustomRoles() atc.CustomRoles
 
 	Delete() error
 	Rename(string) error
@@ -43,6 +51,7 @@ type Team interface {
 		config atc.Config,
 		from ConfigVersion,
 		initiallyPaused bool,
+		createdBy string,
 	) (Pipeline, bool, error)
 	RenamePipeline(oldName string, newName string) (bool, error)
 
@@ -57,8 +66,15 @@ type Team interface {
 
 	PrivateAndPublicBuilds(Page) ([]Build, Pagination, error)
 	Builds(page Page) ([]Build, Pagination, error)
+	BuildLifecycleEvents() (BuildLifecycleSource, error)
 	BuildsWithTime(page Page) ([]Build, Pagination, error)
 
+	NotificationSubscriptions() ([]atc.NotificationSubscription, error)
+	SaveNotificationSubscription(atc.NotificationSubscription) error
+	DestroyNotificationSubscription(name string) (bool, error)
+	NotificationDeliveries(name string, limit int) ([]atc.NotificationDelivery, bool, error)
+	RedeliverNotification(name string, deliveryID int) (bool, error)
+
 	SaveWorker(atcWorker atc.Worker, ttl time.Duration) (Worker, error)
 	Workers() ([]Worker, error)
 	FindVolumeForWorkerArtifact(int) (CreatedVolume, bool, error)
@@ -76,6 +92,9 @@ type Team interface {
 	FindWorkersForResourceCache(rcId int) ([]Worker, error)
 
 	UpdateProviderAuth(auth atc.TeamAuth) error
+	UpdateQuota(quota atc.TeamQuota) error
+	SaveCustomRole(role atc.CustomRole) error
+	DestroyCustomRole(name string) (bool, error)
 }
 
 type team struct {
@@ -86,14 +105,19 @@ type team struct {
 	name  string
 	admin bool
 
-	auth atc.TeamAuth
+	auth        atc.TeamAuth
+	quota       atc.TeamQuota
+	customRoles atc.CustomRoles
 }
 
 func (t *team) ID() int      { return t.id }
 func (t *team) Name() string { return t.name }
 func (t *team) Admin() bool  { return t.admin }
 
-func (t *team) Auth() atc.TeamAuth { return t.auth }
+func (t *team) Auth() atc.TeamAuth   { return t.auth }
+func (t *team) Quota() atc.TeamQuota { return t.quota }
+
+func (t *team) CustomRoles() atc.CustomRoles { return t.customRoles }
 
 func (t *team) Delete() error {
 	_, err := psql.Delete("teams").
@@ -401,6 +425,7 @@ func savePipeline(
 	teamID int,
 	jobID sql.NullInt64,
 	buildID sql.NullInt64,
+	createdBy sql.NullString,
 ) (int, bool, error) {
 
 	var instanceVars sql.NullString
@@ -594,7 +619,12 @@ func savePipeline(
 		return 0, false, err
 	}
 
-	err = insertJobPipes(tx, config.Jobs, resourceNameToID, jobNameToID, pipelineID)
+	err = removeUnusedTaskResultCaches(tx, pipelineID, config.Jobs)
+	if err != nil {
+		return 0, false, err
+	}
+
+	err = insertJobPipes(tx, config.Jobs, resourceNameToID, jobNameToID, pipelineID, teamID)
 	if err != nil {
 		return 0, false, err
 	}
@@ -604,6 +634,11 @@ func savePipeline(
 		return 0, false, err
 	}
 
+	err = recordPipelineConfig(tx, pipelineID, config, createdBy, buildID)
+	if err != nil {
+		return 0, false, err
+	}
+
 	return pipelineID, !existingConfig, nil
 }
 
@@ -612,6 +647,7 @@ func (t *team) SavePipeline(
 	config atc.Config,
 	from ConfigVersion,
 	initiallyPaused bool,
+	createdBy string,
 ) (Pipeline, bool, error) {
 	tx, err := t.conn.Begin()
 	if err != nil {
@@ -621,7 +657,7 @@ func (t *team) SavePipeline(
 	defer Rollback(tx)
 
 	nullID := sql.NullInt64{Valid: false}
-	pipelineID, isNewPipeline, err := savePipeline(tx, pipelineRef, config, from, initiallyPaused, t.id, nullID, nullID)
+	pipelineID, isNewPipeline, err := savePipeline(tx, pipelineRef, config, from, initiallyPaused, t.id, nullID, nullID, newNullString(createdBy))
 	if err != nil {
 		return nil, false, err
 	}
@@ -892,6 +928,11 @@ func (t *team) CreateStartedBuild(plan atc.Plan) (Build, error) {
 		return nil, err
 	}
 
+	err = build.queueNotifications(tx, BuildStatusStarted, build.StartTime(), time.Time{})
+	if err != nil {
+		return nil, err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return nil, err
@@ -923,6 +964,23 @@ func (t *team) Builds(page Page) ([]Build, Pagination, error) {
 	return getBuildsWithPagination(buildsQuery.Where(sq.Eq{"t.id": t.id}), minMaxIdQuery, page, t.conn, t.lockFactory)
 }
 
+func (t *team) BuildLifecycleEvents() (BuildLifecycleSource, error) {
+	notifier, err := newConditionNotifier(t.conn.Bus(), teamBuildsChannel(t.id), func() (bool, error) {
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := newBuildLifecycleSource(t.id, t.conn, t.lockFactory, notifier)
+	if err != nil {
+		_ = notifier.Close()
+		return nil, err
+	}
+
+	return source, nil
+}
+
 func (t *team) SaveWorker(atcWorker atc.Worker, ttl time.Duration) (Worker, error) {
 	tx, err := t.conn.Begin()
 	if err != nil {
@@ -960,7 +1018,7 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 		UPDATE teams
 		SET auth = $1, legacy_auth = NULL, nonce = NULL
 		WHERE id = $2
-		RETURNING id, name, admin, auth, nonce
+		RETURNING id, name, admin, auth, nonce, quota, custom_roles
 	`
 	err = t.queryTeam(tx, query, jsonEncodedProviderAuth, t.id)
 	if err != nil {
@@ -970,6 +1028,141 @@ func (t *team) UpdateProviderAuth(auth atc.TeamAuth) error {
 	return tx.Commit()
 }
 
+func (t *team) UpdateQuota(quota atc.TeamQuota) error {
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return err
+	}
+	defer Rollback(tx)
+
+	jsonEncodedQuota, err := marshalTeamQuota(quota)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE teams
+		SET quota = $1
+		WHERE id = $2
+		RETURNING id, name, admin, auth, nonce, quota, custom_roles
+	`
+	err = t.queryTeam(tx, query, jsonEncodedQuota, t.id)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// SaveCustomRole creates the custom role, or replaces the one with the same
+// name.
+func (t *team) SaveCustomRole(role atc.CustomRole) error {
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return err
+	}
+	defer Rollback(tx)
+
+	roles, err := t.lockCustomRoles(tx)
+	if err != nil {
+		return err
+	}
+
+	replaced := false
+	for i, existing := range roles {
+		if existing.Name == role.Name {
+			roles[i] = role
+			replaced = true
+		}
+	}
+
+	if !replaced {
+		roles = append(roles, role)
+	}
+
+	err = t.updateCustomRoles(tx, roles)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func (t *team) DestroyCustomRole(name string) (bool, error) {
+	tx, err := t.conn.Begin()
+	if err != nil {
+		return false, err
+	}
+	defer Rollback(tx)
+
+	roles, err := t.lockCustomRoles(tx)
+	if err != nil {
+		return false, err
+	}
+
+	remaining := atc.CustomRoles{}
+	for _, role := range roles {
+		if role.Name != name {
+			remaining = append(remaining, role)
+		}
+	}
+
+	if len(remaining) == len(roles) {
+		return false, nil
+	}
+
+	err = t.updateCustomRoles(tx, remaining)
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (t *team) lockCustomRoles(tx Tx) (atc.CustomRoles, error) {
+	var payload sql.NullString
+	err := psql.Select("custom_roles").
+		From("teams").
+		Where(sq.Eq{"id": t.id}).
+		Suffix("FOR UPDATE").
+		RunWith(tx).
+		QueryRow().
+		Scan(&payload)
+	if err != nil {
+		return nil, err
+	}
+
+	var roles atc.CustomRoles
+	if payload.Valid {
+		err = json.Unmarshal([]byte(payload.String), &roles)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return roles, nil
+}
+
+func (t *team) updateCustomRoles(tx Tx, roles atc.CustomRoles) error {
+	jsonEncodedRoles, err := marshalCustomRoles(roles)
+	if err != nil {
+		return err
+	}
+
+	query := `
+		UPDATE teams
+		SET custom_roles = $1
+		WHERE id = $2
+		RETURNING id, name, admin, auth, nonce, quota, custom_roles
+	`
+	return t.queryTeam(tx, query, jsonEncodedRoles, t.id)
+}
+
 func (t *team) FindCheckContainers(logger lager.Logger, pipelineRef atc.PipelineRef, resourceName string, secretManager creds.Secrets, varSourcePool creds.VarSourcePool) ([]Container, map[int]time.Time, error) {
 	pipeline, found, err := t.Pipeline(pipelineRef)
 	if err != nil {
@@ -1243,11 +1436,24 @@ func saveJob(tx Tx, job atc.JobConfig, pipelineID int, groups []string) (int, er
 		return 0, err
 	}
 
+	var schedule *string
+	if job.Schedule != nil {
+		schedulePayload, err := json.Marshal(job.Schedule)
+		if err != nil {
+			return 0, err
+		}
+
+		scheduleString := string(schedulePayload)
+		schedule = &scheduleString
+	}
+
+	// schedule_last_fired is reset whenever the schedule changes so that a
+	// new schedule does not fire for times before it was configured
 	var jobID int
 	err = psql.Insert("jobs").
-		Columns("name", "pipeline_id", "config", "public", "max_in_flight", "disable_manual_trigger", "interruptible", "active", "nonce", "tags").
-		Values(job.Name, pipelineID, encryptedPayload, job.Public, job.MaxInFlight(), job.DisableManualTrigger, job.Interruptible, true, nonce, pq.Array(groups)).
-		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, public = EXCLUDED.public, max_in_flight = EXCLUDED.max_in_flight, disable_manual_trigger = EXCLUDED.disable_manual_trigger, interruptible = EXCLUDED.interruptible, active = EXCLUDED.active, nonce = EXCLUDED.nonce, tags = EXCLUDED.tags").
+		Columns("name", "pipeline_id", "config", "public", "max_in_flight", "disable_manual_trigger", "interruptible", "active", "nonce", "tags", "schedule").
+		Values(job.Name, pipelineID, encryptedPayload, job.Public, job.MaxInFlight(), job.DisableManualTrigger, job.Interruptible, true, nonce, pq.Array(groups), schedule).
+		Suffix("ON CONFLICT (name, pipeline_id) DO UPDATE SET config = EXCLUDED.config, public = EXCLUDED.public, max_in_flight = EXCLUDED.max_in_flight, disable_manual_trigger = EXCLUDED.disable_manual_trigger, interruptible = EXCLUDED.interruptible, active = EXCLUDED.active, nonce = EXCLUDED.nonce, tags = EXCLUDED.tags, schedule = EXCLUDED.schedule, schedule_last_fired = CASE WHEN jobs.schedule IS DISTINCT FROM EXCLUDED.schedule THEN now() ELSE jobs.schedule_last_fired END").
 		Suffix("RETURNING id").
 		RunWith(tx).
 		QueryRow().
@@ -1524,7 +1730,7 @@ func scanContainers(rows *sql.Rows, conn Conn, initContainers []Container) ([]Co
 }
 
 func (t *team) queryTeam(tx Tx, query string, params ...interface{}) error {
-	var providerAuth, nonce sql.NullString
+	var providerAuth, nonce, quota, customRoles sql.NullString
 
 	err := tx.QueryRow(query, params...).Scan(
 		&t.id,
@@ -1532,11 +1738,29 @@ func (t *team) queryTeam(tx Tx, query string, params ...interface{}) error {
 		&t.admin,
 		&providerAuth,
 		&nonce,
+		&quota,
+		&customRoles,
 	)
 	if err != nil {
 		return err
 	}
 
+	t.customRoles = nil
+	if customRoles.Valid {
+		err = json.Unmarshal([]byte(customRoles.String), &t.customRoles)
+		if err != nil {
+			return err
+		}
+	}
+
+	t.quota = atc.TeamQuota{}
+	if quota.Valid {
+		err = json.Unmarshal([]byte(quota.String), &t.quota)
+		if err != nil {
+			return err
+		}
+	}
+
 	if providerAuth.Valid {
 		var auth atc.TeamAuth
 		err = json.Unmarshal([]byte(providerAuth.String), &auth)
@@ -1659,7 +1883,7 @@ func saveJobsAndSerialGroups(tx Tx, jobs atc.JobConfigs, groups atc.GroupConfigs
 	return jobNameToID, nil
 }
 
-func insertJobPipes(tx Tx, jobConfigs atc.JobConfigs, resourceNameToID map[string]int, jobNameToID map[string]int, pipelineID int) error {
+func insertJobPipes(tx Tx, jobConfigs atc.JobConfigs, resourceNameToID map[string]int, jobNameToID map[string]int, pipelineID int, teamID int) error {
 	_, err := psql.Delete("job_inputs").
 		Where(sq.Expr(`job_id in (
         SELECT j.id
@@ -1687,7 +1911,7 @@ func insertJobPipes(tx Tx, jobConfigs atc.JobConfigs, resourceNameToID map[strin
 	for _, jobConfig := range jobConfigs {
 		err := jobConfig.StepConfig().Visit(atc.StepRecursor{
 			OnGet: func(step *atc.GetStep) error {
-				return insertJobInput(tx, step, jobConfig.Name, resourceNameToID, jobNameToID)
+				return insertJobInput(tx, step, jobConfig.Name, resourceNameToID, jobNameToID, teamID)
 			},
 			OnPut: func(step *atc.PutStep) error {
 				return insertJobOutput(tx, step, jobConfig.Name, resourceNameToID, jobNameToID)
@@ -1701,9 +1925,14 @@ func insertJobPipes(tx Tx, jobConfigs atc.JobConfigs, resourceNameToID map[strin
 	return nil
 }
 
-func insertJobInput(tx Tx, step *atc.GetStep, jobName string, resourceNameToID map[string]int, jobNameToID map[string]int) error {
+func insertJobInput(tx Tx, step *atc.GetStep, jobName string, resourceNameToID map[string]int, jobNameToID map[string]int, teamID int) error {
 	if len(step.Passed) != 0 {
 		for _, passedJob := range step.Passed {
+			passedJobID, err := passedJobID(tx, passedJob, jobNameToID, teamID)
+			if err != nil {
+				return err
+			}
+
 			var version sql.NullString
 			if step.Version != nil {
 				versionJSON, err := step.Version.MarshalJSON()
@@ -1714,9 +1943,9 @@ func insertJobInput(tx Tx, step *atc.GetStep, jobName string, resourceNameToID m
 				version = sql.NullString{Valid: true, String: string(versionJSON)}
 			}
 
-			_, err := psql.Insert("job_inputs").
-				Columns("name", "job_id", "resource_id", "passed_job_id", "trigger", "version").
-				Values(step.Name, jobNameToID[jobName], resourceNameToID[step.ResourceName()], jobNameToID[passedJob], step.Trigger, version).
+			_, err = psql.Insert("job_inputs").
+				Columns("name", "job_id", "resource_id", "passed_job_id", "passed_job_name", "trigger", "version").
+				Values(step.Name, jobNameToID[jobName], resourceNameToID[step.ResourceName()], passedJobID, passedJob, step.Trigger, version).
 				RunWith(tx).
 				Exec()
 			if err != nil {
@@ -1747,6 +1976,57 @@ func insertJobInput(tx Tx, step *atc.GetStep, jobName string, resourceNameToID m
 	return nil
 }
 
+// passedJobID finds the job referred to by a passed constraint. Jobs in other
+// pipelines must belong to the same team and be active.
+func passedJobID(tx Tx, passed string, jobNameToID map[string]int, teamID int) (int, error) {
+	if id, found := jobNameToID[passed]; found {
+		return id, nil
+	}
+
+	passedJob, err := atc.ParsePassedJob(passed)
+	if err != nil {
+		return 0, err
+	}
+
+	if !passedJob.IsCrossPipeline() {
+		return 0, ErrPassedJobNotFound(passedJob)
+	}
+
+	var instanceVars sql.NullString
+	if passedJob.Pipeline.InstanceVars != nil {
+		instanceVarsPayload, err := json.Marshal(passedJob.Pipeline.InstanceVars)
+		if err != nil {
+			return 0, err
+		}
+
+		instanceVars = sql.NullString{Valid: true, String: string(instanceVarsPayload)}
+	}
+
+	var id int
+	err = psql.Select("j.id").
+		From("jobs j").
+		Join("pipelines p ON p.id = j.pipeline_id").
+		Where(sq.Eq{
+			"p.team_id":       teamID,
+			"p.name":          passedJob.Pipeline.Name,
+			"p.instance_vars": instanceVars,
+			"j.name":          passedJob.Job,
+			"j.active":        true,
+		}).
+		RunWith(tx).
+		QueryRow().
+		Scan(&id)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, ErrPassedJobNotFound(passedJob)
+		}
+
+		return 0, err
+	}
+
+	return id, nil
+}
+
 func insertJobOutput(tx Tx, step *atc.PutStep, jobName string, resourceNameToID map[string]int, jobNameToID map[string]int) error {
 	_, err := psql.Insert("job_outputs").
 		Columns("name", "job_id", "resource_id").
@@ -1,7 +1,9 @@
 package db
 
 import (
+	"bytes"
 	"database/sql"
+	"errors"
 	"strings"
 
 	"encoding/json"
@@ -11,9 +13,20 @@ import (
 	"github.com/concourse/concourse/atc/db/lock"
 )
 
+var ErrLastAdminTeam = errors.New("cannot destroy the last admin team")
+
+// TeamChanges lists the names of the teams created, updated and destroyed by
+// SetTeams.
+type TeamChanges struct {
+	Created []string
+	Updated []string
+	Deleted []string
+}
+
 //counterfeiter:generate . TeamFactory
 type TeamFactory interface {
 	CreateTeam(atc.Team) (Team, error)
+	SetTeams(teams atc.Teams, deleteUnlisted bool) (TeamChanges, error)
 	FindTeam(string) (Team, bool, error)
 	GetTeams() ([]Team, error)
 	GetByID(teamID int) Team
@@ -51,10 +64,18 @@ func (factory *teamFactory) createTeam(t atc.Team, admin bool) (Team, error) {
 		return nil, err
 	}
 
+	var quota interface{}
+	if t.Quota != nil {
+		quota, err = marshalTeamQuota(*t.Quota)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	row := psql.Insert("teams").
-		Columns("name, auth, admin").
-		Values(t.Name, auth, admin).
-		Suffix("RETURNING id, name, admin, auth").
+		Columns("name, auth, admin, quota").
+		Values(t.Name, auth, admin, quota).
+		Suffix("RETURNING id, name, admin, auth, quota, custom_roles").
 		RunWith(tx).
 		QueryRow()
 
@@ -76,6 +97,150 @@ func (factory *teamFactory) createTeam(t atc.Team, admin bool) (Team, error) {
 	return team, nil
 }
 
+// SetTeams creates or updates every team in teams within one transaction,
+// so that either all of them are configured or none of them are. Teams which
+// are not listed are destroyed if deleteUnlisted is set; ErrLastAdminTeam is
+// returned if that would leave no admin team.
+func (factory *teamFactory) SetTeams(teams atc.Teams, deleteUnlisted bool) (TeamChanges, error) {
+	tx, err := factory.conn.Begin()
+	if err != nil {
+		return TeamChanges{}, err
+	}
+
+	defer Rollback(tx)
+
+	rows, err := psql.Select("id, name, admin, auth, quota, custom_roles").
+		From("teams").
+		OrderBy("name ASC").
+		Suffix("FOR UPDATE").
+		RunWith(tx).
+		Query()
+	if err != nil {
+		return TeamChanges{}, err
+	}
+
+	var existing []*team
+	for rows.Next() {
+		t := &team{}
+
+		err = factory.scanTeam(t, rows)
+		if err != nil {
+			Close(rows)
+			return TeamChanges{}, err
+		}
+
+		existing = append(existing, t)
+	}
+
+	Close(rows)
+
+	var changes TeamChanges
+	for _, desired := range teams {
+		var quota atc.TeamQuota
+		if desired.Quota != nil {
+			quota = *desired.Quota
+		}
+
+		var current *team
+		for _, t := range existing {
+			if strings.EqualFold(t.name, desired.Name) {
+				current = t
+				break
+			}
+		}
+
+		auth, err := json.Marshal(desired.Auth)
+		if err != nil {
+			return TeamChanges{}, err
+		}
+
+		quotaPayload, err := marshalTeamQuota(quota)
+		if err != nil {
+			return TeamChanges{}, err
+		}
+
+		if current == nil {
+			_, err = psql.Insert("teams").
+				Columns("name, auth, admin, quota").
+				Values(desired.Name, auth, false, quotaPayload).
+				RunWith(tx).
+				Exec()
+			if err != nil {
+				return TeamChanges{}, err
+			}
+
+			changes.Created = append(changes.Created, desired.Name)
+			continue
+		}
+
+		currentAuth, err := json.Marshal(current.auth)
+		if err != nil {
+			return TeamChanges{}, err
+		}
+
+		if bytes.Equal(currentAuth, auth) && current.quota == quota {
+			continue
+		}
+
+		_, err = psql.Update("teams").
+			Set("auth", auth).
+			Set("legacy_auth", nil).
+			Set("nonce", nil).
+			Set("quota", quotaPayload).
+			Where(sq.Eq{"id": current.id}).
+			RunWith(tx).
+			Exec()
+		if err != nil {
+			return TeamChanges{}, err
+		}
+
+		changes.Updated = append(changes.Updated, current.name)
+	}
+
+	if deleteUnlisted {
+		var deleteIDs []int
+		var deletesAdmin bool
+		remainingAdmins := 0
+		for _, t := range existing {
+			if _, found := teams.Lookup(t.name); found {
+				if t.admin {
+					remainingAdmins++
+				}
+
+				continue
+			}
+
+			if t.admin {
+				deletesAdmin = true
+			}
+
+			deleteIDs = append(deleteIDs, t.id)
+			changes.Deleted = append(changes.Deleted, t.name)
+		}
+
+		if deletesAdmin && remainingAdmins == 0 {
+			return TeamChanges{}, ErrLastAdminTeam
+		}
+
+		if len(deleteIDs) > 0 {
+			_, err = psql.Delete("teams").
+				Where(sq.Eq{"id": deleteIDs}).
+				RunWith(tx).
+				Exec()
+			if err != nil {
+				return TeamChanges{}, err
+			}
+		}
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return TeamChanges{}, err
+	}
+
+	return changes, nil
+}
+
 func (factory *teamFactory) GetByID(teamID int) Team {
 	return &team{
 		id:          teamID,
@@ -90,7 +255,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 		lockFactory: factory.lockFactory,
 	}
 
-	row := psql.Select("id, name, admin, auth").
+	row := psql.Select("id, name, admin, auth, quota, custom_roles").
 		From("teams").
 		Where(sq.Eq{"LOWER(name)": strings.ToLower(teamName)}).
 		RunWith(factory.conn).
@@ -109,7 +274,7 @@ func (factory *teamFactory) FindTeam(teamName string) (Team, bool, error) {
 }
 
 func (factory *teamFactory) GetTeams() ([]Team, error) {
-	rows, err := psql.Select("id, name, admin, auth").
+	rows, err := psql.Select("id, name, admin, auth, quota, custom_roles").
 		From("teams").
 		OrderBy("name ASC").
 		RunWith(factory.conn).
@@ -175,13 +340,15 @@ func (factory *teamFactory) NotifyCacher() error {
 }
 
 func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
-	var providerAuth sql.NullString
+	var providerAuth, quota, customRoles sql.NullString
 
 	err := rows.Scan(
 		&t.id,
 		&t.name,
 		&t.admin,
 		&providerAuth,
+		&quota,
+		&customRoles,
 	)
 
 	if providerAuth.Valid {
@@ -191,5 +358,35 @@ func (factory *teamFactory) scanTeam(t *team, rows scannable) error {
 		}
 	}
 
+	if quota.Valid {
+		err = json.Unmarshal([]byte(quota.String), &t.quota)
+		if err != nil {
+			return err
+		}
+	}
+
+	if customRoles.Valid {
+		err = json.Unmarshal([]byte(customRoles.String), &t.customRoles)
+		if err != nil {
+			return err
+		}
+	}
+
 	return err
 }
+
+func marshalTeamQuota(quota atc.TeamQuota) (interface{}, error) {
+	if quota.IsZero() {
+		return nil, nil
+	}
+
+	return json.Marshal(quota)
+}
+
+func marshalCustomRoles(roles atc.CustomRoles) (interface{}, error) {
+	if len(roles) == 0 {
+		return nil, nil
+	}
+
+	return json.Marshal(roles)
+}
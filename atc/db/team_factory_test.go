@@ -38,6 +38,22 @@ var _ = Describe("Team Factory", func() {
 			Expect(found).To(BeTrue())
 			Expect(t.ID()).To(Equal(team.ID()))
 		})
+
+		Context("when the team has a quota", func() {
+			BeforeEach(func() {
+				atcTeam.Name = "some-team-with-quota"
+				atcTeam.Quota = &atc.TeamQuota{MaxActiveTasks: 5}
+			})
+
+			It("saves the quota", func() {
+				Expect(team.Quota()).To(Equal(atc.TeamQuota{MaxActiveTasks: 5}))
+
+				t, found, err := teamFactory.FindTeam(atcTeam.Name)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(t.Quota()).To(Equal(atc.TeamQuota{MaxActiveTasks: 5}))
+			})
+		})
 	})
 
 	Describe("FindTeam", func() {
@@ -156,4 +172,112 @@ var _ = Describe("Team Factory", func() {
 			})
 		})
 	})
+
+	Describe("SetTeams", func() {
+		var (
+			teams          atc.Teams
+			deleteUnlisted bool
+
+			changes db.TeamChanges
+			setErr  error
+		)
+
+		BeforeEach(func() {
+			_, err := teamFactory.CreateTeam(atcTeam)
+			Expect(err).ToNot(HaveOccurred())
+
+			teams = atc.Teams{
+				atcTeam,
+				{
+					Name:  "some-new-team",
+					Auth:  atc.TeamAuth{"owner": {"groups": []string{"github:some-org"}}},
+					Quota: &atc.TeamQuota{MaxActiveContainers: 10},
+				},
+			}
+
+			deleteUnlisted = false
+		})
+
+		JustBeforeEach(func() {
+			changes, setErr = teamFactory.SetTeams(teams, deleteUnlisted)
+		})
+
+		It("creates the teams which do not exist", func() {
+			Expect(setErr).ToNot(HaveOccurred())
+			Expect(changes.Created).To(Equal([]string{"some-new-team"}))
+
+			t, found, err := teamFactory.FindTeam("some-new-team")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(t.Admin()).To(BeFalse())
+			Expect(t.Auth()).To(Equal(teams[1].Auth))
+			Expect(t.Quota()).To(Equal(atc.TeamQuota{MaxActiveContainers: 10}))
+		})
+
+		It("leaves unchanged teams alone", func() {
+			Expect(setErr).ToNot(HaveOccurred())
+			Expect(changes.Updated).To(BeEmpty())
+		})
+
+		It("leaves unlisted teams alone", func() {
+			Expect(setErr).ToNot(HaveOccurred())
+			Expect(changes.Deleted).To(BeEmpty())
+
+			_, found, err := teamFactory.FindTeam(defaultTeam.Name())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found).To(BeTrue())
+		})
+
+		Context("when an existing team's config changes", func() {
+			BeforeEach(func() {
+				teams[0].Auth = atc.TeamAuth{"viewer": {"users": []string{"local:some-viewer"}}}
+				teams[0].Quota = &atc.TeamQuota{MaxActiveTasks: 2}
+			})
+
+			It("updates it", func() {
+				Expect(setErr).ToNot(HaveOccurred())
+				Expect(changes.Updated).To(Equal([]string{atcTeam.Name}))
+
+				t, found, err := teamFactory.FindTeam(atcTeam.Name)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(t.Auth()).To(Equal(teams[0].Auth))
+				Expect(t.Quota()).To(Equal(atc.TeamQuota{MaxActiveTasks: 2}))
+			})
+		})
+
+		Context("when unlisted teams are to be deleted", func() {
+			BeforeEach(func() {
+				deleteUnlisted = true
+			})
+
+			It("deletes them", func() {
+				Expect(setErr).ToNot(HaveOccurred())
+				Expect(changes.Deleted).To(Equal([]string{defaultTeam.Name()}))
+
+				_, found, err := teamFactory.FindTeam(defaultTeam.Name())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+
+			Context("when that would delete the last admin team", func() {
+				BeforeEach(func() {
+					_, err := teamFactory.CreateDefaultTeamIfNotExists()
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("returns ErrLastAdminTeam and changes nothing", func() {
+					Expect(setErr).To(Equal(db.ErrLastAdminTeam))
+
+					_, found, err := teamFactory.FindTeam(atc.DefaultTeamName)
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					_, found, err = teamFactory.FindTeam("some-new-team")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeFalse())
+				})
+			})
+		})
+	})
 })
@@ -0,0 +1,86 @@
+package db
+
+import (
+	"database/sql"
+	"encoding/json"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/concourse/concourse/atc"
+)
+
+// TeamQuotaUsage is how much of its quota a team is currently using.
+type TeamQuotaUsage struct {
+	ActiveTasks      int
+	ActiveContainers int
+}
+
+//counterfeiter:generate . TeamQuotaRepository
+type TeamQuotaRepository interface {
+	// FindTeamQuota returns the quota configured for the team along with the
+	// number of containers its running builds currently have on shared
+	// workers. The usage is only counted when the team has a quota.
+	FindTeamQuota(teamID int) (atc.TeamQuota, TeamQuotaUsage, error)
+}
+
+type teamQuotaRepository struct {
+	conn Conn
+}
+
+func NewTeamQuotaRepository(conn Conn) TeamQuotaRepository {
+	return &teamQuotaRepository{
+		conn: conn,
+	}
+}
+
+func (repository *teamQuotaRepository) FindTeamQuota(teamID int) (atc.TeamQuota, TeamQuotaUsage, error) {
+	var quota atc.TeamQuota
+	var usage TeamQuotaUsage
+
+	var quotaJSON sql.NullString
+	err := psql.Select("quota").
+		From("teams").
+		Where(sq.Eq{"id": teamID}).
+		RunWith(repository.conn).
+		QueryRow().
+		Scan(&quotaJSON)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return quota, usage, nil
+		}
+
+		return quota, usage, err
+	}
+
+	if !quotaJSON.Valid {
+		return quota, usage, nil
+	}
+
+	err = json.Unmarshal([]byte(quotaJSON.String), &quota)
+	if err != nil {
+		return quota, usage, err
+	}
+
+	// containers of completed builds are left around for hijacking until they
+	// are garbage collected, so only those of running builds are counted
+	err = psql.Select(
+		"COUNT(*)",
+		"COUNT(*) FILTER (WHERE c.meta_type = '"+string(ContainerTypeTask)+"')",
+	).
+		From("containers c").
+		Join("workers w ON w.name = c.worker_name").
+		Join("builds b ON b.id = c.build_id").
+		Where(sq.Eq{
+			"c.team_id":   teamID,
+			"c.state":     []string{atc.ContainerStateCreating, atc.ContainerStateCreated},
+			"w.team_id":   nil,
+			"b.completed": false,
+		}).
+		RunWith(repository.conn).
+		QueryRow().
+		Scan(&usage.ActiveContainers, &usage.ActiveTasks)
+	if err != nil {
+		return quota, usage, err
+	}
+
+	return quota, usage, nil
+}
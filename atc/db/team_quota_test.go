@@ -0,0 +1,95 @@
+package db_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("TeamQuotaRepository", func() {
+	var (
+		repository db.TeamQuotaRepository
+		build      db.Build
+
+		quota atc.TeamQuota
+		usage db.TeamQuotaUsage
+	)
+
+	BeforeEach(func() {
+		repository = db.NewTeamQuotaRepository(dbConn)
+
+		var err error
+		build, err = defaultJob.CreateBuild(defaultBuildCreatedBy)
+		Expect(err).ToNot(HaveOccurred())
+
+		createContainer := func(worker db.Worker, planID atc.PlanID, containerType db.ContainerType) db.CreatingContainer {
+			container, err := worker.CreateContainer(
+				db.NewBuildStepContainerOwner(build.ID(), planID, defaultTeam.ID()),
+				db.ContainerMetadata{Type: containerType},
+			)
+			Expect(err).ToNot(HaveOccurred())
+			return container
+		}
+
+		createContainer(defaultWorker, "some-task", db.ContainerTypeTask)
+		createContainer(defaultWorker, "some-get", db.ContainerTypeGet)
+
+		_, err = createContainer(otherWorker, "some-other-task", db.ContainerTypeTask).Created()
+		Expect(err).ToNot(HaveOccurred())
+
+		_, err = createContainer(otherWorker, "some-put", db.ContainerTypePut).Failed()
+		Expect(err).ToNot(HaveOccurred())
+
+		teamWorker, err := defaultTeam.SaveWorker(atc.Worker{
+			Name:            "some-team-worker",
+			GardenAddr:      "3.4.5.6:7777",
+			BaggageclaimURL: "7.8.9.10:7878",
+		}, 0)
+		Expect(err).ToNot(HaveOccurred())
+
+		createContainer(teamWorker, "some-team-task", db.ContainerTypeTask)
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		quota, usage, err = repository.FindTeamQuota(defaultTeam.ID())
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Context("when the team has no quota", func() {
+		It("does not count its usage", func() {
+			Expect(quota).To(BeZero())
+			Expect(usage).To(BeZero())
+		})
+	})
+
+	Context("when the team has a quota", func() {
+		BeforeEach(func() {
+			err := defaultTeam.UpdateQuota(atc.TeamQuota{MaxActiveTasks: 2, MaxActiveContainers: 5})
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the quota", func() {
+			Expect(quota).To(Equal(atc.TeamQuota{MaxActiveTasks: 2, MaxActiveContainers: 5}))
+		})
+
+		It("counts the active containers of running builds on shared workers", func() {
+			Expect(usage).To(Equal(db.TeamQuotaUsage{
+				ActiveTasks:      2,
+				ActiveContainers: 3,
+			}))
+		})
+
+		Context("when the build has completed", func() {
+			BeforeEach(func() {
+				err := build.Finish(db.BuildStatusSucceeded)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("no longer counts its containers", func() {
+				Expect(usage).To(BeZero())
+			})
+		})
+	})
+})
@@ -970,6 +970,95 @@ var _ = Describe("Team", func() {
 		})
 	})
 
+	Describe("UpdateQuota", func() {
+		It("saves the quota to the existing team", func() {
+			quota := atc.TeamQuota{MaxActiveTasks: 2, MaxActiveContainers: 10}
+
+			err := team.UpdateQuota(quota)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(team.Quota()).To(Equal(quota))
+
+			found, _, err := teamFactory.FindTeam(team.Name())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found.Quota()).To(Equal(quota))
+		})
+
+		It("clears the quota when given an empty one", func() {
+			err := team.UpdateQuota(atc.TeamQuota{MaxActiveTasks: 2})
+			Expect(err).ToNot(HaveOccurred())
+
+			err = team.UpdateQuota(atc.TeamQuota{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(team.Quota()).To(BeZero())
+
+			var quota sql.NullString
+			err = dbConn.QueryRow("SELECT quota FROM teams WHERE id = $1", team.ID()).Scan(&quota)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(quota.Valid).To(BeFalse())
+		})
+	})
+
+	Describe("custom roles", func() {
+		var releaser atc.CustomRole
+
+		BeforeEach(func() {
+			releaser = atc.CustomRole{
+				Name:        "releaser",
+				Permissions: []string{atc.CreateJobBuild},
+				Pipelines:   []string{"release-*"},
+			}
+
+			err := team.SaveCustomRole(releaser)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("saves the role to the team", func() {
+			Expect(team.CustomRoles()).To(Equal(atc.CustomRoles{releaser}))
+
+			found, _, err := teamFactory.FindTeam(team.Name())
+			Expect(err).ToNot(HaveOccurred())
+			Expect(found.CustomRoles()).To(Equal(atc.CustomRoles{releaser}))
+		})
+
+		It("replaces a role with the same name", func() {
+			releaser.Permissions = []string{atc.CreateJobBuild, atc.AbortBuild}
+
+			err := team.SaveCustomRole(releaser)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(team.CustomRoles()).To(Equal(atc.CustomRoles{releaser}))
+		})
+
+		It("keeps the other roles", func() {
+			auditor := atc.CustomRole{
+				Name:        "auditor",
+				Permissions: []string{atc.GetConfig},
+			}
+
+			err := team.SaveCustomRole(auditor)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(team.CustomRoles()).To(Equal(atc.CustomRoles{releaser, auditor}))
+		})
+
+		Describe("DestroyCustomRole", func() {
+			It("removes the role", func() {
+				destroyed, err := team.DestroyCustomRole("releaser")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(destroyed).To(BeTrue())
+				Expect(team.CustomRoles()).To(BeEmpty())
+
+				found, _, err := teamFactory.FindTeam(team.Name())
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found.CustomRoles()).To(BeEmpty())
+			})
+
+			It("returns false when the role does not exist", func() {
+				destroyed, err := team.DestroyCustomRole("bogus")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(destroyed).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("Pipelines", func() {
 		var (
 			pipelines []db.Pipeline
@@ -991,21 +1080,21 @@ var _ = Describe("Team", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline", InstanceVars: atc.InstanceVars{"branch": "feature/foo"}}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline3, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-two"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -1029,7 +1118,7 @@ var _ = Describe("Team", func() {
 						Jobs: atc.JobConfigs{
 							{Name: "job-name"},
 						},
-					}, db.ConfigVersion(1), false)
+					}, db.ConfigVersion(1), false, "")
 					Expect(err).ToNot(HaveOccurred())
 				})
 
@@ -1074,14 +1163,14 @@ var _ = Describe("Team", func() {
 					Jobs: atc.JobConfigs{
 						{Name: "job-name"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "fake-pipeline-two"}, atc.Config{
 					Jobs: atc.JobConfigs{
 						{Name: "job-fake"},
 					},
-				}, db.ConfigVersion(1), false)
+				}, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				err = pipeline2.Expose()
@@ -1116,19 +1205,19 @@ var _ = Describe("Team", func() {
 
 		BeforeEach(func() {
 			var err error
-			instancePipeline1, _, err = team.SavePipeline(atc.PipelineRef{Name: "group", InstanceVars: atc.InstanceVars{"branch": "master"}}, atc.Config{}, 0, false)
+			instancePipeline1, _, err = team.SavePipeline(atc.PipelineRef{Name: "group", InstanceVars: atc.InstanceVars{"branch": "master"}}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
-			instancePipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "group", InstanceVars: atc.InstanceVars{"branch": "feature/foo"}}, atc.Config{}, 0, false)
+			instancePipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "group", InstanceVars: atc.InstanceVars{"branch": "feature/foo"}}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			pipeline1, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline1"}, atc.Config{}, 0, false)
+			pipeline1, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline1"}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
-			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline2"}, atc.Config{}, 0, false)
+			pipeline2, _, err = team.SavePipeline(atc.PipelineRef{Name: "pipeline2"}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherTeamPipeline1, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "pipeline1"}, atc.Config{}, 0, false)
+			otherTeamPipeline1, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "pipeline1"}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
-			otherTeamPipeline2, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "pipeline2"}, atc.Config{}, 0, false)
+			otherTeamPipeline2, _, err = otherTeam.SavePipeline(atc.PipelineRef{Name: "pipeline2"}, atc.Config{}, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 		})
 
@@ -1241,7 +1330,7 @@ var _ = Describe("Team", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(found).To(BeTrue())
 
-			events, err := startedBuild.Events(0)
+			events, err := startedBuild.Events(context.TODO(), 0)
 			Expect(err).NotTo(HaveOccurred())
 
 			defer db.Close(events)
@@ -1285,7 +1374,7 @@ var _ = Describe("Team", func() {
 					},
 				}
 				var err error
-				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
+				pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job, found, err := pipeline.Job("some-job")
@@ -1436,7 +1525,7 @@ var _ = Describe("Team", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1542,7 +1631,7 @@ var _ = Describe("Team", func() {
 					},
 				},
 			}
-			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false)
+			pipeline, _, err = team.SavePipeline(atc.PipelineRef{Name: "some-pipeline"}, config, db.ConfigVersion(1), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -1684,6 +1773,114 @@ var _ = Describe("Team", func() {
 		})
 	})
 
+	Describe("BuildLifecycleEvents", func() {
+		var events db.BuildLifecycleSource
+
+		BeforeEach(func() {
+			var err error
+			events, err = defaultTeam.BuildLifecycleEvents()
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			Expect(events.Close()).To(Succeed())
+		})
+
+		expectEvent := func(eventType atc.BuildLifecycleEventType, build db.Build, status db.BuildStatus) {
+			ev, err := events.Next()
+			ExpectWithOffset(1, err).ToNot(HaveOccurred())
+			ExpectWithOffset(1, ev.Type).To(Equal(eventType))
+			ExpectWithOffset(1, ev.Build.ID()).To(Equal(build.ID()))
+			ExpectWithOffset(1, ev.Build.Status()).To(Equal(status))
+		}
+
+		It("emits events as the team's builds are created, started and finished", func() {
+			build, err := defaultJob.CreateBuild("some-user")
+			Expect(err).ToNot(HaveOccurred())
+
+			expectEvent(atc.BuildCreated, build, db.BuildStatusPending)
+
+			started, err := build.Start(atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(started).To(BeTrue())
+
+			expectEvent(atc.BuildStarted, build, db.BuildStatusStarted)
+
+			err = build.Finish(db.BuildStatusSucceeded)
+			Expect(err).ToNot(HaveOccurred())
+
+			expectEvent(atc.BuildFinished, build, db.BuildStatusSucceeded)
+		})
+
+		It("emits the status of builds which are created already started", func() {
+			build, err := defaultTeam.CreateStartedBuild(atc.Plan{})
+			Expect(err).ToNot(HaveOccurred())
+
+			expectEvent(atc.BuildCreated, build, db.BuildStatusStarted)
+			expectEvent(atc.BuildStarted, build, db.BuildStatusStarted)
+		})
+
+		It("emits changes to builds which existed before subscribing", func() {
+			Expect(events.Close()).To(Succeed())
+
+			build, err := defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			events, err = defaultTeam.BuildLifecycleEvents()
+			Expect(err).ToNot(HaveOccurred())
+
+			err = build.Finish(db.BuildStatusAborted)
+			Expect(err).ToNot(HaveOccurred())
+
+			expectEvent(atc.BuildFinished, build, db.BuildStatusAborted)
+		})
+
+		It("emits builds which are committed after builds with greater ids", func() {
+			tx, err := dbConn.Begin()
+			Expect(err).ToNot(HaveOccurred())
+
+			defer db.Rollback(tx)
+
+			var lateID int
+			err = tx.QueryRow(`
+				INSERT INTO builds (name, team_id, status)
+				VALUES ('late', $1, 'pending')
+				RETURNING id
+			`, defaultTeam.ID()).Scan(&lateID)
+			Expect(err).ToNot(HaveOccurred())
+
+			build, err := defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(build.ID()).To(BeNumerically(">", lateID))
+
+			expectEvent(atc.BuildCreated, build, db.BuildStatusPending)
+
+			Expect(tx.Commit()).To(Succeed())
+
+			ev, err := events.Next()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ev.Type).To(Equal(atc.BuildCreated))
+			Expect(ev.Build.ID()).To(Equal(lateID))
+		})
+
+		It("does not emit events for the builds of other teams", func() {
+			_, err := otherTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			build, err := defaultTeam.CreateOneOffBuild()
+			Expect(err).ToNot(HaveOccurred())
+
+			expectEvent(atc.BuildCreated, build, db.BuildStatusPending)
+		})
+
+		It("stops once closed", func() {
+			Expect(events.Close()).To(Succeed())
+
+			_, err := events.Next()
+			Expect(err).To(Equal(db.ErrBuildLifecycleStreamClosed))
+		})
+	})
+
 	Describe("Pipeline", func() {
 		Context("when the team has instanced pipelines configured", func() {
 			var (
@@ -1697,7 +1894,7 @@ var _ = Describe("Team", func() {
 					Name:         "fake-pipeline",
 					InstanceVars: atc.InstanceVars{"branch": "feature"},
 				}
-				instancedPipeline, _, err = team.SavePipeline(instancedPipelineRef, atc.Config{}, db.ConfigVersion(0), false)
+				instancedPipeline, _, err = team.SavePipeline(instancedPipelineRef, atc.Config{}, db.ConfigVersion(0), false, "")
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -1724,7 +1921,7 @@ var _ = Describe("Team", func() {
 				BeforeEach(func() {
 					var err error
 					namedPipelineRef = atc.PipelineRef{Name: "fake-pipeline"}
-					namedPipeline, _, err = team.SavePipeline(namedPipelineRef, atc.Config{}, db.ConfigVersion(0), false)
+					namedPipeline, _, err = team.SavePipeline(namedPipelineRef, atc.Config{}, db.ConfigVersion(0), false, "")
 					Expect(err).ToNot(HaveOccurred())
 				})
 
@@ -1872,13 +2069,13 @@ var _ = Describe("Team", func() {
 		})
 
 		It("returns true for created", func() {
-			_, created, err := team.SavePipeline(pipelineRef, config, 0, false)
+			_, created, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeTrue())
 		})
 
 		It("caches the team id", func() {
-			_, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			_, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineRef)
@@ -1888,7 +2085,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("can be saved as paused", func() {
-			_, _, err := team.SavePipeline(pipelineRef, config, 0, true)
+			_, _, err := team.SavePipeline(pipelineRef, config, 0, true, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineRef)
@@ -1899,7 +2096,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("can be saved as unpaused", func() {
-			_, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			_, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineRef)
@@ -1910,7 +2107,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("is not archived by default", func() {
-			_, _, err := team.SavePipeline(pipelineRef, config, 0, true)
+			_, _, err := team.SavePipeline(pipelineRef, config, 0, true, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineRef)
@@ -1921,10 +2118,10 @@ var _ = Describe("Team", func() {
 		})
 
 		It("requests schedule on the pipeline", func() {
-			requestedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			requestedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, otherConfig, 0, false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			requestedJob, found, err := requestedPipeline.Job("some-job")
@@ -1942,7 +2139,7 @@ var _ = Describe("Team", func() {
 				"source-other-config": "some-other-value",
 			}
 
-			_, _, err = team.SavePipeline(pipelineRef, config, requestedPipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(pipelineRef, config, requestedPipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			found, err = requestedJob.Reload()
@@ -1958,7 +2155,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the resources from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := savedPipeline.Resource("some-resource")
@@ -1971,14 +2168,14 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates resource config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Resources[0].Source = atc.Source{
 				"source-other-config": "some-other-value",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := savedPipeline.Resource("some-resource")
@@ -2021,7 +2218,7 @@ var _ = Describe("Team", func() {
 				"version": "v1",
 			}
 
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err := pipeline.Resource("some-resource")
@@ -2032,7 +2229,7 @@ var _ = Describe("Team", func() {
 
 			config.Resources[0].Version = nil
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resource, found, err = savedPipeline.Resource("some-resource")
@@ -2064,7 +2261,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks resource as inactive if it is no longer in config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Resources = []atc.ResourceConfig{}
@@ -2112,7 +2309,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.Resource("some-other-resource")
@@ -2121,7 +2318,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the resource types from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceType, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -2134,14 +2331,14 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates resource type config from the pipeline in the database", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.ResourceTypes[0].Source = atc.Source{
 				"source-other-config": "some-other-value",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceType, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -2154,12 +2351,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks resource type as inactive if it is no longer in config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.ResourceTypes = []atc.ResourceType{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.ResourceType("some-resource-type")
@@ -2168,7 +2365,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the prototypes from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			prototype, found, err := savedPipeline.Prototype("some-prototype")
@@ -2181,14 +2378,14 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates prototype config from the pipeline in the database", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Prototypes[0].Source = atc.Source{
 				"source-other-config": "some-other-value",
 			}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			prototype, found, err := savedPipeline.Prototype("some-prototype")
@@ -2201,12 +2398,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks prototype as inactive if it is no longer in config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Prototypes = atc.Prototypes{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.Prototype("some-resource-type")
@@ -2215,7 +2412,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the jobs from the pipeline in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-job")
@@ -2225,12 +2422,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates job config", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Jobs[0].Public = false
 
-			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2240,12 +2437,12 @@ var _ = Describe("Team", func() {
 		})
 
 		It("marks job inactive when it is no longer in pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			config.Jobs = []atc.JobConfig{}
 
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err := savedPipeline.Job("some-job")
@@ -2253,6 +2450,91 @@ var _ = Describe("Team", func() {
 			Expect(found).To(BeFalse())
 		})
 
+		Context("when a job has passed constraints on a job in another pipeline", func() {
+			var crossConfig atc.Config
+
+			BeforeEach(func() {
+				crossConfig = atc.Config{
+					Jobs: atc.JobConfigs{
+						{
+							Name: "some-job",
+							PlanSequence: []atc.Step{
+								{
+									Config: &atc.GetStep{
+										Name:   "some-resource",
+										Passed: []string{"upstream-pipeline/upstream-job"},
+									},
+								},
+							},
+						},
+					},
+					Resources: atc.ResourceConfigs{
+						{
+							Name: "some-resource",
+							Type: "some-type",
+						},
+					},
+				}
+			})
+
+			Context("when the job exists", func() {
+				var upstreamJob db.Job
+
+				BeforeEach(func() {
+					upstreamPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "upstream-pipeline"}, atc.Config{
+						Jobs: atc.JobConfigs{
+							{
+								Name: "upstream-job",
+								PlanSequence: []atc.Step{
+									{
+										Config: &atc.PutStep{
+											Name: "some-resource",
+										},
+									},
+								},
+							},
+						},
+						Resources: atc.ResourceConfigs{
+							{
+								Name: "some-resource",
+								Type: "some-type",
+							},
+						},
+					}, 0, false, "")
+					Expect(err).ToNot(HaveOccurred())
+
+					var found bool
+					upstreamJob, found, err = upstreamPipeline.Job("upstream-job")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+				})
+
+				It("constrains the input to the job in the other pipeline", func() {
+					savedPipeline, _, err := team.SavePipeline(pipelineRef, crossConfig, 0, false, "")
+					Expect(err).ToNot(HaveOccurred())
+
+					job, found, err := savedPipeline.Job("some-job")
+					Expect(err).ToNot(HaveOccurred())
+					Expect(found).To(BeTrue())
+
+					inputs, err := job.AlgorithmInputs()
+					Expect(err).ToNot(HaveOccurred())
+					Expect(inputs).To(HaveLen(1))
+					Expect(inputs[0].Passed).To(Equal(db.JobSet{upstreamJob.ID(): true}))
+				})
+			})
+
+			Context("when the job does not exist", func() {
+				It("returns an error", func() {
+					_, _, err := team.SavePipeline(pipelineRef, crossConfig, 0, false, "")
+					Expect(err).To(Equal(db.ErrPassedJobNotFound(atc.PassedJob{
+						Pipeline: atc.PipelineRef{Name: "upstream-pipeline"},
+						Job:      "upstream-job",
+					})))
+				})
+			})
+		})
+
 		Context("update job names but keeps history", func() {
 			BeforeEach(func() {
 				newJobConfig := atc.JobConfig{
@@ -2308,7 +2590,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should handle when there are multiple name changes", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job, _, _ := pipeline.Job("some-job")
@@ -2320,7 +2602,7 @@ var _ = Describe("Team", func() {
 				config.Jobs[3].Name = "new-other-job"
 				config.Jobs[3].OldName = "new-job"
 
-				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				updatedJob, _, _ := updatedPipeline.Job("new-job")
@@ -2331,7 +2613,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should handle when old job has the same name as new job", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				job, _, _ := pipeline.Job("some-job")
@@ -2339,7 +2621,7 @@ var _ = Describe("Team", func() {
 				config.Jobs[0].Name = "some-job"
 				config.Jobs[0].OldName = "some-job"
 
-				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				updatedJob, _, _ := updatedPipeline.Job("some-job")
@@ -2347,7 +2629,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should return an error when there is a swap with job name", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				config.Jobs[0].Name = "new-job"
@@ -2356,24 +2638,24 @@ var _ = Describe("Team", func() {
 				config.Jobs[1].Name = "some-job"
 				config.Jobs[1].OldName = "new-job"
 
-				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).To(HaveOccurred())
 			})
 
 			Context("when new job name is in database but is inactive", func() {
 				It("should successfully update job name", func() {
-					pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+					pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					config.Jobs = config.Jobs[:len(config.Jobs)-1]
 
-					_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+					_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 					Expect(err).ToNot(HaveOccurred())
 
 					config.Jobs[0].Name = "new-job"
 					config.Jobs[0].OldName = "some-job"
 
-					_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion()+1, false)
+					_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion()+1, false, "")
 					Expect(err).ToNot(HaveOccurred())
 				})
 			})
@@ -2393,7 +2675,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should successfully update resource name", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, _, _ := pipeline.Resource("some-resource")
@@ -2415,7 +2697,7 @@ var _ = Describe("Team", func() {
 					},
 				}
 
-				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				updatedResource, _, _ := updatedPipeline.Resource("renamed-resource")
@@ -2423,7 +2705,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should handle when there are multiple name changes", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, _, _ := pipeline.Resource("some-resource")
@@ -2449,7 +2731,7 @@ var _ = Describe("Team", func() {
 					},
 				}
 
-				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				updatedResource, _, _ := updatedPipeline.Resource("new-resource")
@@ -2460,7 +2742,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should handle when old resource has the same name as new resource", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				resource, _, _ := pipeline.Resource("some-resource")
@@ -2468,7 +2750,7 @@ var _ = Describe("Team", func() {
 				config.Resources[0].Name = "some-resource"
 				config.Resources[0].OldName = "some-resource"
 
-				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				updatedPipeline, _, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				updatedResource, _, _ := updatedPipeline.Resource("some-resource")
@@ -2476,7 +2758,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("should return an error when there is a swap with resource name", func() {
-				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				config.Resources[0].Name = "new-resource"
@@ -2485,7 +2767,7 @@ var _ = Describe("Team", func() {
 				config.Resources[1].Name = "some-resource"
 				config.Resources[1].OldName = "new-resource"
 
-				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).To(HaveOccurred())
 			})
 
@@ -2592,7 +2874,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("removes task caches for jobs that are no longer in pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2615,7 +2897,7 @@ var _ = Describe("Team", func() {
 
 			config.Jobs = []atc.JobConfig{}
 
-			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = taskCacheFactory.Find(job.ID(), "some-task", "some-path")
@@ -2628,7 +2910,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("removes task caches for tasks that are no longer exist", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2663,7 +2945,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = taskCacheFactory.Find(job.ID(), "some-task", "some-path")
@@ -2676,10 +2958,10 @@ var _ = Describe("Team", func() {
 		})
 
 		It("should not remove task caches in other pipeline", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, config, 0, false)
+			otherPipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "other-pipeline"}, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := pipeline.Job("some-job")
@@ -2718,7 +3000,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			_, found, err = taskCacheFactory.Find(job.ID(), "some-task", "some-path")
@@ -2731,7 +3013,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("creates all of the serial groups from the jobs in the database", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			serialGroups := []SerialGroup{}
@@ -2762,7 +3044,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("saves tags in the jobs table", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, otherConfig, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-other-job")
@@ -2774,7 +3056,7 @@ var _ = Describe("Team", func() {
 
 		It("saves tags in the jobs table based on globs", func() {
 			otherConfig.Groups[0].Jobs = []string{"*-other-job"}
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, otherConfig, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-other-job")
@@ -2785,7 +3067,7 @@ var _ = Describe("Team", func() {
 		})
 
 		It("updates tags in the jobs table", func() {
-			savedPipeline, _, err := team.SavePipeline(pipelineRef, otherConfig, 0, false)
+			savedPipeline, _, err := team.SavePipeline(pipelineRef, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err := savedPipeline.Job("some-other-job")
@@ -2805,7 +3087,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			savedPipeline, _, err = team.SavePipeline(pipelineRef, otherConfig, savedPipeline.ConfigVersion(), false)
+			savedPipeline, _, err = team.SavePipeline(pipelineRef, otherConfig, savedPipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			job, found, err = savedPipeline.Job("some-other-job")
@@ -2816,10 +3098,10 @@ var _ = Describe("Team", func() {
 		})
 
 		It("it returns created as false when updated", func() {
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			_, created, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			_, created, err := team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 			Expect(created).To(BeFalse())
 		})
@@ -2930,7 +3212,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, true)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, true, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			rows, err := psql.Select("name", "job_id", "resource_id", "passed_job_id").
@@ -3086,7 +3368,7 @@ var _ = Describe("Team", func() {
 				},
 			}
 
-			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+			_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			rows, err = psql.Select("name", "job_id", "resource_id", "passed_job_id").
@@ -3131,7 +3413,7 @@ var _ = Describe("Team", func() {
 
 		Context("updating an existing pipeline", func() {
 			It("maintains paused if the pipeline is paused", func() {
-				_, _, err := team.SavePipeline(pipelineRef, config, 0, true)
+				_, _, err := team.SavePipeline(pipelineRef, config, 0, true, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline, found, err := team.Pipeline(pipelineRef)
@@ -3139,7 +3421,7 @@ var _ = Describe("Team", func() {
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeTrue())
 
-				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline, found, err = team.Pipeline(pipelineRef)
@@ -3149,7 +3431,7 @@ var _ = Describe("Team", func() {
 			})
 
 			It("maintains unpaused if the pipeline is unpaused", func() {
-				_, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+				_, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline, found, err := team.Pipeline(pipelineRef)
@@ -3157,7 +3439,7 @@ var _ = Describe("Team", func() {
 				Expect(found).To(BeTrue())
 				Expect(pipeline.Paused()).To(BeFalse())
 
-				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), true)
+				_, _, err = team.SavePipeline(pipelineRef, config, pipeline.ConfigVersion(), true, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				pipeline, found, err = team.Pipeline(pipelineRef)
@@ -3167,11 +3449,11 @@ var _ = Describe("Team", func() {
 			})
 
 			It("resets to unarchived", func() {
-				team.SavePipeline(pipelineRef, config, 0, false)
+				team.SavePipeline(pipelineRef, config, 0, false, "")
 				pipeline, _, _ := team.Pipeline(pipelineRef)
 				pipeline.Archive()
 
-				team.SavePipeline(pipelineRef, config, db.ConfigVersion(0), true)
+				team.SavePipeline(pipelineRef, config, db.ConfigVersion(0), true, "")
 				pipeline.Reload()
 				Expect(pipeline.Archived()).To(BeFalse(), "the pipeline remained archived")
 			})
@@ -3180,9 +3462,9 @@ var _ = Describe("Team", func() {
 		It("can lookup a pipeline by name", func() {
 			otherPipelineFilter := atc.PipelineRef{Name: "an-other-pipeline-name"}
 
-			_, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			_, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
-			_, _, err = team.SavePipeline(otherPipelineFilter, otherConfig, 0, false)
+			_, _, err = team.SavePipeline(otherPipelineFilter, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			pipeline, found, err := team.Pipeline(pipelineRef)
@@ -3231,10 +3513,10 @@ var _ = Describe("Team", func() {
 			otherPipelineFilter := atc.PipelineRef{Name: "an-other-pipeline-name"}
 
 			By("being able to save the config")
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
-			otherPipeline, _, err := team.SavePipeline(otherPipelineFilter, otherConfig, 0, false)
+			otherPipeline, _, err := team.SavePipeline(otherPipelineFilter, otherConfig, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			By("returning the saved config to later gets")
@@ -3312,22 +3594,22 @@ var _ = Describe("Team", func() {
 			})
 
 			By("not allowing non-sequential updates")
-			_, _, err = team.SavePipeline(pipelineRef, updatedConfig, pipeline.ConfigVersion()-1, false)
+			_, _, err = team.SavePipeline(pipelineRef, updatedConfig, pipeline.ConfigVersion()-1, false, "")
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(pipelineRef, updatedConfig, pipeline.ConfigVersion()+10, false)
+			_, _, err = team.SavePipeline(pipelineRef, updatedConfig, pipeline.ConfigVersion()+10, false, "")
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(otherPipelineFilter, updatedConfig, otherPipeline.ConfigVersion()-1, false)
+			_, _, err = team.SavePipeline(otherPipelineFilter, updatedConfig, otherPipeline.ConfigVersion()-1, false, "")
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
-			_, _, err = team.SavePipeline(otherPipelineFilter, updatedConfig, otherPipeline.ConfigVersion()+10, false)
+			_, _, err = team.SavePipeline(otherPipelineFilter, updatedConfig, otherPipeline.ConfigVersion()+10, false, "")
 			Expect(err).To(Equal(db.ErrConfigComparisonFailed))
 
 			By("being able to update the config with a valid con")
-			pipeline, _, err = team.SavePipeline(pipelineRef, updatedConfig, pipeline.ConfigVersion(), false)
+			pipeline, _, err = team.SavePipeline(pipelineRef, updatedConfig, pipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
-			otherPipeline, _, err = team.SavePipeline(otherPipelineFilter, updatedConfig, otherPipeline.ConfigVersion(), false)
+			otherPipeline, _, err = team.SavePipeline(otherPipelineFilter, updatedConfig, otherPipeline.ConfigVersion(), false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			By("returning the updated config")
@@ -3386,7 +3668,7 @@ var _ = Describe("Team", func() {
 				},
 			})
 
-			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false)
+			pipeline, _, err := team.SavePipeline(pipelineRef, config, 0, false, "")
 			Expect(err).ToNot(HaveOccurred())
 
 			resourceTypes, err := pipeline.ResourceTypes()
@@ -3406,27 +3688,27 @@ var _ = Describe("Team", func() {
 			It("can allow pipelines with the same name across teams", func() {
 				pipelineRef := atc.PipelineRef{Name: "steve"}
 
-				teamPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, true)
+				teamPipeline, _, err := team.SavePipeline(pipelineRef, config, 0, true, "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(teamPipeline.Paused()).To(BeTrue())
 
 				By("allowing you to save a pipeline with the same name in another team")
-				otherTeamPipeline, _, err := otherTeam.SavePipeline(pipelineRef, otherConfig, 0, true)
+				otherTeamPipeline, _, err := otherTeam.SavePipeline(pipelineRef, otherConfig, 0, true, "")
 				Expect(err).ToNot(HaveOccurred())
 				Expect(otherTeamPipeline.Paused()).To(BeTrue())
 
 				By("updating the pipeline config for the correct team's pipeline")
-				_, _, err = team.SavePipeline(pipelineRef, otherConfig, teamPipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(pipelineRef, otherConfig, teamPipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
-				_, _, err = otherTeam.SavePipeline(pipelineRef, config, otherTeamPipeline.ConfigVersion(), false)
+				_, _, err = otherTeam.SavePipeline(pipelineRef, config, otherTeamPipeline.ConfigVersion(), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				By("cannot cross update configs")
-				_, _, err = team.SavePipeline(pipelineRef, otherConfig, otherTeamPipeline.ConfigVersion(), false)
+				_, _, err = team.SavePipeline(pipelineRef, otherConfig, otherTeamPipeline.ConfigVersion(), false, "")
 				Expect(err).To(HaveOccurred())
 
-				_, _, err = team.SavePipeline(pipelineRef, otherConfig, otherTeamPipeline.ConfigVersion(), true)
+				_, _, err = team.SavePipeline(pipelineRef, otherConfig, otherTeamPipeline.ConfigVersion(), true, "")
 				Expect(err).To(HaveOccurred())
 			})
 		})
@@ -3496,6 +3778,7 @@ var _ = Describe("Team", func() {
 					config,
 					pipeline.ConfigVersion(),
 					false,
+					"",
 				)
 				if err != nil {
 					panic(err)
@@ -3567,19 +3850,19 @@ var _ = Describe("Team", func() {
 				p1, _, err = defaultTeam.SavePipeline(atc.PipelineRef{
 					Name:         "release",
 					InstanceVars: atc.InstanceVars{"version": "6.7.x"},
-				}, defaultPipelineConfig, db.ConfigVersion(0), false)
+				}, defaultPipelineConfig, db.ConfigVersion(0), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				p2, _, err = defaultTeam.SavePipeline(atc.PipelineRef{
 					Name:         "release",
 					InstanceVars: atc.InstanceVars{"version": "7.0.x"},
-				}, defaultPipelineConfig, db.ConfigVersion(0), false)
+				}, defaultPipelineConfig, db.ConfigVersion(0), false, "")
 				Expect(err).ToNot(HaveOccurred())
 
 				p3, _, err = defaultTeam.SavePipeline(atc.PipelineRef{
 					Name:         "release",
 					InstanceVars: nil,
-				}, defaultPipelineConfig, db.ConfigVersion(0), false)
+				}, defaultPipelineConfig, db.ConfigVersion(0), false, "")
 				Expect(err).ToNot(HaveOccurred())
 			})
 
@@ -3687,7 +3970,7 @@ var _ = Describe("Team", func() {
 										},
 									},
 								},
-							}, db.ConfigVersion(0), false)
+							}, db.ConfigVersion(0), false, "")
 							Expect(err).NotTo(HaveOccurred())
 
 							otherResource, found, err = otherPipeline.Resource("some-resource")
@@ -484,6 +484,7 @@ func (repository *volumeRepository) GetOrphanedVolumes() ([]CreatedVolume, error
 				"v.worker_task_cache_id":         nil,
 				"v.worker_resource_certs_id":     nil,
 				"v.worker_artifact_id":           nil,
+				"v.task_result_cache_id":         nil,
 			},
 		).
 		Where(sq.Eq{"v.state": string(VolumeStateCreated)}).
@@ -738,6 +739,7 @@ var volumeColumns = []string{
 	when v.worker_task_cache_id is not NULL then 'task-cache'
 	when v.worker_resource_certs_id is not NULL then 'resource-certs'
 	when v.worker_artifact_id is not NULL then 'artifact'
+	when v.task_result_cache_id is not NULL then 'task-result'
 	else 'unknown'
 end`,
 }
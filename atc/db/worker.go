@@ -60,6 +60,7 @@ type Worker interface {
 	NoProxy() string
 	ActiveContainers() int
 	ActiveVolumes() int
+	ResourceUsage() *atc.WorkerResourceUsage
 	ResourceTypes() []atc.WorkerResourceType
 	Platform() string
 	Tags() []string
@@ -98,6 +99,7 @@ type worker struct {
 	activeContainers int
 	activeVolumes    int
 	activeTasks      int
+	resourceUsage    *atc.WorkerResourceUsage
 	resourceTypes    []atc.WorkerResourceType
 	platform         string
 	tags             []string
@@ -121,6 +123,7 @@ func (worker *worker) HTTPSProxyURL() string                   { return worker.h
 func (worker *worker) NoProxy() string                         { return worker.noProxy }
 func (worker *worker) ActiveContainers() int                   { return worker.activeContainers }
 func (worker *worker) ActiveVolumes() int                      { return worker.activeVolumes }
+func (worker *worker) ResourceUsage() *atc.WorkerResourceUsage { return worker.resourceUsage }
 func (worker *worker) ResourceTypes() []atc.WorkerResourceType { return worker.resourceTypes }
 func (worker *worker) Platform() string                        { return worker.platform }
 func (worker *worker) Tags() []string                          { return worker.tags }
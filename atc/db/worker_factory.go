@@ -46,6 +46,7 @@ var workersQuery = psql.Select(`
 		w.no_proxy,
 		w.active_containers,
 		w.active_volumes,
+		w.resource_usage,
 		w.resource_types,
 		w.platform,
 		w.tags,
@@ -131,6 +132,7 @@ func scanWorker(worker *worker, row scannable) error {
 		httpProxyURL  sql.NullString
 		httpsProxyURL sql.NullString
 		noProxy       sql.NullString
+		resourceUsage []byte
 		resourceTypes []byte
 		platform      sql.NullString
 		tags          []byte
@@ -153,6 +155,7 @@ func scanWorker(worker *worker, row scannable) error {
 		&noProxy,
 		&worker.activeContainers,
 		&worker.activeVolumes,
+		&resourceUsage,
 		&resourceTypes,
 		&platform,
 		&tags,
@@ -214,6 +217,13 @@ func scanWorker(worker *worker, row scannable) error {
 		worker.ephemeral = ephemeral.Bool
 	}
 
+	if resourceUsage != nil {
+		err = json.Unmarshal(resourceUsage, &worker.resourceUsage)
+		if err != nil {
+			return err
+		}
+	}
+
 	err = json.Unmarshal(resourceTypes, &worker.resourceTypes)
 	if err != nil {
 		return err
@@ -251,10 +261,16 @@ func (f *workerFactory) HeartbeatWorker(atcWorker atc.Worker, ttl time.Duration)
 		return nil, err
 	}
 
+	resourceUsage, err := marshalWorkerResourceUsage(atcWorker.ResourceUsage)
+	if err != nil {
+		return nil, err
+	}
+
 	_, err = psql.Update("workers").
 		Set("expires", sq.Expr(expires)).
 		Set("active_containers", atcWorker.ActiveContainers).
 		Set("active_volumes", atcWorker.ActiveVolumes).
+		Set("resource_usage", resourceUsage).
 		Set("state", sq.Expr("("+cSQL+")")).
 		Where(sq.Eq{"name": atcWorker.Name}).
 		RunWith(tx).
@@ -374,6 +390,11 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		return nil, err
 	}
 
+	resourceUsage, err := marshalWorkerResourceUsage(atcWorker.ResourceUsage)
+	if err != nil {
+		return nil, err
+	}
+
 	expires := "NULL"
 	if ttl != 0 {
 		expires = fmt.Sprintf(`NOW() + '%d second'::INTERVAL`, int(ttl.Seconds()))
@@ -397,6 +418,7 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		atcWorker.GardenAddr,
 		atcWorker.ActiveContainers,
 		atcWorker.ActiveVolumes,
+		resourceUsage,
 		resourceTypes,
 		tags,
 		atcWorker.Platform,
@@ -428,6 +450,7 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 			"addr",
 			"active_containers",
 			"active_volumes",
+			"resource_usage",
 			"resource_types",
 			"tags",
 			"platform",
@@ -453,6 +476,7 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 				addr = ?,
 				active_containers = ?,
 				active_volumes = ?,
+				resource_usage = ?,
 				resource_types = ?,
 				tags = ?,
 				platform = ?,
@@ -501,6 +525,7 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 		noProxy:          atcWorker.NoProxy,
 		activeContainers: atcWorker.ActiveContainers,
 		activeVolumes:    atcWorker.ActiveVolumes,
+		resourceUsage:    atcWorker.ResourceUsage,
 		resourceTypes:    atcWorker.ResourceTypes,
 		platform:         atcWorker.Platform,
 		tags:             atcWorker.Tags,
@@ -556,3 +581,13 @@ func saveWorker(tx Tx, atcWorker atc.Worker, teamID *int, ttl time.Duration, con
 
 	return savedWorker, nil
 }
+
+// marshalWorkerResourceUsage leaves the column NULL for workers which have
+// not reported their resource usage.
+func marshalWorkerResourceUsage(usage *atc.WorkerResourceUsage) (interface{}, error) {
+	if usage == nil {
+		return nil, nil
+	}
+
+	return json.Marshal(usage)
+}
@@ -184,12 +184,13 @@ func (delegate *buildStepDelegate) Finished(logger lager.Logger, succeeded bool)
 	logger.Info("finished")
 }
 
-func (delegate *buildStepDelegate) WaitingForWorker(logger lager.Logger) {
+func (delegate *buildStepDelegate) WaitingForWorker(logger lager.Logger, reason string) {
 	err := delegate.build.SaveEvent(event.WaitingForWorker{
 		Time: time.Now().Unix(),
 		Origin: event.Origin{
 			ID: event.OriginID(delegate.planID),
 		},
+		Reason: reason,
 	})
 	if err != nil {
 		logger.Error("failed-to-save-waiting-for-worker-event", err)
@@ -605,6 +605,22 @@ var _ = Describe("Builder", func() {
 						})
 					})
 
+					Context("that contains a wait_for_approval step", func() {
+						BeforeEach(func() {
+							expectedPlan = planFactory.NewPlan(atc.ApprovalPlan{
+								Name:  "some-approval",
+								Roles: []string{"owner"},
+							})
+						})
+
+						It("constructs wait_for_approval correctly", func() {
+							plan, stepMetadata, build, _ := fakeCoreStepFactory.ApprovalStepArgsForCall(0)
+							Expect(plan).To(Equal(expectedPlan))
+							Expect(stepMetadata).To(Equal(expectedMetadataWithoutCreatedBy))
+							Expect(build).To(Equal(fakeBuild))
+						})
+					})
+
 					Context("that contains a check step", func() {
 						BeforeEach(func() {
 							expectedPlan = planFactory.NewPlan(atc.CheckPlan{
@@ -17,6 +17,8 @@ type coreStepFactory struct {
 	pool                  worker.Pool
 	artifactStreamer      worker.ArtifactStreamer
 	artifactSourcer       worker.ArtifactSourcer
+	artifactArchiver      worker.ArtifactArchiver
+	taskResultCaches      db.TaskResultCacheFactory
 	resourceFactory       resource.ResourceFactory
 	teamFactory           db.TeamFactory
 	buildFactory          db.BuildFactory
@@ -31,6 +33,8 @@ func NewCoreStepFactory(
 	pool worker.Pool,
 	artifactStreamer worker.ArtifactStreamer,
 	artifactSourcer worker.ArtifactSourcer,
+	artifactArchiver worker.ArtifactArchiver,
+	taskResultCaches db.TaskResultCacheFactory,
 	resourceFactory resource.ResourceFactory,
 	teamFactory db.TeamFactory,
 	buildFactory db.BuildFactory,
@@ -44,6 +48,8 @@ func NewCoreStepFactory(
 		pool:                  pool,
 		artifactStreamer:      artifactStreamer,
 		artifactSourcer:       artifactSourcer,
+		artifactArchiver:      artifactArchiver,
+		taskResultCaches:      taskResultCaches,
 		resourceFactory:       resourceFactory,
 		teamFactory:           teamFactory,
 		buildFactory:          buildFactory,
@@ -149,6 +155,12 @@ func (factory *coreStepFactory) RunStep(
 	runStep := exec.NewRunStep(
 		plan.ID,
 		*plan.Run,
+		factory.defaultLimits,
+		stepMetadata,
+		containerMetadata,
+		factory.strategy,
+		factory.pool,
+		factory.artifactSourcer,
 		delegateFactory,
 	)
 
@@ -178,6 +190,8 @@ func (factory *coreStepFactory) TaskStep(
 		factory.pool,
 		factory.artifactStreamer,
 		factory.artifactSourcer,
+		factory.artifactArchiver,
+		factory.taskResultCaches,
 		delegateFactory,
 	)
 
@@ -231,6 +245,23 @@ func (factory *coreStepFactory) LoadVarStep(
 	return loadVarStep
 }
 
+func (factory *coreStepFactory) ApprovalStep(
+	plan atc.Plan,
+	stepMetadata exec.StepMetadata,
+	build db.Build,
+	delegateFactory DelegateFactory,
+) exec.Step {
+	approvalStep := exec.NewApprovalStep(
+		plan.ID,
+		*plan.Approval,
+		stepMetadata,
+		build,
+		delegateFactory,
+	)
+
+	return exec.LogError(approvalStep, delegateFactory)
+}
+
 func (factory *coreStepFactory) ArtifactInputStep(
 	plan atc.Plan,
 	build db.Build,
@@ -10,6 +10,7 @@ import (
 	"github.com/concourse/concourse/atc/db/lock"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/worker"
 )
@@ -27,6 +28,7 @@ func NewTaskDelegate(
 	return &taskDelegate{
 		BuildStepDelegate: NewBuildStepDelegate(build, planID, state, clock, policyChecker, artifactSourcer),
 
+		planID:      planID,
 		eventOrigin: event.Origin{ID: event.OriginID(planID)},
 		build:       build,
 		clock:       clock,
@@ -41,6 +43,7 @@ type taskDelegate struct {
 
 	config      atc.TaskConfig
 	build       db.Build
+	planID      atc.PlanID
 	eventOrigin event.Origin
 	clock       clock.Clock
 
@@ -102,3 +105,40 @@ func (d *taskDelegate) Finished(
 
 	logger.Info("finished", lager.Data{"exit-status": exitStatus})
 }
+
+func (d *taskDelegate) FinishedFromCache(logger lager.Logger) {
+	d.Stdout().(io.Closer).Close()
+	d.Stderr().(io.Closer).Close()
+
+	err := d.build.SaveEvent(event.FinishTask{
+		ExitStatus: 0,
+		Time:       d.clock.Now().Unix(),
+		Origin:     d.eventOrigin,
+		Cached:     true,
+	})
+	if err != nil {
+		logger.Error("failed-to-save-finish-event", err)
+		return
+	}
+
+	logger.Info("finished-from-cache")
+}
+
+func (d *taskDelegate) UsedResources(logger lager.Logger, stepName string, usage atc.ResourceUsage) {
+	metric.StepResourceUsage{
+		Build:    d.build,
+		StepName: stepName,
+		Usage:    usage,
+	}.Emit(logger, metric.Metrics)
+
+	err := d.build.SaveResourceUsage(d.planID, usage)
+	if err != nil {
+		logger.Error("failed-to-save-resource-usage", err)
+		return
+	}
+
+	logger.Debug("used-resources", lager.Data{
+		"cpu-usage":   usage.CPUUsage,
+		"memory-peak": usage.MemoryPeak,
+	})
+}
@@ -2,10 +2,12 @@ package engine
 
 import (
 	"encoding/json"
+	"errors"
 	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
 
 	"code.cloudfoundry.org/clock/fakeclock"
 	"code.cloudfoundry.org/lager/lagertest"
@@ -152,6 +154,58 @@ var _ = Describe("TaskDelegate", func() {
 			Expect(event.EventType()).To(Equal(atc.EventType("finish-task")))
 		})
 	})
+
+	Describe("UsedResources", func() {
+		var usage atc.ResourceUsage
+
+		BeforeEach(func() {
+			usage = atc.ResourceUsage{
+				CPUUsage:   300,
+				CPUUser:    200,
+				CPUSystem:  100,
+				MemoryPeak: 2048,
+				Duration:   time.Minute,
+			}
+		})
+
+		JustBeforeEach(func() {
+			delegate.UsedResources(logger, "some-task", usage)
+		})
+
+		It("saves the usage of the step", func() {
+			Expect(fakeBuild.SaveResourceUsageCallCount()).To(Equal(1))
+			planID, savedUsage := fakeBuild.SaveResourceUsageArgsForCall(0)
+			Expect(planID).To(Equal(atc.PlanID("some-plan-id")))
+			Expect(savedUsage).To(Equal(usage))
+		})
+
+		Context("when saving the usage fails", func() {
+			BeforeEach(func() {
+				fakeBuild.SaveResourceUsageReturns(errors.New("nope"))
+			})
+
+			It("logs the error", func() {
+				Expect(logger).To(gbytes.Say("failed-to-save-resource-usage"))
+			})
+		})
+	})
+
+	Describe("FinishedFromCache", func() {
+		JustBeforeEach(func() {
+			delegate.FinishedFromCache(logger)
+		})
+
+		It("saves a successful finish event marked as cached", func() {
+			Expect(fakeBuild.SaveEventCallCount()).To(Equal(1))
+			event := fakeBuild.SaveEventArgsForCall(0)
+			Expect(json.Marshal(event)).To(MatchJSON(`{
+				"time": 675927000,
+				"exit_status": 0,
+				"origin": {"id": "some-plan-id"},
+				"cached": true
+			}`))
+		})
+	})
 })
 
 func containerSpecDummy() worker.ContainerSpec {
@@ -19,10 +19,14 @@ type FinishTask struct {
 	Time       int64  `json:"time"`
 	ExitStatus int    `json:"exit_status"`
 	Origin     Origin `json:"origin"`
+
+	// Cached is set when the task was not run because the result of a
+	// previous run was reused.
+	Cached bool `json:"cached,omitempty"`
 }
 
 func (FinishTask) EventType() atc.EventType  { return EventTypeFinishTask }
-func (FinishTask) Version() atc.EventVersion { return "4.0" }
+func (FinishTask) Version() atc.EventVersion { return "4.1" }
 
 type InitializeTask struct {
 	Time       int64      `json:"time"`
@@ -95,10 +99,11 @@ func (Status) Version() atc.EventVersion { return "1.0" }
 type WaitingForWorker struct {
 	Time   int64  `json:"time"`
 	Origin Origin `json:"origin"`
+	Reason string `json:"reason,omitempty"`
 }
 
 func (WaitingForWorker) EventType() atc.EventType  { return EventTypeWaitingForWorker }
-func (WaitingForWorker) Version() atc.EventVersion { return "1.0" }
+func (WaitingForWorker) Version() atc.EventVersion { return "1.1" }
 
 type SelectedWorker struct {
 	Time       int64  `json:"time"`
@@ -109,6 +114,26 @@ type SelectedWorker struct {
 func (SelectedWorker) EventType() atc.EventType  { return EventTypeSelectedWorker }
 func (SelectedWorker) Version() atc.EventVersion { return "1.0" }
 
+type Approval struct {
+	Time     int64  `json:"time"`
+	Origin   Origin `json:"origin"`
+	Approved bool   `json:"approved"`
+	User     string `json:"user"`
+	Comment  string `json:"comment,omitempty"`
+}
+
+func (Approval) EventType() atc.EventType  { return EventTypeApproval }
+func (Approval) Version() atc.EventVersion { return "1.0" }
+
+type StepResourceUsage struct {
+	Time   int64             `json:"time"`
+	Origin Origin            `json:"origin"`
+	Usage  atc.ResourceUsage `json:"usage"`
+}
+
+func (StepResourceUsage) EventType() atc.EventType  { return EventTypeStepResourceUsage }
+func (StepResourceUsage) Version() atc.EventVersion { return "1.0" }
+
 type Log struct {
 	Time    int64  `json:"time"`
 	Origin  Origin `json:"origin"`
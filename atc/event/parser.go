@@ -47,6 +47,8 @@ func init() {
 	RegisterEvent(Status{})
 	RegisterEvent(WaitingForWorker{})
 	RegisterEvent(SelectedWorker{})
+	RegisterEvent(Approval{})
+	RegisterEvent(StepResourceUsage{})
 	RegisterEvent(Log{})
 	RegisterEvent(Error{})
 	RegisterEvent(ImageCheck{})
@@ -69,6 +69,8 @@ var _ = Describe("ParseEvent", func() {
 		Entry("Status", event.Status{}),
 		Entry("WaitingForWorker", event.WaitingForWorker{}),
 		Entry("SelectedWorker", event.SelectedWorker{}),
+		Entry("Approval", event.Approval{}),
+		Entry("StepResourceUsage", event.StepResourceUsage{}),
 		Entry("Log", event.Log{}),
 		Entry("Error", event.Error{}),
 		Entry("ImageCheck", event.ImageCheck{}),
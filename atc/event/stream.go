@@ -0,0 +1,56 @@
+package event
+
+import "encoding/json"
+
+// StreamAction is what a client of the build event stream asks the server to
+// do with the events of a build.
+type StreamAction string
+
+const (
+	StreamSubscribe   StreamAction = "subscribe"
+	StreamUnsubscribe StreamAction = "unsubscribe"
+)
+
+// StreamRequest is sent by clients of the build event stream, which
+// multiplexes the events of many builds over a single WebSocket connection.
+type StreamRequest struct {
+	Action  StreamAction `json:"action"`
+	BuildID int          `json:"build_id"`
+
+	// Cursor is the ID of the last event the client has seen for the build.
+	// Events are streamed from the one following it, or from the start of the
+	// build if it is not set.
+	Cursor *uint `json:"cursor,omitempty"`
+}
+
+// StreamMessageType distinguishes the messages sent on the build event
+// stream.
+type StreamMessageType string
+
+const (
+	// StreamEvent carries one event of a build.
+	StreamEvent StreamMessageType = "event"
+
+	// StreamEnd is sent once a build has finished and all of its events have
+	// been sent. No further messages are sent for the build.
+	StreamEnd StreamMessageType = "end"
+
+	// StreamError is sent when a subscription can not be served or fails.
+	// No further messages are sent for the build.
+	StreamError StreamMessageType = "error"
+)
+
+// StreamMessage is sent by the server on the build event stream.
+type StreamMessage struct {
+	Type    StreamMessageType `json:"type"`
+	BuildID int               `json:"build_id"`
+
+	// ID is the ID of the event, to be used as the cursor when resubscribing.
+	ID uint `json:"id"`
+
+	// Event is the event's envelope, as it is sent on a build's
+	// Server-Sent Events stream.
+	Event json.RawMessage `json:"event,omitempty"`
+
+	Error string `json:"error,omitempty"`
+}
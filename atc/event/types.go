@@ -61,4 +61,10 @@ const (
 
 	// image get sub-plan
 	EventTypeImageGet atc.EventType = "image-get"
+
+	// a wait_for_approval step was approved or rejected
+	EventTypeApproval atc.EventType = "approval"
+
+	// resource usage of a step's container
+	EventTypeStepResourceUsage atc.EventType = "step-resource-usage"
 )
@@ -0,0 +1,134 @@
+package exec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/tracing"
+)
+
+// ApprovalStep parks the build until a user approves or rejects it through
+// the API. Approving the step succeeds it, while rejecting it or running out
+// of time fails it.
+type ApprovalStep struct {
+	planID          atc.PlanID
+	plan            atc.ApprovalPlan
+	metadata        StepMetadata
+	build           db.Build
+	delegateFactory BuildStepDelegateFactory
+}
+
+func NewApprovalStep(
+	planID atc.PlanID,
+	plan atc.ApprovalPlan,
+	metadata StepMetadata,
+	build db.Build,
+	delegateFactory BuildStepDelegateFactory,
+) Step {
+	return &ApprovalStep{
+		planID:          planID,
+		plan:            plan,
+		metadata:        metadata,
+		build:           build,
+		delegateFactory: delegateFactory,
+	}
+}
+
+func (step *ApprovalStep) Run(ctx context.Context, state RunState) (bool, error) {
+	delegate := step.delegateFactory.BuildStepDelegate(state)
+	ctx, span := delegate.StartSpan(ctx, "wait_for_approval", tracing.Attrs{
+		"name": step.plan.Name,
+	})
+
+	ok, err := step.run(ctx, delegate)
+	tracing.End(span, err)
+
+	return ok, err
+}
+
+func (step *ApprovalStep) run(ctx context.Context, delegate BuildStepDelegate) (bool, error) {
+	logger := lagerctx.FromContext(ctx)
+	logger = logger.Session("approval-step", lager.Data{
+		"step-name": step.plan.Name,
+		"job-id":    step.metadata.JobID,
+	})
+
+	delegate.Initializing(logger)
+
+	waitCtx, cancel, err := MaybeTimeout(ctx, step.plan.Timeout)
+	if err != nil {
+		return false, err
+	}
+
+	defer cancel()
+
+	notifier, err := step.build.ApprovalNotifier(step.planID)
+	if err != nil {
+		return false, fmt.Errorf("listen for approval: %w", err)
+	}
+
+	defer notifier.Close()
+
+	delegate.Starting(logger)
+
+	stdout := delegate.Stdout()
+	fmt.Fprintf(stdout, "waiting for approval%s...\n", step.approvers())
+
+	for {
+		approval, decided, err := step.build.Approval(step.planID)
+		if err != nil {
+			return false, err
+		}
+
+		if decided {
+			if approval.Approved {
+				fmt.Fprintf(stdout, "\x1b[1;32mapproved\x1b[0m by %s\n", approval.User)
+			} else {
+				fmt.Fprintf(stdout, "\x1b[1;31mrejected\x1b[0m by %s\n", approval.User)
+			}
+
+			if approval.Comment != "" {
+				fmt.Fprintf(stdout, "comment: %s\n", approval.Comment)
+			}
+
+			delegate.Finished(logger, approval.Approved)
+			return approval.Approved, nil
+		}
+
+		select {
+		case <-notifier.Notify():
+		case <-waitCtx.Done():
+			if errors.Is(waitCtx.Err(), context.DeadlineExceeded) {
+				delegate.Errored(logger, TimeoutLogMessage)
+				return false, nil
+			}
+
+			return false, waitCtx.Err()
+		}
+	}
+}
+
+func (step *ApprovalStep) approvers() string {
+	var approvers []string
+
+	if len(step.plan.Roles) > 0 {
+		approvers = append(approvers, "roles: "+strings.Join(step.plan.Roles, ", "))
+	}
+
+	if len(step.plan.Users) > 0 {
+		approvers = append(approvers, "users: "+strings.Join(step.plan.Users, ", "))
+	}
+
+	if len(approvers) == 0 {
+		return ""
+	}
+
+	return " (" + strings.Join(approvers, "; ") + ")"
+}
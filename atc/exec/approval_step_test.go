@@ -0,0 +1,219 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/tracing"
+)
+
+var _ = Describe("ApprovalStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		fakeDelegate        *execfakes.FakeBuildStepDelegate
+		fakeDelegateFactory *execfakes.FakeBuildStepDelegateFactory
+
+		fakeBuild    *dbfakes.FakeBuild
+		fakeNotifier *dbfakes.FakeNotifier
+		notify       chan struct{}
+
+		approvalPlan atc.ApprovalPlan
+		state        *execfakes.FakeRunState
+
+		stdout *gbytes.Buffer
+
+		stepOk  bool
+		stepErr error
+
+		planID = atc.PlanID("56")
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+		ctx = lagerctx.NewContext(ctx, lagertest.NewTestLogger("approval-step-test"))
+
+		stdout = gbytes.NewBuffer()
+
+		fakeDelegate = new(execfakes.FakeBuildStepDelegate)
+		fakeDelegate.StdoutReturns(stdout)
+		fakeDelegate.StartSpanStub = func(ctx context.Context, _ string, _ tracing.Attrs) (context.Context, trace.Span) {
+			return ctx, tracing.NoopSpan
+		}
+
+		fakeDelegateFactory = new(execfakes.FakeBuildStepDelegateFactory)
+		fakeDelegateFactory.BuildStepDelegateReturns(fakeDelegate)
+
+		notify = make(chan struct{}, 1)
+		fakeNotifier = new(dbfakes.FakeNotifier)
+		fakeNotifier.NotifyReturns(notify)
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.ApprovalNotifierReturns(fakeNotifier, nil)
+
+		approvalPlan = atc.ApprovalPlan{Name: "some-approval"}
+		state = new(execfakes.FakeRunState)
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		step := exec.NewApprovalStep(
+			planID,
+			approvalPlan,
+			exec.StepMetadata{BuildID: 42},
+			fakeBuild,
+			fakeDelegateFactory,
+		)
+
+		stepOk, stepErr = step.Run(ctx, state)
+	})
+
+	Context("when the step is approved", func() {
+		BeforeEach(func() {
+			fakeBuild.ApprovalReturns(atc.BuildApproval{
+				Approved: true,
+				User:     "some-user",
+				Comment:  "ship it",
+			}, true, nil)
+		})
+
+		It("succeeds", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(stepOk).To(BeTrue())
+
+			Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+			_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+			Expect(succeeded).To(BeTrue())
+		})
+
+		It("looks up the approval of the step", func() {
+			Expect(fakeBuild.ApprovalNotifierArgsForCall(0)).To(Equal(planID))
+			Expect(fakeBuild.ApprovalArgsForCall(0)).To(Equal(planID))
+		})
+
+		It("shows who approved it", func() {
+			Expect(stdout).To(gbytes.Say("approved.* by some-user"))
+			Expect(stdout).To(gbytes.Say("comment: ship it"))
+		})
+
+		It("stops listening for the approval", func() {
+			Expect(fakeNotifier.CloseCallCount()).To(Equal(1))
+		})
+	})
+
+	Context("when the step is rejected", func() {
+		BeforeEach(func() {
+			fakeBuild.ApprovalReturns(atc.BuildApproval{
+				Approved: false,
+				User:     "some-user",
+			}, true, nil)
+		})
+
+		It("fails", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(stepOk).To(BeFalse())
+
+			Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+			_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+			Expect(succeeded).To(BeFalse())
+		})
+
+		It("shows who rejected it", func() {
+			Expect(stdout).To(gbytes.Say("rejected.* by some-user"))
+		})
+	})
+
+	Context("when the step is decided while waiting", func() {
+		BeforeEach(func() {
+			approvalPlan.Roles = []string{"owner"}
+			approvalPlan.Users = []string{"github:some-user"}
+
+			fakeBuild.ApprovalReturnsOnCall(0, atc.BuildApproval{}, false, nil)
+			fakeBuild.ApprovalReturnsOnCall(1, atc.BuildApproval{Approved: true, User: "some-user"}, true, nil)
+
+			notify <- struct{}{}
+		})
+
+		It("shows who can approve it", func() {
+			Expect(stdout).To(gbytes.Say(`waiting for approval \(roles: owner; users: github:some-user\)`))
+		})
+
+		It("succeeds once notified", func() {
+			Expect(fakeBuild.ApprovalCallCount()).To(Equal(2))
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(stepOk).To(BeTrue())
+		})
+	})
+
+	Context("when the timeout is exceeded", func() {
+		BeforeEach(func() {
+			approvalPlan.Timeout = "10ms"
+		})
+
+		It("fails with a timeout", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(stepOk).To(BeFalse())
+
+			Expect(fakeDelegate.ErroredCallCount()).To(Equal(1))
+			_, message := fakeDelegate.ErroredArgsForCall(0)
+			Expect(message).To(Equal(exec.TimeoutLogMessage))
+		})
+	})
+
+	Context("when the timeout is invalid", func() {
+		BeforeEach(func() {
+			approvalPlan.Timeout = "bogus"
+		})
+
+		It("errors", func() {
+			Expect(stepErr).To(MatchError(ContainSubstring("parse timeout")))
+		})
+	})
+
+	Context("when the build is aborted while waiting", func() {
+		BeforeEach(func() {
+			cancel()
+		})
+
+		It("returns the context error", func() {
+			Expect(stepErr).To(Equal(context.Canceled))
+			Expect(stepOk).To(BeFalse())
+		})
+	})
+
+	Context("when listening for the approval fails", func() {
+		BeforeEach(func() {
+			fakeBuild.ApprovalNotifierReturns(nil, errors.New("nope"))
+		})
+
+		It("errors", func() {
+			Expect(stepErr).To(MatchError(ContainSubstring("nope")))
+			Expect(fakeDelegate.StartingCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when looking up the approval fails", func() {
+		BeforeEach(func() {
+			fakeBuild.ApprovalReturns(atc.BuildApproval{}, false, errors.New("nope"))
+		})
+
+		It("errors", func() {
+			Expect(stepErr).To(MatchError("nope"))
+		})
+	})
+})
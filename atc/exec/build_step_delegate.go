@@ -31,7 +31,7 @@ type BuildStepDelegate interface {
 	Finished(lager.Logger, bool)
 	Errored(lager.Logger, string)
 
-	WaitingForWorker(lager.Logger)
+	WaitingForWorker(lager.Logger, string)
 	SelectedWorker(lager.Logger, string)
 }
 
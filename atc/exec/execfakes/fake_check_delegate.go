@@ -136,10 +136,11 @@ type FakeCheckDelegate struct {
 		result2 bool
 		result3 error
 	}
-	WaitingForWorkerStub        func(lager.Logger)
+	WaitingForWorkerStub        func(lager.Logger, string)
 	waitingForWorkerMutex       sync.RWMutex
 	waitingForWorkerArgsForCall []struct {
 		arg1 lager.Logger
+		arg2 string
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
@@ -740,16 +741,17 @@ func (fake *FakeCheckDelegate) WaitToRunReturnsOnCall(i int, result1 lock.Lock,
 	}{result1, result2, result3}
 }
 
-func (fake *FakeCheckDelegate) WaitingForWorker(arg1 lager.Logger) {
+func (fake *FakeCheckDelegate) WaitingForWorker(arg1 lager.Logger, arg2 string) {
 	fake.waitingForWorkerMutex.Lock()
 	fake.waitingForWorkerArgsForCall = append(fake.waitingForWorkerArgsForCall, struct {
 		arg1 lager.Logger
-	}{arg1})
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.WaitingForWorkerStub
-	fake.recordInvocation("WaitingForWorker", []interface{}{arg1})
+	fake.recordInvocation("WaitingForWorker", []interface{}{arg1, arg2})
 	fake.waitingForWorkerMutex.Unlock()
 	if stub != nil {
-		fake.WaitingForWorkerStub(arg1)
+		fake.WaitingForWorkerStub(arg1, arg2)
 	}
 }
 
@@ -759,17 +761,17 @@ func (fake *FakeCheckDelegate) WaitingForWorkerCallCount() int {
 	return len(fake.waitingForWorkerArgsForCall)
 }
 
-func (fake *FakeCheckDelegate) WaitingForWorkerCalls(stub func(lager.Logger)) {
+func (fake *FakeCheckDelegate) WaitingForWorkerCalls(stub func(lager.Logger, string)) {
 	fake.waitingForWorkerMutex.Lock()
 	defer fake.waitingForWorkerMutex.Unlock()
 	fake.WaitingForWorkerStub = stub
 }
 
-func (fake *FakeCheckDelegate) WaitingForWorkerArgsForCall(i int) lager.Logger {
+func (fake *FakeCheckDelegate) WaitingForWorkerArgsForCall(i int) (lager.Logger, string) {
 	fake.waitingForWorkerMutex.RLock()
 	defer fake.waitingForWorkerMutex.RUnlock()
 	argsForCall := fake.waitingForWorkerArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeCheckDelegate) Invocations() map[string][][]interface{} {
@@ -103,10 +103,11 @@ type FakeGetDelegate struct {
 		arg2 atc.GetPlan
 		arg3 runtime.VersionResult
 	}
-	WaitingForWorkerStub        func(lager.Logger)
+	WaitingForWorkerStub        func(lager.Logger, string)
 	waitingForWorkerMutex       sync.RWMutex
 	waitingForWorkerArgsForCall []struct {
 		arg1 lager.Logger
+		arg2 string
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
@@ -549,16 +550,17 @@ func (fake *FakeGetDelegate) UpdateVersionArgsForCall(i int) (lager.Logger, atc.
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
 }
 
-func (fake *FakeGetDelegate) WaitingForWorker(arg1 lager.Logger) {
+func (fake *FakeGetDelegate) WaitingForWorker(arg1 lager.Logger, arg2 string) {
 	fake.waitingForWorkerMutex.Lock()
 	fake.waitingForWorkerArgsForCall = append(fake.waitingForWorkerArgsForCall, struct {
 		arg1 lager.Logger
-	}{arg1})
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.WaitingForWorkerStub
-	fake.recordInvocation("WaitingForWorker", []interface{}{arg1})
+	fake.recordInvocation("WaitingForWorker", []interface{}{arg1, arg2})
 	fake.waitingForWorkerMutex.Unlock()
 	if stub != nil {
-		fake.WaitingForWorkerStub(arg1)
+		fake.WaitingForWorkerStub(arg1, arg2)
 	}
 }
 
@@ -568,17 +570,17 @@ func (fake *FakeGetDelegate) WaitingForWorkerCallCount() int {
 	return len(fake.waitingForWorkerArgsForCall)
 }
 
-func (fake *FakeGetDelegate) WaitingForWorkerCalls(stub func(lager.Logger)) {
+func (fake *FakeGetDelegate) WaitingForWorkerCalls(stub func(lager.Logger, string)) {
 	fake.waitingForWorkerMutex.Lock()
 	defer fake.waitingForWorkerMutex.Unlock()
 	fake.WaitingForWorkerStub = stub
 }
 
-func (fake *FakeGetDelegate) WaitingForWorkerArgsForCall(i int) lager.Logger {
+func (fake *FakeGetDelegate) WaitingForWorkerArgsForCall(i int) (lager.Logger, string) {
 	fake.waitingForWorkerMutex.RLock()
 	defer fake.waitingForWorkerMutex.RUnlock()
 	argsForCall := fake.waitingForWorkerArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeGetDelegate) Invocations() map[string][][]interface{} {
@@ -105,10 +105,11 @@ type FakePutDelegate struct {
 	stdoutReturnsOnCall map[int]struct {
 		result1 io.Writer
 	}
-	WaitingForWorkerStub        func(lager.Logger)
+	WaitingForWorkerStub        func(lager.Logger, string)
 	waitingForWorkerMutex       sync.RWMutex
 	waitingForWorkerArgsForCall []struct {
 		arg1 lager.Logger
+		arg2 string
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
@@ -553,16 +554,17 @@ func (fake *FakePutDelegate) StdoutReturnsOnCall(i int, result1 io.Writer) {
 	}{result1}
 }
 
-func (fake *FakePutDelegate) WaitingForWorker(arg1 lager.Logger) {
+func (fake *FakePutDelegate) WaitingForWorker(arg1 lager.Logger, arg2 string) {
 	fake.waitingForWorkerMutex.Lock()
 	fake.waitingForWorkerArgsForCall = append(fake.waitingForWorkerArgsForCall, struct {
 		arg1 lager.Logger
-	}{arg1})
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.WaitingForWorkerStub
-	fake.recordInvocation("WaitingForWorker", []interface{}{arg1})
+	fake.recordInvocation("WaitingForWorker", []interface{}{arg1, arg2})
 	fake.waitingForWorkerMutex.Unlock()
 	if stub != nil {
-		fake.WaitingForWorkerStub(arg1)
+		fake.WaitingForWorkerStub(arg1, arg2)
 	}
 }
 
@@ -572,17 +574,17 @@ func (fake *FakePutDelegate) WaitingForWorkerCallCount() int {
 	return len(fake.waitingForWorkerArgsForCall)
 }
 
-func (fake *FakePutDelegate) WaitingForWorkerCalls(stub func(lager.Logger)) {
+func (fake *FakePutDelegate) WaitingForWorkerCalls(stub func(lager.Logger, string)) {
 	fake.waitingForWorkerMutex.Lock()
 	defer fake.waitingForWorkerMutex.Unlock()
 	fake.WaitingForWorkerStub = stub
 }
 
-func (fake *FakePutDelegate) WaitingForWorkerArgsForCall(i int) lager.Logger {
+func (fake *FakePutDelegate) WaitingForWorkerArgsForCall(i int) (lager.Logger, string) {
 	fake.waitingForWorkerMutex.RLock()
 	defer fake.waitingForWorkerMutex.RUnlock()
 	argsForCall := fake.waitingForWorkerArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakePutDelegate) Invocations() map[string][][]interface{} {
@@ -100,10 +100,11 @@ type FakeSetPipelineStepDelegate struct {
 	stdoutReturnsOnCall map[int]struct {
 		result1 io.Writer
 	}
-	WaitingForWorkerStub        func(lager.Logger)
+	WaitingForWorkerStub        func(lager.Logger, string)
 	waitingForWorkerMutex       sync.RWMutex
 	waitingForWorkerArgsForCall []struct {
 		arg1 lager.Logger
+		arg2 string
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
@@ -544,16 +545,17 @@ func (fake *FakeSetPipelineStepDelegate) StdoutReturnsOnCall(i int, result1 io.W
 	}{result1}
 }
 
-func (fake *FakeSetPipelineStepDelegate) WaitingForWorker(arg1 lager.Logger) {
+func (fake *FakeSetPipelineStepDelegate) WaitingForWorker(arg1 lager.Logger, arg2 string) {
 	fake.waitingForWorkerMutex.Lock()
 	fake.waitingForWorkerArgsForCall = append(fake.waitingForWorkerArgsForCall, struct {
 		arg1 lager.Logger
-	}{arg1})
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.WaitingForWorkerStub
-	fake.recordInvocation("WaitingForWorker", []interface{}{arg1})
+	fake.recordInvocation("WaitingForWorker", []interface{}{arg1, arg2})
 	fake.waitingForWorkerMutex.Unlock()
 	if stub != nil {
-		fake.WaitingForWorkerStub(arg1)
+		fake.WaitingForWorkerStub(arg1, arg2)
 	}
 }
 
@@ -563,17 +565,17 @@ func (fake *FakeSetPipelineStepDelegate) WaitingForWorkerCallCount() int {
 	return len(fake.waitingForWorkerArgsForCall)
 }
 
-func (fake *FakeSetPipelineStepDelegate) WaitingForWorkerCalls(stub func(lager.Logger)) {
+func (fake *FakeSetPipelineStepDelegate) WaitingForWorkerCalls(stub func(lager.Logger, string)) {
 	fake.waitingForWorkerMutex.Lock()
 	defer fake.waitingForWorkerMutex.Unlock()
 	fake.WaitingForWorkerStub = stub
 }
 
-func (fake *FakeSetPipelineStepDelegate) WaitingForWorkerArgsForCall(i int) lager.Logger {
+func (fake *FakeSetPipelineStepDelegate) WaitingForWorkerArgsForCall(i int) (lager.Logger, string) {
 	fake.waitingForWorkerMutex.RLock()
 	defer fake.waitingForWorkerMutex.RUnlock()
 	argsForCall := fake.waitingForWorkerArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeSetPipelineStepDelegate) Invocations() map[string][][]interface{} {
@@ -45,6 +45,11 @@ type FakeTaskDelegate struct {
 		arg3 worker.ContainerPlacementStrategy
 		arg4 worker.Client
 	}
+	FinishedFromCacheStub        func(lager.Logger)
+	finishedFromCacheMutex       sync.RWMutex
+	finishedFromCacheArgsForCall []struct {
+		arg1 lager.Logger
+	}
 	InitializingStub        func(lager.Logger)
 	initializingMutex       sync.RWMutex
 	initializingArgsForCall []struct {
@@ -101,10 +106,18 @@ type FakeTaskDelegate struct {
 	stdoutReturnsOnCall map[int]struct {
 		result1 io.Writer
 	}
-	WaitingForWorkerStub        func(lager.Logger)
+	UsedResourcesStub        func(lager.Logger, string, atc.ResourceUsage)
+	usedResourcesMutex       sync.RWMutex
+	usedResourcesArgsForCall []struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 atc.ResourceUsage
+	}
+	WaitingForWorkerStub        func(lager.Logger, string)
 	waitingForWorkerMutex       sync.RWMutex
 	waitingForWorkerArgsForCall []struct {
 		arg1 lager.Logger
+		arg2 string
 	}
 	invocations      map[string][][]interface{}
 	invocationsMutex sync.RWMutex
@@ -245,6 +258,38 @@ func (fake *FakeTaskDelegate) FinishedArgsForCall(i int) (lager.Logger, exec.Exi
 	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3, argsForCall.arg4
 }
 
+func (fake *FakeTaskDelegate) FinishedFromCache(arg1 lager.Logger) {
+	fake.finishedFromCacheMutex.Lock()
+	fake.finishedFromCacheArgsForCall = append(fake.finishedFromCacheArgsForCall, struct {
+		arg1 lager.Logger
+	}{arg1})
+	stub := fake.FinishedFromCacheStub
+	fake.recordInvocation("FinishedFromCache", []interface{}{arg1})
+	fake.finishedFromCacheMutex.Unlock()
+	if stub != nil {
+		stub(arg1)
+	}
+}
+
+func (fake *FakeTaskDelegate) FinishedFromCacheCallCount() int {
+	fake.finishedFromCacheMutex.RLock()
+	defer fake.finishedFromCacheMutex.RUnlock()
+	return len(fake.finishedFromCacheArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) FinishedFromCacheCalls(stub func(lager.Logger)) {
+	fake.finishedFromCacheMutex.Lock()
+	defer fake.finishedFromCacheMutex.Unlock()
+	fake.FinishedFromCacheStub = stub
+}
+
+func (fake *FakeTaskDelegate) FinishedFromCacheArgsForCall(i int) lager.Logger {
+	fake.finishedFromCacheMutex.RLock()
+	defer fake.finishedFromCacheMutex.RUnlock()
+	argsForCall := fake.finishedFromCacheArgsForCall[i]
+	return argsForCall.arg1
+}
+
 func (fake *FakeTaskDelegate) Initializing(arg1 lager.Logger) {
 	fake.initializingMutex.Lock()
 	fake.initializingArgsForCall = append(fake.initializingArgsForCall, struct {
@@ -546,16 +591,51 @@ func (fake *FakeTaskDelegate) StdoutReturnsOnCall(i int, result1 io.Writer) {
 	}{result1}
 }
 
-func (fake *FakeTaskDelegate) WaitingForWorker(arg1 lager.Logger) {
+func (fake *FakeTaskDelegate) UsedResources(arg1 lager.Logger, arg2 string, arg3 atc.ResourceUsage) {
+	fake.usedResourcesMutex.Lock()
+	fake.usedResourcesArgsForCall = append(fake.usedResourcesArgsForCall, struct {
+		arg1 lager.Logger
+		arg2 string
+		arg3 atc.ResourceUsage
+	}{arg1, arg2, arg3})
+	stub := fake.UsedResourcesStub
+	fake.recordInvocation("UsedResources", []interface{}{arg1, arg2, arg3})
+	fake.usedResourcesMutex.Unlock()
+	if stub != nil {
+		stub(arg1, arg2, arg3)
+	}
+}
+
+func (fake *FakeTaskDelegate) UsedResourcesCallCount() int {
+	fake.usedResourcesMutex.RLock()
+	defer fake.usedResourcesMutex.RUnlock()
+	return len(fake.usedResourcesArgsForCall)
+}
+
+func (fake *FakeTaskDelegate) UsedResourcesCalls(stub func(lager.Logger, string, atc.ResourceUsage)) {
+	fake.usedResourcesMutex.Lock()
+	defer fake.usedResourcesMutex.Unlock()
+	fake.UsedResourcesStub = stub
+}
+
+func (fake *FakeTaskDelegate) UsedResourcesArgsForCall(i int) (lager.Logger, string, atc.ResourceUsage) {
+	fake.usedResourcesMutex.RLock()
+	defer fake.usedResourcesMutex.RUnlock()
+	argsForCall := fake.usedResourcesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTaskDelegate) WaitingForWorker(arg1 lager.Logger, arg2 string) {
 	fake.waitingForWorkerMutex.Lock()
 	fake.waitingForWorkerArgsForCall = append(fake.waitingForWorkerArgsForCall, struct {
 		arg1 lager.Logger
-	}{arg1})
+		arg2 string
+	}{arg1, arg2})
 	stub := fake.WaitingForWorkerStub
-	fake.recordInvocation("WaitingForWorker", []interface{}{arg1})
+	fake.recordInvocation("WaitingForWorker", []interface{}{arg1, arg2})
 	fake.waitingForWorkerMutex.Unlock()
 	if stub != nil {
-		fake.WaitingForWorkerStub(arg1)
+		fake.WaitingForWorkerStub(arg1, arg2)
 	}
 }
 
@@ -565,17 +645,17 @@ func (fake *FakeTaskDelegate) WaitingForWorkerCallCount() int {
 	return len(fake.waitingForWorkerArgsForCall)
 }
 
-func (fake *FakeTaskDelegate) WaitingForWorkerCalls(stub func(lager.Logger)) {
+func (fake *FakeTaskDelegate) WaitingForWorkerCalls(stub func(lager.Logger, string)) {
 	fake.waitingForWorkerMutex.Lock()
 	defer fake.waitingForWorkerMutex.Unlock()
 	fake.WaitingForWorkerStub = stub
 }
 
-func (fake *FakeTaskDelegate) WaitingForWorkerArgsForCall(i int) lager.Logger {
+func (fake *FakeTaskDelegate) WaitingForWorkerArgsForCall(i int) (lager.Logger, string) {
 	fake.waitingForWorkerMutex.RLock()
 	defer fake.waitingForWorkerMutex.RUnlock()
 	argsForCall := fake.waitingForWorkerArgsForCall[i]
-	return argsForCall.arg1
+	return argsForCall.arg1, argsForCall.arg2
 }
 
 func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
@@ -587,6 +667,8 @@ func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	defer fake.fetchImageMutex.RUnlock()
 	fake.finishedMutex.RLock()
 	defer fake.finishedMutex.RUnlock()
+	fake.finishedFromCacheMutex.RLock()
+	defer fake.finishedFromCacheMutex.RUnlock()
 	fake.initializingMutex.RLock()
 	defer fake.initializingMutex.RUnlock()
 	fake.selectedWorkerMutex.RLock()
@@ -601,6 +683,8 @@ func (fake *FakeTaskDelegate) Invocations() map[string][][]interface{} {
 	defer fake.stderrMutex.RUnlock()
 	fake.stdoutMutex.RLock()
 	defer fake.stdoutMutex.RUnlock()
+	fake.usedResourcesMutex.RLock()
+	defer fake.usedResourcesMutex.RUnlock()
 	fake.waitingForWorkerMutex.RLock()
 	defer fake.waitingForWorkerMutex.RUnlock()
 	copiedInvocations := map[string][][]interface{}{}
@@ -56,7 +56,7 @@ type GetDelegate interface {
 	Finished(lager.Logger, ExitStatus, runtime.VersionResult)
 	Errored(lager.Logger, string)
 
-	WaitingForWorker(lager.Logger)
+	WaitingForWorker(lager.Logger, string)
 	SelectedWorker(lager.Logger, string)
 
 	UpdateVersion(lager.Logger, atc.GetPlan, runtime.VersionResult)
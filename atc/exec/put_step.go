@@ -36,7 +36,7 @@ type PutDelegate interface {
 	Finished(lager.Logger, ExitStatus, runtime.VersionResult)
 	Errored(lager.Logger, string)
 
-	WaitingForWorker(lager.Logger)
+	WaitingForWorker(lager.Logger, string)
 	SelectedWorker(lager.Logger, string)
 
 	SaveOutput(lager.Logger, atc.PutPlan, atc.Source, atc.VersionedResourceTypes, runtime.VersionResult)
@@ -1,61 +1,299 @@
 package exec
 
 import (
+	"bytes"
 	"context"
-	"fmt"
-	"io"
+	"encoding/json"
+	"errors"
+	"path"
+	"path/filepath"
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagerctx"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/creds"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/tracing"
 )
 
-// RunStep will run a message against a prototype.
-type RunStep struct {
-	planID          atc.PlanID
-	plan            atc.RunPlan
-	delegateFactory RunDelegateFactory
+// prototypeRequest is written to the stdin of the prototype's message
+// executable.
+type prototypeRequest struct {
+	Object atc.Params `json:"object"`
 }
 
+//counterfeiter:generate . RunDelegateFactory
 type RunDelegateFactory interface {
 	RunDelegate(state RunState) RunDelegate
 }
 
+//counterfeiter:generate . RunDelegate
 type RunDelegate interface {
-	Stdout() io.Writer
-	Stderr() io.Writer
+	BuildStepDelegate
+}
 
-	Initializing(lager.Logger)
-	Starting(lager.Logger)
-	Finished(lager.Logger, bool)
+// RunStep will run a message against a prototype.
+type RunStep struct {
+	planID            atc.PlanID
+	plan              atc.RunPlan
+	defaultLimits     atc.ContainerLimits
+	metadata          StepMetadata
+	containerMetadata db.ContainerMetadata
+	strategy          worker.ContainerPlacementStrategy
+	workerPool        worker.Pool
+	artifactSourcer   worker.ArtifactSourcer
+	delegateFactory   RunDelegateFactory
 }
 
 func NewRunStep(
 	planID atc.PlanID,
 	plan atc.RunPlan,
+	defaultLimits atc.ContainerLimits,
+	metadata StepMetadata,
+	containerMetadata db.ContainerMetadata,
+	strategy worker.ContainerPlacementStrategy,
+	workerPool worker.Pool,
+	artifactSourcer worker.ArtifactSourcer,
 	delegateFactory RunDelegateFactory,
 ) Step {
 	return &RunStep{
-		planID:          planID,
-		plan:            plan,
-		delegateFactory: delegateFactory,
+		planID:            planID,
+		plan:              plan,
+		defaultLimits:     defaultLimits,
+		metadata:          metadata,
+		containerMetadata: containerMetadata,
+		strategy:          strategy,
+		workerPool:        workerPool,
+		artifactSourcer:   artifactSourcer,
+		delegateFactory:   delegateFactory,
 	}
 }
 
+// Run fetches the prototype's image and runs the message's executable
+// (/usr/bin/<message>) in a container, providing the object as a JSON request
+// on stdin. Inputs are mounted under the container's working directory by
+// name, and outputs are registered with the artifact.Repository once the
+// message has been run.
+//
+// If any inputs are not available in the artifact.Repository,
+// MissingInputsError is returned.
 func (step *RunStep) Run(ctx context.Context, state RunState) (bool, error) {
+	delegate := step.delegateFactory.RunDelegate(state)
+	ctx, span := delegate.StartSpan(ctx, "run", tracing.Attrs{
+		"message":   step.plan.Message,
+		"prototype": step.plan.Type,
+	})
+
+	ok, err := step.run(ctx, state, delegate)
+	tracing.End(span, err)
+
+	return ok, err
+}
+
+func (step *RunStep) run(ctx context.Context, state RunState, delegate RunDelegate) (bool, error) {
 	logger := lagerctx.FromContext(ctx)
+	logger = logger.Session("run-step", lager.Data{
+		"message":   step.plan.Message,
+		"prototype": step.plan.Type,
+	})
 
-	delegate := step.delegateFactory.RunDelegate(state)
 	delegate.Initializing(logger)
 
-	stderr := delegate.Stderr()
-	fmt.Fprint(stderr, "\x1b[1;33mthe run step is not yet implemented\x1b[0m\n\n")
+	object, err := creds.NewParams(state, step.plan.Object).Evaluate()
+	if err != nil {
+		return false, err
+	}
+
+	request, err := json.Marshal(prototypeRequest{
+		Object: object,
+	})
+	if err != nil {
+		return false, err
+	}
+
+	types, err := creds.NewVersionedResourceTypes(state, step.plan.VersionedResourceTypes).Evaluate()
+	if err != nil {
+		return false, err
+	}
+
+	image := step.plan.Image
+	if len(image.Tags) == 0 {
+		image.Tags = step.plan.Tags
+	}
+
+	imageSpec, err := delegate.FetchImage(ctx, image, types, step.plan.Privileged)
+	if err != nil {
+		return false, err
+	}
+
+	containerSpec, err := step.containerSpec(logger, state, imageSpec)
+	if err != nil {
+		return false, err
+	}
+	tracing.Inject(ctx, &containerSpec)
+
+	processSpec := runtime.ProcessSpec{
+		Path:         path.Join("/usr/bin", step.plan.Message),
+		StdinReader:  bytes.NewReader(request),
+		StdoutWriter: delegate.Stdout(),
+		StderrWriter: delegate.Stderr(),
+	}
+
+	owner := db.NewBuildStepContainerOwner(step.metadata.BuildID, step.planID, step.metadata.TeamID)
+
+	workerSpec := worker.WorkerSpec{
+		Tags:   step.plan.Tags,
+		TeamID: step.metadata.TeamID,
+	}
+
+	chosenWorker, _, err := step.workerPool.SelectWorker(
+		lagerctx.NewContext(ctx, logger),
+		owner,
+		containerSpec,
+		workerSpec,
+		step.strategy,
+		delegate,
+	)
+	if err != nil {
+		return false, err
+	}
+
+	delegate.SelectedWorker(logger, chosenWorker.Name())
+
+	defer func() {
+		step.workerPool.ReleaseWorker(
+			lagerctx.NewContext(ctx, logger),
+			containerSpec,
+			chosenWorker,
+			step.strategy,
+		)
+	}()
+
+	processCtx, cancel, err := MaybeTimeout(ctx, step.plan.Timeout)
+	if err != nil {
+		return false, err
+	}
+
+	defer cancel()
+
+	result, runErr := chosenWorker.RunTaskStep(
+		lagerctx.NewContext(processCtx, logger),
+		owner,
+		containerSpec,
+		step.containerMetadata,
+		processSpec,
+		delegate,
+	)
 
-	delegate.Starting(logger)
+	step.registerOutputs(logger, state.ArtifactRepository(), result.VolumeMounts)
 
-	fmt.Fprintf(stderr, "\x1b[1;34mpretending to run %s on prototype %s...\x1b[0m\n", step.plan.Message, step.plan.Type)
+	if runErr != nil {
+		if errors.Is(runErr, context.DeadlineExceeded) {
+			delegate.Errored(logger, TimeoutLogMessage)
+			return false, nil
+		}
 
-	delegate.Finished(logger, true)
+		return false, runErr
+	}
+
+	succeeded := result.ExitStatus == 0
+
+	delegate.Finished(logger, succeeded)
+
+	return succeeded, nil
+}
+
+func (step *RunStep) containerSpec(logger lager.Logger, state RunState, imageSpec worker.ImageSpec) (worker.ContainerSpec, error) {
+	limits := worker.ContainerLimits{
+		CPU:    (*uint64)(step.defaultLimits.CPU),
+		Memory: (*uint64)(step.defaultLimits.Memory),
+	}
+	if step.plan.Limits != nil {
+		if step.plan.Limits.CPU != nil {
+			limits.CPU = (*uint64)(step.plan.Limits.CPU)
+		}
+		if step.plan.Limits.Memory != nil {
+			limits.Memory = (*uint64)(step.plan.Limits.Memory)
+		}
+	}
+
+	containerSpec := worker.ContainerSpec{
+		ImageSpec: imageSpec,
+		TeamID:    step.metadata.TeamID,
+		Type:      step.containerMetadata.Type,
+
+		Dir:    step.containerMetadata.WorkingDirectory,
+		Env:    step.metadata.Env(),
+		Limits: limits,
+
+		Outputs: worker.OutputPaths{},
+	}
+
+	var err error
+	containerSpec.Inputs, err = step.containerInputs(logger, state.ArtifactRepository())
+	if err != nil {
+		return worker.ContainerSpec{}, err
+	}
+
+	for _, output := range step.plan.Outputs {
+		containerSpec.Outputs[output] = step.artifactPath(output) + "/"
+	}
+
+	return containerSpec, nil
+}
+
+func (step *RunStep) containerInputs(logger lager.Logger, repository *build.Repository) ([]worker.InputSource, error) {
+	inputs := map[string]runtime.Artifact{}
+
+	var missingInputs []string
+
+	for _, input := range step.plan.Inputs {
+		inputName := input
+		if sourceName, ok := step.plan.InputMapping[input]; ok {
+			inputName = sourceName
+		}
+
+		art, found := repository.ArtifactFor(build.ArtifactName(inputName))
+		if !found {
+			missingInputs = append(missingInputs, inputName)
+			continue
+		}
+
+		inputs[step.artifactPath(input)] = art
+	}
+
+	if len(missingInputs) > 0 {
+		return nil, MissingInputsError{missingInputs}
+	}
+
+	return step.artifactSourcer.SourceInputsAndCaches(logger, step.metadata.TeamID, inputs)
+}
+
+func (step *RunStep) registerOutputs(logger lager.Logger, repository *build.Repository, volumeMounts []worker.VolumeMount) {
+	logger.Debug("registering-outputs", lager.Data{"outputs": step.plan.Outputs})
+
+	for _, output := range step.plan.Outputs {
+		outputName := output
+		if destinationName, ok := step.plan.OutputMapping[output]; ok {
+			outputName = destinationName
+		}
+
+		outputPath := step.artifactPath(output)
+
+		for _, mount := range volumeMounts {
+			if filepath.Clean(mount.MountPath) == outputPath {
+				art := &runtime.TaskArtifact{
+					VolumeHandle: mount.Volume.Handle(),
+				}
+				repository.RegisterArtifact(build.ArtifactName(outputName), art)
+			}
+		}
+	}
+}
 
-	return true, nil
+func (step *RunStep) artifactPath(name string) string {
+	return filepath.Join(step.containerMetadata.WorkingDirectory, name)
 }
@@ -0,0 +1,418 @@
+package exec_test
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/exec"
+	"github.com/concourse/concourse/atc/exec/build"
+	"github.com/concourse/concourse/atc/exec/execfakes"
+	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/runtime/runtimefakes"
+	"github.com/concourse/concourse/atc/worker"
+	"github.com/concourse/concourse/atc/worker/workerfakes"
+	"github.com/concourse/concourse/tracing"
+	"github.com/concourse/concourse/vars"
+	"github.com/onsi/gomega/gbytes"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("RunStep", func() {
+	var (
+		ctx    context.Context
+		cancel func()
+
+		stdoutBuf *gbytes.Buffer
+		stderrBuf *gbytes.Buffer
+
+		fakePool            *workerfakes.FakePool
+		fakeClient          *workerfakes.FakeClient
+		fakeArtifactSourcer *workerfakes.FakeArtifactSourcer
+		fakeStrategy        *workerfakes.FakeContainerPlacementStrategy
+
+		fakeDelegate        *execfakes.FakeRunDelegate
+		fakeDelegateFactory *execfakes.FakeRunDelegateFactory
+
+		runPlan *atc.RunPlan
+
+		repo  *build.Repository
+		state *execfakes.FakeRunState
+
+		runStep exec.Step
+		stepOk  bool
+		stepErr error
+
+		containerMetadata = db.ContainerMetadata{
+			WorkingDirectory: "/tmp/build/run",
+			Type:             db.ContainerTypeRun,
+		}
+
+		stepMetadata = exec.StepMetadata{
+			TeamID:  123,
+			BuildID: 1234,
+			JobID:   12345,
+		}
+
+		planID = atc.PlanID("42")
+
+		imageSpec = worker.ImageSpec{
+			ImageArtifactSource: new(workerfakes.FakeStreamableArtifactSource),
+		}
+
+		shouldRunMessage bool
+
+		owner              db.ContainerOwner
+		containerSpec      worker.ContainerSpec
+		metadata           db.ContainerMetadata
+		processSpec        runtime.ProcessSpec
+		startEventDelegate runtime.StartingEventDelegate
+	)
+
+	BeforeEach(func() {
+		ctx, cancel = context.WithCancel(context.Background())
+
+		stdoutBuf = gbytes.NewBuffer()
+		stderrBuf = gbytes.NewBuffer()
+
+		fakeClient = new(workerfakes.FakeClient)
+		fakeClient.NameReturns("some-worker")
+		fakePool = new(workerfakes.FakePool)
+		fakePool.SelectWorkerReturns(fakeClient, 0, nil)
+
+		fakeArtifactSourcer = new(workerfakes.FakeArtifactSourcer)
+		fakeStrategy = new(workerfakes.FakeContainerPlacementStrategy)
+
+		fakeDelegate = new(execfakes.FakeRunDelegate)
+		fakeDelegate.StdoutReturns(stdoutBuf)
+		fakeDelegate.StderrReturns(stderrBuf)
+		fakeDelegate.StartSpanReturns(context.Background(), tracing.NoopSpan)
+		fakeDelegate.FetchImageReturns(imageSpec, nil)
+
+		fakeDelegateFactory = new(execfakes.FakeRunDelegateFactory)
+		fakeDelegateFactory.RunDelegateReturns(fakeDelegate)
+
+		repo = build.NewRepository()
+		state = new(execfakes.FakeRunState)
+		state.ArtifactRepositoryReturns(repo)
+		state.GetStub = vars.StaticVariables{"secret": "super-secret"}.Get
+
+		runPlan = &atc.RunPlan{
+			Message: "some-message",
+			Type:    "some-prototype",
+			Object: atc.Params{
+				"some": "object",
+				"cred": "((secret))",
+			},
+			Image: atc.ImageResource{
+				Name:   "some-prototype",
+				Type:   "registry-image",
+				Source: atc.Source{"repository": "some/prototype"},
+			},
+			VersionedResourceTypes: atc.VersionedResourceTypes{
+				{
+					ResourceType: atc.ResourceType{
+						Name:   "custom-resource",
+						Type:   "custom-type",
+						Source: atc.Source{"some-custom": "((secret))"},
+					},
+					Version: atc.Version{"some-custom": "version"},
+				},
+			},
+		}
+
+		fakeClient.RunTaskStepReturns(worker.TaskResult{ExitStatus: 0}, nil)
+
+		shouldRunMessage = true
+	})
+
+	AfterEach(func() {
+		cancel()
+	})
+
+	JustBeforeEach(func() {
+		runStep = exec.NewRunStep(
+			planID,
+			*runPlan,
+			atc.ContainerLimits{},
+			stepMetadata,
+			containerMetadata,
+			fakeStrategy,
+			fakePool,
+			fakeArtifactSourcer,
+			fakeDelegateFactory,
+		)
+
+		stepOk, stepErr = runStep.Run(ctx, state)
+
+		if shouldRunMessage {
+			Expect(fakeClient.RunTaskStepCallCount()).To(Equal(1), "message should have run")
+			_, owner, containerSpec, metadata, processSpec, startEventDelegate = fakeClient.RunTaskStepArgsForCall(0)
+		} else {
+			Expect(fakeClient.RunTaskStepCallCount()).To(Equal(0), "message should NOT have run")
+		}
+	})
+
+	It("fetches the prototype's image with evaluated resource types", func() {
+		Expect(fakeDelegate.FetchImageCallCount()).To(Equal(1))
+		_, image, types, privileged := fakeDelegate.FetchImageArgsForCall(0)
+		Expect(image).To(Equal(runPlan.Image))
+		Expect(types[0].Source).To(Equal(atc.Source{"some-custom": "super-secret"}))
+		Expect(privileged).To(BeFalse())
+	})
+
+	It("runs the message's executable with the request on stdin", func() {
+		Expect(processSpec.Path).To(Equal("/usr/bin/some-message"))
+		Expect(processSpec.StdoutWriter).To(Equal(stdoutBuf))
+		Expect(processSpec.StderrWriter).To(Equal(stderrBuf))
+
+		payload, err := ioutil.ReadAll(processSpec.StdinReader)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(payload).To(MatchJSON(`{
+			"object": {"some": "object", "cred": "super-secret"}
+		}`))
+	})
+
+	It("runs the container with the fetched image", func() {
+		Expect(containerSpec.ImageSpec).To(Equal(imageSpec))
+		Expect(containerSpec.Dir).To(Equal("/tmp/build/run"))
+		Expect(containerSpec.TeamID).To(Equal(123))
+	})
+
+	It("uses the correct owner, metadata and delegate", func() {
+		Expect(owner).To(Equal(db.NewBuildStepContainerOwner(1234, planID, 123)))
+		Expect(metadata).To(Equal(containerMetadata))
+		Expect(startEventDelegate).To(Equal(fakeDelegate))
+	})
+
+	It("emits a SelectedWorker event", func() {
+		Expect(fakeDelegate.SelectedWorkerCallCount()).To(Equal(1))
+		_, workerName := fakeDelegate.SelectedWorkerArgsForCall(0)
+		Expect(workerName).To(Equal("some-worker"))
+	})
+
+	It("releases the worker", func() {
+		Expect(fakePool.ReleaseWorkerCallCount()).To(Equal(1))
+	})
+
+	It("finishes successfully", func() {
+		Expect(stepErr).ToNot(HaveOccurred())
+		Expect(stepOk).To(BeTrue())
+
+		Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+		_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+		Expect(succeeded).To(BeTrue())
+	})
+
+	Context("when tags are configured", func() {
+		BeforeEach(func() {
+			runPlan.Tags = atc.Tags{"some", "tags"}
+		})
+
+		It("selects a worker with the tags", func() {
+			_, _, _, workerSpec, _, _ := fakePool.SelectWorkerArgsForCall(0)
+			Expect(workerSpec.Tags).To(Equal([]string{"some", "tags"}))
+		})
+
+		It("fetches the image with the tags", func() {
+			_, image, _, _ := fakeDelegate.FetchImageArgsForCall(0)
+			Expect(image.Tags).To(Equal(atc.Tags{"some", "tags"}))
+		})
+	})
+
+	Context("when privileged", func() {
+		BeforeEach(func() {
+			runPlan.Privileged = true
+		})
+
+		It("fetches a privileged image", func() {
+			_, _, _, privileged := fakeDelegate.FetchImageArgsForCall(0)
+			Expect(privileged).To(BeTrue())
+		})
+	})
+
+	Context("when limits are configured", func() {
+		BeforeEach(func() {
+			cpu := atc.CPULimit(512)
+			memory := atc.MemoryLimit(1024)
+			runPlan.Limits = &atc.ContainerLimits{CPU: &cpu, Memory: &memory}
+		})
+
+		It("sets the container limits", func() {
+			Expect(*containerSpec.Limits.CPU).To(Equal(uint64(512)))
+			Expect(*containerSpec.Limits.Memory).To(Equal(uint64(1024)))
+		})
+	})
+
+	Context("when fetching the image fails", func() {
+		BeforeEach(func() {
+			fakeDelegate.FetchImageReturns(worker.ImageSpec{}, errors.New("nope"))
+			shouldRunMessage = false
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(MatchError("nope"))
+		})
+	})
+
+	Context("when selecting a worker fails", func() {
+		BeforeEach(func() {
+			fakePool.SelectWorkerReturns(nil, 0, errors.New("nope"))
+			shouldRunMessage = false
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(MatchError("nope"))
+		})
+	})
+
+	Context("when inputs are configured", func() {
+		var inputArtifact *runtimefakes.FakeArtifact
+		var remappedArtifact *runtimefakes.FakeArtifact
+
+		BeforeEach(func() {
+			inputArtifact = new(runtimefakes.FakeArtifact)
+			remappedArtifact = new(runtimefakes.FakeArtifact)
+
+			runPlan.Inputs = []string{"some-input", "remapped-input"}
+			runPlan.InputMapping = map[string]string{"remapped-input": "remapped-input-src"}
+		})
+
+		Context("when all inputs are present", func() {
+			BeforeEach(func() {
+				repo.RegisterArtifact("some-input", inputArtifact)
+				repo.RegisterArtifact("remapped-input-src", remappedArtifact)
+			})
+
+			It("mounts the inputs in the working directory", func() {
+				Expect(fakeArtifactSourcer.SourceInputsAndCachesCallCount()).To(Equal(1))
+				_, teamID, inputMap := fakeArtifactSourcer.SourceInputsAndCachesArgsForCall(0)
+				Expect(teamID).To(Equal(123))
+				Expect(inputMap).To(Equal(map[string]runtime.Artifact{
+					"/tmp/build/run/some-input":     inputArtifact,
+					"/tmp/build/run/remapped-input": remappedArtifact,
+				}))
+			})
+		})
+
+		Context("when any of the inputs are missing", func() {
+			BeforeEach(func() {
+				repo.RegisterArtifact("some-input", inputArtifact)
+				shouldRunMessage = false
+			})
+
+			It("returns a MissingInputsError", func() {
+				Expect(stepErr).To(Equal(exec.MissingInputsError{Inputs: []string{"remapped-input-src"}}))
+			})
+		})
+	})
+
+	Context("when outputs are configured", func() {
+		var fakeVolume *workerfakes.FakeVolume
+		var fakeRemappedVolume *workerfakes.FakeVolume
+
+		BeforeEach(func() {
+			runPlan.Outputs = []string{"some-output", "remapped-output"}
+			runPlan.OutputMapping = map[string]string{"remapped-output": "remapped-output-dst"}
+
+			fakeVolume = new(workerfakes.FakeVolume)
+			fakeVolume.HandleReturns("some-handle")
+			fakeRemappedVolume = new(workerfakes.FakeVolume)
+			fakeRemappedVolume.HandleReturns("some-remapped-handle")
+
+			fakeClient.RunTaskStepReturns(worker.TaskResult{
+				ExitStatus: 0,
+				VolumeMounts: []worker.VolumeMount{
+					{Volume: fakeVolume, MountPath: "/tmp/build/run/some-output/"},
+					{Volume: fakeRemappedVolume, MountPath: "/tmp/build/run/remapped-output/"},
+				},
+			}, nil)
+		})
+
+		It("configures the outputs on the container", func() {
+			Expect(containerSpec.Outputs).To(Equal(worker.OutputPaths{
+				"some-output":     "/tmp/build/run/some-output/",
+				"remapped-output": "/tmp/build/run/remapped-output/",
+			}))
+		})
+
+		It("registers the outputs as artifacts", func() {
+			artifact, found := repo.ArtifactFor("some-output")
+			Expect(found).To(BeTrue())
+			Expect(artifact).To(Equal(&runtime.TaskArtifact{VolumeHandle: "some-handle"}))
+
+			artifact, found = repo.ArtifactFor("remapped-output-dst")
+			Expect(found).To(BeTrue())
+			Expect(artifact).To(Equal(&runtime.TaskArtifact{VolumeHandle: "some-remapped-handle"}))
+		})
+	})
+
+	Context("when the message exits nonzero", func() {
+		BeforeEach(func() {
+			fakeClient.RunTaskStepReturns(worker.TaskResult{ExitStatus: 1}, nil)
+		})
+
+		It("finishes unsuccessfully", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(stepOk).To(BeFalse())
+
+			Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+			_, succeeded := fakeDelegate.FinishedArgsForCall(0)
+			Expect(succeeded).To(BeFalse())
+		})
+	})
+
+	Context("when running the message errors", func() {
+		BeforeEach(func() {
+			fakeClient.RunTaskStepReturns(worker.TaskResult{}, errors.New("nope"))
+		})
+
+		It("returns the error", func() {
+			Expect(stepErr).To(MatchError("nope"))
+			Expect(fakeDelegate.FinishedCallCount()).To(Equal(0))
+		})
+	})
+
+	Context("when a timeout is configured", func() {
+		BeforeEach(func() {
+			runPlan.Timeout = "1ms"
+
+			fakeClient.RunTaskStepStub = func(ctx context.Context, _ db.ContainerOwner, _ worker.ContainerSpec, _ db.ContainerMetadata, _ runtime.ProcessSpec, _ runtime.StartingEventDelegate) (worker.TaskResult, error) {
+				select {
+				case <-ctx.Done():
+					return worker.TaskResult{}, ctx.Err()
+				case <-time.After(100 * time.Millisecond):
+					return worker.TaskResult{}, nil
+				}
+			}
+		})
+
+		It("emits an Errored event and fails", func() {
+			Expect(stepErr).ToNot(HaveOccurred())
+			Expect(stepOk).To(BeFalse())
+
+			Expect(fakeDelegate.ErroredCallCount()).To(Equal(1))
+			_, message := fakeDelegate.ErroredArgsForCall(0)
+			Expect(message).To(Equal(exec.TimeoutLogMessage))
+		})
+	})
+
+	Context("before running the message", func() {
+		BeforeEach(func() {
+			fakeDelegate.InitializingStub = func(lager.Logger) {
+				defer GinkgoRecover()
+				Expect(fakeClient.RunTaskStepCallCount()).To(BeZero())
+			}
+		})
+
+		It("invokes the delegate's Initializing callback", func() {
+			Expect(fakeDelegate.InitializingCallCount()).To(Equal(1))
+		})
+	})
+})
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"path"
 	"strings"
 
 	"code.cloudfoundry.org/lager"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/concourse/baggageclaim"
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/configinclude"
 	"github.com/concourse/concourse/atc/configvalidate"
 	"github.com/concourse/concourse/atc/creds"
 	"github.com/concourse/concourse/atc/db"
@@ -335,6 +337,11 @@ func (s setPipelineSource) FetchPipelineConfig() (atc.Config, error) {
 		}
 	}
 
+	config, err = configinclude.Resolve(config, s.step.plan.File, s, staticVars)
+	if err != nil {
+		return atc.Config{}, err
+	}
+
 	atcConfig := atc.Config{}
 	err = atc.UnmarshalConfig(config, &atcConfig)
 	if err != nil {
@@ -344,6 +351,18 @@ func (s setPipelineSource) FetchPipelineConfig() (atc.Config, error) {
 	return atcConfig, nil
 }
 
+// Join resolves the path of a file included by the pipeline config relative
+// to the file including it, e.g. "repo/ci/pipeline.yml" including
+// "modules/unit.yml" refers to "repo/ci/modules/unit.yml".
+func (s setPipelineSource) Join(from string, file string) string {
+	return path.Join(path.Dir(from), file)
+}
+
+// Read fetches a file included by the pipeline config.
+func (s setPipelineSource) Read(file string) ([]byte, error) {
+	return s.fetchPipelineBits(file)
+}
+
 func (s setPipelineSource) fetchPipelineBits(path string) ([]byte, error) {
 	segs := strings.SplitN(path, "/", 2)
 	if len(segs) != 2 {
@@ -11,6 +11,7 @@ import (
 
 	"code.cloudfoundry.org/lager/lagerctx"
 	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/baggageclaim"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 	"github.com/concourse/concourse/atc/db/dbfakes"
@@ -20,6 +21,7 @@ import (
 	"github.com/concourse/concourse/atc/exec/execfakes"
 	"github.com/concourse/concourse/atc/policy"
 	"github.com/concourse/concourse/atc/policy/policyfakes"
+	"github.com/concourse/concourse/atc/runtime"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 	"github.com/concourse/concourse/tracing"
 	"github.com/concourse/concourse/vars"
@@ -286,6 +288,54 @@ jobs:
 			})
 		})
 
+		Context("when pipeline file includes other files", func() {
+			BeforeEach(func() {
+				fakeArtifactStreamer.StreamFileFromArtifactStub = func(_ context.Context, _ runtime.Artifact, file string) (io.ReadCloser, error) {
+					switch file {
+					case "pipeline.yml":
+						return &fakeReadCloser{str: "include: [{file: modules/job.yml, vars: {job: some-job}}]"}, nil
+					case "modules/job.yml":
+						return &fakeReadCloser{str: pipelineContent}, nil
+					default:
+						return nil, baggageclaim.ErrFileNotFound
+					}
+				}
+
+				fakeTeam.PipelineReturns(nil, false, nil)
+				fakeBuild.SavePipelineReturns(fakePipeline, true, nil)
+			})
+
+			It("saves the pipeline with the included files merged in", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(fakeBuild.SavePipelineCallCount()).To(Equal(1))
+				_, _, config, _, _ := fakeBuild.SavePipelineArgsForCall(0)
+				Expect(config).To(Equal(pipelineObject))
+			})
+
+			It("fetches the included files relative to the pipeline file", func() {
+				Expect(fakeArtifactStreamer.StreamFileFromArtifactCallCount()).To(Equal(2))
+				_, art, file := fakeArtifactStreamer.StreamFileFromArtifactArgsForCall(1)
+				Expect(art).To(Equal(fakeSource))
+				Expect(file).To(Equal("modules/job.yml"))
+			})
+
+			Context("when an included file does not exist", func() {
+				BeforeEach(func() {
+					fakeArtifactStreamer.StreamFileFromArtifactStub = func(_ context.Context, _ runtime.Artifact, file string) (io.ReadCloser, error) {
+						if file == "pipeline.yml" {
+							return &fakeReadCloser{str: "include: [{file: modules/bogus.yml}]"}, nil
+						}
+
+						return nil, baggageclaim.ErrFileNotFound
+					}
+				})
+
+				It("should return an error", func() {
+					Expect(stepErr).To(MatchError(ContainSubstring("read included file some-resource/modules/bogus.yml")))
+				})
+			})
+		})
+
 		Context("when pipeline file is good", func() {
 			BeforeEach(func() {
 				fakeArtifactStreamer.StreamFileFromArtifactReturns(&fakeReadCloser{str: pipelineContent}, nil)
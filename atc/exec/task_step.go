@@ -2,6 +2,8 @@ package exec
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
@@ -70,9 +72,14 @@ type TaskDelegate interface {
 	Initializing(lager.Logger)
 	Starting(lager.Logger)
 	Finished(lager.Logger, ExitStatus, worker.ContainerPlacementStrategy, worker.Client)
+	FinishedFromCache(lager.Logger)
 	Errored(lager.Logger, string)
 
-	WaitingForWorker(lager.Logger)
+	// UsedResources is given the resource usage of the task's container,
+	// along with the name of the step, once its process has exited.
+	UsedResources(lager.Logger, string, atc.ResourceUsage)
+
+	WaitingForWorker(lager.Logger, string)
 	SelectedWorker(lager.Logger, string)
 }
 
@@ -88,6 +95,8 @@ type TaskStep struct {
 	workerPool        worker.Pool
 	artifactSourcer   worker.ArtifactSourcer
 	artifactStreamer  worker.ArtifactStreamer
+	artifactArchiver  worker.ArtifactArchiver
+	resultCaches      db.TaskResultCacheFactory
 	delegateFactory   TaskDelegateFactory
 }
 
@@ -101,6 +110,8 @@ func NewTaskStep(
 	workerPool worker.Pool,
 	artifactStreamer worker.ArtifactStreamer,
 	artifactSourcer worker.ArtifactSourcer,
+	artifactArchiver worker.ArtifactArchiver,
+	resultCaches db.TaskResultCacheFactory,
 	delegateFactory TaskDelegateFactory,
 ) Step {
 	return &TaskStep{
@@ -113,6 +124,8 @@ func NewTaskStep(
 		workerPool:        workerPool,
 		artifactStreamer:  artifactStreamer,
 		artifactSourcer:   artifactSourcer,
+		artifactArchiver:  artifactArchiver,
+		resultCaches:      resultCaches,
 		delegateFactory:   delegateFactory,
 	}
 }
@@ -131,7 +144,13 @@ func NewTaskStep(
 // If the script exits successfully, the outputs specified in the TaskConfig
 // are registered with the artifact.Repository. If no outputs are specified, the
 // task's entire working directory is registered as an StreamableArtifactSource under the
-// name of the task.
+// name of the task. Outputs configured with archive: true are then copied to
+// the artifact store.
+//
+// If the step is configured with cache_result, a key is computed from the
+// task's config, image and inputs. If a previous build of the job succeeded
+// with the same key, its outputs are registered instead and the task is not
+// run.
 func (step *TaskStep) Run(ctx context.Context, state RunState) (bool, error) {
 	delegate := step.delegateFactory.TaskDelegate(state)
 	ctx, span := delegate.StartSpan(ctx, "task", tracing.Attrs{
@@ -235,6 +254,23 @@ func (step *TaskStep) run(ctx context.Context, state RunState, delegate TaskDele
 	}
 	tracing.Inject(ctx, &containerSpec)
 
+	var resultCacheKey string
+	if step.plan.CacheResult && step.metadata.JobID != 0 {
+		resultCacheKey, err = step.resultCacheKey(logger, repository, config, imageSpec)
+		if err != nil {
+			return false, err
+		}
+
+		reused, err := step.reuseCachedResult(ctx, logger, repository, config, resultCacheKey, delegate)
+		if err != nil {
+			return false, err
+		}
+
+		if reused {
+			return true, nil
+		}
+	}
+
 	processSpec := runtime.ProcessSpec{
 		Path:         config.Run.Path,
 		Args:         config.Run.Args,
@@ -289,6 +325,10 @@ func (step *TaskStep) run(ctx context.Context, state RunState, delegate TaskDele
 		delegate,
 	)
 
+	if result.ResourceUsage != nil {
+		delegate.UsedResources(logger, step.plan.Name, *result.ResourceUsage)
+	}
+
 	step.registerOutputs(logger, repository, config, result.VolumeMounts, step.containerMetadata)
 
 	// Do not initialize caches for one-off builds
@@ -307,6 +347,17 @@ func (step *TaskStep) run(ctx context.Context, state RunState, delegate TaskDele
 		return false, runErr
 	}
 
+	if result.ExitStatus == 0 {
+		err = step.archiveOutputs(ctx, logger, repository, config, delegate)
+		if err != nil {
+			return false, err
+		}
+
+		if resultCacheKey != "" {
+			step.saveCachedResult(logger, config, result.VolumeMounts, resultCacheKey)
+		}
+	}
+
 	delegate.Finished(logger, ExitStatus(result.ExitStatus), step.strategy, chosenWorker)
 
 	return result.ExitStatus == 0, nil
@@ -470,6 +521,173 @@ func (step *TaskStep) registerOutputs(logger lager.Logger, repository *build.Rep
 	}
 }
 
+func (step *TaskStep) archiveOutputs(ctx context.Context, logger lager.Logger, repository *build.Repository, config atc.TaskConfig, delegate TaskDelegate) error {
+	for _, output := range config.Outputs {
+		if !output.Archive {
+			continue
+		}
+
+		outputName := output.Name
+		if destinationName, ok := step.plan.OutputMapping[output.Name]; ok {
+			outputName = destinationName
+		}
+
+		if step.artifactArchiver == nil {
+			fmt.Fprintf(delegate.Stderr(), "[WARNING] not archiving output '%s': no artifact store is configured\n", outputName)
+			continue
+		}
+
+		art, found := repository.ArtifactFor(build.ArtifactName(outputName))
+		if !found {
+			continue
+		}
+
+		logger.Debug("archiving-output", lager.Data{"output": outputName})
+
+		_, err := step.artifactArchiver.ArchiveArtifact(ctx, step.metadata.BuildID, outputName, art)
+		if err != nil {
+			return fmt.Errorf("archive output %s: %w", outputName, err)
+		}
+	}
+
+	return nil
+}
+
+// resultCacheKey identifies everything that goes into a run of the task.
+// Inputs fetched by get steps are identified by their resource cache, so that
+// they match across builds; any other artifact is identified by its volume,
+// which only matches when it was itself reused from a cached result.
+func (step *TaskStep) resultCacheKey(logger lager.Logger, repository *build.Repository, config atc.TaskConfig, imageSpec worker.ImageSpec) (string, error) {
+	key := taskResultCacheKey{
+		Config:     config,
+		Privileged: bool(step.plan.Privileged),
+		Inputs:     map[string]string{},
+	}
+
+	if imageSpec.ImageArtifactSource != nil {
+		digest, err := step.artifactDigest(logger, 0, imageSpec.ImageArtifactSource.Artifact())
+		if err != nil {
+			return "", err
+		}
+
+		key.Image = digest
+	}
+
+	for _, input := range config.Inputs {
+		inputName := input.Name
+		if sourceName, ok := step.plan.InputMapping[inputName]; ok {
+			inputName = sourceName
+		}
+
+		art, found := repository.ArtifactFor(build.ArtifactName(inputName))
+		if !found {
+			continue
+		}
+
+		digest, err := step.artifactDigest(logger, step.metadata.TeamID, art)
+		if err != nil {
+			return "", err
+		}
+
+		key.Inputs[input.Name] = digest
+	}
+
+	payload, err := json.Marshal(key)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%x", sha256.Sum256(payload)), nil
+}
+
+func (step *TaskStep) artifactDigest(logger lager.Logger, teamID int, art runtime.Artifact) (string, error) {
+	volume, found, err := step.workerPool.FindVolume(logger, teamID, art.ID())
+	if err != nil {
+		return "", err
+	}
+
+	if !found {
+		return "", fmt.Errorf("volume not found for artifact id %v type %T", art.ID(), art)
+	}
+
+	if resourceCacheID := volume.GetResourceCacheID(); resourceCacheID != 0 {
+		return fmt.Sprintf("resource-cache:%d", resourceCacheID), nil
+	}
+
+	return "volume:" + volume.Handle(), nil
+}
+
+func (step *TaskStep) reuseCachedResult(ctx context.Context, logger lager.Logger, repository *build.Repository, config atc.TaskConfig, key string, delegate TaskDelegate) (bool, error) {
+	cached, found, err := step.resultCaches.Find(step.metadata.JobID, step.plan.Name, key)
+	if err != nil {
+		return false, err
+	}
+
+	if !found {
+		return false, nil
+	}
+
+	for _, output := range config.Outputs {
+		handle, found := cached.Outputs[output.Name]
+		if !found {
+			return false, nil
+		}
+
+		_, found, err := step.workerPool.FindVolume(logger, step.metadata.TeamID, handle)
+		if err != nil {
+			return false, err
+		}
+
+		if !found {
+			return false, nil
+		}
+	}
+
+	logger.Info("reusing-cached-result", lager.Data{"build-id": cached.BuildID})
+
+	fmt.Fprintf(delegate.Stdout(), "reusing the outputs of build %d, which ran this task with the same config, image and inputs\n", cached.BuildID)
+
+	for _, output := range config.Outputs {
+		outputName := output.Name
+		if destinationName, ok := step.plan.OutputMapping[output.Name]; ok {
+			outputName = destinationName
+		}
+
+		repository.RegisterArtifact(build.ArtifactName(outputName), &runtime.TaskArtifact{
+			VolumeHandle: cached.Outputs[output.Name],
+		})
+	}
+
+	err = step.archiveOutputs(ctx, logger, repository, config, delegate)
+	if err != nil {
+		return false, err
+	}
+
+	delegate.FinishedFromCache(logger)
+
+	return true, nil
+}
+
+// saveCachedResult is best-effort; failing to cache the result of a task
+// which succeeded does not fail the build.
+func (step *TaskStep) saveCachedResult(logger lager.Logger, config atc.TaskConfig, volumeMounts []worker.VolumeMount, key string) {
+	outputs := map[string]string{}
+	for _, output := range config.Outputs {
+		outputPath := artifactsPath(output, step.containerMetadata.WorkingDirectory)
+
+		for _, mount := range volumeMounts {
+			if filepath.Clean(mount.MountPath) == filepath.Clean(outputPath) {
+				outputs[output.Name] = mount.Volume.Handle()
+			}
+		}
+	}
+
+	err := step.resultCaches.Save(step.metadata.JobID, step.plan.Name, key, step.metadata.BuildID, outputs)
+	if err != nil {
+		logger.Error("failed-to-save-task-result-cache", err)
+	}
+}
+
 func (step *TaskStep) registerCaches(logger lager.Logger, repository *build.Repository, config atc.TaskConfig, volumeMounts []worker.VolumeMount, metadata db.ContainerMetadata) error {
 	for _, cacheConfig := range config.Caches {
 		for _, volumeMount := range volumeMounts {
@@ -497,6 +715,13 @@ func (step *TaskStep) registerCaches(logger lager.Logger, repository *build.Repo
 	return nil
 }
 
+type taskResultCacheKey struct {
+	Config     atc.TaskConfig    `json:"config"`
+	Privileged bool              `json:"privileged"`
+	Image      string            `json:"image,omitempty"`
+	Inputs     map[string]string `json:"inputs"`
+}
+
 type taskInput struct {
 	config        atc.TaskInputConfig
 	artifact      runtime.Artifact
@@ -9,6 +9,7 @@ import (
 	"code.cloudfoundry.org/lager"
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/exec"
 	"github.com/concourse/concourse/atc/exec/build"
 	"github.com/concourse/concourse/atc/exec/execfakes"
@@ -38,8 +39,13 @@ var _ = Describe("TaskStep", func() {
 		fakeClient           *workerfakes.FakeClient
 		fakeArtifactStreamer *workerfakes.FakeArtifactStreamer
 		fakeArtifactSourcer  *workerfakes.FakeArtifactSourcer
+		fakeArtifactArchiver *workerfakes.FakeArtifactArchiver
 		fakeStrategy         *workerfakes.FakeContainerPlacementStrategy
 
+		artifactArchiver worker.ArtifactArchiver
+
+		fakeTaskResultCaches *dbfakes.FakeTaskResultCacheFactory
+
 		spanCtx      context.Context
 		fakeDelegate *execfakes.FakeTaskDelegate
 
@@ -87,8 +93,13 @@ var _ = Describe("TaskStep", func() {
 
 		fakeArtifactStreamer = new(workerfakes.FakeArtifactStreamer)
 		fakeArtifactSourcer = new(workerfakes.FakeArtifactSourcer)
+		fakeArtifactArchiver = new(workerfakes.FakeArtifactArchiver)
 		fakeStrategy = new(workerfakes.FakeContainerPlacementStrategy)
 
+		artifactArchiver = fakeArtifactArchiver
+
+		fakeTaskResultCaches = new(dbfakes.FakeTaskResultCacheFactory)
+
 		fakeDelegate = new(execfakes.FakeTaskDelegate)
 		fakeDelegate.StdoutReturns(stdoutBuf)
 		fakeDelegate.StderrReturns(stderrBuf)
@@ -145,6 +156,8 @@ var _ = Describe("TaskStep", func() {
 			fakePool,
 			fakeArtifactStreamer,
 			fakeArtifactSourcer,
+			artifactArchiver,
+			fakeTaskResultCaches,
 			fakeDelegateFactory,
 		)
 
@@ -1085,6 +1098,40 @@ var _ = Describe("TaskStep", func() {
 					Expect(stepErr).ToNot(HaveOccurred())
 				})
 			})
+
+			Context("when the worker reports the resource usage of the task", func() {
+				var usage atc.ResourceUsage
+
+				BeforeEach(func() {
+					usage = atc.ResourceUsage{
+						CPUUsage:   300,
+						MemoryPeak: 2048,
+						Duration:   time.Minute,
+					}
+
+					fakeClient.RunTaskStepReturns(worker.TaskResult{
+						ExitStatus:    0,
+						ResourceUsage: &usage,
+					}, nil)
+				})
+
+				It("passes the usage to the delegate", func() {
+					Expect(fakeDelegate.UsedResourcesCallCount()).To(Equal(1))
+					_, stepName, reported := fakeDelegate.UsedResourcesArgsForCall(0)
+					Expect(stepName).To(Equal("some-task"))
+					Expect(reported).To(Equal(usage))
+				})
+			})
+
+			Context("when the worker does not report the resource usage of the task", func() {
+				BeforeEach(func() {
+					fakeClient.RunTaskStepReturns(worker.TaskResult{ExitStatus: 0}, nil)
+				})
+
+				It("does not pass any usage to the delegate", func() {
+					Expect(fakeDelegate.UsedResourcesCallCount()).To(BeZero())
+				})
+			})
 		})
 
 		Context("when running the task fails", func() {
@@ -1268,5 +1315,285 @@ var _ = Describe("TaskStep", func() {
 				Expect(artifactMap).To(ConsistOf(artifact))
 			})
 		})
+
+		Context("when outputs are archived", func() {
+			var taskResult worker.TaskResult
+
+			BeforeEach(func() {
+				taskPlan.OutputMapping = map[string]string{"some-output": "remapped-output"}
+				taskPlan.Config = &atc.TaskConfig{
+					Platform: "some-platform",
+					Run: atc.TaskRunConfig{
+						Path: "ls",
+					},
+					Outputs: []atc.TaskOutputConfig{
+						{Name: "some-output", Archive: true},
+						{Name: "some-other-output"},
+					},
+				}
+
+				fakeVolume := new(workerfakes.FakeVolume)
+				fakeVolume.HandleReturns("some-handle")
+
+				fakeOtherVolume := new(workerfakes.FakeVolume)
+				fakeOtherVolume.HandleReturns("some-other-handle")
+
+				taskResult = worker.TaskResult{
+					ExitStatus: 0,
+					VolumeMounts: []worker.VolumeMount{
+						{
+							Volume:    fakeVolume,
+							MountPath: "some-artifact-root/some-output/",
+						},
+						{
+							Volume:    fakeOtherVolume,
+							MountPath: "some-artifact-root/some-other-output/",
+						},
+					},
+				}
+				fakeClient.RunTaskStepReturns(taskResult, nil)
+			})
+
+			It("archives the outputs configured to be archived", func() {
+				Expect(stepErr).ToNot(HaveOccurred())
+				Expect(stepOk).To(BeTrue())
+				Expect(fakeArtifactArchiver.ArchiveArtifactCallCount()).To(Equal(1))
+
+				_, buildID, name, artifact := fakeArtifactArchiver.ArchiveArtifactArgsForCall(0)
+				Expect(buildID).To(Equal(stepMetadata.BuildID))
+				Expect(name).To(Equal("remapped-output"))
+				Expect(artifact.ID()).To(Equal("some-handle"))
+			})
+
+			Context("when the task finishes", func() {
+				var archivedBeforeFinishing int
+
+				BeforeEach(func() {
+					fakeDelegate.FinishedStub = func(lager.Logger, exec.ExitStatus, worker.ContainerPlacementStrategy, worker.Client) {
+						archivedBeforeFinishing = fakeArtifactArchiver.ArchiveArtifactCallCount()
+					}
+				})
+
+				It("has already archived the outputs", func() {
+					Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+					Expect(archivedBeforeFinishing).To(Equal(1))
+				})
+			})
+
+			Context("when archiving fails", func() {
+				BeforeEach(func() {
+					fakeArtifactArchiver.ArchiveArtifactReturns(nil, errors.New("disaster"))
+				})
+
+				It("errors", func() {
+					Expect(stepErr).To(MatchError(ContainSubstring("disaster")))
+					Expect(fakeDelegate.FinishedCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when the task fails", func() {
+				BeforeEach(func() {
+					taskResult.ExitStatus = 1
+					fakeClient.RunTaskStepReturns(taskResult, nil)
+				})
+
+				It("does not archive the outputs", func() {
+					Expect(fakeArtifactArchiver.ArchiveArtifactCallCount()).To(BeZero())
+				})
+			})
+
+			Context("when no artifact store is configured", func() {
+				BeforeEach(func() {
+					artifactArchiver = nil
+				})
+
+				It("warns that the output was not archived", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(stepOk).To(BeTrue())
+					Expect(stderrBuf).To(gbytes.Say("not archiving output 'remapped-output': no artifact store is configured"))
+				})
+			})
+		})
+
+		Context("when the result is cached", func() {
+			var (
+				fakeInputVolume  *workerfakes.FakeVolume
+				fakeOutputVolume *workerfakes.FakeVolume
+				taskResult       worker.TaskResult
+			)
+
+			BeforeEach(func() {
+				stepMetadata.JobID = 12
+				taskPlan.CacheResult = true
+				taskPlan.OutputMapping = map[string]string{"some-output": "remapped-output"}
+				taskPlan.Config = &atc.TaskConfig{
+					Platform: "some-platform",
+					Run: atc.TaskRunConfig{
+						Path: "ls",
+					},
+					Inputs: []atc.TaskInputConfig{
+						{Name: "some-input"},
+					},
+					Outputs: []atc.TaskOutputConfig{
+						{Name: "some-output"},
+					},
+				}
+
+				repo.RegisterArtifact("some-input", &runtime.GetArtifact{VolumeHandle: "some-input-handle"})
+
+				fakeInputVolume = new(workerfakes.FakeVolume)
+				fakeInputVolume.HandleReturns("some-input-handle")
+				fakeInputVolume.GetResourceCacheIDReturns(42)
+
+				fakeOutputVolume = new(workerfakes.FakeVolume)
+				fakeOutputVolume.HandleReturns("some-output-handle")
+
+				fakePool.FindVolumeStub = func(_ lager.Logger, _ int, handle string) (worker.Volume, bool, error) {
+					switch handle {
+					case "some-input-handle":
+						return fakeInputVolume, true, nil
+					case "some-cached-output-handle":
+						return fakeOutputVolume, true, nil
+					default:
+						return nil, false, nil
+					}
+				}
+
+				taskResult = worker.TaskResult{
+					ExitStatus: 0,
+					VolumeMounts: []worker.VolumeMount{
+						{
+							Volume:    fakeOutputVolume,
+							MountPath: "some-artifact-root/some-output/",
+						},
+					},
+				}
+				fakeClient.RunTaskStepReturns(taskResult, nil)
+			})
+
+			Context("when there is no cached result for the key", func() {
+				It("runs the task and saves its result", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(stepOk).To(BeTrue())
+
+					Expect(fakeTaskResultCaches.FindCallCount()).To(Equal(1))
+					jobID, stepName, key := fakeTaskResultCaches.FindArgsForCall(0)
+					Expect(jobID).To(Equal(stepMetadata.JobID))
+					Expect(stepName).To(Equal("some-task"))
+
+					Expect(fakeTaskResultCaches.SaveCallCount()).To(Equal(1))
+					jobID, stepName, savedKey, buildID, outputs := fakeTaskResultCaches.SaveArgsForCall(0)
+					Expect(jobID).To(Equal(stepMetadata.JobID))
+					Expect(stepName).To(Equal("some-task"))
+					Expect(savedKey).To(Equal(key))
+					Expect(buildID).To(Equal(stepMetadata.BuildID))
+					Expect(outputs).To(Equal(map[string]string{"some-output": "some-output-handle"}))
+				})
+
+				Context("when the task fails", func() {
+					BeforeEach(func() {
+						taskResult.ExitStatus = 1
+						fakeClient.RunTaskStepReturns(taskResult, nil)
+					})
+
+					It("does not save its result", func() {
+						Expect(fakeTaskResultCaches.SaveCallCount()).To(BeZero())
+					})
+				})
+
+				Context("when saving the result fails", func() {
+					BeforeEach(func() {
+						fakeTaskResultCaches.SaveReturns(errors.New("disaster"))
+					})
+
+					It("does not fail the step", func() {
+						Expect(stepErr).ToNot(HaveOccurred())
+						Expect(stepOk).To(BeTrue())
+					})
+				})
+			})
+
+			Context("when the key changes", func() {
+				var firstKey string
+
+				BeforeEach(func() {
+					fakeClient.RunTaskStepReturnsOnCall(1, taskResult, nil)
+				})
+
+				JustBeforeEach(func() {
+					_, _, firstKey = fakeTaskResultCaches.FindArgsForCall(0)
+
+					fakeInputVolume.GetResourceCacheIDReturns(43)
+
+					_, err := taskStep.Run(ctx, state)
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				It("is computed from the input's resource cache", func() {
+					Expect(fakeTaskResultCaches.FindCallCount()).To(Equal(2))
+					_, _, secondKey := fakeTaskResultCaches.FindArgsForCall(1)
+					Expect(secondKey).ToNot(Equal(firstKey))
+				})
+			})
+
+			Context("when there is a cached result for the key", func() {
+				BeforeEach(func() {
+					shouldRunTaskStep = false
+
+					fakeTaskResultCaches.FindReturns(db.TaskResultCache{
+						ID:      1,
+						BuildID: 1000,
+						Outputs: map[string]string{"some-output": "some-cached-output-handle"},
+					}, true, nil)
+				})
+
+				It("succeeds without running the task", func() {
+					Expect(stepErr).ToNot(HaveOccurred())
+					Expect(stepOk).To(BeTrue())
+					Expect(fakeTaskResultCaches.SaveCallCount()).To(BeZero())
+				})
+
+				It("registers the cached outputs", func() {
+					artifact, found := repo.ArtifactFor("remapped-output")
+					Expect(found).To(BeTrue())
+					Expect(artifact.ID()).To(Equal("some-cached-output-handle"))
+				})
+
+				It("finishes from the cache", func() {
+					Expect(stdoutBuf).To(gbytes.Say("reusing the outputs of build 1000"))
+					Expect(fakeDelegate.FinishedFromCacheCallCount()).To(Equal(1))
+					Expect(fakeDelegate.FinishedCallCount()).To(BeZero())
+				})
+
+				Context("when a cached output volume has disappeared", func() {
+					BeforeEach(func() {
+						shouldRunTaskStep = true
+
+						fakeTaskResultCaches.FindReturns(db.TaskResultCache{
+							ID:      1,
+							BuildID: 1000,
+							Outputs: map[string]string{"some-output": "some-missing-handle"},
+						}, true, nil)
+					})
+
+					It("runs the task", func() {
+						Expect(stepErr).ToNot(HaveOccurred())
+						Expect(fakeDelegate.FinishedFromCacheCallCount()).To(BeZero())
+						Expect(fakeDelegate.FinishedCallCount()).To(Equal(1))
+					})
+				})
+			})
+
+			Context("when the task does not belong to a job (one-off build)", func() {
+				BeforeEach(func() {
+					stepMetadata.JobID = 0
+				})
+
+				It("does not cache the result", func() {
+					Expect(fakeTaskResultCaches.FindCallCount()).To(BeZero())
+					Expect(fakeTaskResultCaches.SaveCallCount()).To(BeZero())
+				})
+			})
+		})
 	})
 })
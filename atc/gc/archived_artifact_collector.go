@@ -0,0 +1,67 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/blobstore"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// archivedArtifactCollector removes archived artifacts once their build's
+// logs have been reaped, so that they are retained for as long as the job's
+// build_log_retention allows. The artifacts of builds which don't belong to a
+// job are kept for oneOffRetention after the build finishes instead.
+type archivedArtifactCollector struct {
+	archivedArtifacts db.ArchivedArtifactRepository
+	store             blobstore.Store
+	oneOffRetention   time.Duration
+}
+
+func NewArchivedArtifactCollector(archivedArtifacts db.ArchivedArtifactRepository, store blobstore.Store, oneOffRetention time.Duration) *archivedArtifactCollector {
+	return &archivedArtifactCollector{
+		archivedArtifacts: archivedArtifacts,
+		store:             store,
+		oneOffRetention:   oneOffRetention,
+	}
+}
+
+func (c *archivedArtifactCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("archived-artifact-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	artifacts, err := c.archivedArtifacts.ReapableArchivedArtifacts(c.oneOffRetention)
+	if err != nil {
+		logger.Error("failed-to-get-reapable-archived-artifacts", err)
+		return err
+	}
+
+	for _, artifact := range artifacts {
+		data := lager.Data{
+			"build":    artifact.BuildID(),
+			"artifact": artifact.Name(),
+		}
+
+		// remove the blob first so that it is never left behind without a
+		// record pointing to it
+		err := c.store.Delete(ctx, artifact.Key())
+		if err != nil {
+			logger.Error("failed-to-delete-archived-artifact-blob", err, data)
+			continue
+		}
+
+		err = c.archivedArtifacts.RemoveArchivedArtifact(artifact.ID())
+		if err != nil {
+			logger.Error("failed-to-remove-archived-artifact", err, data)
+			continue
+		}
+
+		logger.Debug("removed-archived-artifact", data)
+	}
+
+	return nil
+}
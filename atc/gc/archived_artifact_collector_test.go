@@ -0,0 +1,88 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/blobstore/blobstorefakes"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ArchivedArtifactCollector", func() {
+	var (
+		collector             GcCollector
+		fakeArchivedArtifacts *dbfakes.FakeArchivedArtifactRepository
+		fakeStore             *blobstorefakes.FakeStore
+
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeArchivedArtifacts = new(dbfakes.FakeArchivedArtifactRepository)
+		fakeStore = new(blobstorefakes.FakeStore)
+
+		collector = gc.NewArchivedArtifactCollector(fakeArchivedArtifacts, fakeStore, 24*time.Hour)
+	})
+
+	JustBeforeEach(func() {
+		runErr = collector.Run(context.TODO())
+	})
+
+	Context("when there are reapable artifacts", func() {
+		BeforeEach(func() {
+			artifact1 := new(dbfakes.FakeArchivedArtifact)
+			artifact1.IDReturns(1)
+			artifact1.KeyReturns("some-key")
+
+			artifact2 := new(dbfakes.FakeArchivedArtifact)
+			artifact2.IDReturns(2)
+			artifact2.KeyReturns("some-other-key")
+
+			fakeArchivedArtifacts.ReapableArchivedArtifactsReturns([]db.ArchivedArtifact{artifact1, artifact2}, nil)
+		})
+
+		It("deletes their blobs and records", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+
+			Expect(fakeArchivedArtifacts.ReapableArchivedArtifactsArgsForCall(0)).To(Equal(24 * time.Hour))
+
+			Expect(fakeStore.DeleteCallCount()).To(Equal(2))
+			_, key := fakeStore.DeleteArgsForCall(0)
+			Expect(key).To(Equal("some-key"))
+			_, key = fakeStore.DeleteArgsForCall(1)
+			Expect(key).To(Equal("some-other-key"))
+
+			Expect(fakeArchivedArtifacts.RemoveArchivedArtifactCallCount()).To(Equal(2))
+			Expect(fakeArchivedArtifacts.RemoveArchivedArtifactArgsForCall(0)).To(Equal(1))
+			Expect(fakeArchivedArtifacts.RemoveArchivedArtifactArgsForCall(1)).To(Equal(2))
+		})
+
+		Context("when deleting a blob fails", func() {
+			BeforeEach(func() {
+				fakeStore.DeleteReturnsOnCall(0, errors.New("disaster"))
+			})
+
+			It("keeps its record and carries on with the rest", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+
+				Expect(fakeArchivedArtifacts.RemoveArchivedArtifactCallCount()).To(Equal(1))
+				Expect(fakeArchivedArtifacts.RemoveArchivedArtifactArgsForCall(0)).To(Equal(2))
+			})
+		})
+	})
+
+	Context("when finding reapable artifacts fails", func() {
+		BeforeEach(func() {
+			fakeArchivedArtifacts.ReapableArchivedArtifactsReturns(nil, errors.New("disaster"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("disaster"))
+		})
+	})
+})
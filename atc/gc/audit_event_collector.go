@@ -0,0 +1,41 @@
+package gc
+
+import (
+	"context"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+type auditEventCollector struct {
+	auditEvents db.AuditEventRepository
+	retention   time.Duration
+}
+
+func NewAuditEventCollector(auditEvents db.AuditEventRepository, retention time.Duration) *auditEventCollector {
+	return &auditEventCollector{
+		auditEvents: auditEvents,
+		retention:   retention,
+	}
+}
+
+func (c *auditEventCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("audit-event-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	removed, err := c.auditEvents.RemoveEventsOlderThan(c.retention)
+	if err != nil {
+		logger.Error("failed-to-remove-old-audit-events", err)
+		return err
+	}
+
+	if removed > 0 {
+		logger.Debug("removed-old-audit-events", lager.Data{"count": removed})
+	}
+
+	return nil
+}
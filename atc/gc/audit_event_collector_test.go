@@ -0,0 +1,44 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("AuditEventCollector", func() {
+	var collector GcCollector
+	var fakeAuditEvents *dbfakes.FakeAuditEventRepository
+
+	BeforeEach(func() {
+		fakeAuditEvents = new(dbfakes.FakeAuditEventRepository)
+
+		collector = gc.NewAuditEventCollector(fakeAuditEvents, 30*24*time.Hour)
+	})
+
+	Describe("Run", func() {
+		It("removes the audit events older than the retention period", func() {
+			err := collector.Run(context.TODO())
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(fakeAuditEvents.RemoveEventsOlderThanCallCount()).To(Equal(1))
+			Expect(fakeAuditEvents.RemoveEventsOlderThanArgsForCall(0)).To(Equal(30 * 24 * time.Hour))
+		})
+
+		Context("when removing the audit events fails", func() {
+			BeforeEach(func() {
+				fakeAuditEvents.RemoveEventsOlderThanReturns(0, errors.New("disaster"))
+			})
+
+			It("returns the error", func() {
+				err := collector.Run(context.TODO())
+				Expect(err).To(MatchError("disaster"))
+			})
+		})
+	})
+})
@@ -0,0 +1,63 @@
+package gc
+
+import (
+	"context"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+)
+
+// buildEventArchiveCollector offloads the events of completed builds to the
+// build event store, and removes them from the store once they have been
+// reaped according to the job's build_log_retention.
+type buildEventArchiveCollector struct {
+	archive   db.BuildEventArchive
+	batchSize int
+}
+
+func NewBuildEventArchiveCollector(archive db.BuildEventArchive, batchSize int) *buildEventArchiveCollector {
+	return &buildEventArchiveCollector{
+		archive:   archive,
+		batchSize: batchSize,
+	}
+}
+
+func (c *buildEventArchiveCollector) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("build-event-archive-collector")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+
+	buildIDs, err := c.archive.ReapableArchivedEvents()
+	if err != nil {
+		logger.Error("failed-to-get-reapable-archived-events", err)
+		return err
+	}
+
+	for _, buildID := range buildIDs {
+		err := c.archive.RemoveArchivedEvents(ctx, buildID)
+		if err != nil {
+			logger.Error("failed-to-remove-archived-events", err, lager.Data{"build": buildID})
+			continue
+		}
+	}
+
+	builds, err := c.archive.ArchivableBuilds(c.batchSize)
+	if err != nil {
+		logger.Error("failed-to-get-archivable-builds", err)
+		return err
+	}
+
+	for _, build := range builds {
+		err := c.archive.ArchiveEvents(ctx, build)
+		if err != nil {
+			logger.Error("failed-to-archive-events", err, build.LagerData())
+			continue
+		}
+
+		logger.Debug("archived-events", build.LagerData())
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package gc_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/gc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BuildEventArchiveCollector", func() {
+	var (
+		collector        GcCollector
+		fakeEventArchive *dbfakes.FakeBuildEventArchive
+
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeEventArchive = new(dbfakes.FakeBuildEventArchive)
+
+		collector = gc.NewBuildEventArchiveCollector(fakeEventArchive, 42)
+	})
+
+	JustBeforeEach(func() {
+		runErr = collector.Run(context.TODO())
+	})
+
+	It("archives a batch of builds", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+		Expect(fakeEventArchive.ArchivableBuildsCallCount()).To(Equal(1))
+		Expect(fakeEventArchive.ArchivableBuildsArgsForCall(0)).To(Equal(42))
+	})
+
+	Context("when there are archivable builds", func() {
+		var build1, build2 *dbfakes.FakeBuild
+
+		BeforeEach(func() {
+			build1 = new(dbfakes.FakeBuild)
+			build1.IDReturns(1)
+
+			build2 = new(dbfakes.FakeBuild)
+			build2.IDReturns(2)
+
+			fakeEventArchive.ArchivableBuildsReturns([]db.Build{build1, build2}, nil)
+		})
+
+		It("archives their events", func() {
+			Expect(fakeEventArchive.ArchiveEventsCallCount()).To(Equal(2))
+			_, build := fakeEventArchive.ArchiveEventsArgsForCall(0)
+			Expect(build).To(Equal(build1))
+			_, build = fakeEventArchive.ArchiveEventsArgsForCall(1)
+			Expect(build).To(Equal(build2))
+		})
+
+		Context("when archiving a build fails", func() {
+			BeforeEach(func() {
+				fakeEventArchive.ArchiveEventsReturnsOnCall(0, errors.New("disaster"))
+			})
+
+			It("carries on with the rest", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(fakeEventArchive.ArchiveEventsCallCount()).To(Equal(2))
+			})
+		})
+	})
+
+	Context("when finding archivable builds fails", func() {
+		BeforeEach(func() {
+			fakeEventArchive.ArchivableBuildsReturns(nil, errors.New("disaster"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("disaster"))
+		})
+	})
+
+	Context("when there are reapable archived events", func() {
+		BeforeEach(func() {
+			fakeEventArchive.ReapableArchivedEventsReturns([]int{1, 2}, nil)
+		})
+
+		It("removes them", func() {
+			Expect(fakeEventArchive.RemoveArchivedEventsCallCount()).To(Equal(2))
+			_, buildID := fakeEventArchive.RemoveArchivedEventsArgsForCall(0)
+			Expect(buildID).To(Equal(1))
+			_, buildID = fakeEventArchive.RemoveArchivedEventsArgsForCall(1)
+			Expect(buildID).To(Equal(2))
+		})
+
+		Context("when removing them fails", func() {
+			BeforeEach(func() {
+				fakeEventArchive.RemoveArchivedEventsReturnsOnCall(0, errors.New("disaster"))
+			})
+
+			It("carries on with the rest", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(fakeEventArchive.RemoveArchivedEventsCallCount()).To(Equal(2))
+				Expect(fakeEventArchive.ArchivableBuildsCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when finding reapable archived events fails", func() {
+		BeforeEach(func() {
+			fakeEventArchive.ReapableArchivedEventsReturns(nil, errors.New("disaster"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("disaster"))
+			Expect(fakeEventArchive.ArchivableBuildsCallCount()).To(BeZero())
+		})
+	})
+})
@@ -22,6 +22,8 @@ type Job struct {
 	FinishedBuild   *Build `json:"finished_build"`
 	TransitionBuild *Build `json:"transition_build,omitempty"`
 
+	NextScheduledTime int64 `json:"next_scheduled_time,omitempty"`
+
 	Inputs  []JobInput  `json:"inputs,omitempty"`
 	Outputs []JobOutput `json:"outputs,omitempty"`
 }
@@ -54,3 +56,38 @@ type BuildInput struct {
 	Version  Version  `json:"version"`
 	Tags     []string `json:"tags,omitempty"`
 }
+
+// SchedulingExplanation describes how the inputs of a job's next build would
+// currently be resolved, and what would prevent its pending builds from
+// starting.
+type SchedulingExplanation struct {
+	Resolved bool               `json:"resolved"`
+	Inputs   []InputExplanation `json:"inputs"`
+
+	Paused         bool `json:"paused"`
+	PipelinePaused bool `json:"pipeline_paused"`
+
+	MaxInFlight      int      `json:"max_in_flight,omitempty"`
+	SerialGroups     []string `json:"serial_groups,omitempty"`
+	RunningBuilds    []Build  `json:"running_builds,omitempty"`
+	NextPendingBuild *Build   `json:"next_pending_build,omitempty"`
+	PendingBuilds    int      `json:"pending_builds"`
+
+	BlockedBy []string `json:"blocked_by,omitempty"`
+}
+
+type InputExplanation struct {
+	Name     string   `json:"name"`
+	Resource string   `json:"resource"`
+	Trigger  bool     `json:"trigger"`
+	Passed   []string `json:"passed,omitempty"`
+
+	PinnedVersion    Version   `json:"pinned_version,omitempty"`
+	DisabledVersions []Version `json:"disabled_versions,omitempty"`
+
+	Version         Version `json:"version,omitempty"`
+	FirstOccurrence bool    `json:"first_occurrence,omitempty"`
+
+	ResolveError string   `json:"resolve_error,omitempty"`
+	FailedPassed []string `json:"failed_passed,omitempty"`
+}
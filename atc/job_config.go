@@ -1,5 +1,12 @@
 package atc
 
+import (
+	"fmt"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
 type JobConfig struct {
 	Name    string `json:"name"`
 	OldName string `json:"old_name,omitempty"`
@@ -14,6 +21,8 @@ type JobConfig struct {
 
 	BuildLogRetention *BuildLogRetention `json:"build_log_retention,omitempty"`
 
+	Schedule *JobSchedule `json:"schedule,omitempty"`
+
 	OnSuccess *Step `json:"on_success,omitempty"`
 	OnFailure *Step `json:"on_failure,omitempty"`
 	OnAbort   *Step `json:"on_abort,omitempty"`
@@ -29,6 +38,41 @@ type BuildLogRetention struct {
 	Days                   int `json:"days,omitempty"`
 }
 
+// JobSchedule configures a job to be triggered periodically, without needing
+// a time resource.
+type JobSchedule struct {
+	// A standard five-field cron expression, or a descriptor such as @daily or
+	// @every 1h.
+	Cron string `json:"cron"`
+
+	// The IANA time zone to evaluate the cron expression in. Defaults to UTC.
+	Timezone string `json:"timezone,omitempty"`
+}
+
+// Parse interprets the cron expression in the configured time zone.
+func (schedule JobSchedule) Parse() (cron.Schedule, error) {
+	timezone := schedule.Timezone
+	if timezone == "" {
+		timezone = "UTC"
+	}
+
+	location, err := time.LoadLocation(timezone)
+	if err != nil {
+		return nil, fmt.Errorf("invalid timezone '%s': %w", schedule.Timezone, err)
+	}
+
+	spec, err := cron.ParseStandard(schedule.Cron)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron expression '%s': %w", schedule.Cron, err)
+	}
+
+	if specSchedule, ok := spec.(*cron.SpecSchedule); ok {
+		specSchedule.Location = location
+	}
+
+	return spec, nil
+}
+
 func (config JobConfig) Step() Step {
 	return Step{Config: config.StepConfig()}
 }
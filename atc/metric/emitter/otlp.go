@@ -0,0 +1,317 @@
+package emitter
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/pkg/errors"
+	collectorpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	OTLPProtocolGRPC = "grpc"
+	OTLPProtocolHTTP = "http"
+)
+
+// otlpCounters are the events whose values are the number of occurrences
+// since the previous emission, i.e. the deltas of a metric.Counter. They are
+// exported as monotonic sums; all other events are exported as gauges.
+var otlpCounters = map[string]bool{
+	"database queries":              true,
+	"containers deleted":            true,
+	"volumes deleted":               true,
+	"volumes streamed":              true,
+	"get step cache hits":           true,
+	"streamed resource caches":      true,
+	"containers created":            true,
+	"volumes created":               true,
+	"failed containers":             true,
+	"failed volumes":                true,
+	"jobs scheduled":                true,
+	"builds started":                true,
+	"check builds started":          true,
+	"concurrent requests limit hit": true,
+	"checks finished":               true,
+	"checks started":                true,
+	"checks enqueued":               true,
+}
+
+type (
+	// OTLPClient sends metrics to an OTLP collector over either gRPC or HTTP.
+	OTLPClient interface {
+		Export(context.Context, *collectorpb.ExportMetricsServiceRequest) error
+	}
+
+	OTLPEmitter struct {
+		Client        OTLPClient
+		ServiceName   string
+		BatchSize     int
+		BatchDuration time.Duration
+		LastEmitTime  time.Time
+		OTLPBatch     []*metricspb.Metric
+	}
+
+	OTLPConfig struct {
+		Address       string            `long:"otlp-metrics-address" description:"OTLP collector address to send metrics to"`
+		Protocol      string            `long:"otlp-metrics-protocol" default:"grpc" choice:"grpc" choice:"http" description:"Protocol used to send metrics to the OTLP collector"`
+		Headers       map[string]string `long:"otlp-metrics-header" description:"Headers to attach to each request to the OTLP collector"`
+		UseTLS        bool              `long:"otlp-metrics-use-tls" description:"Whether to use TLS when connecting to the OTLP collector"`
+		ServiceName   string            `long:"otlp-metrics-service-name" default:"concourse" description:"Value of the service.name resource attribute attached to emitted metrics"`
+		BatchSize     uint64            `long:"otlp-metrics-batch-size" default:"500" description:"Number of metrics to batch together before emitting"`
+		BatchDuration time.Duration     `long:"otlp-metrics-batch-duration" default:"15s" description:"Length of time to wait between emitting until all currently batched metrics are emitted"`
+	}
+)
+
+func init() {
+	metric.Metrics.RegisterEmitter(&OTLPConfig{})
+}
+
+func (config *OTLPConfig) Description() string { return "OpenTelemetry" }
+func (config *OTLPConfig) IsConfigured() bool  { return config.Address != "" }
+
+func (config *OTLPConfig) NewEmitter() (metric.Emitter, error) {
+	var client OTLPClient
+	switch config.Protocol {
+	case OTLPProtocolHTTP:
+		client = config.httpClient()
+	case OTLPProtocolGRPC, "":
+		var err error
+		client, err = config.grpcClient()
+		if err != nil {
+			return nil, err
+		}
+	default:
+		return nil, fmt.Errorf("unknown otlp protocol: %s", config.Protocol)
+	}
+
+	return &OTLPEmitter{
+		Client:        client,
+		ServiceName:   config.ServiceName,
+		BatchSize:     int(config.BatchSize),
+		BatchDuration: config.BatchDuration,
+		LastEmitTime:  time.Now(),
+		OTLPBatch:     make([]*metricspb.Metric, 0),
+	}, nil
+}
+
+func (config *OTLPConfig) grpcClient() (OTLPClient, error) {
+	security := grpc.WithInsecure()
+	if config.UseTLS {
+		security = grpc.WithTransportCredentials(credentials.NewClientTLSFromCert(nil, ""))
+	}
+
+	conn, err := grpc.Dial(config.Address, security)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to dial otlp collector")
+	}
+
+	return &otlpGRPCClient{
+		client:  collectorpb.NewMetricsServiceClient(conn),
+		headers: config.Headers,
+	}, nil
+}
+
+func (config *OTLPConfig) httpClient() OTLPClient {
+	scheme := "http"
+	if config.UseTLS {
+		scheme = "https"
+	}
+
+	url := config.Address
+	if !strings.Contains(url, "://") {
+		url = fmt.Sprintf("%s://%s", scheme, url)
+	}
+
+	return &otlpHTTPClient{
+		client: &http.Client{
+			Transport: &http.Transport{Proxy: http.ProxyFromEnvironment},
+			Timeout:   time.Minute,
+		},
+		url:     strings.TrimSuffix(url, "/") + "/v1/metrics",
+		headers: config.Headers,
+	}
+}
+
+type otlpGRPCClient struct {
+	client  collectorpb.MetricsServiceClient
+	headers map[string]string
+}
+
+func (client *otlpGRPCClient) Export(ctx context.Context, request *collectorpb.ExportMetricsServiceRequest) error {
+	if len(client.headers) > 0 {
+		ctx = metadata.NewOutgoingContext(ctx, metadata.New(client.headers))
+	}
+
+	_, err := client.client.Export(ctx, request)
+	return err
+}
+
+type otlpHTTPClient struct {
+	client  *http.Client
+	url     string
+	headers map[string]string
+}
+
+func (client *otlpHTTPClient) Export(ctx context.Context, request *collectorpb.ExportMetricsServiceRequest) error {
+	payload, err := proto.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, client.url, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	for k, v := range client.headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := client.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		body, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("unexpected response status %d: %s", resp.StatusCode, string(body))
+	}
+
+	return nil
+}
+
+func (emitter *OTLPEmitter) Emit(logger lager.Logger, event metric.Event) {
+	logger = logger.Session("otlp")
+
+	emitter.OTLPBatch = append(emitter.OTLPBatch, transformToOTLPMetric(event))
+
+	duration := time.Since(emitter.LastEmitTime)
+	if len(emitter.OTLPBatch) >= emitter.BatchSize || duration >= emitter.BatchDuration {
+		logger.Debug("pre-emit-batch", lager.Data{
+			"batch-size":         emitter.BatchSize,
+			"current-batch-size": len(emitter.OTLPBatch),
+			"batch-duration":     emitter.BatchDuration,
+			"current-duration":   duration,
+		})
+		emitter.submitBatch(logger)
+	}
+}
+
+func (emitter *OTLPEmitter) submitBatch(logger lager.Logger) {
+	batchToSubmit := emitter.OTLPBatch
+	emitter.OTLPBatch = make([]*metricspb.Metric, 0)
+	emitter.LastEmitTime = time.Now()
+	go emitter.emitBatch(logger, batchToSubmit)
+}
+
+func (emitter *OTLPEmitter) emitBatch(logger lager.Logger, batch []*metricspb.Metric) {
+	request := &collectorpb.ExportMetricsServiceRequest{
+		ResourceMetrics: []*metricspb.ResourceMetrics{
+			{
+				Resource: &resourcepb.Resource{
+					Attributes: []*commonpb.KeyValue{
+						{
+							Key: "service.name",
+							Value: &commonpb.AnyValue{
+								Value: &commonpb.AnyValue_StringValue{StringValue: emitter.ServiceName},
+							},
+						},
+					},
+				},
+				InstrumentationLibraryMetrics: []*metricspb.InstrumentationLibraryMetrics{
+					{
+						InstrumentationLibrary: &commonpb.InstrumentationLibrary{
+							Name: "github.com/concourse/concourse/atc/metric",
+						},
+						Metrics: batch,
+					},
+				},
+			},
+		},
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	err := emitter.Client.Export(ctx, request)
+	if err != nil {
+		logger.Error("failed-to-send-request",
+			errors.Wrap(metric.ErrFailedToEmit, err.Error()))
+		return
+	}
+}
+
+var otlpSpecialChars = regexp.MustCompile("[^a-z0-9_]+")
+
+// transformToOTLPMetric converts the event into a single data point metric,
+// e.g. "scheduling: job duration (ms)" is named
+// "concourse.scheduling_job_duration_ms". The event's attributes, including
+// any configured with --metrics-attribute, are attached as labels along with
+// the host that emitted it.
+func transformToOTLPMetric(event metric.Event) *metricspb.Metric {
+	name := otlpSpecialChars.ReplaceAllString(strings.Replace(strings.ToLower(event.Name), " ", "_", -1), "")
+
+	labels := []*commonpb.StringKeyValue{}
+	if event.Host != "" {
+		labels = append(labels, &commonpb.StringKeyValue{Key: "host", Value: event.Host})
+	}
+
+	keys := make([]string, 0, len(event.Attributes))
+	for k := range event.Attributes {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		labels = append(labels, &commonpb.StringKeyValue{Key: k, Value: event.Attributes[k]})
+	}
+
+	dataPoints := []*metricspb.DoubleDataPoint{
+		{
+			Labels:       labels,
+			TimeUnixNano: uint64(event.Time.UnixNano()),
+			Value:        event.Value,
+		},
+	}
+
+	otlpMetric := &metricspb.Metric{
+		Name:        "concourse." + name,
+		Description: event.Name,
+	}
+
+	if otlpCounters[event.Name] {
+		otlpMetric.Data = &metricspb.Metric_DoubleSum{
+			DoubleSum: &metricspb.DoubleSum{
+				DataPoints:             dataPoints,
+				AggregationTemporality: metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA,
+				IsMonotonic:            true,
+			},
+		}
+	} else {
+		otlpMetric.Data = &metricspb.Metric_DoubleGauge{
+			DoubleGauge: &metricspb.DoubleGauge{
+				DataPoints: dataPoints,
+			},
+		}
+	}
+
+	return otlpMetric
+}
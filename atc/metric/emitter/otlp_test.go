@@ -0,0 +1,271 @@
+package emitter_test
+
+import (
+	"context"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc/metric"
+	"github.com/concourse/concourse/atc/metric/emitter"
+	collectorpb "go.opentelemetry.io/proto/otlp/collector/metrics/v1"
+	metricspb "go.opentelemetry.io/proto/otlp/metrics/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/proto"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/ghttp"
+)
+
+type fakeCollector struct {
+	collectorpb.UnimplementedMetricsServiceServer
+
+	lock     sync.Mutex
+	requests []*collectorpb.ExportMetricsServiceRequest
+	metadata []metadata.MD
+}
+
+func (collector *fakeCollector) Export(ctx context.Context, request *collectorpb.ExportMetricsServiceRequest) (*collectorpb.ExportMetricsServiceResponse, error) {
+	collector.lock.Lock()
+	defer collector.lock.Unlock()
+
+	md, _ := metadata.FromIncomingContext(ctx)
+
+	collector.requests = append(collector.requests, request)
+	collector.metadata = append(collector.metadata, md)
+
+	return &collectorpb.ExportMetricsServiceResponse{}, nil
+}
+
+func (collector *fakeCollector) Requests() []*collectorpb.ExportMetricsServiceRequest {
+	collector.lock.Lock()
+	defer collector.lock.Unlock()
+
+	return collector.requests
+}
+
+func exportedMetrics(request *collectorpb.ExportMetricsServiceRequest) []*metricspb.Metric {
+	var metrics []*metricspb.Metric
+	for _, rm := range request.ResourceMetrics {
+		for _, ilm := range rm.InstrumentationLibraryMetrics {
+			metrics = append(metrics, ilm.Metrics...)
+		}
+	}
+	return metrics
+}
+
+var _ = Describe("OTLPEmitter", func() {
+	var (
+		config      *emitter.OTLPConfig
+		testEmitter metric.Emitter
+		testLogger  *lagertest.TestLogger
+		eventTime   time.Time
+	)
+
+	BeforeEach(func() {
+		testLogger = lagertest.NewTestLogger("otlp")
+		eventTime = time.Unix(1625097600, 0)
+
+		config = &emitter.OTLPConfig{
+			ServiceName:   "concourse",
+			BatchSize:     2,
+			BatchDuration: 100 * time.Second,
+		}
+	})
+
+	JustBeforeEach(func() {
+		var err error
+		testEmitter, err = config.NewEmitter()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	Describe("IsConfigured", func() {
+		It("is configured once an address is set", func() {
+			Expect((&emitter.OTLPConfig{}).IsConfigured()).To(BeFalse())
+			Expect((&emitter.OTLPConfig{Address: "localhost:4317"}).IsConfigured()).To(BeTrue())
+		})
+	})
+
+	Context("using grpc", func() {
+		var (
+			collector  *fakeCollector
+			grpcServer *grpc.Server
+		)
+
+		BeforeEach(func() {
+			listener, err := net.Listen("tcp", "127.0.0.1:0")
+			Expect(err).ToNot(HaveOccurred())
+
+			collector = &fakeCollector{}
+			grpcServer = grpc.NewServer()
+			collectorpb.RegisterMetricsServiceServer(grpcServer, collector)
+
+			go grpcServer.Serve(listener)
+
+			config.Address = listener.Addr().String()
+			config.Protocol = emitter.OTLPProtocolGRPC
+			config.Headers = map[string]string{"x-api-key": "some-key"}
+		})
+
+		AfterEach(func() {
+			grpcServer.Stop()
+		})
+
+		It("sends no metrics until the batch is full", func() {
+			testEmitter.Emit(testLogger, metric.Event{Name: "goroutines", Value: 1, Time: eventTime})
+
+			Consistently(collector.Requests, 200*time.Millisecond).Should(BeEmpty())
+		})
+
+		Context("when the batch is full", func() {
+			JustBeforeEach(func() {
+				testEmitter.Emit(testLogger, metric.Event{
+					Name:  "builds running",
+					Value: 3,
+					Host:  "some-host",
+					Time:  eventTime,
+					Attributes: map[string]string{
+						"environment": "production",
+					},
+				})
+				testEmitter.Emit(testLogger, metric.Event{
+					Name:  "builds started",
+					Value: 5,
+					Host:  "some-host",
+					Time:  eventTime,
+				})
+
+				Eventually(collector.Requests).Should(HaveLen(1))
+			})
+
+			It("identifies the service", func() {
+				resource := collector.Requests()[0].ResourceMetrics[0].Resource
+				Expect(resource.Attributes).To(HaveLen(1))
+				Expect(resource.Attributes[0].Key).To(Equal("service.name"))
+				Expect(resource.Attributes[0].Value.GetStringValue()).To(Equal("concourse"))
+			})
+
+			It("sends the configured headers", func() {
+				collector.lock.Lock()
+				defer collector.lock.Unlock()
+
+				Expect(collector.metadata[0].Get("x-api-key")).To(Equal([]string{"some-key"}))
+			})
+
+			It("sends gauges with their attributes as labels", func() {
+				metrics := exportedMetrics(collector.Requests()[0])
+				Expect(metrics).To(HaveLen(2))
+
+				gauge := metrics[0]
+				Expect(gauge.Name).To(Equal("concourse.builds_running"))
+				Expect(gauge.GetDoubleGauge()).ToNot(BeNil())
+
+				points := gauge.GetDoubleGauge().DataPoints
+				Expect(points).To(HaveLen(1))
+				Expect(points[0].Value).To(Equal(3.0))
+				Expect(points[0].TimeUnixNano).To(Equal(uint64(eventTime.UnixNano())))
+
+				labels := map[string]string{}
+				for _, label := range points[0].Labels {
+					labels[label.Key] = label.Value
+				}
+				Expect(labels).To(Equal(map[string]string{
+					"host":        "some-host",
+					"environment": "production",
+				}))
+			})
+
+			It("sends counters as monotonic delta sums", func() {
+				metrics := exportedMetrics(collector.Requests()[0])
+				Expect(metrics).To(HaveLen(2))
+
+				counter := metrics[1]
+				Expect(counter.Name).To(Equal("concourse.builds_started"))
+
+				sum := counter.GetDoubleSum()
+				Expect(sum).ToNot(BeNil())
+				Expect(sum.IsMonotonic).To(BeTrue())
+				Expect(sum.AggregationTemporality).To(Equal(metricspb.AggregationTemporality_AGGREGATION_TEMPORALITY_DELTA))
+				Expect(sum.DataPoints).To(HaveLen(1))
+				Expect(sum.DataPoints[0].Value).To(Equal(5.0))
+			})
+		})
+
+		Context("when the batch duration has elapsed", func() {
+			BeforeEach(func() {
+				config.BatchSize = 100
+				config.BatchDuration = time.Millisecond
+			})
+
+			It("sends the batch", func() {
+				time.Sleep(time.Millisecond)
+				testEmitter.Emit(testLogger, metric.Event{Name: "scheduling: job duration (ms)", Value: 12, Time: eventTime})
+
+				Eventually(collector.Requests).Should(HaveLen(1))
+
+				metrics := exportedMetrics(collector.Requests()[0])
+				Expect(metrics).To(HaveLen(1))
+				Expect(metrics[0].Name).To(Equal("concourse.scheduling_job_duration_ms"))
+			})
+		})
+	})
+
+	Context("using http", func() {
+		var server *Server
+
+		BeforeEach(func() {
+			server = NewServer()
+
+			config.Address = server.URL()
+			config.Protocol = emitter.OTLPProtocolHTTP
+			config.Headers = map[string]string{"X-Api-Key": "some-key"}
+			config.BatchSize = 1
+		})
+
+		AfterEach(func() {
+			server.Close()
+		})
+
+		It("posts the metrics as protobuf", func() {
+			server.RouteToHandler(http.MethodPost, "/v1/metrics", CombineHandlers(
+				VerifyContentType("application/x-protobuf"),
+				VerifyHeaderKV("X-Api-Key", "some-key"),
+				func(w http.ResponseWriter, r *http.Request) {
+					defer GinkgoRecover()
+
+					body, err := ioutil.ReadAll(r.Body)
+					Expect(err).ToNot(HaveOccurred())
+
+					var request collectorpb.ExportMetricsServiceRequest
+					Expect(proto.Unmarshal(body, &request)).To(Succeed())
+
+					metrics := exportedMetrics(&request)
+					Expect(metrics).To(HaveLen(1))
+					Expect(metrics[0].Name).To(Equal("concourse.goroutines"))
+				},
+			))
+
+			testEmitter.Emit(testLogger, metric.Event{Name: "goroutines", Value: 10, Time: eventTime})
+
+			Eventually(server.ReceivedRequests).Should(HaveLen(1))
+		})
+
+		Context("when the collector responds with an error", func() {
+			It("logs the failure", func() {
+				server.RouteToHandler(http.MethodPost, "/v1/metrics", RespondWith(http.StatusBadRequest, "bad metrics"))
+
+				testEmitter.Emit(testLogger, metric.Event{Name: "goroutines", Value: 10, Time: eventTime})
+
+				Eventually(testLogger.Logs).Should(ContainElement(WithTransform(func(log lager.LogFormat) string {
+					return log.Message
+				}, ContainSubstring("failed-to-send-request"))))
+			})
+		})
+	})
+})
@@ -35,6 +35,10 @@ type PrometheusEmitter struct {
 	stepsWaiting         *prometheus.GaugeVec
 	stepsWaitingDuration *prometheus.HistogramVec
 
+	stepsCPUUsage   *prometheus.CounterVec
+	stepsMemoryPeak *prometheus.GaugeVec
+	stepsDuration   *prometheus.CounterVec
+
 	buildDurationsVec *prometheus.HistogramVec
 	buildsAborted     prometheus.Counter
 	buildsErrored     prometheus.Counter
@@ -273,6 +277,39 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 	)
 	prometheus.MustRegister(buildsFinishedVec)
 
+	stepsCPUUsage := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "cpu_usage_seconds_total",
+			Help:      "CPU time consumed by the containers of steps.",
+		},
+		[]string{"team", "pipeline", "job", "step"},
+	)
+	prometheus.MustRegister(stepsCPUUsage)
+
+	stepsMemoryPeak := prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "memory_peak_bytes",
+			Help:      "Peak memory usage of the container of the most recent run of a step.",
+		},
+		[]string{"team", "pipeline", "job", "step"},
+	)
+	prometheus.MustRegister(stepsMemoryPeak)
+
+	stepsDuration := prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "concourse",
+			Subsystem: "steps",
+			Name:      "duration_seconds_total",
+			Help:      "Time spent running the processes of steps.",
+		},
+		[]string{"team", "pipeline", "job", "step"},
+	)
+	prometheus.MustRegister(stepsDuration)
+
 	buildDurationsVec := prometheus.NewHistogramVec(
 		prometheus.HistogramOpts{
 			Namespace: "concourse",
@@ -507,6 +544,10 @@ func (config *PrometheusConfig) NewEmitter() (metric.Emitter, error) {
 		stepsWaiting:         stepsWaiting,
 		stepsWaitingDuration: stepsWaitingDuration,
 
+		stepsCPUUsage:   stepsCPUUsage,
+		stepsMemoryPeak: stepsMemoryPeak,
+		stepsDuration:   stepsDuration,
+
 		buildDurationsVec: buildDurationsVec,
 		buildsAborted:     buildsAborted,
 		buildsErrored:     buildsErrored,
@@ -604,6 +645,8 @@ func (emitter *PrometheusEmitter) Emit(logger lager.Logger, event metric.Event)
 				event.Attributes["type"],
 				event.Attributes["workerTags"],
 			).Observe(event.Value)
+	case "step cpu usage", "step memory peak", "step duration":
+		emitter.stepResourceUsageMetrics(logger, event)
 	case "build finished":
 		emitter.buildFinishedMetrics(logger, event)
 	case "check build finished":
@@ -721,6 +764,34 @@ func (emitter *PrometheusEmitter) buildFinishedMetrics(logger lager.Logger, even
 	emitter.buildDurationsVec.WithLabelValues(team, pipeline, job).Observe(duration)
 }
 
+func (emitter *PrometheusEmitter) stepResourceUsageMetrics(logger lager.Logger, event metric.Event) {
+	team, exists := event.Attributes["team_name"]
+	if !exists {
+		logger.Error("failed-to-find-team-name-in-event", fmt.Errorf("expected team_name to exist in event.Attributes"))
+		return
+	}
+
+	step, exists := event.Attributes["step"]
+	if !exists {
+		logger.Error("failed-to-find-step-in-event", fmt.Errorf("expected step to exist in event.Attributes"))
+		return
+	}
+
+	// one-off builds belong to neither a pipeline nor a job, but their usage
+	// still counts towards their team
+	labels := []string{team, event.Attributes["pipeline"], event.Attributes["job"], step}
+
+	switch event.Name {
+	case "step cpu usage":
+		// seconds are the standard prometheus base unit for time
+		emitter.stepsCPUUsage.WithLabelValues(labels...).Add(event.Value / 1000)
+	case "step memory peak":
+		emitter.stepsMemoryPeak.WithLabelValues(labels...).Set(event.Value)
+	case "step duration":
+		emitter.stepsDuration.WithLabelValues(labels...).Add(event.Value / 1000)
+	}
+}
+
 func (emitter *PrometheusEmitter) checkBuildFinishedMetrics(logger lager.Logger, event metric.Event) {
 	// concourse_builds_finished_total
 	emitter.checkBuildsFinished.Inc()
@@ -8,6 +8,7 @@ import (
 	"github.com/concourse/concourse/atc/db/lock"
 
 	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
 )
 
@@ -634,3 +635,40 @@ func (event WorkersState) Emit(logger lager.Logger, m *Monitor) {
 		)
 	}
 }
+
+// StepResourceUsage reports the CPU time, peak memory and duration of a task
+// step of a build, labeled with the build's team, pipeline and job so that
+// usage can be attributed to them and compared against the step's container
+// limits.
+type StepResourceUsage struct {
+	Build    db.Build
+	StepName string
+	Usage    atc.ResourceUsage
+}
+
+func (event StepResourceUsage) Emit(logger lager.Logger, m *Monitor) {
+	logger = logger.Session("step-resource-usage")
+
+	attrs := map[string]string{"step": event.StepName}
+	for k, v := range event.Build.TracingAttrs() {
+		attrs[k] = v
+	}
+
+	m.emit(logger, Event{
+		Name:       "step cpu usage",
+		Value:      ms(time.Duration(event.Usage.CPUUsage)),
+		Attributes: attrs,
+	})
+
+	m.emit(logger, Event{
+		Name:       "step memory peak",
+		Value:      float64(event.Usage.MemoryPeak),
+		Attributes: attrs,
+	})
+
+	m.emit(logger, Event{
+		Name:       "step duration",
+		Value:      ms(event.Usage.Duration),
+		Attributes: attrs,
+	})
+}
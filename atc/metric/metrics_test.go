@@ -1,9 +1,14 @@
 package metric_test
 
 import (
+	"time"
+
+	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
 	"github.com/concourse/concourse/atc/metric"
 	"github.com/concourse/concourse/atc/metric/metricfakes"
+	"github.com/concourse/concourse/tracing"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -49,6 +54,62 @@ var _ = Describe("Metrics", func() {
 			Expect(event.Value).To(Equal(float64(1)))
 		})
 	})
+
+	Describe("step resource usage metric", func() {
+		var (
+			emitter *smartFakeEmitter
+			monitor *metric.Monitor
+		)
+
+		BeforeEach(func() {
+			emitter = new(smartFakeEmitter)
+			monitor = metric.NewMonitor()
+
+			emitterFactory := new(metricfakes.FakeEmitterFactory)
+			emitterFactory.IsConfiguredReturns(true)
+			emitterFactory.NewEmitterReturns(emitter, nil)
+
+			monitor.RegisterEmitter(emitterFactory)
+			monitor.Initialize(testLogger, "test", map[string]string{}, 1000)
+		})
+
+		It("emits the cpu usage, memory peak and duration of the step", func() {
+			fakeBuild := new(dbfakes.FakeBuild)
+			fakeBuild.TracingAttrsReturns(tracing.Attrs{
+				"team_name": "some-team",
+				"pipeline":  "some-pipeline",
+				"job":       "some-job",
+			})
+
+			metric.StepResourceUsage{
+				Build:    fakeBuild,
+				StepName: "some-task",
+				Usage: atc.ResourceUsage{
+					CPUUsage:   uint64(2 * time.Second),
+					MemoryPeak: 1024,
+					Duration:   time.Minute,
+				},
+			}.Emit(testLogger, monitor)
+
+			Eventually(emitter.EmitCallCount).Should(Equal(3))
+
+			values := map[string]float64{}
+			for i := 0; i < emitter.EmitCallCount(); i++ {
+				_, event := emitter.EmitArgsForCall(i)
+				Expect(event.Attributes).To(HaveKeyWithValue("team_name", "some-team"))
+				Expect(event.Attributes).To(HaveKeyWithValue("pipeline", "some-pipeline"))
+				Expect(event.Attributes).To(HaveKeyWithValue("job", "some-job"))
+				Expect(event.Attributes).To(HaveKeyWithValue("step", "some-task"))
+				values[event.Name] = event.Value
+			}
+
+			Expect(values).To(Equal(map[string]float64{
+				"step cpu usage":   2000,
+				"step memory peak": 1024,
+				"step duration":    60000,
+			}))
+		})
+	})
 })
 
 type smartFakeEmitter struct {
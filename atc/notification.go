@@ -0,0 +1,135 @@
+package atc
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+)
+
+type NotificationEvent string
+
+const (
+	NotificationBuildStarted        NotificationEvent = "build_started"
+	NotificationBuildFinished       NotificationEvent = "build_finished"
+	NotificationBuildErrored        NotificationEvent = "build_errored"
+	NotificationBuildAborted        NotificationEvent = "build_aborted"
+	NotificationResourceCheckFailed NotificationEvent = "resource_check_failed"
+)
+
+var NotificationEvents = []NotificationEvent{
+	NotificationBuildStarted,
+	NotificationBuildFinished,
+	NotificationBuildErrored,
+	NotificationBuildAborted,
+	NotificationResourceCheckFailed,
+}
+
+const (
+	// NotificationSignatureHeader carries the HMAC-SHA256 of the request body,
+	// keyed with the subscription's secret, in the form "sha256=<hex>".
+	NotificationSignatureHeader = "X-Concourse-Signature"
+	NotificationEventHeader     = "X-Concourse-Event"
+	NotificationDeliveryHeader  = "X-Concourse-Delivery"
+)
+
+// NotificationSubscription is a webhook which is sent the team's
+// notifications for the given events.
+type NotificationSubscription struct {
+	Name   string              `json:"name"`
+	URL    string              `json:"url"`
+	Events []NotificationEvent `json:"events"`
+
+	// Secret is used to sign the notifications. It is never returned by the
+	// API.
+	Secret string `json:"secret,omitempty"`
+}
+
+func (subscription NotificationSubscription) Validate() error {
+	if subscription.Name == "" {
+		return errors.New("name must be specified")
+	}
+
+	if subscription.Secret == "" {
+		return errors.New("secret must be specified")
+	}
+
+	u, err := url.Parse(subscription.URL)
+	if err != nil {
+		return fmt.Errorf("invalid url: %w", err)
+	}
+
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return fmt.Errorf("invalid url '%s': must be an absolute http or https url", subscription.URL)
+	}
+
+	if len(subscription.Events) == 0 {
+		return errors.New("at least one event must be specified")
+	}
+
+	for _, ev := range subscription.Events {
+		if !ev.Valid() {
+			return fmt.Errorf("unknown event '%s'", ev)
+		}
+	}
+
+	return nil
+}
+
+func (ev NotificationEvent) Valid() bool {
+	for _, known := range NotificationEvents {
+		if ev == known {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Notification is the payload sent to a subscription's URL.
+type Notification struct {
+	Event    NotificationEvent     `json:"event"`
+	Time     int64                 `json:"time"`
+	TeamName string                `json:"team_name"`
+	Build    NotificationBuild     `json:"build"`
+	Resource *NotificationResource `json:"resource,omitempty"`
+}
+
+type NotificationBuild struct {
+	ID                   int          `json:"id"`
+	Name                 string       `json:"name"`
+	Status               BuildStatus  `json:"status"`
+	PipelineName         string       `json:"pipeline_name,omitempty"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+	JobName              string       `json:"job_name,omitempty"`
+	StartTime            int64        `json:"start_time,omitempty"`
+	EndTime              int64        `json:"end_time,omitempty"`
+}
+
+// NotificationResource is the resource whose check failed, for
+// resource_check_failed notifications.
+type NotificationResource struct {
+	Name                 string       `json:"name"`
+	PipelineName         string       `json:"pipeline_name"`
+	PipelineInstanceVars InstanceVars `json:"pipeline_instance_vars,omitempty"`
+}
+
+type NotificationDeliveryStatus string
+
+const (
+	NotificationDeliveryPending   NotificationDeliveryStatus = "pending"
+	NotificationDeliverySucceeded NotificationDeliveryStatus = "succeeded"
+	NotificationDeliveryFailed    NotificationDeliveryStatus = "failed"
+)
+
+// NotificationDelivery is an entry in a subscription's delivery log.
+type NotificationDelivery struct {
+	ID             int                        `json:"id"`
+	Event          NotificationEvent          `json:"event"`
+	Status         NotificationDeliveryStatus `json:"status"`
+	Attempts       int                        `json:"attempts"`
+	ResponseStatus int                        `json:"response_status,omitempty"`
+	LastError      string                     `json:"last_error,omitempty"`
+	CreatedAt      int64                      `json:"created_at"`
+	DeliveredAt    int64                      `json:"delivered_at,omitempty"`
+	Payload        *Notification              `json:"payload,omitempty"`
+}
@@ -0,0 +1,171 @@
+package notifications
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"golang.org/x/sync/errgroup"
+)
+
+const (
+	// MaxAttempts is how many times a notification is sent before giving up
+	// on it. It can still be redelivered through the API afterwards.
+	MaxAttempts = 5
+
+	// RetryBackoff is how long to wait before retrying a notification after
+	// its first failed attempt. The wait doubles after each attempt.
+	RetryBackoff = 30 * time.Second
+
+	batchSize = 100
+
+	// maxConcurrentDeliveries is how many notifications are sent at once, so
+	// that a slow subscription doesn't hold up the rest of the batch.
+	maxConcurrentDeliveries = 10
+)
+
+type Deliverer struct {
+	queue  db.NotificationQueue
+	client *http.Client
+	clock  clock.Clock
+}
+
+func NewDeliverer(queue db.NotificationQueue, client *http.Client, clock clock.Clock) *Deliverer {
+	return &Deliverer{
+		queue:  queue,
+		client: client,
+		clock:  clock,
+	}
+}
+
+func (d *Deliverer) Run(ctx context.Context) error {
+	logger := lagerctx.FromContext(ctx).Session("notifications")
+
+	pending, err := d.queue.Pending(batchSize)
+	if err != nil {
+		logger.Error("failed-to-get-pending-notifications", err)
+		return err
+	}
+
+	// deliveries are sent with ctx rather than the group's context so that
+	// one failing to be saved doesn't cut the others short
+	group, groupCtx := errgroup.WithContext(ctx)
+
+	notifications := make(chan db.PendingNotification)
+
+	group.Go(func() error {
+		defer close(notifications)
+
+		for _, notification := range pending {
+			select {
+			case notifications <- notification:
+			case <-groupCtx.Done():
+				return nil
+			}
+		}
+
+		return nil
+	})
+
+	for i := 0; i < maxConcurrentDeliveries; i++ {
+		group.Go(func() error {
+			for notification := range notifications {
+				err := d.deliver(ctx, logger, notification)
+				if err != nil {
+					return err
+				}
+			}
+
+			return nil
+		})
+	}
+
+	return group.Wait()
+}
+
+func (d *Deliverer) deliver(ctx context.Context, logger lager.Logger, notification db.PendingNotification) error {
+	logger = logger.Session("deliver", lager.Data{
+		"delivery": notification.ID,
+		"event":    notification.Event,
+	})
+
+	responseStatus, sendErr := d.send(ctx, notification)
+	if sendErr == nil {
+		err := d.queue.Delivered(notification.ID, responseStatus)
+		if err != nil {
+			logger.Error("failed-to-mark-notification-as-delivered", err)
+			return err
+		}
+
+		return nil
+	}
+
+	logger.Info("failed-to-deliver-notification", lager.Data{
+		"attempt": notification.Attempts + 1,
+		"error":   sendErr.Error(),
+	})
+
+	var retryAt time.Time
+	if notification.Attempts+1 < MaxAttempts {
+		retryAt = d.clock.Now().Add(RetryBackoff << notification.Attempts)
+	}
+
+	err := d.queue.Failed(notification.ID, responseStatus, sendErr.Error(), retryAt)
+	if err != nil {
+		logger.Error("failed-to-mark-notification-as-failed", err)
+		return err
+	}
+
+	return nil
+}
+
+// send posts the notification, returning the status of the response if
+// there was one.
+func (d *Deliverer) send(ctx context.Context, notification db.PendingNotification) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, notification.URL, bytes.NewReader(notification.Payload))
+	if err != nil {
+		return 0, err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(atc.NotificationEventHeader, string(notification.Event))
+	req.Header.Set(atc.NotificationDeliveryHeader, strconv.Itoa(notification.ID))
+	req.Header.Set(atc.NotificationSignatureHeader, Sign(notification.Secret, notification.Payload))
+
+	response, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+
+	// drain the body so that the connection can be reused
+	_, _ = io.Copy(ioutil.Discard, response.Body)
+	_ = response.Body.Close()
+
+	if response.StatusCode < 200 || response.StatusCode >= 300 {
+		return response.StatusCode, fmt.Errorf("unexpected response: %s", response.Status)
+	}
+
+	return response.StatusCode, nil
+}
+
+// Sign returns the value of the signature header for the payload, which
+// receivers can compute themselves to verify that a notification came from
+// Concourse.
+func Sign(secret string, payload []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,224 @@
+package notifications_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/notifications"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Deliverer", func() {
+	var (
+		fakeQueue *dbfakes.FakeNotificationQueue
+		fakeClock *fakeclock.FakeClock
+		server    *ghttp.Server
+
+		deliverer *notifications.Deliverer
+
+		notification db.PendingNotification
+		runErr       error
+	)
+
+	BeforeEach(func() {
+		fakeQueue = new(dbfakes.FakeNotificationQueue)
+		fakeClock = fakeclock.NewFakeClock(time.Unix(123, 0))
+		server = ghttp.NewServer()
+
+		deliverer = notifications.NewDeliverer(fakeQueue, &http.Client{}, fakeClock)
+
+		notification = db.PendingNotification{
+			ID:      42,
+			Event:   atc.NotificationBuildFinished,
+			URL:     server.URL() + "/hook",
+			Secret:  "some-secret",
+			Payload: []byte(`{"event":"build_finished"}`),
+		}
+
+		fakeQueue.PendingReturns([]db.PendingNotification{notification}, nil)
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	JustBeforeEach(func() {
+		ctx := lagerctx.NewContext(context.Background(), lagertest.NewTestLogger("test"))
+		runErr = deliverer.Run(ctx)
+	})
+
+	Context("when the subscription accepts the notification", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/hook"),
+					ghttp.VerifyContentType("application/json"),
+					ghttp.VerifyHeaderKV(atc.NotificationEventHeader, "build_finished"),
+					ghttp.VerifyHeaderKV(atc.NotificationDeliveryHeader, "42"),
+					ghttp.VerifyHeaderKV(atc.NotificationSignatureHeader, notifications.Sign("some-secret", []byte(`{"event":"build_finished"}`))),
+					ghttp.VerifyBody([]byte(`{"event":"build_finished"}`)),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+		})
+
+		It("signs and sends the notification", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(server.ReceivedRequests()).To(HaveLen(1))
+		})
+
+		It("marks the notification as delivered", func() {
+			Expect(fakeQueue.DeliveredCallCount()).To(Equal(1))
+
+			id, status := fakeQueue.DeliveredArgsForCall(0)
+			Expect(id).To(Equal(42))
+			Expect(status).To(Equal(http.StatusNoContent))
+
+			Expect(fakeQueue.FailedCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the subscription rejects the notification", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusInternalServerError, "nope"))
+		})
+
+		It("retries it after backing off", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeQueue.FailedCallCount()).To(Equal(1))
+
+			id, status, reason, retryAt := fakeQueue.FailedArgsForCall(0)
+			Expect(id).To(Equal(42))
+			Expect(status).To(Equal(http.StatusInternalServerError))
+			Expect(reason).To(Equal("unexpected response: 500 Internal Server Error"))
+			Expect(retryAt).To(Equal(fakeClock.Now().Add(notifications.RetryBackoff)))
+		})
+
+		Context("when it has been attempted before", func() {
+			BeforeEach(func() {
+				notification.Attempts = 2
+				fakeQueue.PendingReturns([]db.PendingNotification{notification}, nil)
+			})
+
+			It("backs off for longer", func() {
+				_, _, _, retryAt := fakeQueue.FailedArgsForCall(0)
+				Expect(retryAt).To(Equal(fakeClock.Now().Add(4 * notifications.RetryBackoff)))
+			})
+		})
+
+		Context("when it is the last attempt", func() {
+			BeforeEach(func() {
+				notification.Attempts = notifications.MaxAttempts - 1
+				fakeQueue.PendingReturns([]db.PendingNotification{notification}, nil)
+			})
+
+			It("gives up on it", func() {
+				_, _, _, retryAt := fakeQueue.FailedArgsForCall(0)
+				Expect(retryAt).To(BeZero())
+			})
+		})
+	})
+
+	Context("when the subscription cannot be reached", func() {
+		BeforeEach(func() {
+			notification.URL = "http://127.0.0.1:1/hook"
+			fakeQueue.PendingReturns([]db.PendingNotification{notification}, nil)
+		})
+
+		It("retries it without a response status", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeQueue.FailedCallCount()).To(Equal(1))
+
+			_, status, reason, _ := fakeQueue.FailedArgsForCall(0)
+			Expect(status).To(BeZero())
+			Expect(reason).To(ContainSubstring("connection refused"))
+		})
+	})
+
+	Context("when there are several pending notifications", func() {
+		BeforeEach(func() {
+			other := notification
+			other.ID = 43
+			fakeQueue.PendingReturns([]db.PendingNotification{notification, other}, nil)
+
+			// the first request is only responded to once the second arrives,
+			// which it never would if they were sent one after the other
+			var requests int32
+			released := make(chan struct{})
+			server.RouteToHandler("POST", "/hook", func(w http.ResponseWriter, r *http.Request) {
+				if atomic.AddInt32(&requests, 1) == 1 {
+					select {
+					case <-released:
+					case <-time.After(5 * time.Second):
+					}
+				} else {
+					close(released)
+				}
+
+				w.WriteHeader(http.StatusNoContent)
+			})
+
+			deliverer = notifications.NewDeliverer(fakeQueue, &http.Client{Timeout: time.Second}, fakeClock)
+		})
+
+		It("sends them concurrently", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+			Expect(fakeQueue.DeliveredCallCount()).To(Equal(2))
+			Expect(fakeQueue.FailedCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the subscription is on an internal address", func() {
+		BeforeEach(func() {
+			server.AppendHandlers(ghttp.RespondWith(http.StatusNoContent, nil))
+		})
+
+		Context("when internal targets are not allowed", func() {
+			BeforeEach(func() {
+				deliverer = notifications.NewDeliverer(fakeQueue, notifications.NewClient(time.Second, false), fakeClock)
+			})
+
+			It("refuses to send the notification", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(server.ReceivedRequests()).To(BeEmpty())
+				Expect(fakeQueue.FailedCallCount()).To(Equal(1))
+
+				_, _, reason, _ := fakeQueue.FailedArgsForCall(0)
+				Expect(reason).To(ContainSubstring("refusing to deliver to internal address 127.0.0.1"))
+			})
+		})
+
+		Context("when internal targets are allowed", func() {
+			BeforeEach(func() {
+				deliverer = notifications.NewDeliverer(fakeQueue, notifications.NewClient(time.Second, true), fakeClock)
+			})
+
+			It("sends the notification", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+				Expect(server.ReceivedRequests()).To(HaveLen(1))
+				Expect(fakeQueue.DeliveredCallCount()).To(Equal(1))
+			})
+		})
+	})
+
+	Context("when getting the pending notifications fails", func() {
+		BeforeEach(func() {
+			fakeQueue.PendingReturns(nil, errors.New("disaster"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError("disaster"))
+		})
+	})
+})
@@ -0,0 +1,57 @@
+package atc
+
+import (
+	"fmt"
+	"strings"
+)
+
+// PassedJob is a job named in a get step's passed constraint. Jobs in the
+// same pipeline are referred to by name alone, while jobs in other pipelines
+// of the same team are referred to as '<pipeline>/<job>', or as
+// '<pipeline>/<instance vars>/<job>' for instanced pipelines.
+type PassedJob struct {
+	Pipeline PipelineRef
+	Job      string
+}
+
+func ParsePassedJob(passed string) (PassedJob, error) {
+	jobNameIdx := strings.LastIndex(passed, "/")
+	if jobNameIdx == -1 {
+		return PassedJob{Job: passed}, nil
+	}
+
+	job := PassedJob{Job: passed[jobNameIdx+1:]}
+	if job.Job == "" {
+		return PassedJob{}, fmt.Errorf("passed job '%s' should be formatted as <pipeline>/<job>", passed)
+	}
+
+	vs := strings.SplitN(passed[:jobNameIdx], "/", 2)
+
+	job.Pipeline.Name = vs[0]
+	if job.Pipeline.Name == "" {
+		return PassedJob{}, fmt.Errorf("passed job '%s' should be formatted as <pipeline>/<job>", passed)
+	}
+
+	if len(vs) == 2 {
+		var err error
+		job.Pipeline.InstanceVars, err = ParseInstanceVars(vs[1])
+		if err != nil {
+			return PassedJob{}, fmt.Errorf("passed job '%s' has invalid instance vars: %w", passed, err)
+		}
+	}
+
+	return job, nil
+}
+
+// IsCrossPipeline returns whether the job belongs to another pipeline.
+func (job PassedJob) IsCrossPipeline() bool {
+	return job.Pipeline.Name != ""
+}
+
+func (job PassedJob) String() string {
+	if !job.IsCrossPipeline() {
+		return job.Job
+	}
+
+	return job.Pipeline.String() + "/" + job.Job
+}
@@ -0,0 +1,88 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PassedJob", func() {
+	Describe("ParsePassedJob", func() {
+		for _, tt := range []struct {
+			desc   string
+			passed string
+			job    atc.PassedJob
+			err    string
+		}{
+			{
+				desc:   "job in the same pipeline",
+				passed: "some-job",
+				job:    atc.PassedJob{Job: "some-job"},
+			},
+			{
+				desc:   "job in another pipeline",
+				passed: "other-pipeline/some-job",
+				job: atc.PassedJob{
+					Pipeline: atc.PipelineRef{Name: "other-pipeline"},
+					Job:      "some-job",
+				},
+			},
+			{
+				desc:   "job in an instanced pipeline",
+				passed: "other-pipeline/branch:feature/foo,env:prod/some-job",
+				job: atc.PassedJob{
+					Pipeline: atc.PipelineRef{
+						Name:         "other-pipeline",
+						InstanceVars: atc.InstanceVars{"branch": "feature/foo", "env": "prod"},
+					},
+					Job: "some-job",
+				},
+			},
+			{
+				desc:   "missing job name",
+				passed: "other-pipeline/",
+				err:    "passed job 'other-pipeline/' should be formatted as <pipeline>/<job>",
+			},
+			{
+				desc:   "missing pipeline name",
+				passed: "/some-job",
+				err:    "passed job '/some-job' should be formatted as <pipeline>/<job>",
+			},
+			{
+				desc:   "malformed instance vars",
+				passed: "other-pipeline/branch/some-job",
+				err:    "passed job 'other-pipeline/branch/some-job' has invalid instance vars",
+			},
+		} {
+			tt := tt
+
+			It(tt.desc, func() {
+				job, err := atc.ParsePassedJob(tt.passed)
+				if tt.err != "" {
+					Expect(err).To(MatchError(ContainSubstring(tt.err)))
+				} else {
+					Expect(err).NotTo(HaveOccurred())
+					Expect(job).To(Equal(tt.job))
+				}
+			})
+		}
+	})
+
+	Describe("String", func() {
+		It("returns the job name for jobs in the same pipeline", func() {
+			Expect(atc.PassedJob{Job: "some-job"}.String()).To(Equal("some-job"))
+		})
+
+		It("prefixes the pipeline for jobs in other pipelines", func() {
+			job := atc.PassedJob{
+				Pipeline: atc.PipelineRef{
+					Name:         "other-pipeline",
+					InstanceVars: atc.InstanceVars{"branch": "feature"},
+				},
+				Job: "some-job",
+			}
+
+			Expect(job.String()).To(Equal("other-pipeline/branch:feature/some-job"))
+		})
+	})
+})
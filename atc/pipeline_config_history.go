@@ -0,0 +1,15 @@
+package atc
+
+// PipelineConfigRevision is a config which was saved for a pipeline. Every
+// save of a pipeline's config is recorded as a new revision, numbered from 1.
+type PipelineConfigRevision struct {
+	Revision  int    `json:"revision"`
+	CreatedBy string `json:"created_by,omitempty"`
+	CreatedAt int64  `json:"created_at"`
+
+	// BuildID is the ID of the build whose set_pipeline step saved the
+	// config. It is zero if the config was set through the API, e.g. by fly.
+	BuildID int `json:"build_id,omitempty"`
+
+	Config *Config `json:"config,omitempty"`
+}
@@ -0,0 +1,208 @@
+package atc
+
+const (
+	PipelineGraphJob      = "job"
+	PipelineGraphResource = "resource"
+)
+
+const (
+	PipelineGraphUpstream   = "upstream"
+	PipelineGraphDownstream = "downstream"
+)
+
+// PipelineGraph is the dependency graph of a pipeline's jobs and resources,
+// as described by the get and put steps in its configuration.
+type PipelineGraph struct {
+	Nodes []PipelineGraphNode `json:"nodes"`
+	Edges []PipelineGraphEdge `json:"edges"`
+}
+
+type PipelineGraphNode struct {
+	ID   string `json:"id"`
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+
+	ResourceType string `json:"resource_type,omitempty"`
+
+	// Pipeline is set for jobs in other pipelines which jobs in this pipeline
+	// have passed constraints on.
+	Pipeline string `json:"pipeline,omitempty"`
+}
+
+// PipelineGraphEdge connects a resource to a job that gets it, a job to a job
+// whose get of the resource has a passed constraint on it, or a job to a
+// resource that it puts to.
+type PipelineGraphEdge struct {
+	Source   string `json:"source"`
+	Target   string `json:"target"`
+	Resource string `json:"resource"`
+	Trigger  bool   `json:"trigger"`
+}
+
+func PipelineGraphJobID(name string) string {
+	return PipelineGraphJob + ":" + name
+}
+
+func PipelineGraphResourceID(name string) string {
+	return PipelineGraphResource + ":" + name
+}
+
+func NewPipelineGraph(config Config) PipelineGraph {
+	graph := PipelineGraph{
+		Nodes: []PipelineGraphNode{},
+		Edges: []PipelineGraphEdge{},
+	}
+
+	for _, job := range config.Jobs {
+		graph.Nodes = append(graph.Nodes, PipelineGraphNode{
+			ID:   PipelineGraphJobID(job.Name),
+			Kind: PipelineGraphJob,
+			Name: job.Name,
+		})
+	}
+
+	for _, resource := range config.Resources {
+		graph.Nodes = append(graph.Nodes, PipelineGraphNode{
+			ID:           PipelineGraphResourceID(resource.Name),
+			Kind:         PipelineGraphResource,
+			Name:         resource.Name,
+			ResourceType: resource.Type,
+		})
+	}
+
+	edges := map[PipelineGraphEdge]int{}
+	addEdge := func(edge PipelineGraphEdge) {
+		trigger := edge.Trigger
+		edge.Trigger = false
+
+		// a job may get the same resource more than once, in which case any
+		// one of them triggering is enough to trigger the job
+		if i, found := edges[edge]; found {
+			graph.Edges[i].Trigger = graph.Edges[i].Trigger || trigger
+			return
+		}
+
+		edges[edge] = len(graph.Edges)
+
+		edge.Trigger = trigger
+		graph.Edges = append(graph.Edges, edge)
+	}
+
+	external := map[string]bool{}
+	addExternalJob := func(passed string) {
+		passedJob, err := ParsePassedJob(passed)
+		if err != nil || !passedJob.IsCrossPipeline() || external[passed] {
+			return
+		}
+
+		external[passed] = true
+
+		graph.Nodes = append(graph.Nodes, PipelineGraphNode{
+			ID:       PipelineGraphJobID(passed),
+			Kind:     PipelineGraphJob,
+			Name:     passedJob.Job,
+			Pipeline: passedJob.Pipeline.String(),
+		})
+	}
+
+	for _, job := range config.Jobs {
+		for _, input := range job.Inputs() {
+			if len(input.Passed) == 0 {
+				addEdge(PipelineGraphEdge{
+					Source:   PipelineGraphResourceID(input.Resource),
+					Target:   PipelineGraphJobID(job.Name),
+					Resource: input.Resource,
+					Trigger:  input.Trigger,
+				})
+
+				continue
+			}
+
+			for _, passed := range input.Passed {
+				if _, found := config.Jobs.Lookup(passed); !found {
+					addExternalJob(passed)
+				}
+
+				addEdge(PipelineGraphEdge{
+					Source:   PipelineGraphJobID(passed),
+					Target:   PipelineGraphJobID(job.Name),
+					Resource: input.Resource,
+					Trigger:  input.Trigger,
+				})
+			}
+		}
+
+		for _, output := range job.Outputs() {
+			addEdge(PipelineGraphEdge{
+				Source:   PipelineGraphJobID(job.Name),
+				Target:   PipelineGraphResourceID(output.Resource),
+				Resource: output.Resource,
+			})
+		}
+	}
+
+	return graph
+}
+
+func (graph PipelineGraph) Node(id string) (PipelineGraphNode, bool) {
+	for _, node := range graph.Nodes {
+		if node.ID == id {
+			return node, true
+		}
+	}
+
+	return PipelineGraphNode{}, false
+}
+
+// Traverse returns the part of the graph which can be reached from the given
+// node by following edges in the given direction, including the node itself.
+func (graph PipelineGraph) Traverse(from string, direction string) PipelineGraph {
+	reached := map[string]bool{from: true}
+	queue := []string{from}
+
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+
+		for _, edge := range graph.Edges {
+			next, ok := edge.follow(id, direction)
+			if !ok || reached[next] {
+				continue
+			}
+
+			reached[next] = true
+			queue = append(queue, next)
+		}
+	}
+
+	subgraph := PipelineGraph{
+		Nodes: []PipelineGraphNode{},
+		Edges: []PipelineGraphEdge{},
+	}
+
+	for _, node := range graph.Nodes {
+		if reached[node.ID] {
+			subgraph.Nodes = append(subgraph.Nodes, node)
+		}
+	}
+
+	for _, edge := range graph.Edges {
+		if reached[edge.Source] && reached[edge.Target] {
+			subgraph.Edges = append(subgraph.Edges, edge)
+		}
+	}
+
+	return subgraph
+}
+
+func (edge PipelineGraphEdge) follow(from string, direction string) (string, bool) {
+	if direction == PipelineGraphUpstream {
+		if edge.Target == from {
+			return edge.Source, true
+		}
+	} else if edge.Source == from {
+		return edge.Target, true
+	}
+
+	return "", false
+}
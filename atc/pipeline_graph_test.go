@@ -0,0 +1,152 @@
+package atc_test
+
+import (
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("PipelineGraph", func() {
+	var graph atc.PipelineGraph
+
+	BeforeEach(func() {
+		graph = atc.NewPipelineGraph(atc.Config{
+			Resources: atc.ResourceConfigs{
+				{Name: "repo", Type: "git"},
+				{Name: "image", Type: "registry-image"},
+			},
+			Jobs: atc.JobConfigs{
+				{
+					Name: "build",
+					PlanSequence: []atc.Step{
+						{Config: &atc.GetStep{Name: "repo", Trigger: true}},
+						{Config: &atc.GetStep{Name: "source", Resource: "repo"}},
+						{Config: &atc.PutStep{Name: "image"}},
+					},
+				},
+				{
+					Name: "test",
+					PlanSequence: []atc.Step{
+						{Config: &atc.GetStep{Name: "repo", Passed: []string{"build"}, Trigger: true}},
+						{Config: &atc.GetStep{Name: "image", Trigger: true}},
+					},
+				},
+				{
+					Name: "deploy",
+					PlanSequence: []atc.Step{
+						{Config: &atc.GetStep{Name: "repo", Passed: []string{"test"}}},
+					},
+				},
+			},
+		})
+	})
+
+	It("has a node for every job and resource", func() {
+		Expect(graph.Nodes).To(Equal([]atc.PipelineGraphNode{
+			{ID: "job:build", Kind: atc.PipelineGraphJob, Name: "build"},
+			{ID: "job:test", Kind: atc.PipelineGraphJob, Name: "test"},
+			{ID: "job:deploy", Kind: atc.PipelineGraphJob, Name: "deploy"},
+			{ID: "resource:repo", Kind: atc.PipelineGraphResource, Name: "repo", ResourceType: "git"},
+			{ID: "resource:image", Kind: atc.PipelineGraphResource, Name: "image", ResourceType: "registry-image"},
+		}))
+	})
+
+	It("connects jobs through their gets, passed constraints and puts", func() {
+		Expect(graph.Edges).To(Equal([]atc.PipelineGraphEdge{
+			{Source: "resource:repo", Target: "job:build", Resource: "repo", Trigger: true},
+			{Source: "job:build", Target: "resource:image", Resource: "image"},
+			{Source: "job:build", Target: "job:test", Resource: "repo", Trigger: true},
+			{Source: "resource:image", Target: "job:test", Resource: "image", Trigger: true},
+			{Source: "job:test", Target: "job:deploy", Resource: "repo"},
+		}))
+	})
+
+	Context("when a job has passed constraints on a job in another pipeline", func() {
+		BeforeEach(func() {
+			graph = atc.NewPipelineGraph(atc.Config{
+				Resources: atc.ResourceConfigs{
+					{Name: "repo", Type: "git"},
+				},
+				Jobs: atc.JobConfigs{
+					{
+						Name: "deploy",
+						PlanSequence: []atc.Step{
+							{Config: &atc.GetStep{Name: "repo", Passed: []string{"other-pipeline/branch:main/test"}, Trigger: true}},
+						},
+					},
+				},
+			})
+		})
+
+		It("has a node for the job in the other pipeline", func() {
+			Expect(graph.Nodes).To(Equal([]atc.PipelineGraphNode{
+				{ID: "job:deploy", Kind: atc.PipelineGraphJob, Name: "deploy"},
+				{ID: "resource:repo", Kind: atc.PipelineGraphResource, Name: "repo", ResourceType: "git"},
+				{ID: "job:other-pipeline/branch:main/test", Kind: atc.PipelineGraphJob, Name: "test", Pipeline: "other-pipeline/branch:main"},
+			}))
+
+			Expect(graph.Edges).To(Equal([]atc.PipelineGraphEdge{
+				{Source: "job:other-pipeline/branch:main/test", Target: "job:deploy", Resource: "repo", Trigger: true},
+			}))
+		})
+	})
+
+	Describe("Node", func() {
+		It("finds nodes by their id", func() {
+			node, found := graph.Node(atc.PipelineGraphJobID("test"))
+			Expect(found).To(BeTrue())
+			Expect(node.Name).To(Equal("test"))
+		})
+
+		It("does not find nodes which do not exist", func() {
+			_, found := graph.Node(atc.PipelineGraphJobID("bogus"))
+			Expect(found).To(BeFalse())
+		})
+	})
+
+	Describe("Traverse", func() {
+		It("finds everything downstream of a job", func() {
+			subgraph := graph.Traverse(atc.PipelineGraphJobID("test"), atc.PipelineGraphDownstream)
+			Expect(subgraph).To(Equal(atc.PipelineGraph{
+				Nodes: []atc.PipelineGraphNode{
+					{ID: "job:test", Kind: atc.PipelineGraphJob, Name: "test"},
+					{ID: "job:deploy", Kind: atc.PipelineGraphJob, Name: "deploy"},
+				},
+				Edges: []atc.PipelineGraphEdge{
+					{Source: "job:test", Target: "job:deploy", Resource: "repo"},
+				},
+			}))
+		})
+
+		It("follows puts to the jobs which get the resource", func() {
+			subgraph := graph.Traverse(atc.PipelineGraphJobID("build"), atc.PipelineGraphDownstream)
+
+			var names []string
+			for _, node := range subgraph.Nodes {
+				names = append(names, node.ID)
+			}
+
+			Expect(names).To(Equal([]string{"job:build", "job:test", "job:deploy", "resource:image"}))
+			Expect(subgraph.Edges).To(HaveLen(4))
+		})
+
+		It("finds everything upstream of a job", func() {
+			subgraph := graph.Traverse(atc.PipelineGraphJobID("test"), atc.PipelineGraphUpstream)
+			Expect(subgraph).To(Equal(atc.PipelineGraph{
+				Nodes: []atc.PipelineGraphNode{
+					{ID: "job:build", Kind: atc.PipelineGraphJob, Name: "build"},
+					{ID: "job:test", Kind: atc.PipelineGraphJob, Name: "test"},
+					{ID: "resource:repo", Kind: atc.PipelineGraphResource, Name: "repo", ResourceType: "git"},
+					{ID: "resource:image", Kind: atc.PipelineGraphResource, Name: "image", ResourceType: "registry-image"},
+				},
+				Edges: []atc.PipelineGraphEdge{
+					{Source: "resource:repo", Target: "job:build", Resource: "repo", Trigger: true},
+					{Source: "job:build", Target: "resource:image", Resource: "image"},
+					{Source: "job:build", Target: "job:test", Resource: "repo", Trigger: true},
+					{Source: "resource:image", Target: "job:test", Resource: "image", Trigger: true},
+				},
+			}))
+		})
+	})
+})
@@ -11,6 +11,7 @@ type Plan struct {
 	Run         *RunPlan         `json:"run,omitempty"`
 	SetPipeline *SetPipelinePlan `json:"set_pipeline,omitempty"`
 	LoadVar     *LoadVarPlan     `json:"load_var,omitempty"`
+	Approval    *ApprovalPlan    `json:"approval,omitempty"`
 
 	Do         *DoPlan         `json:"do,omitempty"`
 	InParallel *InParallelPlan `json:"in_parallel,omitempty"`
@@ -303,6 +304,10 @@ type TaskPlan struct {
 	// image does not count towards the timeout.
 	Timeout string `json:"timeout,omitempty"`
 
+	// Skip running the task if a previous build of the job ran it with the
+	// same config, image and inputs, reusing that build's outputs instead.
+	CacheResult bool `json:"cache_result,omitempty"`
+
 	// Resource types to have available for use when fetching the task's image.
 	//
 	// XXX(check-refactor): Eliminating this would be great - if we can replace
@@ -338,6 +343,23 @@ type RunPlan struct {
 	// A timeout to enforce on the run step's process. Note that fetching the
 	// prototype's image does not count towards the timeout.
 	Timeout string `json:"timeout,omitempty"`
+
+	// The prototype's image, fetched in the same way as a resource type's.
+	Image ImageResource `json:"image"`
+
+	// Artifacts to mount into the prototype's container, and artifacts to
+	// register from it once the message has been run.
+	Inputs  []string `json:"inputs,omitempty"`
+	Outputs []string `json:"outputs,omitempty"`
+
+	// Remap inputs and output artifacts from the names the prototype sees to
+	// other names in the build plan.
+	InputMapping  map[string]string `json:"input_mapping,omitempty"`
+	OutputMapping map[string]string `json:"output_mapping,omitempty"`
+
+	// Resource types to have available for use when fetching the prototype's
+	// image.
+	VersionedResourceTypes VersionedResourceTypes `json:"resource_types,omitempty"`
 }
 
 type SetPipelinePlan struct {
@@ -356,6 +378,13 @@ type LoadVarPlan struct {
 	Reveal bool   `json:"reveal,omitempty"`
 }
 
+type ApprovalPlan struct {
+	Name    string   `json:"name"`
+	Roles   []string `json:"roles,omitempty"`
+	Users   []string `json:"users,omitempty"`
+	Timeout string   `json:"timeout,omitempty"`
+}
+
 type RetryPlan []Plan
 
 type DependentGetPlan struct {
@@ -0,0 +1,46 @@
+package atc
+
+import "time"
+
+// ResourceUsage is the CPU time and memory consumed by the container of a
+// task step, along with how long its process ran. CPU times are in
+// nanoseconds.
+//
+// Only task steps are accounted for. The containers of get and check steps
+// are shared with other builds through resource caches and checks, so their
+// usage can't be attributed to any one build, and put steps are not sampled.
+type ResourceUsage struct {
+	CPUUsage  uint64 `json:"cpu_usage"`
+	CPUUser   uint64 `json:"cpu_user"`
+	CPUSystem uint64 `json:"cpu_system"`
+
+	// MemoryPeak is the highest memory usage of the container, in bytes. It
+	// is the kernel's high-water mark where the worker's runtime reports it,
+	// and otherwise the highest periodic sample, excluding inactive page cache.
+	MemoryPeak uint64 `json:"memory_peak"`
+
+	Duration time.Duration `json:"duration"`
+}
+
+// BuildResourceUsage totals the resource usage of every task step of a build
+// which reported it. CPU times and durations are summed, while MemoryPeak is
+// the highest peak of any one step.
+type BuildResourceUsage struct {
+	BuildID int `json:"build_id"`
+	Steps   int `json:"steps"`
+
+	ResourceUsage
+}
+
+// Add accumulates the usage of a step into the build's totals.
+func (usage *BuildResourceUsage) Add(step ResourceUsage) {
+	usage.Steps++
+	usage.CPUUsage += step.CPUUsage
+	usage.CPUUser += step.CPUUser
+	usage.CPUSystem += step.CPUSystem
+	usage.Duration += step.Duration
+
+	if step.MemoryPeak > usage.MemoryPeak {
+		usage.MemoryPeak = step.MemoryPeak
+	}
+}
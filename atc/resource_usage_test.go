@@ -0,0 +1,46 @@
+package atc_test
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("BuildResourceUsage", func() {
+	Describe("Add", func() {
+		It("sums CPU times and durations and keeps the highest memory peak", func() {
+			usage := atc.BuildResourceUsage{BuildID: 1}
+
+			usage.Add(atc.ResourceUsage{
+				CPUUsage:   300,
+				CPUUser:    200,
+				CPUSystem:  100,
+				MemoryPeak: 2048,
+				Duration:   time.Minute,
+			})
+
+			usage.Add(atc.ResourceUsage{
+				CPUUsage:   30,
+				CPUUser:    20,
+				CPUSystem:  10,
+				MemoryPeak: 1024,
+				Duration:   time.Second,
+			})
+
+			Expect(usage).To(Equal(atc.BuildResourceUsage{
+				BuildID: 1,
+				Steps:   2,
+				ResourceUsage: atc.ResourceUsage{
+					CPUUsage:   330,
+					CPUUser:    220,
+					CPUSystem:  110,
+					MemoryPeak: 2048,
+					Duration:   time.Minute + time.Second,
+				},
+			}))
+		})
+	})
+})
@@ -11,10 +11,14 @@ const (
 	CreateBuild         = "CreateBuild"
 	ListBuilds          = "ListBuilds"
 	BuildEvents         = "BuildEvents"
+	StreamBuildEvents   = "StreamBuildEvents"
 	BuildResources      = "BuildResources"
 	AbortBuild          = "AbortBuild"
+	SetBuildApproval    = "SetBuildApproval"
 	GetBuildPreparation = "GetBuildPreparation"
 
+	GetBuildResourceUsage = "GetBuildResourceUsage"
+
 	GetJob         = "GetJob"
 	CreateJobBuild = "CreateJobBuild"
 	RerunJobBuild  = "RerunJobBuild"
@@ -30,6 +34,8 @@ const (
 	JobBadge       = "JobBadge"
 	MainJobBadge   = "MainJobBadge"
 
+	GetJobSchedulingExplanation = "GetJobSchedulingExplanation"
+
 	ClearTaskCache = "ClearTaskCache"
 
 	ListAllResources     = "ListAllResources"
@@ -70,6 +76,11 @@ const (
 	ListPipelineBuilds        = "ListPipelineBuilds"
 	CreatePipelineBuild       = "CreatePipelineBuild"
 	PipelineBadge             = "PipelineBadge"
+	GetPipelineGraph          = "GetPipelineGraph"
+
+	ListPipelineConfigHistory   = "ListPipelineConfigHistory"
+	GetPipelineConfigRevision   = "GetPipelineConfigRevision"
+	DiffPipelineConfigRevisions = "DiffPipelineConfigRevisions"
 
 	RegisterWorker  = "RegisterWorker"
 	LandWorker      = "LandWorker"
@@ -99,17 +110,36 @@ const (
 	ListTeams      = "ListTeams"
 	GetTeam        = "GetTeam"
 	SetTeam        = "SetTeam"
+	SetTeams       = "SetTeams"
 	RenameTeam     = "RenameTeam"
 	DestroyTeam    = "DestroyTeam"
 	ListTeamBuilds = "ListTeamBuilds"
+	TeamEvents     = "TeamEvents"
+
+	ListCustomRoles   = "ListCustomRoles"
+	SetCustomRole     = "SetCustomRole"
+	DestroyCustomRole = "DestroyCustomRole"
+
+	ListAPITokens  = "ListAPITokens"
+	CreateAPIToken = "CreateAPIToken"
+	RevokeAPIToken = "RevokeAPIToken"
+
+	ListNotificationSubscriptions   = "ListNotificationSubscriptions"
+	SetNotificationSubscription     = "SetNotificationSubscription"
+	DestroyNotificationSubscription = "DestroyNotificationSubscription"
+	ListNotificationDeliveries      = "ListNotificationDeliveries"
+	RedeliverNotification           = "RedeliverNotification"
 
 	CreateArtifact     = "CreateArtifact"
 	GetArtifact        = "GetArtifact"
 	ListBuildArtifacts = "ListBuildArtifacts"
+	GetBuildArtifact   = "GetBuildArtifact"
 
 	GetUser              = "GetUser"
 	ListActiveUsersSince = "ListActiveUsersSince"
 
+	ListAuditEvents = "ListAuditEvents"
+
 	SetWall   = "SetWall"
 	GetWall   = "GetWall"
 	ClearWall = "ClearWall"
@@ -118,6 +148,8 @@ const (
 const (
 	ClearTaskCacheQueryPath = "cache_path"
 	SaveConfigCheckCreds    = "check_creds"
+	TeamEventsQueryPipeline = "pipeline"
+	TeamEventsQueryJob      = "job"
 )
 
 var Routes = rata.Routes([]rata.Route{
@@ -127,13 +159,17 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/builds", Method: "POST", Name: CreateBuild},
 
 	{Path: "/api/v1/builds", Method: "GET", Name: ListBuilds},
+	{Path: "/api/v1/builds/events", Method: "GET", Name: StreamBuildEvents},
 	{Path: "/api/v1/builds/:build_id", Method: "GET", Name: GetBuild},
 	{Path: "/api/v1/builds/:build_id/plan", Method: "GET", Name: GetBuildPlan},
 	{Path: "/api/v1/builds/:build_id/events", Method: "GET", Name: BuildEvents},
 	{Path: "/api/v1/builds/:build_id/resources", Method: "GET", Name: BuildResources},
 	{Path: "/api/v1/builds/:build_id/abort", Method: "PUT", Name: AbortBuild},
+	{Path: "/api/v1/builds/:build_id/approvals/:plan_id", Method: "PUT", Name: SetBuildApproval},
 	{Path: "/api/v1/builds/:build_id/preparation", Method: "GET", Name: GetBuildPreparation},
+	{Path: "/api/v1/builds/:build_id/resource-usage", Method: "GET", Name: GetBuildResourceUsage},
 	{Path: "/api/v1/builds/:build_id/artifacts", Method: "GET", Name: ListBuildArtifacts},
+	{Path: "/api/v1/builds/:build_id/artifacts/:artifact_name", Method: "GET", Name: GetBuildArtifact},
 
 	{Path: "/api/v1/jobs", Method: "GET", Name: ListAllJobs},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs", Method: "GET", Name: ListJobs},
@@ -142,6 +178,7 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds", Method: "POST", Name: CreateJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", Method: "POST", Name: RerunJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/inputs", Method: "GET", Name: ListJobInputs},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/scheduling-explanation", Method: "GET", Name: GetJobSchedulingExplanation},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/builds/:build_name", Method: "GET", Name: GetJobBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/pause", Method: "PUT", Name: PauseJob},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/jobs/:job_name/unpause", Method: "PUT", Name: UnpauseJob},
@@ -167,6 +204,10 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "GET", Name: ListPipelineBuilds},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/builds", Method: "POST", Name: CreatePipelineBuild},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/badge", Method: "GET", Name: PipelineBadge},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/graph", Method: "GET", Name: GetPipelineGraph},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/history", Method: "GET", Name: ListPipelineConfigHistory},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/history/:revision", Method: "GET", Name: GetPipelineConfigRevision},
+	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/config/history/:revision/diff", Method: "GET", Name: DiffPipelineConfigRevisions},
 
 	{Path: "/api/v1/resources", Method: "GET", Name: ListAllResources},
 	{Path: "/api/v1/teams/:team_name/pipelines/:pipeline_name/resources", Method: "GET", Name: ListResources},
@@ -209,6 +250,8 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/user", Method: "GET", Name: GetUser},
 	{Path: "/api/v1/users", Method: "GET", Name: ListActiveUsersSince},
 
+	{Path: "/api/v1/audit", Method: "GET", Name: ListAuditEvents},
+
 	{Path: "/api/v1/containers/destroying", Method: "GET", Name: ListDestroyingContainers},
 	{Path: "/api/v1/containers/report", Method: "PUT", Name: ReportWorkerContainers},
 	{Path: "/api/v1/teams/:team_name/containers", Method: "GET", Name: ListContainers},
@@ -220,11 +263,27 @@ var Routes = rata.Routes([]rata.Route{
 	{Path: "/api/v1/volumes/report", Method: "PUT", Name: ReportWorkerVolumes},
 
 	{Path: "/api/v1/teams", Method: "GET", Name: ListTeams},
+	{Path: "/api/v1/teams", Method: "PUT", Name: SetTeams},
 	{Path: "/api/v1/teams/:team_name", Method: "GET", Name: GetTeam},
 	{Path: "/api/v1/teams/:team_name", Method: "PUT", Name: SetTeam},
 	{Path: "/api/v1/teams/:team_name/rename", Method: "PUT", Name: RenameTeam},
 	{Path: "/api/v1/teams/:team_name", Method: "DELETE", Name: DestroyTeam},
 	{Path: "/api/v1/teams/:team_name/builds", Method: "GET", Name: ListTeamBuilds},
+	{Path: "/api/v1/teams/:team_name/events", Method: "GET", Name: TeamEvents},
+
+	{Path: "/api/v1/teams/:team_name/roles", Method: "GET", Name: ListCustomRoles},
+	{Path: "/api/v1/teams/:team_name/roles/:role_name", Method: "PUT", Name: SetCustomRole},
+	{Path: "/api/v1/teams/:team_name/roles/:role_name", Method: "DELETE", Name: DestroyCustomRole},
+
+	{Path: "/api/v1/teams/:team_name/tokens", Method: "GET", Name: ListAPITokens},
+	{Path: "/api/v1/teams/:team_name/tokens", Method: "POST", Name: CreateAPIToken},
+	{Path: "/api/v1/teams/:team_name/tokens/:token_name", Method: "DELETE", Name: RevokeAPIToken},
+
+	{Path: "/api/v1/teams/:team_name/notifications", Method: "GET", Name: ListNotificationSubscriptions},
+	{Path: "/api/v1/teams/:team_name/notifications/:notification_name", Method: "PUT", Name: SetNotificationSubscription},
+	{Path: "/api/v1/teams/:team_name/notifications/:notification_name", Method: "DELETE", Name: DestroyNotificationSubscription},
+	{Path: "/api/v1/teams/:team_name/notifications/:notification_name/deliveries", Method: "GET", Name: ListNotificationDeliveries},
+	{Path: "/api/v1/teams/:team_name/notifications/:notification_name/deliveries/:delivery_id/redeliver", Method: "PUT", Name: RedeliverNotification},
 
 	{Path: "/api/v1/teams/:team_name/artifacts", Method: "POST", Name: CreateArtifact},
 	{Path: "/api/v1/teams/:team_name/artifacts/:artifact_id", Method: "GET", Name: GetArtifact},
@@ -88,6 +88,7 @@ type ProcessSpec struct {
 	Args         []string
 	Dir          string
 	User         string
+	StdinReader  io.Reader
 	StdoutWriter io.Writer
 	StderrWriter io.Writer
 }
@@ -376,6 +376,89 @@ var _ = DescribeTable("Input resolving",
 		},
 	}),
 
+	Entry("resolves passed constraints on jobs in other pipelines through the resource they use", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+				{Resource: "resource-x", Version: "rxv2", CheckOrder: 2},
+			},
+
+			BuildOutputs: []DBRow{
+				{Job: "other-pipeline-job", BuildID: 1, Resource: "other-resource-x", Version: "rxv1", CheckOrder: 1, DoNotInsertVersion: true},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:           "resource-x",
+				Resource:       "resource-x",
+				Passed:         []string{"other-pipeline-job"},
+				ExternalPassed: map[string]string{"other-pipeline-job": "other-resource-x"},
+			},
+		},
+
+		Result: Result{
+			OK: true,
+			Values: map[string]string{
+				"resource-x": "rxv1",
+			},
+			PassedBuildIDs: map[string][]int{
+				"resource-x": []int{1},
+			},
+		},
+	}),
+
+	Entry("does not resolve passed constraints on jobs in other pipelines with versions the input's resource does not have", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+			},
+
+			BuildOutputs: []DBRow{
+				{Job: "other-pipeline-job", BuildID: 1, Resource: "other-resource-x", Version: "rxv2", CheckOrder: 2, DoNotInsertVersion: true},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:           "resource-x",
+				Resource:       "resource-x",
+				Passed:         []string{"other-pipeline-job"},
+				ExternalPassed: map[string]string{"other-pipeline-job": "other-resource-x"},
+			},
+		},
+
+		Result: Result{
+			OK: false,
+			Errors: map[string]string{
+				"resource-x": "no satisfiable builds from passed jobs found for set of inputs",
+			},
+		},
+	}),
+
+	Entry("does not resolve passed constraints on jobs which have been deleted", Example{
+		DB: DB{
+			Resources: []DBRow{
+				{Resource: "resource-x", Version: "rxv1", CheckOrder: 1},
+			},
+		},
+
+		Inputs: Inputs{
+			{
+				Name:          "resource-x",
+				Resource:      "resource-x",
+				MissingPassed: []string{"deleted-pipeline/some-job"},
+			},
+		},
+
+		Result: Result{
+			OK: false,
+			Errors: map[string]string{
+				"resource-x": "passed job no longer exists",
+			},
+		},
+	}),
+
 	Entry("finds next version for inputs that use every version when there is a build for that resource", Example{
 		DB: DB{
 			BuildInputs: []DBRow{
@@ -82,7 +82,7 @@ var _ = Describe("Resolve", func() {
 					},
 				},
 			},
-		}, db.ConfigVersion(0), false)
+		}, db.ConfigVersion(0), false, "")
 		Expect(err).NotTo(HaveOccurred())
 
 		setupTx, err := dbConn.Begin()
@@ -259,7 +259,9 @@ outputs:
 			}
 
 			if candidate == nil {
-				exists, err := r.vdb.VersionExists(ctx, output.ResourceID, output.Version)
+				// the output may have come from a job in another pipeline, so check
+				// that the version exists for the input's own resource
+				exists, err := r.vdb.VersionExists(ctx, r.inputConfigs[c].ResourceID, output.Version)
 				if err != nil {
 					tracing.End(span, err)
 					return false, err
@@ -410,7 +412,7 @@ func (r *groupResolver) constrainingCandidates(passedJobID int) map[string][]str
 	constrainingCandidates := map[string][]string{}
 	for passedIndex, passedInput := range r.inputConfigs {
 		if passedInput.Passed[passedJobID] && r.candidates[passedIndex] != nil {
-			resID := strconv.Itoa(passedInput.ResourceID)
+			resID := strconv.Itoa(passedInput.PassedResourceID(passedJobID))
 			constrainingCandidates[resID] = append(constrainingCandidates[resID], string(r.candidates[passedIndex].Version))
 		}
 	}
@@ -422,13 +424,13 @@ func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trac
 	inputConfig := r.inputConfigs[candidateIdx]
 	candidate := r.candidates[candidateIdx]
 
-	if inputConfig.ResourceID != output.ResourceID {
-		// unrelated; different resource
+	if !inputConfig.Passed[passedJobID] {
+		// unrelated; this input is unaffected by the current job
 		return false, false, nil
 	}
 
-	if !inputConfig.Passed[passedJobID] {
-		// unrelated; this input is unaffected by the current job
+	if inputConfig.PassedResourceID(passedJobID) != output.ResourceID {
+		// unrelated; different resource
 		return false, false, nil
 	}
 
@@ -438,7 +440,7 @@ func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trac
 		return false, true, nil
 	}
 
-	disabled, err := r.vdb.VersionIsDisabled(ctx, output.ResourceID, output.Version)
+	disabled, err := r.vdb.VersionIsDisabled(ctx, inputConfig.ResourceID, output.Version)
 	if err != nil {
 		return false, false, err
 	}
@@ -446,7 +448,7 @@ func (r *groupResolver) outputIsRelatedAndMatches(ctx context.Context, span trac
 	if disabled {
 		// this version is disabled so it cannot be used
 		span.AddEvent("version disabled", trace.WithAttributes(
-			attribute.Int("resourceID", output.ResourceID),
+			attribute.Int("resourceID", inputConfig.ResourceID),
 			attribute.String("version", string(output.Version)),
 		))
 		return false, false, nil
@@ -0,0 +1,36 @@
+package algorithm
+
+import (
+	"context"
+
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/tracing"
+	"go.opentelemetry.io/otel/codes"
+)
+
+// missingPassedResolver never resolves an input, because one of the jobs in
+// its passed constraint has been deleted. Ignoring the constraint instead would
+// let the input pick versions which never went through the job.
+type missingPassedResolver struct {
+	inputConfig db.InputConfig
+}
+
+func NewMissingPassedResolver(inputConfig db.InputConfig) Resolver {
+	return &missingPassedResolver{
+		inputConfig: inputConfig,
+	}
+}
+
+func (r *missingPassedResolver) InputConfigs() db.InputConfigs {
+	return db.InputConfigs{r.inputConfig}
+}
+
+func (r *missingPassedResolver) Resolve(ctx context.Context) (map[string]*versionCandidate, db.ResolutionFailure, error) {
+	_, span := tracing.StartSpan(ctx, "missingPassedResolver.Resolve", tracing.Attrs{
+		"input": r.inputConfig.Name,
+	})
+	defer span.End()
+
+	span.SetStatus(codes.Error, "passed job no longer exists")
+	return nil, db.PassedJobNotFound, nil
+}
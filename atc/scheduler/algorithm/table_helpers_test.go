@@ -65,6 +65,13 @@ type Input struct {
 	Passed                []string
 	Version               Version
 	NoResourceConfigScope bool
+
+	// ExternalPassed maps passed jobs which stand in for jobs in other
+	// pipelines to the resource their builds record versions against
+	ExternalPassed map[string]string
+
+	// MissingPassed names passed jobs which have since been deleted
+	MissingPassed []string
 }
 
 type Version struct {
@@ -125,7 +132,7 @@ func (example Example) Run() {
 	team, err := teamFactory.CreateTeam(atc.Team{Name: "algorithm"})
 	Expect(err).NotTo(HaveOccurred())
 
-	pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "algorithm"}, atc.Config{}, db.ConfigVersion(0), false)
+	pipeline, _, err := team.SavePipeline(atc.PipelineRef{Name: "algorithm"}, atc.Config{}, db.ConfigVersion(0), false, "")
 	Expect(err).NotTo(HaveOccurred())
 
 	setupTx, err := dbConn.Begin()
@@ -168,6 +175,18 @@ func (example Example) Run() {
 			ResourceID:      setup.resourceIDs.ID(input.Resource),
 			UseEveryVersion: input.Version.Every,
 			JobID:           setup.jobIDs.ID(CurrentJobName),
+			MissingPassed:   input.MissingPassed,
+		}
+
+		for jobName, resourceName := range input.ExternalPassed {
+			if inputConfigs[i].ExternalPassed == nil {
+				inputConfigs[i].ExternalPassed = map[int]db.ExternalPassedJob{}
+			}
+
+			inputConfigs[i].ExternalPassed[setup.jobIDs.ID(jobName)] = db.ExternalPassedJob{
+				Name:       jobName,
+				ResourceID: setup.resourceIDs.ID(resourceName),
+			}
 		}
 
 		if len(input.Version.Pinned) != 0 {
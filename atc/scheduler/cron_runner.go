@@ -0,0 +1,151 @@
+package scheduler
+
+import (
+	"context"
+	"fmt"
+
+	"code.cloudfoundry.org/clock"
+	"code.cloudfoundry.org/lager"
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/tracing"
+)
+
+// ScheduledBuildCreatedBy is recorded as the creator of builds triggered by a
+// job's schedule.
+const ScheduledBuildCreatedBy = "schedule"
+
+// CronRunner creates builds for jobs configured with a schedule once their
+// next fire time has passed. Builds are created in the same way as manually
+// triggered builds, so their inputs are determined by the scheduler once the
+// job's resources have been checked.
+//
+// If several fire times have passed since the schedule last fired (e.g.
+// because the job was paused), only one build is created.
+type CronRunner struct {
+	logger       lager.Logger
+	jobFactory   db.JobFactory
+	checkFactory db.CheckFactory
+	clock        clock.Clock
+}
+
+func NewCronRunner(logger lager.Logger, jobFactory db.JobFactory, checkFactory db.CheckFactory, clock clock.Clock) *CronRunner {
+	return &CronRunner{
+		logger:       logger,
+		jobFactory:   jobFactory,
+		checkFactory: checkFactory,
+		clock:        clock,
+	}
+}
+
+func (r *CronRunner) Run(ctx context.Context) error {
+	logger := r.logger.Session("run")
+
+	logger.Debug("start")
+	defer logger.Debug("done")
+	spanCtx, span := tracing.StartSpan(ctx, "cron.Run", nil)
+	defer span.End()
+
+	jobs, err := r.jobFactory.ScheduledJobs()
+	if err != nil {
+		return fmt.Errorf("find scheduled jobs: %w", err)
+	}
+
+	for _, job := range jobs {
+		jLog := logger.Session("job", lager.Data{
+			"pipeline": job.PipelineName(),
+			"job":      job.Name(),
+		})
+
+		err := r.triggerJob(spanCtx, jLog, job)
+		if err != nil {
+			jLog.Error("failed-to-trigger-job", err)
+		}
+	}
+
+	return nil
+}
+
+func (r *CronRunner) triggerJob(ctx context.Context, logger lager.Logger, job db.Job) error {
+	config, err := job.Config()
+	if err != nil {
+		return fmt.Errorf("get config: %w", err)
+	}
+
+	if config.Schedule == nil {
+		return nil
+	}
+
+	schedule, err := config.Schedule.Parse()
+	if err != nil {
+		return fmt.Errorf("parse schedule: %w", err)
+	}
+
+	lastFired := job.ScheduleLastFired()
+	if schedule.Next(lastFired).After(r.clock.Now()) {
+		return nil
+	}
+
+	build, created, err := job.CreateScheduledBuild(ScheduledBuildCreatedBy, lastFired)
+	if err != nil {
+		return fmt.Errorf("create build: %w", err)
+	}
+
+	if !created {
+		logger.Debug("already-fired")
+		return nil
+	}
+
+	logger.Info("created-scheduled-build", lager.Data{"build": build.Name()})
+
+	return r.checkInputs(ctx, logger, job)
+}
+
+// checkInputs kicks off checks for the job's inputs, as is done for manually
+// triggered builds, so that the build does not need to wait for the resources'
+// next periodic check.
+func (r *CronRunner) checkInputs(ctx context.Context, logger lager.Logger, job db.Job) error {
+	pipeline, found, err := job.Pipeline()
+	if err != nil {
+		return fmt.Errorf("get pipeline: %w", err)
+	}
+
+	if !found {
+		return nil
+	}
+
+	resources, err := pipeline.Resources()
+	if err != nil {
+		return fmt.Errorf("get resources: %w", err)
+	}
+
+	resourceTypes, err := pipeline.ResourceTypes()
+	if err != nil {
+		return fmt.Errorf("get resource types: %w", err)
+	}
+
+	inputs, err := job.Inputs()
+	if err != nil {
+		return fmt.Errorf("get job inputs: %w", err)
+	}
+
+	for _, input := range inputs {
+		resource, found := resources.Lookup(input.Resource)
+		if !found {
+			continue
+		}
+
+		_, _, err := r.checkFactory.TryCreateCheck(
+			lagerctx.NewContext(ctx, logger),
+			resource,
+			resourceTypes,
+			resource.CurrentPinnedVersion(),
+			true,
+		)
+		if err != nil {
+			logger.Error("failed-to-create-check", err, lager.Data{"resource": input.Resource})
+		}
+	}
+
+	return nil
+}
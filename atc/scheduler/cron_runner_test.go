@@ -0,0 +1,178 @@
+package scheduler_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"code.cloudfoundry.org/clock/fakeclock"
+	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	. "github.com/concourse/concourse/atc/scheduler"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("CronRunner", func() {
+	var (
+		fakeJobFactory   *dbfakes.FakeJobFactory
+		fakeCheckFactory *dbfakes.FakeCheckFactory
+		fakeClock        *fakeclock.FakeClock
+
+		fakeJob      *dbfakes.FakeJob
+		fakePipeline *dbfakes.FakePipeline
+		fakeResource *dbfakes.FakeResource
+		fakeBuild    *dbfakes.FakeBuild
+
+		lastFired time.Time
+
+		runErr error
+	)
+
+	BeforeEach(func() {
+		fakeJobFactory = new(dbfakes.FakeJobFactory)
+		fakeCheckFactory = new(dbfakes.FakeCheckFactory)
+		fakeClock = fakeclock.NewFakeClock(time.Date(2021, 7, 1, 11, 0, 30, 0, time.UTC))
+
+		lastFired = time.Date(2021, 7, 1, 10, 30, 0, 0, time.UTC)
+
+		fakeBuild = new(dbfakes.FakeBuild)
+		fakeBuild.NameReturns("42")
+
+		fakeResource = new(dbfakes.FakeResource)
+		fakeResource.NameReturns("some-resource")
+
+		fakePipeline = new(dbfakes.FakePipeline)
+		fakePipeline.ResourcesReturns(db.Resources{fakeResource}, nil)
+
+		fakeJob = new(dbfakes.FakeJob)
+		fakeJob.NameReturns("some-job")
+		fakeJob.PipelineNameReturns("some-pipeline")
+		fakeJob.ConfigReturns(atc.JobConfig{
+			Name: "some-job",
+			Schedule: &atc.JobSchedule{
+				Cron: "0 * * * *",
+			},
+		}, nil)
+		fakeJob.ScheduleLastFiredReturns(lastFired)
+		fakeJob.CreateScheduledBuildReturns(fakeBuild, true, nil)
+		fakeJob.PipelineReturns(fakePipeline, true, nil)
+		fakeJob.InputsReturns([]atc.JobInput{
+			{Name: "some-input", Resource: "some-resource"},
+		}, nil)
+
+		fakeJobFactory.ScheduledJobsReturns(db.Jobs{fakeJob}, nil)
+	})
+
+	JustBeforeEach(func() {
+		runErr = NewCronRunner(
+			lagertest.NewTestLogger("test"),
+			fakeJobFactory,
+			fakeCheckFactory,
+			fakeClock,
+		).Run(context.TODO())
+	})
+
+	It("succeeds", func() {
+		Expect(runErr).ToNot(HaveOccurred())
+	})
+
+	Context("when the schedule is due", func() {
+		It("creates a scheduled build", func() {
+			Expect(fakeJob.CreateScheduledBuildCallCount()).To(Equal(1))
+
+			createdBy, fired := fakeJob.CreateScheduledBuildArgsForCall(0)
+			Expect(createdBy).To(Equal(ScheduledBuildCreatedBy))
+			Expect(fired).To(Equal(lastFired))
+		})
+
+		It("checks the job's inputs", func() {
+			Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(Equal(1))
+
+			_, checkable, _, _, manuallyTriggered := fakeCheckFactory.TryCreateCheckArgsForCall(0)
+			Expect(checkable).To(Equal(fakeResource))
+			Expect(manuallyTriggered).To(BeTrue())
+		})
+
+		Context("when the schedule has already been fired by another ATC", func() {
+			BeforeEach(func() {
+				fakeJob.CreateScheduledBuildReturns(nil, false, nil)
+			})
+
+			It("does not check the job's inputs", func() {
+				Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(BeZero())
+			})
+		})
+
+		Context("when creating the build fails", func() {
+			BeforeEach(func() {
+				fakeJob.CreateScheduledBuildReturns(nil, false, errors.New("disaster"))
+			})
+
+			It("does not return the error", func() {
+				Expect(runErr).ToNot(HaveOccurred())
+			})
+
+			It("does not check the job's inputs", func() {
+				Expect(fakeCheckFactory.TryCreateCheckCallCount()).To(BeZero())
+			})
+		})
+	})
+
+	Context("when the schedule is not yet due", func() {
+		BeforeEach(func() {
+			fakeJob.ScheduleLastFiredReturns(time.Date(2021, 7, 1, 11, 0, 0, 0, time.UTC))
+		})
+
+		It("does not create a build", func() {
+			Expect(fakeJob.CreateScheduledBuildCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the schedule's timezone delays the next fire time", func() {
+		BeforeEach(func() {
+			fakeJob.ConfigReturns(atc.JobConfig{
+				Name: "some-job",
+				Schedule: &atc.JobSchedule{
+					Cron:     "0 11 * * *",
+					Timezone: "America/New_York",
+				},
+			}, nil)
+		})
+
+		It("does not create a build", func() {
+			Expect(fakeJob.CreateScheduledBuildCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when the job's schedule is invalid", func() {
+		BeforeEach(func() {
+			fakeJob.ConfigReturns(atc.JobConfig{
+				Name: "some-job",
+				Schedule: &atc.JobSchedule{
+					Cron: "whenever",
+				},
+			}, nil)
+		})
+
+		It("does not return an error", func() {
+			Expect(runErr).ToNot(HaveOccurred())
+		})
+
+		It("does not create a build", func() {
+			Expect(fakeJob.CreateScheduledBuildCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when finding the scheduled jobs fails", func() {
+		BeforeEach(func() {
+			fakeJobFactory.ScheduledJobsReturns(nil, errors.New("disaster"))
+		})
+
+		It("returns the error", func() {
+			Expect(runErr).To(MatchError(ContainSubstring("disaster")))
+		})
+	})
+})
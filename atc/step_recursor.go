@@ -25,6 +25,9 @@ type StepRecursor struct {
 
 	// OnLoadVar will be invoked for any *LoadVarStep present in the StepConfig.
 	OnLoadVar func(*LoadVarStep) error
+
+	// OnApproval will be invoked for any *ApprovalStep present in the StepConfig.
+	OnApproval func(*ApprovalStep) error
 }
 
 // VisitTask calls the OnTask hook if configured.
@@ -81,6 +84,15 @@ func (recursor StepRecursor) VisitLoadVar(step *LoadVarStep) error {
 	return nil
 }
 
+// VisitApproval calls the OnApproval hook if configured.
+func (recursor StepRecursor) VisitApproval(step *ApprovalStep) error {
+	if recursor.OnApproval != nil {
+		return recursor.OnApproval(step)
+	}
+
+	return nil
+}
+
 // VisitTry recurses through to the wrapped step.
 func (recursor StepRecursor) VisitTry(step *TryStep) error {
 	return step.Step.Config.Visit(recursor)
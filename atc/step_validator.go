@@ -136,7 +136,26 @@ func (validator *StepValidator) VisitGet(step *GetStep) error {
 	for _, job := range step.Passed {
 		jobConfig, found := validator.config.Jobs.Lookup(job)
 		if !found {
-			validator.recordError("unknown job '%s'", job)
+			passedJob, err := ParsePassedJob(job)
+			if err != nil {
+				validator.recordError(err.Error())
+				continue
+			}
+
+			if !passedJob.IsCrossPipeline() {
+				validator.recordError("unknown job '%s'", job)
+				continue
+			}
+
+			// jobs in other pipelines are resolved when the pipeline is saved,
+			// as they can't be seen from this config
+			warning, err := ValidateIdentifier(passedJob.Pipeline.Name, validator.context...)
+			if err != nil {
+				validator.recordError(err.Error())
+			} else if warning != nil {
+				validator.recordWarning(*warning)
+			}
+
 			continue
 		}
 
@@ -252,6 +271,28 @@ func (validator *StepValidator) VisitLoadVar(step *LoadVarStep) error {
 	return nil
 }
 
+func (validator *StepValidator) VisitApproval(step *ApprovalStep) error {
+	validator.pushContext(".wait_for_approval(%s)", step.Name)
+	defer validator.popContext()
+
+	warning, err := ValidateIdentifier(step.Name, validator.context...)
+	if err != nil {
+		validator.recordError(err.Error())
+	}
+	if warning != nil {
+		validator.recordWarning(*warning)
+	}
+
+	if step.Timeout != "" {
+		_, err := time.ParseDuration(step.Timeout)
+		if err != nil {
+			validator.recordError("invalid timeout '%s'", step.Timeout)
+		}
+	}
+
+	return nil
+}
+
 func (validator *StepValidator) VisitTry(step *TryStep) error {
 	validator.pushContext(".try")
 	defer validator.popContext()
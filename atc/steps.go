@@ -194,6 +194,7 @@ type StepVisitor interface {
 	VisitRun(*RunStep) error
 	VisitSetPipeline(*SetPipelineStep) error
 	VisitLoadVar(*LoadVarStep) error
+	VisitApproval(*ApprovalStep) error
 	VisitTry(*TryStep) error
 	VisitDo(*DoStep) error
 	VisitInParallel(*InParallelStep) error
@@ -266,6 +267,10 @@ var StepPrecedence = []StepDetector{
 		Key: "get",
 		New: func() StepConfig { return &GetStep{} },
 	},
+	{
+		Key: "wait_for_approval",
+		New: func() StepConfig { return &ApprovalStep{} },
+	},
 	{
 		Key: "timeout",
 		New: func() StepConfig { return &TimeoutStep{} },
@@ -350,6 +355,7 @@ type TaskStep struct {
 	OutputMapping     map[string]string `json:"output_mapping,omitempty"`
 	ImageArtifactName string            `json:"image,omitempty"`
 	Timeout           string            `json:"timeout,omitempty"`
+	CacheResult       bool              `json:"cache_result,omitempty"`
 }
 
 func (step *TaskStep) Visit(v StepVisitor) error {
@@ -365,8 +371,10 @@ type RunStep struct {
 	Limits     *ContainerLimits `json:"container_limits,omitempty"`
 	Timeout    string           `json:"timeout,omitempty"`
 
-	// XXX(prototypes): inputs, outputs, input_mapping, output_mapping?
-	// see https://github.com/concourse/rfcs/pull/103
+	Inputs        []string          `json:"inputs,omitempty"`
+	InputMapping  map[string]string `json:"input_mapping,omitempty"`
+	Outputs       []string          `json:"outputs,omitempty"`
+	OutputMapping map[string]string `json:"output_mapping,omitempty"`
 
 	// XXX(prototypes): set_vars?
 
@@ -402,6 +410,22 @@ func (step *LoadVarStep) Visit(v StepVisitor) error {
 	return v.VisitLoadVar(step)
 }
 
+// ApprovalStep parks the build until a user approves or rejects it.
+//
+// If Roles or Users are configured, only users who have one of the roles in
+// the build's team, or who are listed by connector and name (e.g.
+// "github:some-user"), may decide.
+type ApprovalStep struct {
+	Name    string   `json:"wait_for_approval"`
+	Roles   []string `json:"roles,omitempty"`
+	Users   []string `json:"users,omitempty"`
+	Timeout string   `json:"timeout,omitempty"`
+}
+
+func (step *ApprovalStep) Visit(v StepVisitor) error {
+	return v.VisitApproval(step)
+}
+
 type TryStep struct {
 	Step Step `json:"try"`
 }
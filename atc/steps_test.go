@@ -178,6 +178,10 @@ var factoryTests = []StepTest{
 			tags: [tag-1, tag-2]
 			container_limits: {cpu: 10, memory: 1024}
 			timeout: 1h
+			inputs: [some-input]
+			input_mapping: {some-input: some-artifact}
+			outputs: [some-output]
+			output_mapping: {some-output: other-artifact}
 		`,
 
 		StepConfig: &atc.RunStep{
@@ -193,6 +197,11 @@ var factoryTests = []StepTest{
 			Tags:    []string{"tag-1", "tag-2"},
 			Limits:  &atc.ContainerLimits{CPU: newCPULimit(10), Memory: newMemoryLimit(1024)},
 			Timeout: "1h",
+
+			Inputs:        []string{"some-input"},
+			InputMapping:  map[string]string{"some-input": "some-artifact"},
+			Outputs:       []string{"some-output"},
+			OutputMapping: map[string]string{"some-output": "other-artifact"},
 		},
 	},
 	{
@@ -231,6 +240,23 @@ var factoryTests = []StepTest{
 			Reveal: true,
 		},
 	},
+	{
+		Title: "wait_for_approval step",
+
+		ConfigYAML: `
+			wait_for_approval: some-approval
+			roles: [owner, member]
+			users: ["github:some-user"]
+			timeout: 1h
+		`,
+
+		StepConfig: &atc.ApprovalStep{
+			Name:    "some-approval",
+			Roles:   []string{"owner", "member"},
+			Users:   []string{"github:some-user"},
+			Timeout: "1h",
+		},
+	},
 	{
 		Title: "try step",
 
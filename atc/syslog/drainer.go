@@ -58,7 +58,7 @@ func (d *drainer) Run(ctx context.Context) error {
 		defer db.Close(syslog)
 
 		for _, build := range builds {
-			err := d.drainBuild(logger, build, syslog)
+			err := d.drainBuild(ctx, logger, build, syslog)
 			if err != nil {
 				return err
 			}
@@ -67,10 +67,10 @@ func (d *drainer) Run(ctx context.Context) error {
 	return nil
 }
 
-func (d *drainer) drainBuild(logger lager.Logger, build db.Build, syslog *Syslog) error {
+func (d *drainer) drainBuild(ctx context.Context, logger lager.Logger, build db.Build, syslog *Syslog) error {
 	logger = logger.Session("drain-build", build.LagerData())
 
-	events, err := build.Events(0)
+	events, err := build.Events(ctx, 0)
 	if err != nil {
 		return err
 	}
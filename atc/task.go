@@ -151,6 +151,10 @@ type TaskInputConfig struct {
 type TaskOutputConfig struct {
 	Name string `json:"name"`
 	Path string `json:"path,omitempty"`
+
+	// Archive the output to the artifact store once the task succeeds, so
+	// that it can be downloaded after the build.
+	Archive bool `json:"archive,omitempty"`
 }
 
 type TaskCacheConfig struct {
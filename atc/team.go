@@ -2,23 +2,101 @@ package atc
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 )
 
 var (
 	ErrAuthConfigEmpty   = errors.New("auth config for the team must not be empty")
 	ErrAuthConfigInvalid = errors.New("auth config for the team does not have users and groups configured")
+	ErrTeamQuotaInvalid  = errors.New("quota for the team must not be negative")
 )
 
 type Team struct {
 	ID   int      `json:"id,omitempty"`
 	Name string   `json:"name,omitempty"`
 	Auth TeamAuth `json:"auth,omitempty"`
+
+	Quota *TeamQuota `json:"quota,omitempty"`
 }
 
 func (team Team) Validate() error {
+	if team.Quota != nil {
+		err := team.Quota.Validate()
+		if err != nil {
+			return err
+		}
+	}
+
 	return team.Auth.Validate()
 }
 
+// TeamQuota limits how many containers a team's builds may have running on
+// shared workers at once. Workers owned by the team are not subject to it. A
+// limit of zero means no limit.
+type TeamQuota struct {
+	MaxActiveTasks      int `json:"max_active_tasks,omitempty"`
+	MaxActiveContainers int `json:"max_active_containers,omitempty"`
+}
+
+func (quota TeamQuota) Validate() error {
+	if quota.MaxActiveTasks < 0 || quota.MaxActiveContainers < 0 {
+		return ErrTeamQuotaInvalid
+	}
+
+	return nil
+}
+
+func (quota TeamQuota) IsZero() bool {
+	return quota == TeamQuota{}
+}
+
+// Teams is the configuration of several teams at once, as given to
+// SetTeams.
+type Teams []Team
+
+// Lookup finds a team by name. Team names are case-insensitive.
+func (teams Teams) Lookup(name string) (Team, bool) {
+	for _, team := range teams {
+		if strings.EqualFold(team.Name, name) {
+			return team, true
+		}
+	}
+
+	return Team{}, false
+}
+
+func (teams Teams) Validate() error {
+	seen := map[string]bool{}
+	for _, team := range teams {
+		if team.Name == "" {
+			return errors.New("team name must not be empty")
+		}
+
+		name := strings.ToLower(team.Name)
+		if seen[name] {
+			return fmt.Errorf("team '%s' is configured more than once", team.Name)
+		}
+
+		seen[name] = true
+
+		err := team.Validate()
+		if err != nil {
+			return fmt.Errorf("team '%s': %w", team.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// SetTeamsRequest configures every team in Teams at once. Teams which are not
+// listed are left alone unless DeleteUnlisted is set, in which case they are
+// destroyed.
+type SetTeamsRequest struct {
+	Teams          Teams `json:"teams"`
+	DeleteUnlisted bool  `json:"delete_unlisted,omitempty"`
+}
+
 type TeamAuth map[string]map[string][]string
 
 func (auth TeamAuth) Validate() error {
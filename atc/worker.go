@@ -20,6 +20,15 @@ type Worker struct {
 	ActiveVolumes    int `json:"active_volumes"`
 	ActiveTasks      int `json:"active_tasks"`
 
+	// CPUs is the number of CPUs available to the worker's containers, used
+	// to determine its CPU utilization.
+	CPUs int `json:"cpus,omitempty"`
+
+	// ResourceUsage is the utilization of the worker's resources by its
+	// containers as of its last heartbeat. It is nil if the worker has not
+	// reported it.
+	ResourceUsage *WorkerResourceUsage `json:"resource_usage,omitempty"`
+
 	ResourceTypes []WorkerResourceType `json:"resource_types"`
 
 	Platform  string   `json:"platform"`
@@ -48,6 +57,49 @@ func (w Worker) Validate() error {
 	return nil
 }
 
+type WorkerResourceUsage struct {
+	// CPU is the fraction of the worker's CPU time used by its containers
+	// since its previous heartbeat.
+	CPU float64 `json:"cpu"`
+
+	MemoryUsedBytes     uint64 `json:"memory_used_bytes"`
+	MemoryCapacityBytes uint64 `json:"memory_capacity_bytes"`
+
+	DiskUsedBytes     uint64 `json:"disk_used_bytes"`
+	DiskCapacityBytes uint64 `json:"disk_capacity_bytes"`
+}
+
+// MemoryAvailableBytes is the amount of memory not yet used by any container.
+func (usage WorkerResourceUsage) MemoryAvailableBytes() uint64 {
+	if usage.MemoryUsedBytes >= usage.MemoryCapacityBytes {
+		return 0
+	}
+
+	return usage.MemoryCapacityBytes - usage.MemoryUsedBytes
+}
+
+// Utilization is the fraction of the worker's most utilized resource which
+// is in use.
+func (usage WorkerResourceUsage) Utilization() float64 {
+	utilization := usage.CPU
+
+	if usage.MemoryCapacityBytes > 0 {
+		memory := float64(usage.MemoryUsedBytes) / float64(usage.MemoryCapacityBytes)
+		if memory > utilization {
+			utilization = memory
+		}
+	}
+
+	if usage.DiskCapacityBytes > 0 {
+		disk := float64(usage.DiskUsedBytes) / float64(usage.DiskCapacityBytes)
+		if disk > utilization {
+			utilization = disk
+		}
+	}
+
+	return utilization
+}
+
 type WorkerResourceType struct {
 	Type                 string `json:"type"`
 	Image                string `json:"image"`
@@ -0,0 +1,89 @@
+package worker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/url"
+
+	"code.cloudfoundry.org/lager/lagerctx"
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc/blobstore"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/runtime"
+)
+
+// ArtifactArchiver copies an artifact out of its volume and into the artifact
+// store, recording it against the build so that it can be downloaded once the
+// volume is gone.
+//
+//counterfeiter:generate . ArtifactArchiver
+type ArtifactArchiver interface {
+	ArchiveArtifact(ctx context.Context, buildID int, name string, artifact runtime.Artifact) (db.ArchivedArtifact, error)
+}
+
+func NewArtifactArchiver(volumeFinder VolumeFinder, store blobstore.Store, archivedArtifacts db.ArchivedArtifactRepository) ArtifactArchiver {
+	return artifactArchiver{
+		volumeFinder:      volumeFinder,
+		store:             store,
+		archivedArtifacts: archivedArtifacts,
+	}
+}
+
+type artifactArchiver struct {
+	volumeFinder      VolumeFinder
+	store             blobstore.Store
+	archivedArtifacts db.ArchivedArtifactRepository
+}
+
+// ArchivedArtifactKey is the key under which an artifact archived by a build
+// is stored.
+func ArchivedArtifactKey(buildID int, name string) string {
+	return fmt.Sprintf("builds/%d/artifacts/%s.tgz", buildID, url.PathEscape(name))
+}
+
+// ArchiveArtifact streams the artifact out of its volume as a gzipped tarball,
+// regardless of the configured streaming compression, so that it can be
+// served to users as-is.
+func (a artifactArchiver) ArchiveArtifact(
+	ctx context.Context,
+	buildID int,
+	name string,
+	artifact runtime.Artifact,
+) (db.ArchivedArtifact, error) {
+	artifactVolume, found, err := a.volumeFinder.FindVolume(lagerctx.FromContext(ctx), 0, artifact.ID())
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, baggageclaim.ErrVolumeNotFound
+	}
+
+	out, err := artifactVolume.StreamOut(ctx, ".", baggageclaim.GzipEncoding)
+	if err != nil {
+		return nil, err
+	}
+
+	defer out.Close()
+
+	key := ArchivedArtifactKey(buildID, name)
+	counter := &countingReader{reader: out}
+
+	err = a.store.Put(ctx, key, counter)
+	if err != nil {
+		return nil, fmt.Errorf("store artifact: %w", err)
+	}
+
+	return a.archivedArtifacts.SaveArchivedArtifact(buildID, name, key, counter.count)
+}
+
+type countingReader struct {
+	reader io.Reader
+	count  int64
+}
+
+func (r *countingReader) Read(p []byte) (int, error) {
+	n, err := r.reader.Read(p)
+	r.count += int64(n)
+	return n, err
+}
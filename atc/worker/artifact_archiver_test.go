@@ -0,0 +1,103 @@
+package worker_test
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+
+	"github.com/concourse/baggageclaim"
+	"github.com/concourse/concourse/atc/blobstore/blobstorefakes"
+	"github.com/concourse/concourse/atc/db"
+	"github.com/concourse/concourse/atc/db/dbfakes"
+	"github.com/concourse/concourse/atc/runtime"
+	"github.com/concourse/concourse/atc/worker"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ArtifactArchiver", func() {
+	var (
+		fakeStore             *blobstorefakes.FakeStore
+		fakeArchivedArtifacts *dbfakes.FakeArchivedArtifactRepository
+		fakeArchivedArtifact  *dbfakes.FakeArchivedArtifact
+		volumeFinder          FakeVolumeFinder
+
+		stored []byte
+
+		archived   db.ArchivedArtifact
+		archiveErr error
+	)
+
+	BeforeEach(func() {
+		stored = nil
+
+		fakeStore = new(blobstorefakes.FakeStore)
+		fakeStore.PutStub = func(_ context.Context, _ string, contents io.Reader) error {
+			var err error
+			stored, err = ioutil.ReadAll(contents)
+			return err
+		}
+
+		fakeArchivedArtifact = new(dbfakes.FakeArchivedArtifact)
+		fakeArchivedArtifacts = new(dbfakes.FakeArchivedArtifactRepository)
+		fakeArchivedArtifacts.SaveArchivedArtifactReturns(fakeArchivedArtifact, nil)
+
+		volumeFinder = FakeVolumeFinder{Volumes: map[string]worker.Volume{
+			"output": newVolumeWithContent(content{".": []byte("some-tarball")}),
+		}}
+	})
+
+	JustBeforeEach(func() {
+		archiver := worker.NewArtifactArchiver(volumeFinder, fakeStore, fakeArchivedArtifacts)
+		archived, archiveErr = archiver.ArchiveArtifact(
+			context.Background(),
+			42,
+			"some-output",
+			&runtime.TaskArtifact{VolumeHandle: "output"},
+		)
+	})
+
+	It("stores the artifact's contents", func() {
+		Expect(archiveErr).ToNot(HaveOccurred())
+		Expect(fakeStore.PutCallCount()).To(Equal(1))
+
+		_, key, _ := fakeStore.PutArgsForCall(0)
+		Expect(key).To(Equal("builds/42/artifacts/some-output.tgz"))
+		Expect(stored).To(Equal([]byte("some-tarball")))
+	})
+
+	It("records the artifact against the build", func() {
+		Expect(archived).To(Equal(fakeArchivedArtifact))
+		Expect(fakeArchivedArtifacts.SaveArchivedArtifactCallCount()).To(Equal(1))
+
+		buildID, name, key, size := fakeArchivedArtifacts.SaveArchivedArtifactArgsForCall(0)
+		Expect(buildID).To(Equal(42))
+		Expect(name).To(Equal("some-output"))
+		Expect(key).To(Equal("builds/42/artifacts/some-output.tgz"))
+		Expect(size).To(Equal(int64(len("some-tarball"))))
+	})
+
+	Context("when the volume is not found", func() {
+		BeforeEach(func() {
+			volumeFinder = FakeVolumeFinder{}
+		})
+
+		It("errors", func() {
+			Expect(archiveErr).To(MatchError(baggageclaim.ErrVolumeNotFound))
+			Expect(fakeArchivedArtifacts.SaveArchivedArtifactCallCount()).To(BeZero())
+		})
+	})
+
+	Context("when storing the artifact fails", func() {
+		BeforeEach(func() {
+			fakeStore.PutStub = nil
+			fakeStore.PutReturns(errors.New("disaster"))
+		})
+
+		It("does not record the artifact", func() {
+			Expect(archiveErr).To(MatchError(ContainSubstring("disaster")))
+			Expect(fakeArchivedArtifacts.SaveArchivedArtifactCallCount()).To(BeZero())
+		})
+	})
+})
@@ -117,6 +117,9 @@ type StreamableArtifactSource interface {
 	// StreamFile returns the contents of a single file in the artifact source.
 	// This is used for loading a task's configuration at runtime.
 	StreamFile(context.Context, string) (io.ReadCloser, error)
+
+	// Artifact returns the artifact which the source provides.
+	Artifact() runtime.Artifact
 }
 
 type artifactSource struct {
@@ -304,6 +307,11 @@ func (source *artifactSource) ExistsOn(logger lager.Logger, worker Worker) (Volu
 
 }
 
+// Artifact returns the artifact which the source provides.
+func (source *artifactSource) Artifact() runtime.Artifact {
+	return source.artifact
+}
+
 type CacheArtifactSource struct {
 	runtime.CacheArtifact
 }
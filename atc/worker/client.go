@@ -79,6 +79,10 @@ type client struct {
 type TaskResult struct {
 	ExitStatus   int
 	VolumeMounts []VolumeMount
+
+	// ResourceUsage is nil if the task's process had already exited, or if
+	// the worker's runtime could not report the container's metrics.
+	ResourceUsage *atc.ResourceUsage
 }
 
 type CheckResult struct {
@@ -181,6 +185,7 @@ func (client *client) RunTaskStep(
 	}
 
 	processIO := garden.ProcessIO{
+		Stdin:  processSpec.StdinReader,
 		Stdout: processSpec.StdoutWriter,
 		Stderr: processSpec.StderrWriter,
 	}
@@ -219,6 +224,8 @@ func (client *client) RunTaskStep(
 
 	logger.Info("attached")
 
+	usage := sampleResourceUsage(logger, container)
+
 	exitStatusChan := make(chan processStatus)
 
 	go func() {
@@ -236,26 +243,32 @@ func (client *client) RunTaskStep(
 
 		status := <-exitStatusChan
 		return TaskResult{
-			ExitStatus:   status.processStatus,
-			VolumeMounts: container.VolumeMounts(),
+			ExitStatus:    status.processStatus,
+			VolumeMounts:  container.VolumeMounts(),
+			ResourceUsage: usage.Finish(),
 		}, ctx.Err()
 
 	case status := <-exitStatusChan:
+		resourceUsage := usage.Finish()
+
 		if status.processErr != nil {
 			return TaskResult{
-				ExitStatus: status.processStatus,
+				ExitStatus:    status.processStatus,
+				ResourceUsage: resourceUsage,
 			}, status.processErr
 		}
 
 		err = container.SetProperty(taskExitStatusPropertyName, fmt.Sprintf("%d", status.processStatus))
 		if err != nil {
 			return TaskResult{
-				ExitStatus: status.processStatus,
+				ExitStatus:    status.processStatus,
+				ResourceUsage: resourceUsage,
 			}, err
 		}
 		return TaskResult{
-			ExitStatus:   status.processStatus,
-			VolumeMounts: container.VolumeMounts(),
+			ExitStatus:    status.processStatus,
+			VolumeMounts:  container.VolumeMounts(),
+			ResourceUsage: resourceUsage,
 		}, err
 	}
 }
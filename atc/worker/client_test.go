@@ -6,6 +6,7 @@ import (
 	"errors"
 	"fmt"
 	"path"
+	"time"
 
 	"code.cloudfoundry.org/garden"
 	"code.cloudfoundry.org/garden/gardenfakes"
@@ -359,6 +360,11 @@ var _ = Describe("Client", func() {
 				Expect(status).To(Equal(8))
 			})
 
+			It("does not report resource usage", func() {
+				Expect(fakeContainer.MetricsCallCount()).To(BeZero())
+				Expect(taskResult.ResourceUsage).To(BeNil())
+			})
+
 			Context("when volumes are configured and present on the container", func() {
 				var (
 					fakeMountPath1 = "some-artifact-root/some-output-configured-path/"
@@ -537,6 +543,43 @@ var _ = Describe("Client", func() {
 						Expect(err).ToNot(HaveOccurred())
 					})
 
+					Context("when the container's metrics can be sampled", func() {
+						BeforeEach(func() {
+							fakeContainer.MetricsReturns(garden.Metrics{
+								MemoryStat: garden.ContainerMemoryStat{TotalUsageTowardLimit: 2048},
+								CPUStat: garden.ContainerCPUStat{
+									Usage:  300,
+									User:   200,
+									System: 100,
+								},
+								Age: time.Hour,
+							}, nil)
+						})
+
+						It("returns the resource usage of the container", func() {
+							Expect(taskResult.ResourceUsage).ToNot(BeNil())
+							Expect(taskResult.ResourceUsage.CPUUsage).To(Equal(uint64(300)))
+							Expect(taskResult.ResourceUsage.CPUUser).To(Equal(uint64(200)))
+							Expect(taskResult.ResourceUsage.CPUSystem).To(Equal(uint64(100)))
+							Expect(taskResult.ResourceUsage.MemoryPeak).To(Equal(uint64(2048)))
+						})
+
+						It("measures the duration from when the container was created rather than attached to", func() {
+							Expect(taskResult.ResourceUsage.Duration).To(Equal(time.Hour))
+						})
+					})
+
+					Context("when the container's metrics cannot be sampled", func() {
+						BeforeEach(func() {
+							fakeContainer.MetricsReturns(garden.Metrics{}, errors.New("not implemented"))
+						})
+
+						It("still succeeds without resource usage", func() {
+							Expect(err).ToNot(HaveOccurred())
+							Expect(taskResult.ResourceUsage).To(BeNil())
+						})
+					})
+
 					It("returns all the volume mounts", func() {
 						Expect(volumeMounts).To(ConsistOf(
 							worker.VolumeMount{
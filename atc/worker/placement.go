@@ -12,16 +12,19 @@ import (
 )
 
 type ContainerPlacementStrategyOptions struct {
-	ContainerPlacementStrategy   []string `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" choice:"limit-active-tasks" choice:"limit-active-containers" choice:"limit-active-volumes" description:"Method by which a worker is selected during container placement. If multiple methods are specified, they will be applied in order. Random strategy should only be used alone."`
+	ContainerPlacementStrategy   []string `long:"container-placement-strategy" default:"volume-locality" choice:"volume-locality" choice:"random" choice:"fewest-build-containers" choice:"limit-active-tasks" choice:"limit-active-containers" choice:"limit-active-volumes" choice:"least-utilized" description:"Method by which a worker is selected during container placement. If multiple methods are specified, they will be applied in order. Random strategy should only be used alone."`
 	MaxActiveTasksPerWorker      int      `long:"max-active-tasks-per-worker" default:"0" description:"Maximum allowed number of active build tasks per worker. Has effect only when used with limit-active-tasks placement strategy. 0 means no limit."`
 	MaxActiveContainersPerWorker int      `long:"max-active-containers-per-worker" default:"0" description:"Maximum allowed number of active containers per worker. Has effect only when used with limit-active-containers placement strategy. 0 means no limit."`
 	MaxActiveVolumesPerWorker    int      `long:"max-active-volumes-per-worker" default:"0" description:"Maximum allowed number of active volumes per worker. Has effect only when used with limit-active-volumes placement strategy. 0 means no limit."`
+	MaxWorkerUtilization         float64  `long:"max-worker-utilization" default:"0.9" description:"Maximum utilization (between 0 and 1) of a worker's CPU, memory or disk at which containers may still be placed on it. Has effect only when used with least-utilized placement strategy. 0 means no limit."`
 }
 
 var (
 	ErrTooManyActiveTasks = errors.New("worker has too many active tasks")
 	ErrTooManyContainers  = errors.New("worker has too many containers")
 	ErrTooManyVolumes     = errors.New("worker has too many volumes")
+	ErrWorkerSaturated    = errors.New("worker is too heavily utilized")
+	ErrNotEnoughMemory    = errors.New("worker does not have enough memory available")
 )
 
 type NoWorkerFitContainerPlacementStrategyError struct {
@@ -92,6 +95,12 @@ func NewChainPlacementStrategy(opts ContainerPlacementStrategyOptions) (*ChainPl
 			}
 			cps.nodes = append(cps.nodes, newLimitActiveVolumesPlacementStrategy(strategy, opts.MaxActiveVolumesPerWorker))
 
+		case "least-utilized":
+			if opts.MaxWorkerUtilization < 0 || opts.MaxWorkerUtilization > 1 {
+				return nil, errors.New("max-worker-utilization must be between 0 and 1")
+			}
+			cps.nodes = append(cps.nodes, newLeastUtilizedStrategy(strategy, opts.MaxWorkerUtilization))
+
 		case "volume-locality":
 			cps.nodes = append(cps.nodes, newVolumeLocalityStrategy(strategy))
 
@@ -400,3 +409,60 @@ func (strategy *LimitActiveVolumesStrategy) Approve(logger lager.Logger, worker
 
 func (strategy *LimitActiveVolumesStrategy) Release(logger lager.Logger, worker Worker, spec ContainerSpec) {
 }
+
+// Strategy which orders candidate workers by the utilization of their most
+// utilized resource, as reported by their heartbeat, and rejects workers which
+// are saturated or do not have enough memory left for the container's limit.
+//
+// Workers which have not reported their resource usage are ordered last, and
+// are never rejected.
+type LeastUtilizedStrategy struct {
+	NamedPlacementStrategy
+	maxUtilization float64
+}
+
+func newLeastUtilizedStrategy(name string, maxUtilization float64) ContainerPlacementStrategy {
+	return &LeastUtilizedStrategy{
+		NamedPlacementStrategy: NamedPlacementStrategy{name},
+		maxUtilization:         maxUtilization,
+	}
+}
+
+func (strategy *LeastUtilizedStrategy) Order(logger lager.Logger, workers []Worker, spec ContainerSpec) ([]Worker, error) {
+	candidates := append([]Worker(nil), workers...)
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		usageI := candidates[i].ResourceUsage()
+		usageJ := candidates[j].ResourceUsage()
+
+		if usageI == nil || usageJ == nil {
+			return usageI != nil && usageJ == nil
+		}
+
+		return usageI.Utilization() < usageJ.Utilization()
+	})
+
+	return candidates, nil
+}
+
+func (strategy *LeastUtilizedStrategy) Approve(logger lager.Logger, worker Worker, spec ContainerSpec) error {
+	usage := worker.ResourceUsage()
+	if usage == nil {
+		return nil
+	}
+
+	if strategy.maxUtilization > 0 && usage.Utilization() >= strategy.maxUtilization {
+		return ErrWorkerSaturated
+	}
+
+	// CPU limits are relative shares rather than an amount of CPU time, so
+	// only the memory limit can be compared against what is available
+	if spec.Limits.Memory != nil && usage.MemoryCapacityBytes > 0 && usage.MemoryAvailableBytes() < *spec.Limits.Memory {
+		return ErrNotEnoughMemory
+	}
+
+	return nil
+}
+
+func (strategy *LeastUtilizedStrategy) Release(logger lager.Logger, worker Worker, spec ContainerSpec) {
+}
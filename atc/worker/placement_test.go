@@ -7,6 +7,7 @@ import (
 
 	"code.cloudfoundry.org/lager"
 	"code.cloudfoundry.org/lager/lagertest"
+	"github.com/concourse/concourse/atc"
 	. "github.com/concourse/concourse/atc/worker"
 	"github.com/concourse/concourse/atc/worker/workerfakes"
 
@@ -676,6 +677,135 @@ var _ = Describe("ContainerPlacementStrategy", func() {
 		})
 	})
 
+	Describe("least-utilized", func() {
+		var maxUtilization float64
+		var shouldError bool
+
+		BeforeEach(func() {
+			maxUtilization = 0.9
+			shouldError = false
+
+			workerFakes[0].ResourceUsageReturns(&atc.WorkerResourceUsage{
+				CPU:                 0.5,
+				MemoryUsedBytes:     1024,
+				MemoryCapacityBytes: 4096,
+			})
+			workerFakes[1].ResourceUsageReturns(&atc.WorkerResourceUsage{
+				CPU:                 0.95,
+				MemoryUsedBytes:     1024,
+				MemoryCapacityBytes: 4096,
+			})
+			workerFakes[2].ResourceUsageReturns(&atc.WorkerResourceUsage{
+				CPU:                 0.1,
+				MemoryUsedBytes:     3072,
+				MemoryCapacityBytes: 4096,
+			})
+		})
+
+		JustBeforeEach(func() {
+			strategy, strategyErr = NewChainPlacementStrategy(ContainerPlacementStrategyOptions{
+				ContainerPlacementStrategy: []string{"least-utilized"},
+				MaxWorkerUtilization:       maxUtilization,
+			})
+
+			if !shouldError {
+				Expect(strategyErr).ToNot(HaveOccurred())
+			} else {
+				Expect(strategyErr).To(HaveOccurred())
+			}
+		})
+
+		Context("when max-worker-utilization is greater than 1", func() {
+			BeforeEach(func() {
+				maxUtilization = 1.5
+				shouldError = true
+			})
+
+			It("should fail", func() {
+				Expect(strategyErr).To(Equal(errors.New("max-worker-utilization must be between 0 and 1")))
+				Expect(strategy).To(BeNil())
+			})
+		})
+
+		Describe("strategy.Order", func() {
+			JustBeforeEach(func() {
+				order(true)
+			})
+
+			It("orders workers by their most utilized resource", func() {
+				Expect(orderedWorkers).To(Equal([]Worker{workers[0], workers[2], workers[1]}))
+			})
+
+			Context("when a worker has not reported its resource usage", func() {
+				BeforeEach(func() {
+					workerFakes[0].ResourceUsageReturns(nil)
+				})
+
+				It("orders it last", func() {
+					Expect(orderedWorkers).To(Equal([]Worker{workers[2], workers[1], workers[0]}))
+				})
+			})
+		})
+
+		Describe("strategy.Approve and strategy.Release", func() {
+			JustBeforeEach(func() {
+				pickAndRelease()
+			})
+
+			BeforeEach(func() {
+				orderedWorkers = []Worker{workers[1], workers[2], workers[0]}
+			})
+
+			It("does not pick saturated workers", func() {
+				Expect(pickedWorker).To(Equal(workers[2]))
+			})
+
+			Context("when the limit is zero", func() {
+				BeforeEach(func() {
+					maxUtilization = 0
+				})
+
+				It("picks saturated workers", func() {
+					Expect(pickedWorker).To(Equal(workers[1]))
+				})
+			})
+
+			Context("when the container has a memory limit", func() {
+				BeforeEach(func() {
+					memory := uint64(2048)
+					containerSpec.Limits = ContainerLimits{Memory: &memory}
+				})
+
+				It("does not pick workers without enough memory available", func() {
+					Expect(pickedWorker).To(Equal(workers[0]))
+				})
+			})
+
+			Context("when no workers have headroom", func() {
+				BeforeEach(func() {
+					memory := uint64(8192)
+					containerSpec.Limits = ContainerLimits{Memory: &memory}
+					orderedWorkers = []Worker{workers[2], workers[0]}
+				})
+
+				It("fails to pick a worker", func() {
+					Expect(pickedWorker).To(BeNil())
+					Expect(pickErr).To(Equal(ErrNotEnoughMemory))
+				})
+			})
+
+			Context("when a worker has not reported its resource usage", func() {
+				BeforeEach(func() {
+					workerFakes[1].ResourceUsageReturns(nil)
+				})
+
+				It("picks it", func() {
+					Expect(pickedWorker).To(Equal(workers[1]))
+				})
+			})
+		})
+	})
+
 	Describe("Chained placement strategy", func() {
 		Describe("strategy.Order", func() {
 			Context("fewest-build-containers,volume-locality", func() {
@@ -58,7 +58,7 @@ type Pool interface {
 
 //counterfeiter:generate . PoolCallbacks
 type PoolCallbacks interface {
-	WaitingForWorker(lager.Logger)
+	WaitingForWorker(lager.Logger, string)
 }
 
 //counterfeiter:generate . VolumeFinder
@@ -68,12 +68,14 @@ type VolumeFinder interface {
 
 type pool struct {
 	provider WorkerProvider
+	quotas   db.TeamQuotaRepository
 	waker    chan bool
 }
 
-func NewPool(provider WorkerProvider) Pool {
+func NewPool(provider WorkerProvider, quotas db.TeamQuotaRepository) Pool {
 	return &pool{
 		provider: provider,
+		quotas:   quotas,
 		waker:    make(chan bool),
 	}
 }
@@ -169,22 +171,46 @@ func (pool *pool) findWorkerFromStrategy(
 	return nil, nil
 }
 
+// exceededTeamQuota returns a reason for the container to wait if placing it
+// on a shared worker would exceed its team's quota.
+//
+// The quota is checked against the containers that exist at the time, so
+// steps selecting workers at the same moment may briefly exceed it.
+func (pool *pool) exceededTeamQuota(teamID int, containerSpec ContainerSpec) (string, error) {
+	quota, usage, err := pool.quotas.FindTeamQuota(teamID)
+	if err != nil {
+		return "", err
+	}
+
+	if quota.MaxActiveContainers > 0 && usage.ActiveContainers >= quota.MaxActiveContainers {
+		return fmt.Sprintf("team has reached its quota of %d active containers on shared workers", quota.MaxActiveContainers), nil
+	}
+
+	if containerSpec.Type == db.ContainerTypeTask && quota.MaxActiveTasks > 0 && usage.ActiveTasks >= quota.MaxActiveTasks {
+		return fmt.Sprintf("team has reached its quota of %d active tasks on shared workers", quota.MaxActiveTasks), nil
+	}
+
+	return "", nil
+}
+
+// findWorker returns the worker to place the container on, or the reason
+// for waiting if the team has exceeded its quota.
 func (pool *pool) findWorker(
 	ctx context.Context,
 	containerOwner db.ContainerOwner,
 	containerSpec ContainerSpec,
 	workerSpec WorkerSpec,
 	strategy ContainerPlacementStrategy,
-) (Client, error) {
+) (Client, string, error) {
 	logger := lagerctx.FromContext(ctx)
 
 	compatibleWorkers, err := pool.allSatisfying(logger, workerSpec)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	if len(compatibleWorkers) == 0 {
-		return nil, nil
+		return nil, "", nil
 	}
 
 	worker, err := pool.findWorkerWithContainer(
@@ -193,10 +219,23 @@ func (pool *pool) findWorker(
 		containerOwner,
 	)
 	if err != nil {
-		return nil, err
+		return nil, "", err
 	}
 
 	if worker == nil {
+		// compatible workers are either all owned by the team or all shared
+		if !compatibleWorkers[0].IsOwnedByTeam() {
+			reason, err := pool.exceededTeamQuota(workerSpec.TeamID, containerSpec)
+			if err != nil {
+				return nil, "", err
+			}
+
+			if reason != "" {
+				logger.Debug("team-quota-exceeded", lager.Data{"reason": reason})
+				return nil, reason, nil
+			}
+		}
+
 		worker, err = pool.findWorkerFromStrategy(
 			logger,
 			compatibleWorkers,
@@ -204,15 +243,15 @@ func (pool *pool) findWorker(
 			strategy,
 		)
 		if err != nil {
-			return nil, err
+			return nil, "", err
 		}
 	}
 
 	if worker == nil {
-		return nil, nil
+		return nil, "", nil
 	}
 
-	return NewClient(worker), nil
+	return NewClient(worker), "", nil
 }
 
 func (pool *pool) FindContainer(logger lager.Logger, teamID int, handle string) (Container, bool, error) {
@@ -287,9 +326,11 @@ func (pool *pool) SelectWorker(
 
 	var worker Client
 	var pollingTicker *time.Ticker
+	var waitingReason string
 	for {
+		var reason string
 		var err error
-		worker, err = pool.findWorker(ctx, owner, containerSpec, workerSpec, strategy)
+		worker, reason, err = pool.findWorker(ctx, owner, containerSpec, workerSpec, strategy)
 
 		if err != nil {
 			return nil, 0, err
@@ -299,6 +340,16 @@ func (pool *pool) SelectWorker(
 			break
 		}
 
+		if pollingTicker != nil && reason != waitingReason {
+			logger.Debug("reason-for-waiting-changed", lager.Data{"reason": reason})
+
+			if callbacks != nil {
+				callbacks.WaitingForWorker(logger, reason)
+			}
+		}
+
+		waitingReason = reason
+
 		if pollingTicker == nil {
 			pollingTicker = time.NewTicker(WorkerPollingInterval)
 			defer pollingTicker.Stop()
@@ -314,7 +365,7 @@ func (pool *pool) SelectWorker(
 			defer metric.Metrics.StepsWaiting[labels].Dec()
 
 			if callbacks != nil {
-				callbacks.WaitingForWorker(logger)
+				callbacks.WaitingForWorker(logger, reason)
 			}
 		}
 
@@ -23,6 +23,7 @@ var _ = Describe("Pool", func() {
 	var (
 		logger       *lagertest.TestLogger
 		fakeProvider *workerfakes.FakeWorkerProvider
+		fakeQuotas   *dbfakes.FakeTeamQuotaRepository
 
 		pool Pool
 	)
@@ -30,8 +31,9 @@ var _ = Describe("Pool", func() {
 	BeforeEach(func() {
 		logger = lagertest.NewTestLogger("test")
 		fakeProvider = new(workerfakes.FakeWorkerProvider)
+		fakeQuotas = new(dbfakes.FakeTeamQuotaRepository)
 
-		pool = NewPool(fakeProvider)
+		pool = NewPool(fakeProvider, fakeQuotas)
 	})
 
 	Describe("FindContainer", func() {
@@ -326,6 +328,7 @@ var _ = Describe("Pool", func() {
 					It("succeeds and returns the compatible worker with the container", func() {
 						Expect(fakeStrategy.OrderCallCount()).To(Equal(0))
 						Expect(fakeStrategy.ApproveCallCount()).To(Equal(0))
+						Expect(fakeQuotas.FindTeamQuotaCallCount()).To(Equal(0))
 
 						Expect(selectErr).NotTo(HaveOccurred())
 						Expect(selectedWorker.Name()).To(Equal(workers[0].Name()))
@@ -527,6 +530,80 @@ var _ = Describe("Pool", func() {
 							Expect(selectedWorker.Name()).To(Equal(workers[1].Name()))
 						})
 					})
+
+					Context("when the team is within its quota", func() {
+						BeforeEach(func() {
+							containerSpec.Type = db.ContainerTypeTask
+
+							fakeQuotas.FindTeamQuotaReturns(
+								atc.TeamQuota{MaxActiveTasks: 2, MaxActiveContainers: 5},
+								db.TeamQuotaUsage{ActiveTasks: 1, ActiveContainers: 4},
+								nil,
+							)
+						})
+
+						It("checks the quota of the team", func() {
+							Expect(fakeQuotas.FindTeamQuotaCallCount()).To(Equal(1))
+							Expect(fakeQuotas.FindTeamQuotaArgsForCall(0)).To(Equal(4567))
+						})
+
+						It("chooses a worker", func() {
+							Expect(selectErr).ToNot(HaveOccurred())
+							Expect(selectedWorker.Name()).To(Equal(workers[0].Name()))
+						})
+					})
+
+					Context("when the team has exceeded its task quota but the container is not a task", func() {
+						BeforeEach(func() {
+							containerSpec.Type = db.ContainerTypeGet
+
+							fakeQuotas.FindTeamQuotaReturns(
+								atc.TeamQuota{MaxActiveTasks: 2},
+								db.TeamQuotaUsage{ActiveTasks: 2, ActiveContainers: 2},
+								nil,
+							)
+						})
+
+						It("chooses a worker", func() {
+							Expect(selectErr).ToNot(HaveOccurred())
+							Expect(selectedWorker.Name()).To(Equal(workers[0].Name()))
+						})
+					})
+
+					Context("when finding the quota of the team fails", func() {
+						var disaster error
+
+						BeforeEach(func() {
+							disaster = errors.New("nope")
+							fakeQuotas.FindTeamQuotaReturns(atc.TeamQuota{}, db.TeamQuotaUsage{}, disaster)
+						})
+
+						It("returns the error", func() {
+							Expect(selectErr).To(Equal(disaster))
+						})
+					})
+				})
+
+				Context("when only team workers satisfy the spec and the team has exceeded its quota", func() {
+					BeforeEach(func() {
+						workerFakes[0].SatisfiesReturns(true)
+						workerFakes[0].IsOwnedByTeamReturns(true)
+
+						fakeProvider.RunningWorkersReturns(workers, nil)
+
+						fakeQuotas.FindTeamQuotaReturns(
+							atc.TeamQuota{MaxActiveContainers: 1},
+							db.TeamQuotaUsage{ActiveContainers: 1},
+							nil,
+						)
+					})
+
+					It("does not apply the quota", func() {
+						Expect(fakeQuotas.FindTeamQuotaCallCount()).To(Equal(0))
+
+						Expect(selectErr).ToNot(HaveOccurred())
+						Expect(selectedWorker.Name()).To(Equal(workers[0].Name()))
+					})
 				})
 			})
 		})
@@ -576,6 +653,41 @@ var _ = Describe("Pool", func() {
 					Expect(fakeProvider.RunningWorkersCallCount()).To(Equal(2))
 					Expect(workerFakes[0].SatisfiesCallCount()).To(Equal(2))
 				})
+
+				It("notifies that it is waiting for a worker", func() {
+					Expect(fakeCallbacks.WaitingForWorkerCallCount()).To(Equal(1))
+
+					_, reason := fakeCallbacks.WaitingForWorkerArgsForCall(0)
+					Expect(reason).To(BeEmpty())
+				})
+			})
+
+			Context("when the team has exceeded its quota", func() {
+				BeforeEach(func() {
+					workerFakes[0].SatisfiesReturns(true)
+					fakeProvider.RunningWorkersReturns(workers, nil)
+
+					containerSpec.Type = db.ContainerTypeTask
+
+					fakeQuotas.FindTeamQuotaReturns(
+						atc.TeamQuota{MaxActiveTasks: 2},
+						db.TeamQuotaUsage{ActiveTasks: 2, ActiveContainers: 2},
+						nil,
+					)
+				})
+
+				It("waits rather than placing the container", func() {
+					Expect(selectErr).To(Equal(selectCtx.Err()))
+					Expect(fakeQuotas.FindTeamQuotaCallCount()).To(Equal(2))
+					Expect(fakeStrategy.OrderCallCount()).To(Equal(0))
+				})
+
+				It("explains why it is waiting only once", func() {
+					Expect(fakeCallbacks.WaitingForWorkerCallCount()).To(Equal(1))
+
+					_, reason := fakeCallbacks.WaitingForWorkerArgsForCall(0)
+					Expect(reason).To(Equal("team has reached its quota of 2 active tasks on shared workers"))
+				})
 			})
 		})
 	})
@@ -0,0 +1,103 @@
+package worker
+
+import (
+	"time"
+
+	"code.cloudfoundry.org/lager"
+	"github.com/concourse/concourse/atc"
+)
+
+// ResourceUsageSampleInterval is how often the container of a running task is
+// sampled, so that the peak of its memory usage can be tracked on workers whose
+// runtime only reports the current usage. CPU time is cumulative, so only the
+// final sample is needed for it.
+const ResourceUsageSampleInterval = 10 * time.Second
+
+type resourceUsageSampler struct {
+	logger    lager.Logger
+	container Container
+	started   time.Time
+
+	// only accessed by the sampling goroutine until it is done
+	peak uint64
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+func sampleResourceUsage(logger lager.Logger, container Container) *resourceUsageSampler {
+	sampler := &resourceUsageSampler{
+		logger:    logger.Session("sample-resource-usage"),
+		container: container,
+		started:   time.Now(),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+
+	go sampler.run()
+
+	return sampler
+}
+
+func (sampler *resourceUsageSampler) run() {
+	defer close(sampler.done)
+
+	ticker := time.NewTicker(ResourceUsageSampleInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			metrics, err := sampler.container.Metrics()
+			if err != nil {
+				sampler.logger.Debug("failed-to-sample", lager.Data{"error": err.Error()})
+				continue
+			}
+
+			sampler.observe(metrics.MemoryStat.TotalUsageTowardLimit)
+
+		case <-sampler.stop:
+			return
+		}
+	}
+}
+
+func (sampler *resourceUsageSampler) observe(memory uint64) {
+	if memory > sampler.peak {
+		sampler.peak = memory
+	}
+}
+
+// Finish stops sampling and takes a final sample of the container, returning
+// its usage. Nil is returned if the container's metrics could not be sampled,
+// e.g. if its runtime does not support them.
+//
+// The duration is the age of the container, as the process may have been
+// started long before it was attached to, e.g. after the ATC restarted. It is
+// only measured from when sampling started if the runtime does not report it.
+func (sampler *resourceUsageSampler) Finish() *atc.ResourceUsage {
+	close(sampler.stop)
+	<-sampler.done
+
+	duration := time.Since(sampler.started)
+
+	metrics, err := sampler.container.Metrics()
+	if err != nil {
+		sampler.logger.Info("failed-to-sample", lager.Data{"error": err.Error()})
+		return nil
+	}
+
+	if metrics.Age != 0 {
+		duration = metrics.Age
+	}
+
+	sampler.observe(metrics.MemoryStat.TotalUsageTowardLimit)
+
+	return &atc.ResourceUsage{
+		CPUUsage:   metrics.CPUStat.Usage,
+		CPUUser:    metrics.CPUStat.User,
+		CPUSystem:  metrics.CPUStat.System,
+		MemoryPeak: sampler.peak,
+		Duration:   duration,
+	}
+}
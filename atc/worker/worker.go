@@ -85,6 +85,7 @@ type Worker interface {
 
 	ActiveContainers() int
 	ActiveVolumes() int
+	ResourceUsage() *atc.WorkerResourceUsage
 }
 
 type gardenWorker struct {
@@ -883,3 +884,7 @@ func (worker *gardenWorker) ActiveContainers() int {
 func (worker *gardenWorker) ActiveVolumes() int {
 	return worker.dbWorker.ActiveVolumes()
 }
+
+func (worker *gardenWorker) ResourceUsage() *atc.WorkerResourceUsage {
+	return worker.dbWorker.ResourceUsage()
+}
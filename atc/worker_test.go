@@ -61,4 +61,51 @@ var _ = Describe("Worker", func() {
 			})
 		})
 	})
+
+	Describe("WorkerResourceUsage", func() {
+		var usage atc.WorkerResourceUsage
+
+		BeforeEach(func() {
+			usage = atc.WorkerResourceUsage{
+				CPU:                 0.25,
+				MemoryUsedBytes:     512,
+				MemoryCapacityBytes: 1024,
+				DiskUsedBytes:       100,
+				DiskCapacityBytes:   1000,
+			}
+		})
+
+		Describe("Utilization", func() {
+			It("returns the utilization of the most utilized resource", func() {
+				Expect(usage.Utilization()).To(Equal(0.5))
+			})
+
+			Context("when the capacities are unknown", func() {
+				BeforeEach(func() {
+					usage.MemoryCapacityBytes = 0
+					usage.DiskCapacityBytes = 0
+				})
+
+				It("returns the CPU utilization", func() {
+					Expect(usage.Utilization()).To(Equal(0.25))
+				})
+			})
+		})
+
+		Describe("MemoryAvailableBytes", func() {
+			It("returns the unused memory", func() {
+				Expect(usage.MemoryAvailableBytes()).To(Equal(uint64(512)))
+			})
+
+			Context("when more memory is used than the capacity", func() {
+				BeforeEach(func() {
+					usage.MemoryUsedBytes = 2048
+				})
+
+				It("returns zero", func() {
+					Expect(usage.MemoryAvailableBytes()).To(BeZero())
+				})
+			})
+		})
+	})
 })
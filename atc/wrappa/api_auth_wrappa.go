@@ -47,11 +47,14 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		case atc.GetBuildPreparation,
 			atc.BuildEvents,
 			atc.GetBuildPlan,
-			atc.ListBuildArtifacts:
+			atc.GetBuildResourceUsage,
+			atc.ListBuildArtifacts,
+			atc.GetBuildArtifact:
 			newHandler = wrappa.checkBuildReadAccessHandlerFactory.CheckIfPrivateJobHandler(handler, rejector)
 
 			// resource belongs to authorized team
-		case atc.AbortBuild:
+		case atc.AbortBuild,
+			atc.SetBuildApproval:
 			newHandler = wrappa.checkBuildWriteAccessHandlerFactory.HandlerFor(handler, rejector)
 
 		// requester is system, admin team, or worker owning team
@@ -66,6 +69,7 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 
 		// pipeline is public or authorized
 		case atc.GetPipeline,
+			atc.GetPipelineGraph,
 			atc.GetJobBuild,
 			atc.PipelineBadge,
 			atc.JobBadge,
@@ -102,14 +106,17 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListAllJobs,
 			atc.ListAllResources,
 			atc.ListBuilds,
+			atc.StreamBuildEvents,
 			atc.MainJobBadge,
 			atc.GetWall:
 			newHandler = auth.CheckAuthenticationIfProvidedHandler(handler, rejector)
 
 		// admin
 		case atc.GetLogLevel,
+			atc.SetTeams,
 			atc.DestroyTeam,
 			atc.ListActiveUsersSince,
+			atc.ListAuditEvents,
 			atc.SetLogLevel,
 			atc.GetInfoCreds,
 			atc.SetWall,
@@ -120,6 +127,18 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		case atc.GetTeam,
 			atc.SetTeam,
 			atc.RenameTeam,
+			atc.TeamEvents,
+			atc.ListCustomRoles,
+			atc.SetCustomRole,
+			atc.DestroyCustomRole,
+			atc.ListAPITokens,
+			atc.CreateAPIToken,
+			atc.RevokeAPIToken,
+			atc.ListNotificationSubscriptions,
+			atc.SetNotificationSubscription,
+			atc.DestroyNotificationSubscription,
+			atc.ListNotificationDeliveries,
+			atc.RedeliverNotification,
 			atc.ListContainers,
 			atc.GetContainer,
 			atc.HijackContainer,
@@ -138,9 +157,13 @@ func (wrappa *APIAuthWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.UnpinResource,
 			atc.SetPinCommentOnResource,
 			atc.GetConfig,
+			atc.ListPipelineConfigHistory,
+			atc.GetPipelineConfigRevision,
+			atc.DiffPipelineConfigRevisions,
 			atc.GetCC,
 			atc.GetVersionsDB,
 			atc.ListJobInputs,
+			atc.GetJobSchedulingExplanation,
 			atc.OrderPipelines,
 			atc.OrderPipelinesWithinGroup,
 			atc.PauseJob,
@@ -23,7 +23,7 @@ func (wrappa CompressionWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 	for name, handler := range handlers {
 		switch name {
 		// always gzip for events
-		case atc.BuildEvents:
+		case atc.BuildEvents, atc.TeamEvents:
 			gzipEnforcedHandler, err := gziphandler.GzipHandlerWithOpts(gziphandler.MinSize(0))
 			if err != nil {
 				wrappa.Logger.Error("failed-to-create-gzip-handler", err)
@@ -33,6 +33,9 @@ func (wrappa CompressionWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 		// skip gzip as this endpoint does it already
 		case atc.DownloadCLI:
 			wrapped[name] = handler
+		// skip gzip as the connection is hijacked for a websocket
+		case atc.StreamBuildEvents:
+			wrapped[name] = handler
 		default:
 			wrapped[name] = gziphandler.GzipHandler(handler)
 		}
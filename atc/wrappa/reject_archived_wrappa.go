@@ -43,13 +43,20 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			// leave the handler as-is
 		case
 			atc.GetConfig,
+			atc.ListPipelineConfigHistory,
+			atc.GetPipelineConfigRevision,
+			atc.DiffPipelineConfigRevisions,
 			atc.GetBuild,
 			atc.BuildResources,
 			atc.BuildEvents,
+			atc.StreamBuildEvents,
 			atc.ListBuildArtifacts,
+			atc.GetBuildArtifact,
 			atc.GetBuildPreparation,
 			atc.GetBuildPlan,
+			atc.GetBuildResourceUsage,
 			atc.AbortBuild,
+			atc.SetBuildApproval,
 			atc.PruneWorker,
 			atc.LandWorker,
 			atc.ReportWorkerContainers,
@@ -58,6 +65,7 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListDestroyingContainers,
 			atc.ListDestroyingVolumes,
 			atc.GetPipeline,
+			atc.GetPipelineGraph,
 			atc.GetJobBuild,
 			atc.PipelineBadge,
 			atc.JobBadge,
@@ -79,12 +87,25 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.ListContainers,
 			atc.ListVolumes,
 			atc.ListTeamBuilds,
+			atc.TeamEvents,
+			atc.ListCustomRoles,
+			atc.SetCustomRole,
+			atc.DestroyCustomRole,
+			atc.ListAPITokens,
+			atc.CreateAPIToken,
+			atc.RevokeAPIToken,
+			atc.ListNotificationSubscriptions,
+			atc.SetNotificationSubscription,
+			atc.DestroyNotificationSubscription,
+			atc.ListNotificationDeliveries,
+			atc.RedeliverNotification,
 			atc.ListWorkers,
 			atc.RegisterWorker,
 			atc.HeartbeatWorker,
 			atc.DeleteWorker,
 			atc.GetTeam,
 			atc.SetTeam,
+			atc.SetTeams,
 			atc.RenameTeam,
 			atc.DestroyTeam,
 			atc.GetUser,
@@ -103,12 +124,14 @@ func (rw *RejectArchivedWrappa) Wrap(handlers rata.Handlers) rata.Handlers {
 			atc.SetLogLevel,
 			atc.GetInfoCreds,
 			atc.ListActiveUsersSince,
+			atc.ListAuditEvents,
 			atc.SetWall,
 			atc.ClearWall,
 			atc.DeletePipeline,
 			atc.GetCC,
 			atc.GetVersionsDB,
 			atc.ListJobInputs,
+			atc.GetJobSchedulingExplanation,
 			atc.OrderPipelines,
 			atc.OrderPipelinesWithinGroup,
 			atc.PauseJob,
@@ -20,7 +20,8 @@ type ConcourseCommand struct {
 	LandWorker   land.LandWorkerCommand     `command:"land-worker" description:"Safely drain a worker's assignments for temporary downtime."`
 	RetireWorker retire.RetireWorkerCommand `command:"retire-worker" description:"Safely remove a worker from the cluster permanently."`
 
-	GenerateKey GenerateKeyCommand `command:"generate-key" description:"Generate RSA key for use with Concourse components."`
+	GenerateKey  GenerateKeyCommand  `command:"generate-key" description:"Generate RSA key for use with Concourse components."`
+	EncryptCreds EncryptCredsCommand `command:"encrypt-creds" description:"Encrypt a YAML file of credentials for use with the file credential manager."`
 }
 
 func (cmd ConcourseCommand) LessenRequirements(parser *flags.Parser) {
@@ -0,0 +1,45 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+
+	"github.com/concourse/concourse/atc/creds/file"
+	"github.com/concourse/flag"
+	"sigs.k8s.io/yaml"
+)
+
+type EncryptCredsCommand struct {
+	Key flag.Cipher `short:"k"  long:"key"  required:"true"  description:"A 16 or 32 length key to encrypt the file with. Configure the same key with --file-creds-key."`
+
+	Input  string `short:"i"  long:"input"   required:"true"  description:"Path to the plain YAML file of credentials."`
+	Output string `short:"o"  long:"output"  required:"true"  description:"File path where the encrypted credentials shall be written."`
+}
+
+func (cmd *EncryptCredsCommand) Execute(args []string) error {
+	plaintext, err := ioutil.ReadFile(cmd.Input)
+	if err != nil {
+		return fmt.Errorf("failed to read credentials: %s", err)
+	}
+
+	// catch mistakes now rather than when the web node loads the file
+	var tree map[string]interface{}
+	err = yaml.Unmarshal(plaintext, &tree)
+	if err != nil {
+		return fmt.Errorf("failed to parse credentials: %s", err)
+	}
+
+	encrypted, err := file.Encrypt(cmd.Key.AEAD, plaintext)
+	if err != nil {
+		return fmt.Errorf("failed to encrypt credentials: %s", err)
+	}
+
+	err = ioutil.WriteFile(cmd.Output, encrypted, 0600)
+	if err != nil {
+		return fmt.Errorf("failed to write encrypted credentials: %s", err)
+	}
+
+	fmt.Println("wrote encrypted credentials to", cmd.Output)
+
+	return nil
+}
@@ -0,0 +1,156 @@
+package commands
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+)
+
+type ApproveCommand struct {
+	Job     flaghelpers.JobFlag `short:"j" long:"job"     value-name:"PIPELINE/JOB" description:"Name of the job whose build is waiting for approval"`
+	Build   string              `short:"b" long:"build"   required:"true"           description:"If job is specified: build number. If job not specified: build id"`
+	Step    string              `short:"s" long:"step"                              description:"Name of the wait_for_approval step, required if the build has more than one"`
+	Comment string              `short:"c" long:"comment"                           description:"Comment to record with the approval"`
+}
+
+func (command *ApproveCommand) Execute([]string) error {
+	return decideApproval(command.Job, command.Build, command.Step, atc.BuildApproval{
+		Approved: true,
+		Comment:  command.Comment,
+	})
+}
+
+type RejectCommand struct {
+	Job     flaghelpers.JobFlag `short:"j" long:"job"     value-name:"PIPELINE/JOB" description:"Name of the job whose build is waiting for approval"`
+	Build   string              `short:"b" long:"build"   required:"true"           description:"If job is specified: build number. If job not specified: build id"`
+	Step    string              `short:"s" long:"step"                              description:"Name of the wait_for_approval step, required if the build has more than one"`
+	Comment string              `short:"c" long:"comment"                           description:"Comment to record with the rejection"`
+}
+
+func (command *RejectCommand) Execute([]string) error {
+	return decideApproval(command.Job, command.Build, command.Step, atc.BuildApproval{
+		Approved: false,
+		Comment:  command.Comment,
+	})
+}
+
+func decideApproval(job flaghelpers.JobFlag, buildName string, stepName string, approval atc.BuildApproval) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var build atc.Build
+	var exists bool
+	if job.PipelineRef.Name == "" && job.JobName == "" {
+		build, exists, err = target.Client().Build(buildName)
+	} else {
+		build, exists, err = target.Team().JobBuild(job.PipelineRef, job.JobName, buildName)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return errors.New("build does not exist")
+	}
+
+	plan, found, err := target.Client().BuildPlan(build.ID)
+	if err != nil {
+		return err
+	}
+
+	if !found || plan.Plan == nil {
+		return errors.New("build has not been planned yet")
+	}
+
+	step, err := findApprovalStep(*plan.Plan, stepName)
+	if err != nil {
+		return err
+	}
+
+	found, err = target.Client().SetBuildApproval(strconv.Itoa(build.ID), step.id, approval)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("step '%s' not found", step.name)
+	}
+
+	if approval.Approved {
+		fmt.Printf("step '%s' approved\n", step.name)
+	} else {
+		fmt.Printf("step '%s' rejected\n", step.name)
+	}
+
+	return nil
+}
+
+type approvalStep struct {
+	id   atc.PlanID
+	name string
+}
+
+// findApprovalStep walks the public plan of a build looking for the
+// wait_for_approval step with the given name, or the only one if no name is
+// given.
+func findApprovalStep(rawPlan json.RawMessage, stepName string) (approvalStep, error) {
+	var plan interface{}
+	err := json.Unmarshal(rawPlan, &plan)
+	if err != nil {
+		return approvalStep{}, err
+	}
+
+	var steps []approvalStep
+	collectApprovalSteps(plan, &steps)
+
+	var matching []approvalStep
+	for _, step := range steps {
+		if stepName == "" || step.name == stepName {
+			matching = append(matching, step)
+		}
+	}
+
+	switch {
+	case len(matching) == 1:
+		return matching[0], nil
+	case len(steps) == 0:
+		return approvalStep{}, errors.New("build has no wait_for_approval steps")
+	case len(matching) == 0:
+		return approvalStep{}, fmt.Errorf("build has no wait_for_approval step named '%s'", stepName)
+	case stepName == "":
+		return approvalStep{}, errors.New("build has more than one wait_for_approval step, specify one with --step")
+	default:
+		return approvalStep{}, fmt.Errorf("build has more than one wait_for_approval step named '%s'", stepName)
+	}
+}
+
+func collectApprovalSteps(plan interface{}, steps *[]approvalStep) {
+	switch p := plan.(type) {
+	case map[string]interface{}:
+		if approval, ok := p["approval"].(map[string]interface{}); ok {
+			id, _ := p["id"].(string)
+			name, _ := approval["name"].(string)
+			*steps = append(*steps, approvalStep{id: atc.PlanID(id), name: name})
+		}
+
+		for _, v := range p {
+			collectApprovalSteps(v, steps)
+		}
+	case []interface{}:
+		for _, v := range p {
+			collectApprovalSteps(v, steps)
+		}
+	}
+}
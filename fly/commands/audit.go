@@ -0,0 +1,94 @@
+package commands
+
+import (
+	"errors"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type AuditCommand struct {
+	Team   string `long:"team"   description:"Only show events for the given team"`
+	User   string `long:"user"   description:"Only show events for requests made by the given user"`
+	Action string `long:"action" description:"Only show events for the given API action, e.g. SaveConfig"`
+	Since  string `long:"since"  description:"Only show events recorded since the given time, in the format yyyy-mm-dd hh:mm:ss"`
+	Count  int    `short:"c" long:"count" default:"50" description:"Number of events you want to limit the return to"`
+
+	ui.OutputFlags
+}
+
+func (command *AuditCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	filter := concourse.AuditEventFilter{
+		Team:   command.Team,
+		User:   command.User,
+		Action: command.Action,
+		Limit:  command.Count,
+	}
+
+	if command.Since != "" {
+		filter.Since, err = time.ParseInLocation(inputTimeLayout, command.Since, time.Now().Location())
+		if err != nil {
+			return errors.New("since time should be in the format: " + inputTimeLayout)
+		}
+	}
+
+	events, err := target.Client().ListAuditEvents(filter)
+	if err != nil {
+		return err
+	}
+
+	if format := command.Format(); !format.IsTable() {
+		return format.Print(os.Stdout, events)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "time", Color: color.New(color.Bold)},
+			{Contents: "user", Color: color.New(color.Bold)},
+			{Contents: "team", Color: color.New(color.Bold)},
+			{Contents: "action", Color: color.New(color.Bold)},
+			{Contents: "target", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, event := range events {
+		team := ui.TableCell{Contents: event.Team}
+		if event.Team == "" {
+			team = ui.TableCell{Contents: "n/a", Color: color.New(color.Faint)}
+		}
+
+		status := ui.TableCell{Contents: strconv.Itoa(event.Status)}
+		if event.Status == 0 {
+			status = ui.TableCell{Contents: "n/a", Color: color.New(color.Faint)}
+		} else if event.Status >= 400 {
+			status.Color = color.New(color.FgRed)
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: time.Unix(event.Time, 0).Format(timeDateLayout)},
+			{Contents: event.User},
+			team,
+			{Contents: event.Action},
+			{Contents: event.Target},
+			status,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
@@ -25,11 +25,12 @@ type BuildsCommand struct {
 	Count       int                       `short:"c" long:"count" default:"50" description:"Number of builds you want to limit the return to"`
 	CurrentTeam bool                      `long:"current-team" description:"Show builds for the currently targeted team"`
 	Job         flaghelpers.JobFlag       `short:"j" long:"job" value-name:"PIPELINE/JOB" description:"Name of a job to get builds for"`
-	Json        bool                      `long:"json" description:"Print command result as JSON"`
 	Pipeline    *flaghelpers.PipelineFlag `short:"p" long:"pipeline" description:"Name of a pipeline to get builds for"`
 	Teams       []string                  `short:"n"  long:"team" description:"Show builds for these teams"`
 	Since       string                    `long:"since" description:"Start of the range to filter builds"`
 	Until       string                    `long:"until" description:"End of the range to filter builds"`
+
+	ui.OutputFlags
 }
 
 func (command *BuildsCommand) Execute([]string) error {
@@ -174,13 +175,8 @@ func (command *BuildsCommand) validatePipelineBuilds(builds []atc.Build, current
 }
 
 func (command *BuildsCommand) displayBuilds(builds []atc.Build) error {
-	var err error
-	if command.Json {
-		err = displayhelpers.JsonPrint(builds)
-		if err != nil {
-			return err
-		}
-		return nil
+	if format := command.Format(); !format.IsTable() {
+		return format.Print(os.Stdout, builds)
 	}
 
 	table := ui.Table{
@@ -6,14 +6,13 @@ import (
 	"strconv"
 
 	"github.com/concourse/concourse/atc"
-	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/fatih/color"
 )
 
 type ContainersCommand struct {
-	Json bool `long:"json" description:"Print command result as JSON"`
+	ui.OutputFlags
 }
 
 func (command *ContainersCommand) Execute([]string) error {
@@ -32,12 +31,8 @@ func (command *ContainersCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(containers)
-		if err != nil {
-			return err
-		}
-		return nil
+	if format := command.Format(); !format.IsTable() {
+		return format.Print(os.Stdout, containers)
 	}
 
 	table := ui.Table{
@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type CreateTokenCommand struct {
+	Name      string        `short:"n" long:"name"       required:"true" description:"Name of the token, unique within the team"`
+	Role      string        `short:"r" long:"role"       required:"true" description:"Role the token is granted on the team, either built-in or custom"`
+	ExpiresIn time.Duration `long:"expires-in" description:"How long until the token expires, e.g. 720h; tokens without one never expire"`
+
+	Team string `long:"team" description:"Name of the team to create the token for, if different from the target default"`
+}
+
+func (command *CreateTokenCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	token := atc.APIToken{
+		Name: command.Name,
+		Role: command.Role,
+	}
+
+	if command.ExpiresIn != 0 {
+		token.ExpiresAt = time.Now().Add(command.ExpiresIn).Unix()
+	}
+
+	created, err := team.CreateAPIToken(token)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("token '%s' created with role '%s' on team '%s'\n", created.Name, created.Role, created.TeamName)
+	fmt.Println("make sure to copy it now, as it will not be shown again:")
+	fmt.Println()
+	fmt.Println(created.Token)
+
+	return nil
+}
@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"os"
+	"strings"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type CustomRolesCommand struct {
+	Team string `long:"team" description:"Name of the team whose custom roles to list, if different from the target default"`
+
+	ui.OutputFlags
+}
+
+func (command *CustomRolesCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	roles, err := team.CustomRoles()
+	if err != nil {
+		return err
+	}
+
+	if format := command.Format(); !format.IsTable() {
+		return format.Print(os.Stdout, roles)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "permissions", Color: color.New(color.Bold)},
+			{Contents: "pipelines", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, role := range roles {
+		pipelines := ui.TableCell{Contents: "all", Color: color.New(color.Faint)}
+		if len(role.Pipelines) > 0 {
+			pipelines = ui.TableCell{Contents: strings.Join(role.Pipelines, ",")}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: role.Name},
+			{Contents: strings.Join(role.Permissions, ",")},
+			pipelines,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type DestroyCustomRoleCommand struct {
+	Name string `short:"n" long:"name" required:"true" description:"Name of the custom role to destroy"`
+
+	Team string `long:"team" description:"Name of the team to which the custom role belongs, if different from the target default"`
+}
+
+func (command *DestroyCustomRoleCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	found, err := team.DestroyCustomRole(command.Name)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("custom role '%s' not found", command.Name)
+	}
+
+	fmt.Printf("custom role '%s' destroyed\n", command.Name)
+
+	return nil
+}
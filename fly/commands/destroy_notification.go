@@ -0,0 +1,49 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type DestroyNotificationCommand struct {
+	Name string `short:"n" long:"name" required:"true" description:"Name of the notification subscription to destroy"`
+
+	Team string `long:"team" description:"Name of the team to which the notification subscription belongs, if different from the target default"`
+}
+
+func (command *DestroyNotificationCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	found, err := team.DestroyNotificationSubscription(command.Name)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("notification subscription '%s' not found", command.Name)
+	}
+
+	fmt.Printf("notification subscription '%s' destroyed\n", command.Name)
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package commands
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/go-archive/tgzfs"
+)
+
+type DownloadArtifactCommand struct {
+	Job      flaghelpers.JobFlag `short:"j" long:"job"      value-name:"PIPELINE/JOB" description:"Name of the job the build belongs to"`
+	Build    string              `short:"b" long:"build"    required:"true"          description:"If job is specified: build number. If job not specified: build id"`
+	Artifact string              `short:"a" long:"artifact" required:"true"          description:"Name of the archived task output to download"`
+	Output   string              `short:"o" long:"output"   default:"."              description:"Directory to extract the artifact into"`
+}
+
+func (command *DownloadArtifactCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var build atc.Build
+	var exists bool
+	if command.Job.PipelineRef.Name == "" && command.Job.JobName == "" {
+		build, exists, err = target.Client().Build(command.Build)
+	} else {
+		build, exists, err = target.Team().JobBuild(command.Job.PipelineRef, command.Job.JobName, command.Build)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		return fmt.Errorf("build does not exist")
+	}
+
+	contents, found, err := target.Client().GetBuildArtifact(strconv.Itoa(build.ID), command.Artifact)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("artifact '%s' was not archived by build %d", command.Artifact, build.ID)
+	}
+
+	defer contents.Close()
+
+	err = tgzfs.Extract(contents, command.Output)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("downloaded artifact '%s' to %s\n", command.Artifact, command.Output)
+	return nil
+}
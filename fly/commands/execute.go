@@ -1,6 +1,8 @@
 package commands
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
@@ -13,6 +15,7 @@ import (
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/executehelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/localexec"
 	"github.com/concourse/concourse/fly/commands/internal/templatehelpers"
 	"github.com/concourse/concourse/fly/config"
 	"github.com/concourse/concourse/fly/eventstream"
@@ -36,9 +39,20 @@ type ExecuteCommand struct {
 	Var            []flaghelpers.VariablePairFlag     `short:"v"  long:"var"       value-name:"[NAME=STRING]"  unquote:"false"  description:"Specify a string value to set for a variable in the pipeline"`
 	YAMLVar        []flaghelpers.YAMLVariablePairFlag `short:"y"  long:"yaml-var"  value-name:"[NAME=YAML]"    unquote:"false"  description:"Specify a YAML value to set for a variable in the pipeline"`
 	VarsFrom       []atc.PathFlag                     `short:"l"  long:"load-vars-from"  description:"Variable flag that can be used for filling in template values in configuration from a YAML file"`
+
+	Local                  bool          `long:"local"                    description:"Run the task in a container on this machine through containerd instead of on a Concourse cluster (linux only, requires root)"`
+	LocalContainerdAddress string        `long:"local-containerd-address" default:"/run/containerd/containerd.sock" description:"Address of the containerd socket to run the task through"`
+	LocalNamespace         string        `long:"local-namespace"          default:"fly"                             description:"containerd namespace to create the task's container and images in"`
+	LocalCNIPluginsDir     string        `long:"local-cni-plugins-dir"    default:"/usr/local/concourse/bin"        description:"Path to CNI network plugins"`
+	LocalInitBin           string        `long:"local-init-bin"           default:"/usr/local/concourse/bin/init"   description:"Path to the init executable to run as the container's entrypoint"`
+	LocalRequestTimeout    time.Duration `long:"local-request-timeout"    default:"5m"                              description:"How long to wait for requests to containerd to complete"`
 }
 
 func (command *ExecuteCommand) Execute(args []string) error {
+	if command.Local {
+		return command.executeLocally(args)
+	}
+
 	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
 	if err != nil {
 		return err
@@ -183,6 +197,86 @@ func (command *ExecuteCommand) Execute(args []string) error {
 	return nil
 }
 
+func (command *ExecuteCommand) executeLocally(args []string) error {
+	if command.InputsFrom.PipelineRef.Name != "" || command.InputsFrom.JobName != "" {
+		return errors.New("--inputs-from can not be used with --local")
+	}
+
+	if command.Image != "" {
+		return errors.New("--image can not be used with --local")
+	}
+
+	taskConfig, err := command.CreateTaskConfig(args)
+	if err != nil {
+		return err
+	}
+
+	inputs, err := executehelpers.DetermineLocalInputs(taskConfig.Inputs, command.Inputs)
+	if err != nil {
+		return err
+	}
+
+	outputs, err := executehelpers.DetermineOutputs(
+		atc.NewPlanFactory(time.Now().Unix()),
+		taskConfig.Outputs,
+		command.Outputs,
+	)
+	if err != nil {
+		return err
+	}
+
+	task := localexec.Task{
+		Config:     taskConfig,
+		Privileged: command.Privileged,
+	}
+
+	for _, input := range inputs {
+		task.Inputs = append(task.Inputs, localexec.Artifact{Name: input.Name, Path: input.Path})
+	}
+
+	for _, output := range outputs {
+		task.Outputs = append(task.Outputs, localexec.Artifact{Name: output.Name, Path: output.Path})
+	}
+
+	runtime := localexec.Runtime{
+		ContainerdAddress: command.LocalContainerdAddress,
+		Namespace:         command.LocalNamespace,
+		CNIPluginsDir:     command.LocalCNIPluginsDir,
+		InitBinPath:       command.LocalInitBin,
+		RequestTimeout:    command.LocalRequestTimeout,
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	terminate := make(chan os.Signal, 1)
+	signal.Notify(terminate, syscall.SIGINT, syscall.SIGTERM)
+
+	go func() {
+		<-terminate
+		fmt.Fprintf(ui.Stderr, "\naborting...\n")
+		cancel()
+
+		// if told to terminate again, exit immediately
+		<-terminate
+		fmt.Fprintln(ui.Stderr, "exiting immediately")
+		os.Exit(2)
+	}()
+
+	exitCode, err := runtime.Run(ctx, task, os.Stdout, os.Stderr)
+	if err != nil {
+		if errors.Is(err, context.Canceled) {
+			os.Exit(2)
+		}
+
+		return err
+	}
+
+	os.Exit(exitCode)
+
+	return nil
+}
+
 func (command *ExecuteCommand) CreateTaskConfig(args []string) (atc.TaskConfig, error) {
 
 	taskTemplate := templatehelpers.NewYamlTemplateWithParams(
@@ -0,0 +1,138 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+)
+
+type ExplainJobCommand struct {
+	Job flaghelpers.JobFlag `short:"j" long:"job" required:"true" value-name:"PIPELINE/JOB" description:"Name of a job to explain"`
+
+	ui.OutputFlags
+}
+
+func (command *ExplainJobCommand) Execute(args []string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	explanation, found, err := target.Team().JobSchedulingExplanation(command.Job.PipelineRef, command.Job.JobName)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("%s/%s not found\n", command.Job.PipelineRef.String(), command.Job.JobName)
+	}
+
+	if format := command.Format(); !format.IsTable() {
+		return format.Print(os.Stdout, explanation)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "resource", Color: color.New(color.Bold)},
+			{Contents: "version", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, input := range explanation.Inputs {
+		versionCell := ui.TableCell{Contents: presentExplainedVersion(input.Version)}
+		if input.Version == nil {
+			versionCell.Contents = "n/a"
+			versionCell.Color = color.New(color.Faint)
+		}
+
+		var statusCell ui.TableCell
+		switch {
+		case input.ResolveError != "":
+			statusCell.Contents = input.ResolveError
+			if len(input.FailedPassed) > 0 {
+				statusCell.Contents += fmt.Sprintf(" (passed: %s)", strings.Join(input.FailedPassed, ","))
+			}
+			if len(input.DisabledVersions) > 0 {
+				statusCell.Contents += fmt.Sprintf(" (%d disabled versions)", len(input.DisabledVersions))
+			}
+			statusCell.Color = ui.FailedColor
+		case input.FirstOccurrence:
+			statusCell.Contents = "new"
+			statusCell.Color = ui.SucceededColor
+		case input.Version != nil:
+			statusCell.Contents = "resolved"
+			statusCell.Color = ui.SucceededColor
+		default:
+			statusCell.Contents = "n/a"
+			statusCell.Color = color.New(color.Faint)
+		}
+
+		if input.PinnedVersion != nil {
+			statusCell.Contents += fmt.Sprintf(" (pinned to %s)", presentExplainedVersion(input.PinnedVersion))
+		}
+
+		table.Data = append(table.Data, []ui.TableCell{
+			{Contents: input.Name},
+			{Contents: input.Resource},
+			versionCell,
+			statusCell,
+		})
+	}
+
+	err = table.Render(os.Stdout, Fly.PrintTableHeaders)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println()
+
+	if explanation.Resolved {
+		fmt.Printf("inputs: %s\n", ui.SucceededColor.Sprint("resolved"))
+	} else {
+		fmt.Printf("inputs: %s\n", ui.FailedColor.Sprint("not resolved"))
+	}
+
+	fmt.Printf("pending builds: %d\n", explanation.PendingBuilds)
+
+	if explanation.MaxInFlight > 0 {
+		fmt.Printf("max in flight: %d\n", explanation.MaxInFlight)
+		fmt.Printf("serial groups: %s\n", strings.Join(explanation.SerialGroups, ","))
+		fmt.Printf("running builds: %d\n", len(explanation.RunningBuilds))
+	}
+
+	if len(explanation.BlockedBy) == 0 {
+		fmt.Printf("blocked by: %s\n", ui.SucceededColor.Sprint("nothing"))
+	} else {
+		fmt.Println("blocked by:")
+		for _, reason := range explanation.BlockedBy {
+			fmt.Printf("  %s\n", ui.PausedColor.Sprint(reason))
+		}
+	}
+
+	return nil
+}
+
+func presentExplainedVersion(version atc.Version) string {
+	fields := []string{}
+	for k, v := range version {
+		fields = append(fields, k+":"+v)
+	}
+
+	sort.Strings(fields)
+
+	return strings.Join(fields, ",")
+}
@@ -25,13 +25,29 @@ type FlyCommand struct {
 
 	ActiveUsers ActiveUsersCommand `command:"active-users" alias:"au" description:"List the active users since a date or for the past 2 months"`
 	Userinfo    UserinfoCommand    `command:"userinfo" description:"User information"`
+	Audit       AuditCommand       `command:"audit" alias:"ad" description:"List the recorded audit events of API requests"`
 
 	Teams       TeamsCommand       `command:"teams" alias:"t" description:"List the configured teams"`
 	GetTeam     GetTeamCommand     `command:"get-team"  alias:"gt" description:"Show team configuration"`
 	SetTeam     SetTeamCommand     `command:"set-team"  alias:"st" description:"Create or modify a team to have the given credentials"`
+	SetTeams    SetTeamsCommand    `command:"set-teams" alias:"sts" description:"Create or modify every team at once from a configuration file"`
 	RenameTeam  RenameTeamCommand  `command:"rename-team"   alias:"rt" description:"Rename a team"`
 	DestroyTeam DestroyTeamCommand `command:"destroy-team"  alias:"dt" description:"Destroy a team and delete all of its data"`
 
+	Notifications          NotificationsCommand          `command:"notifications"           alias:"ns"  description:"List the team's webhook notification subscriptions"`
+	SetNotification        SetNotificationCommand        `command:"set-notification"        alias:"sn"  description:"Create or update a webhook notification subscription"`
+	DestroyNotification    DestroyNotificationCommand    `command:"destroy-notification"    alias:"dn"  description:"Destroy a webhook notification subscription"`
+	NotificationDeliveries NotificationDeliveriesCommand `command:"notification-deliveries" alias:"nd"  description:"List the recent deliveries of a webhook notification subscription"`
+	RedeliverNotification  RedeliverNotificationCommand  `command:"redeliver-notification"  alias:"rdn" description:"Send a webhook notification delivery again"`
+
+	CustomRoles       CustomRolesCommand       `command:"custom-roles"        alias:"crs" description:"List the team's custom roles"`
+	SetCustomRole     SetCustomRoleCommand     `command:"set-custom-role"     alias:"scr" description:"Create or update a custom role which permits a set of actions"`
+	DestroyCustomRole DestroyCustomRoleCommand `command:"destroy-custom-role" alias:"dcr" description:"Destroy a custom role"`
+
+	Tokens      TokensCommand      `command:"tokens"       alias:"tks" description:"List the team's API tokens"`
+	CreateToken CreateTokenCommand `command:"create-token" alias:"ctk" description:"Create an API token for automation to authenticate as"`
+	RevokeToken RevokeTokenCommand `command:"revoke-token" alias:"rtk" description:"Revoke an API token"`
+
 	Checklist ChecklistCommand `command:"checklist" alias:"cl" description:"Print a Checkfile of the given pipeline"`
 
 	Execute ExecuteCommand `command:"execute" alias:"e" description:"Execute a one-off build using local bits"`
@@ -44,6 +60,7 @@ type FlyCommand struct {
 	PauseJob    PauseJobCommand    `command:"pause-job" alias:"pj" description:"Pause a job"`
 	UnpauseJob  UnpauseJobCommand  `command:"unpause-job" alias:"uj" description:"Unpause a job"`
 	ScheduleJob ScheduleJobCommand `command:"schedule-job" alias:"sj" description:"Request the scheduler to run for a job. Introduced as a recovery command for the v6.0 scheduler."`
+	ExplainJob  ExplainJobCommand  `command:"explain-job" alias:"ej" description:"Explain why a job's next build is or is not being started"`
 
 	Pipelines                 PipelinesCommand               `command:"pipelines"                 alias:"ps"   description:"List the configured pipelines"`
 	DestroyPipeline           DestroyPipelineCommand         `command:"destroy-pipeline"          alias:"dp"   description:"Destroy a pipeline"`
@@ -55,6 +72,9 @@ type FlyCommand struct {
 	ExposePipeline            ExposePipelineCommand          `command:"expose-pipeline"           alias:"ep"   description:"Make a pipeline publicly viewable"`
 	HidePipeline              HidePipelineCommand            `command:"hide-pipeline"             alias:"hp"   description:"Hide a pipeline from the public"`
 	RenamePipeline            RenamePipelineCommand          `command:"rename-pipeline"           alias:"rp"   description:"Rename a pipeline"`
+	PipelineHistory           PipelineHistoryCommand         `command:"pipeline-history"          alias:"ph"   description:"List the revisions of a pipeline's configuration"`
+	RollbackPipeline          RollbackPipelineCommand        `command:"rollback-pipeline"         alias:"rbp"  description:"Apply a previous revision of a pipeline's configuration"`
+	Graph                     GraphCommand                   `command:"graph"                     alias:"gr"   description:"Print the dependency graph of a pipeline's jobs and resources"`
 	ValidatePipeline          ValidatePipelineCommand        `command:"validate-pipeline"         alias:"vp"   description:"Validate a pipeline config"`
 	FormatPipeline            FormatPipelineCommand          `command:"format-pipeline"           alias:"fp"   description:"Format a pipeline config"`
 	OrderPipelines            OrderPipelinesCommand          `command:"order-pipelines"           alias:"op"   description:"Orders pipelines"`
@@ -73,9 +93,12 @@ type FlyCommand struct {
 
 	ClearTaskCache ClearTaskCacheCommand `command:"clear-task-cache" alias:"ctc" description:"Clears cache from a task container"`
 
-	Builds     BuildsCommand     `command:"builds"      alias:"bs" description:"List builds data"`
-	AbortBuild AbortBuildCommand `command:"abort-build" alias:"ab" description:"Abort a build"`
-	RerunBuild RerunBuildCommand `command:"rerun-build" alias:"rb" description:"Rerun a build"`
+	Builds           BuildsCommand           `command:"builds"            alias:"bs" description:"List builds data"`
+	AbortBuild       AbortBuildCommand       `command:"abort-build"       alias:"ab" description:"Abort a build"`
+	RerunBuild       RerunBuildCommand       `command:"rerun-build"       alias:"rb" description:"Rerun a build"`
+	Approve          ApproveCommand          `command:"approve"                      description:"Approve a build step waiting for approval"`
+	Reject           RejectCommand           `command:"reject"                       description:"Reject a build step waiting for approval"`
+	DownloadArtifact DownloadArtifactCommand `command:"download-artifact" alias:"da" description:"Download a task output archived by a build"`
 
 	TriggerJob TriggerJobCommand `command:"trigger-job" alias:"tj" description:"Start a job in a pipeline"`
 
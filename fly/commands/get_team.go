@@ -6,7 +6,6 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
@@ -14,8 +13,9 @@ import (
 )
 
 type GetTeamCommand struct {
-	Team flaghelpers.TeamFlag `short:"n" long:"team-name" required:"true" description:"Get configuration of this team"`
-	JSON bool                 `short:"j" long:"json" description:"Print command result as JSON"`
+	Team   flaghelpers.TeamFlag `short:"n" long:"team-name" required:"true" description:"Get configuration of this team"`
+	JSON   bool                 `short:"j" long:"json" description:"Print command result as JSON (same as --output=json)"`
+	Output ui.OutputFormat      `long:"output" value-name:"FORMAT" default:"table" description:"Output format: table, json, yaml, jsonpath=<expr> or go-template=<template>"`
 }
 
 func (command *GetTeamCommand) Execute(args []string) error {
@@ -34,12 +34,13 @@ func (command *GetTeamCommand) Execute(args []string) error {
 		return err
 	}
 
+	format := command.Output
 	if command.JSON {
-		err := displayhelpers.JsonPrint(team.ATCTeam())
-		if err != nil {
-			return err
-		}
-		return nil
+		format = ui.OutputFormat{Kind: ui.JSONOutput}
+	}
+
+	if !format.IsTable() {
+		return format.Print(os.Stdout, team.ATCTeam())
 	}
 
 	headers := ui.TableRow{
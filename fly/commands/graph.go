@@ -0,0 +1,183 @@
+package commands
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type GraphCommand struct {
+	Pipeline  flaghelpers.PipelineFlag `short:"p" long:"pipeline"  required:"true" description:"Pipeline to graph"`
+	Job       string                   `short:"j" long:"job"       description:"Only graph the jobs and resources upstream or downstream of this job"`
+	Direction string                   `long:"direction" default:"downstream" choice:"upstream" choice:"downstream" description:"Direction to follow the graph in from --job"`
+	Format    string                   `long:"format"    default:"dot"        choice:"dot" choice:"mermaid"         description:"Format to print the graph in"`
+	Team      string                   `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+}
+
+func (command *GraphCommand) Validate() error {
+	_, err := command.Pipeline.Validate()
+	return err
+}
+
+func (command *GraphCommand) Execute([]string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	direction := ""
+	if command.Job != "" {
+		direction = command.Direction
+	}
+
+	graph, found, err := team.PipelineGraph(command.Pipeline.Ref(), command.Job, direction)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		if command.Job != "" {
+			return fmt.Errorf("pipeline '%s' or job '%s' not found", command.Pipeline.Ref().String(), command.Job)
+		}
+
+		return fmt.Errorf("pipeline '%s' not found", command.Pipeline.Ref().String())
+	}
+
+	if command.Format == "mermaid" {
+		return renderMermaidGraph(os.Stdout, graph)
+	}
+
+	return renderDotGraph(os.Stdout, command.Pipeline.Ref().String(), graph)
+}
+
+// renderDotGraph prints the graph in Graphviz's DOT language. Edges which do
+// not trigger the job they lead to are dashed, as they are in the web UI.
+func renderDotGraph(dst io.Writer, name string, graph atc.PipelineGraph) error {
+	quote := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "digraph \"%s\" {\n", quote.Replace(name))
+	fmt.Fprintf(&b, "  rankdir=LR;\n")
+
+	for _, node := range graph.Nodes {
+		shape := "box"
+		if node.Kind == atc.PipelineGraphResource {
+			shape = "ellipse"
+		}
+
+		fmt.Fprintf(&b, "  \"%s\" [label=\"%s\", shape=%s", quote.Replace(node.ID), quote.Replace(graphNodeLabel(node)), shape)
+		if node.Pipeline != "" {
+			fmt.Fprintf(&b, ", style=dashed")
+		}
+		fmt.Fprintf(&b, "];\n")
+	}
+
+	for _, edge := range graph.Edges {
+		var attrs []string
+		if !isResourceEdge(edge) {
+			attrs = append(attrs, fmt.Sprintf("label=\"%s\"", quote.Replace(edge.Resource)))
+		}
+
+		if !edge.Trigger {
+			attrs = append(attrs, "style=dashed")
+		}
+
+		fmt.Fprintf(&b, "  \"%s\" -> \"%s\"", quote.Replace(edge.Source), quote.Replace(edge.Target))
+		if len(attrs) > 0 {
+			fmt.Fprintf(&b, " [%s]", strings.Join(attrs, ", "))
+		}
+		fmt.Fprintf(&b, ";\n")
+	}
+
+	fmt.Fprintf(&b, "}\n")
+
+	_, err := io.WriteString(dst, b.String())
+	return err
+}
+
+// renderMermaidGraph prints the graph as a Mermaid flowchart. Mermaid is
+// picky about the characters in node ids, so nodes are numbered instead.
+func renderMermaidGraph(dst io.Writer, graph atc.PipelineGraph) error {
+	quote := strings.NewReplacer(`"`, "#quot;", "|", "#124;")
+
+	ids := map[string]string{}
+	id := func(nodeID string) string {
+		if _, found := ids[nodeID]; !found {
+			ids[nodeID] = fmt.Sprintf("n%d", len(ids))
+		}
+
+		return ids[nodeID]
+	}
+
+	var b strings.Builder
+
+	fmt.Fprintf(&b, "flowchart LR\n")
+
+	for _, node := range graph.Nodes {
+		if node.Kind == atc.PipelineGraphResource {
+			fmt.Fprintf(&b, "  %s([\"%s\"])\n", id(node.ID), quote.Replace(graphNodeLabel(node)))
+		} else {
+			fmt.Fprintf(&b, "  %s[\"%s\"]\n", id(node.ID), quote.Replace(graphNodeLabel(node)))
+		}
+	}
+
+	for _, edge := range graph.Edges {
+		arrow := "-.->"
+		if edge.Trigger {
+			arrow = "-->"
+		}
+
+		label := ""
+		if !isResourceEdge(edge) {
+			label = "|" + quote.Replace(edge.Resource) + "|"
+		}
+
+		fmt.Fprintf(&b, "  %s %s%s %s\n", id(edge.Source), arrow, label, id(edge.Target))
+	}
+
+	_, err := io.WriteString(dst, b.String())
+	return err
+}
+
+// graphNodeLabel prefixes jobs in other pipelines with their pipeline.
+func graphNodeLabel(node atc.PipelineGraphNode) string {
+	if node.Pipeline != "" {
+		return node.Pipeline + "/" + node.Name
+	}
+
+	return node.Name
+}
+
+// isResourceEdge returns whether the edge leads to or from a resource, in
+// which case the resource it is labelled with is already apparent.
+func isResourceEdge(edge atc.PipelineGraphEdge) bool {
+	return edge.Source == atc.PipelineGraphResourceID(edge.Resource) ||
+		edge.Target == atc.PipelineGraphResourceID(edge.Resource)
+}
@@ -35,20 +35,73 @@ func DetermineInputs(
 ) ([]Input, map[string]string, *atc.ImageResource, atc.VersionedResourceTypes, error) {
 	inputMappings := ConvertInputMappings(userInputMappings)
 
-	err := CheckForUnknownInputMappings(localInputMappings, taskInputs)
+	localInputMappings, err := DetermineLocalInputMappings(
+		taskInputs,
+		localInputMappings,
+		inputsFrom.PipelineRef.Name == "" && inputsFrom.JobName == "",
+	)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
 
-	err = CheckForInputType(localInputMappings)
+	inputsFromLocal, err := GenerateLocalInputs(fact, team, localInputMappings, includeIgnored, platform, tags)
 	if err != nil {
 		return nil, nil, nil, nil, err
 	}
 
-	if inputsFrom.PipelineRef.Name == "" && inputsFrom.JobName == "" {
+	inputsFromJob, imageResourceFromJob, resourceTypes, err := FetchInputsFromJob(fact, team, inputsFrom, jobInputImage)
+	if err != nil {
+		return nil, nil, nil, nil, err
+	}
+
+	inputs := []Input{}
+	for _, taskInput := range taskInputs {
+		input, found := inputsFromLocal[taskInput.Name]
+		if !found {
+
+			jobInputName := taskInput.Name
+			if name, ok := inputMappings[taskInput.Name]; ok {
+				jobInputName = name
+			}
+
+			input, found = inputsFromJob[jobInputName]
+			if !found {
+				if taskInput.Optional {
+					continue
+				} else {
+					return nil, nil, nil, nil, fmt.Errorf("missing required input `%s`", taskInput.Name)
+				}
+			}
+		}
+
+		inputs = append(inputs, input)
+	}
+
+	return inputs, inputMappings, imageResourceFromJob, resourceTypes, nil
+}
+
+// DetermineLocalInputMappings validates the inputs provided from the local
+// machine, defaulting the input named after the working directory to it when
+// the inputs are not being taken from a job.
+func DetermineLocalInputMappings(
+	taskInputs []atc.TaskInputConfig,
+	localInputMappings []flaghelpers.InputPairFlag,
+	defaultToWorkingDirectory bool,
+) ([]flaghelpers.InputPairFlag, error) {
+	err := CheckForUnknownInputMappings(localInputMappings, taskInputs)
+	if err != nil {
+		return nil, err
+	}
+
+	err = CheckForInputType(localInputMappings)
+	if err != nil {
+		return nil, err
+	}
+
+	if defaultToWorkingDirectory {
 		wd, err := os.Getwd()
 		if err != nil {
-			return nil, nil, nil, nil, err
+			return nil, err
 		}
 
 		required := false
@@ -75,40 +128,43 @@ func DetermineInputs(
 		}
 	}
 
-	inputsFromLocal, err := GenerateLocalInputs(fact, team, localInputMappings, includeIgnored, platform, tags)
+	return localInputMappings, nil
+}
+
+// DetermineLocalInputs resolves every input of the task to a directory on
+// the local machine, for running the task without uploading its inputs.
+func DetermineLocalInputs(
+	taskInputs []atc.TaskInputConfig,
+	localInputMappings []flaghelpers.InputPairFlag,
+) ([]Input, error) {
+	localInputMappings, err := DetermineLocalInputMappings(taskInputs, localInputMappings, true)
 	if err != nil {
-		return nil, nil, nil, nil, err
+		return nil, err
 	}
 
-	inputsFromJob, imageResourceFromJob, resourceTypes, err := FetchInputsFromJob(fact, team, inputsFrom, jobInputImage)
-	if err != nil {
-		return nil, nil, nil, nil, err
+	paths := map[string]string{}
+	for _, mapping := range localInputMappings {
+		paths[mapping.Name] = mapping.Path
 	}
 
 	inputs := []Input{}
 	for _, taskInput := range taskInputs {
-		input, found := inputsFromLocal[taskInput.Name]
+		path, found := paths[taskInput.Name]
 		if !found {
-
-			jobInputName := taskInput.Name
-			if name, ok := inputMappings[taskInput.Name]; ok {
-				jobInputName = name
+			if taskInput.Optional {
+				continue
 			}
 
-			input, found = inputsFromJob[jobInputName]
-			if !found {
-				if taskInput.Optional {
-					continue
-				} else {
-					return nil, nil, nil, nil, fmt.Errorf("missing required input `%s`", taskInput.Name)
-				}
-			}
+			return nil, fmt.Errorf("missing required input `%s`", taskInput.Name)
 		}
 
-		inputs = append(inputs, input)
+		inputs = append(inputs, Input{
+			Name: taskInput.Name,
+			Path: path,
+		})
 	}
 
-	return inputs, inputMappings, imageResourceFromJob, resourceTypes, nil
+	return inputs, nil
 }
 
 func ConvertInputMappings(variables []flaghelpers.InputMappingPairFlag) map[string]string {
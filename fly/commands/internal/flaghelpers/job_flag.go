@@ -37,7 +37,7 @@ func (flag *JobFlag) UnmarshalFlag(value string) error {
 	flag.PipelineRef.Name = vs[0]
 	if len(vs) == 2 {
 		var err error
-		flag.PipelineRef.InstanceVars, err = unmarshalInstanceVars(vs[1])
+		flag.PipelineRef.InstanceVars, err = atc.ParseInstanceVars(vs[1])
 		if err != nil {
 			return err
 		}
@@ -119,7 +119,7 @@ func (flag *JobFlag) Complete(match string) []flags.Completion {
 }
 
 func parsePipelineRef(pipelineName, rawInstanceVars string) (atc.PipelineRef, error) {
-	instanceVars, err := unmarshalInstanceVars(rawInstanceVars)
+	instanceVars, err := atc.ParseInstanceVars(rawInstanceVars)
 	if err != nil {
 		return atc.PipelineRef{}, err
 	}
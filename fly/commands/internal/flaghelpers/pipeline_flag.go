@@ -51,7 +51,7 @@ func (flag *PipelineFlag) UnmarshalFlag(value string) error {
 	if len(vs) == 2 {
 		flag.Name = vs[0]
 		var err error
-		flag.InstanceVars, err = unmarshalInstanceVars(vs[1])
+		flag.InstanceVars, err = atc.ParseInstanceVars(vs[1])
 		if err != nil {
 			return err
 		}
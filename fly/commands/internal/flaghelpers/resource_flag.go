@@ -34,7 +34,7 @@ func (flag *ResourceFlag) UnmarshalFlag(value string) error {
 	flag.PipelineRef.Name = vs[0]
 	if len(vs) == 2 {
 		var err error
-		flag.PipelineRef.InstanceVars, err = unmarshalInstanceVars(vs[1])
+		flag.PipelineRef.InstanceVars, err = atc.ParseInstanceVars(vs[1])
 		if err != nil {
 			return err
 		}
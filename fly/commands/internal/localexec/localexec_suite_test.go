@@ -0,0 +1,13 @@
+package localexec_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"testing"
+)
+
+func TestLocalExec(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "Local Exec Suite")
+}
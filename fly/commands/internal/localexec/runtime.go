@@ -0,0 +1,29 @@
+package localexec
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"time"
+)
+
+// Runtime runs tasks through containerd, the same way workers using the
+// containerd runtime do. It requires root privileges and a running
+// containerd.
+type Runtime struct {
+	ContainerdAddress string
+	Namespace         string
+	CNIPluginsDir     string
+	InitBinPath       string
+	RequestTimeout    time.Duration
+}
+
+func newHandle() (string, error) {
+	b := make([]byte, 8)
+
+	_, err := rand.Read(b)
+	if err != nil {
+		return "", err
+	}
+
+	return "fly-local-" + hex.EncodeToString(b), nil
+}
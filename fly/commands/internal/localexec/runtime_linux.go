@@ -0,0 +1,198 @@
+// +build linux
+
+package localexec
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/worker/runtime"
+	"github.com/concourse/concourse/worker/runtime/libcontainerd"
+	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/mount"
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/opencontainers/image-spec/identity"
+)
+
+// Run runs the task to completion, returning its exit status. The outputs of
+// the task are collected once it has exited.
+//
+// If ctx is canceled while the task is running, the task is terminated.
+func (r Runtime) Run(ctx context.Context, task Task, stdout io.Writer, stderr io.Writer) (int, error) {
+	handle, err := newHandle()
+	if err != nil {
+		return 0, err
+	}
+
+	scratchDir, err := ioutil.TempDir("", handle)
+	if err != nil {
+		return 0, err
+	}
+
+	defer os.RemoveAll(scratchDir)
+
+	workspace, err := NewWorkspace(task, scratchDir)
+	if err != nil {
+		return 0, err
+	}
+
+	rootfsPath, imageRef, err := Image(task.Config)
+	if err != nil {
+		return 0, err
+	}
+
+	if imageRef != "" {
+		fmt.Fprintf(stderr, "pulling %s...\n", imageRef)
+
+		var cleanup func()
+		rootfsPath, cleanup, err = r.pullImage(ctx, handle, imageRef, task.Config.ImageResource)
+		if err != nil {
+			return 0, fmt.Errorf("pull image: %w", err)
+		}
+
+		defer cleanup()
+	}
+
+	network, err := runtime.NewCNINetwork(runtime.WithCNIBinariesDir(r.CNIPluginsDir))
+	if err != nil {
+		return 0, fmt.Errorf("new cni network: %w", err)
+	}
+
+	backend, err := runtime.NewGardenBackend(
+		libcontainerd.New(r.ContainerdAddress, r.Namespace, r.RequestTimeout),
+		runtime.WithNetwork(network),
+		runtime.WithRequestTimeout(r.RequestTimeout),
+		runtime.WithInitBinPath(r.InitBinPath),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("containerd init: %w", err)
+	}
+
+	err = backend.Start()
+	if err != nil {
+		return 0, err
+	}
+
+	defer backend.Stop()
+
+	container, err := backend.Create(ContainerSpec(task, handle, rootfsPath, workspace))
+	if err != nil {
+		return 0, fmt.Errorf("create container: %w", err)
+	}
+
+	defer backend.Destroy(handle)
+
+	process, err := container.Run(ProcessSpec(task), garden.ProcessIO{
+		Stdout: stdout,
+		Stderr: stderr,
+	})
+	if err != nil {
+		return 0, fmt.Errorf("run task: %w", err)
+	}
+
+	type result struct {
+		status int
+		err    error
+	}
+
+	exited := make(chan result, 1)
+	go func() {
+		status, err := process.Wait()
+		exited <- result{status, err}
+	}()
+
+	var exit result
+	select {
+	case exit = <-exited:
+	case <-ctx.Done():
+		_ = process.Signal(garden.SignalTerminate)
+		<-exited
+		return 0, ctx.Err()
+	}
+
+	if exit.err != nil {
+		return 0, exit.err
+	}
+
+	err = workspace.CollectOutputs(task.Outputs)
+	if err != nil {
+		return exit.status, err
+	}
+
+	return exit.status, nil
+}
+
+// pullImage pulls the image into containerd and mounts a writable snapshot
+// of it to use as the root filesystem of the container.
+func (r Runtime) pullImage(ctx context.Context, handle string, ref string, imageResource *atc.ImageResource) (string, func(), error) {
+	client, err := containerd.New(r.ContainerdAddress, containerd.WithDefaultNamespace(r.Namespace))
+	if err != nil {
+		return "", nil, err
+	}
+
+	opts := []containerd.RemoteOpt{containerd.WithPullUnpack}
+
+	if imageResource != nil {
+		username, _ := imageResource.Source["username"].(string)
+		password, _ := imageResource.Source["password"].(string)
+
+		if username != "" {
+			authorizer := docker.NewDockerAuthorizer(docker.WithAuthCreds(func(string) (string, string, error) {
+				return username, password, nil
+			}))
+
+			opts = append(opts, containerd.WithResolver(docker.NewResolver(docker.ResolverOptions{
+				Hosts: docker.ConfigureDefaultRegistries(docker.WithAuthorizer(authorizer)),
+			})))
+		}
+	}
+
+	image, err := client.Pull(ctx, ref, opts...)
+	if err != nil {
+		client.Close()
+		return "", nil, err
+	}
+
+	diffIDs, err := image.RootFS(ctx)
+	if err != nil {
+		client.Close()
+		return "", nil, err
+	}
+
+	snapshotter := client.SnapshotService(containerd.DefaultSnapshotter)
+
+	mounts, err := snapshotter.Prepare(ctx, handle, identity.ChainID(diffIDs).String())
+	if err != nil {
+		client.Close()
+		return "", nil, err
+	}
+
+	cleanup := func() {
+		_ = snapshotter.Remove(context.Background(), handle)
+		client.Close()
+	}
+
+	rootfsPath, err := ioutil.TempDir("", handle+"-rootfs")
+	if err != nil {
+		cleanup()
+		return "", nil, err
+	}
+
+	err = mount.All(mounts, rootfsPath)
+	if err != nil {
+		os.RemoveAll(rootfsPath)
+		cleanup()
+		return "", nil, err
+	}
+
+	return rootfsPath, func() {
+		_ = mount.UnmountAll(rootfsPath, 0)
+		os.RemoveAll(rootfsPath)
+		cleanup()
+	}, nil
+}
@@ -0,0 +1,14 @@
+// +build !linux
+
+package localexec
+
+import (
+	"context"
+	"errors"
+	"io"
+)
+
+// Run is only supported on linux, as it relies on containerd.
+func (r Runtime) Run(ctx context.Context, task Task, stdout io.Writer, stderr io.Writer) (int, error) {
+	return 0, errors.New("running tasks locally is only supported on linux")
+}
@@ -0,0 +1,326 @@
+// Package localexec runs one-off tasks in a container on the local machine,
+// without an ATC or any workers.
+package localexec
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/atc"
+)
+
+// WorkingDirectory is where the inputs, outputs and caches of the task are
+// mounted within its container.
+const WorkingDirectory = "/tmp/build/local"
+
+// Artifact is a directory on the local machine provided to or collected from
+// the task.
+type Artifact struct {
+	Name string
+	Path string
+}
+
+// Task is a task to run in a local container.
+type Task struct {
+	Config     atc.TaskConfig
+	Privileged bool
+
+	Inputs  []Artifact
+	Outputs []Artifact
+}
+
+// Workspace is the set of directories on the local machine mounted into the
+// task's container.
+type Workspace struct {
+	BindMounts []garden.BindMount
+
+	// OutputDirs maps each output to the scratch directory it is mounted from.
+	OutputDirs map[string]string
+}
+
+// NewWorkspace lays out the directories for the task under scratchDir.
+//
+// Inputs are mounted read-only so that the task can not change the files it
+// was given. Outputs and caches are mounted from scratch directories instead;
+// an output sharing its path with an input starts out as a copy of the input,
+// as it would in a build.
+func NewWorkspace(task Task, scratchDir string) (Workspace, error) {
+	workspace := Workspace{
+		OutputDirs: map[string]string{},
+	}
+
+	inputPaths := map[string]string{}
+	for _, input := range task.Inputs {
+		src, err := filepath.Abs(input.Path)
+		if err != nil {
+			return Workspace{}, err
+		}
+
+		inputPaths[artifactPath(input.Name, inputConfig(task.Config, input.Name).Path)] = src
+	}
+
+	for _, output := range task.Config.Outputs {
+		dst := artifactPath(output.Name, output.Path)
+
+		dir, err := scratch(scratchDir, "outputs", output.Name)
+		if err != nil {
+			return Workspace{}, err
+		}
+
+		if src, found := inputPaths[dst]; found {
+			err := CopyDir(src, dir)
+			if err != nil {
+				return Workspace{}, fmt.Errorf("copy input to output '%s': %w", output.Name, err)
+			}
+
+			delete(inputPaths, dst)
+		}
+
+		workspace.OutputDirs[output.Name] = dir
+		workspace.BindMounts = append(workspace.BindMounts, garden.BindMount{
+			SrcPath: dir,
+			DstPath: dst,
+			Mode:    garden.BindMountModeRW,
+			Origin:  garden.BindMountOriginHost,
+		})
+	}
+
+	for _, input := range task.Config.Inputs {
+		dst := artifactPath(input.Name, input.Path)
+
+		src, found := inputPaths[dst]
+		if !found {
+			continue
+		}
+
+		workspace.BindMounts = append(workspace.BindMounts, garden.BindMount{
+			SrcPath: src,
+			DstPath: dst,
+			Mode:    garden.BindMountModeRO,
+			Origin:  garden.BindMountOriginHost,
+		})
+	}
+
+	for i, cache := range task.Config.Caches {
+		dir, err := scratch(scratchDir, "caches", fmt.Sprintf("%d", i))
+		if err != nil {
+			return Workspace{}, err
+		}
+
+		workspace.BindMounts = append(workspace.BindMounts, garden.BindMount{
+			SrcPath: dir,
+			DstPath: filepath.Join(WorkingDirectory, cache.Path),
+			Mode:    garden.BindMountModeRW,
+			Origin:  garden.BindMountOriginHost,
+		})
+	}
+
+	return workspace, nil
+}
+
+// CollectOutputs copies the outputs the task produced to the directories
+// they were requested to be written to.
+func (workspace Workspace) CollectOutputs(outputs []Artifact) error {
+	for _, output := range outputs {
+		dir, found := workspace.OutputDirs[output.Name]
+		if !found {
+			return fmt.Errorf("unknown output '%s'", output.Name)
+		}
+
+		err := CopyDir(dir, output.Path)
+		if err != nil {
+			return fmt.Errorf("copy output '%s': %w", output.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// ContainerSpec returns the spec of the container to run the task in.
+func ContainerSpec(task Task, handle string, rootfsPath string, workspace Workspace) garden.ContainerSpec {
+	spec := garden.ContainerSpec{
+		Handle:     handle,
+		Image:      garden.ImageRef{URI: "raw://" + rootfsPath},
+		Privileged: task.Privileged,
+		BindMounts: workspace.BindMounts,
+		Env:        task.Config.Params.Env(),
+	}
+
+	if task.Config.Limits != nil {
+		if task.Config.Limits.CPU != nil {
+			spec.Limits.CPU = garden.CPULimits{LimitInShares: uint64(*task.Config.Limits.CPU)}
+		}
+
+		if task.Config.Limits.Memory != nil {
+			spec.Limits.Memory = garden.MemoryLimits{LimitInBytes: uint64(*task.Config.Limits.Memory)}
+		}
+	}
+
+	return spec
+}
+
+// ProcessSpec returns the spec of the task's process.
+func ProcessSpec(task Task) garden.ProcessSpec {
+	return garden.ProcessSpec{
+		Path: task.Config.Run.Path,
+		Args: task.Config.Run.Args,
+		Dir:  filepath.Join(WorkingDirectory, task.Config.Run.Dir),
+		User: task.Config.Run.User,
+	}
+}
+
+// Image determines what the task's container is created from: either a
+// directory to use as the root filesystem as-is, or a reference to an image
+// to pull from a registry.
+func Image(config atc.TaskConfig) (rootfsPath string, imageRef string, err error) {
+	if config.RootfsURI != "" {
+		parts := strings.SplitN(config.RootfsURI, "://", 2)
+		if len(parts) != 2 {
+			return "", "", fmt.Errorf("malformed rootfs_uri '%s'", config.RootfsURI)
+		}
+
+		switch parts[0] {
+		case "raw":
+			if !filepath.IsAbs(parts[1]) {
+				return "", "", fmt.Errorf("rootfs_uri '%s' must be an absolute path", config.RootfsURI)
+			}
+
+			return parts[1], "", nil
+		case "docker":
+			return "", NormalizeImageRef(strings.Replace(strings.TrimPrefix(parts[1], "/"), "#", ":", 1)), nil
+		default:
+			return "", "", fmt.Errorf("unsupported rootfs_uri scheme '%s'", parts[0])
+		}
+	}
+
+	if config.ImageResource == nil {
+		return "", "", fmt.Errorf("task must specify image_resource or rootfs_uri to run locally")
+	}
+
+	switch config.ImageResource.Type {
+	case "registry-image", "docker-image":
+	default:
+		return "", "", fmt.Errorf("image resource type '%s' can not be run locally", config.ImageResource.Type)
+	}
+
+	repository, _ := config.ImageResource.Source["repository"].(string)
+	if repository == "" {
+		return "", "", fmt.Errorf("image resource must specify a repository")
+	}
+
+	if digest, ok := config.ImageResource.Version["digest"]; ok && digest != "" {
+		return "", NormalizeImageRef(repository + "@" + digest), nil
+	}
+
+	tag, _ := config.ImageResource.Source["tag"].(string)
+	if tag == "" {
+		tag = "latest"
+	}
+
+	return "", NormalizeImageRef(repository + ":" + tag), nil
+}
+
+// NormalizeImageRef qualifies a reference the way docker does, so that
+// "busybox:latest" refers to "docker.io/library/busybox:latest".
+func NormalizeImageRef(ref string) string {
+	segments := strings.SplitN(ref, "/", 2)
+	if len(segments) == 1 {
+		return "docker.io/library/" + ref
+	}
+
+	domain := segments[0]
+	if !strings.ContainsAny(domain, ".:") && domain != "localhost" {
+		return "docker.io/" + ref
+	}
+
+	return ref
+}
+
+// CopyDir copies the contents of the src directory into dst, creating it if
+// necessary.
+func CopyDir(src string, dst string) error {
+	return filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+
+		target := filepath.Join(dst, rel)
+
+		switch {
+		case info.IsDir():
+			return os.MkdirAll(target, info.Mode().Perm()|0700)
+		case info.Mode()&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+
+			return os.Symlink(link, target)
+		case info.Mode().IsRegular():
+			return copyFile(path, target, info.Mode().Perm())
+		default:
+			return nil
+		}
+	})
+}
+
+func copyFile(src string, dst string, mode os.FileMode) error {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+
+	defer in.Close()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+
+	_, err = out.ReadFrom(in)
+	if err != nil {
+		out.Close()
+		return err
+	}
+
+	return out.Close()
+}
+
+func inputConfig(config atc.TaskConfig, name string) atc.TaskInputConfig {
+	for _, input := range config.Inputs {
+		if input.Name == name {
+			return input
+		}
+	}
+
+	return atc.TaskInputConfig{Name: name}
+}
+
+func artifactPath(name string, path string) string {
+	if path == "" {
+		path = name
+	}
+
+	return filepath.Join(WorkingDirectory, path)
+}
+
+// scratch creates a directory which any user in the container can write to,
+// as the task may not run as the same user as fly.
+func scratch(scratchDir string, kind string, name string) (string, error) {
+	dir := filepath.Join(scratchDir, kind, name)
+
+	err := os.MkdirAll(dir, 0777)
+	if err != nil {
+		return "", err
+	}
+
+	return dir, os.Chmod(dir, 0777)
+}
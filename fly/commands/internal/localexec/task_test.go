@@ -0,0 +1,303 @@
+package localexec_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/garden"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/localexec"
+)
+
+var _ = Describe("Task", func() {
+	var (
+		tmpDir     string
+		scratchDir string
+		inputDir   string
+		task       localexec.Task
+	)
+
+	BeforeEach(func() {
+		var err error
+		tmpDir, err = ioutil.TempDir("", "localexec-test")
+		Expect(err).ToNot(HaveOccurred())
+
+		scratchDir = filepath.Join(tmpDir, "scratch")
+
+		inputDir = filepath.Join(tmpDir, "some-input")
+		Expect(os.MkdirAll(filepath.Join(inputDir, "sub"), 0755)).To(Succeed())
+		Expect(ioutil.WriteFile(filepath.Join(inputDir, "sub", "some-file"), []byte("some-contents"), 0644)).To(Succeed())
+
+		task = localexec.Task{
+			Config: atc.TaskConfig{
+				Inputs: []atc.TaskInputConfig{
+					{Name: "some-input"},
+				},
+				Outputs: []atc.TaskOutputConfig{
+					{Name: "some-output", Path: "out"},
+				},
+				Caches: []atc.TaskCacheConfig{
+					{Path: "some-cache"},
+				},
+				Run: atc.TaskRunConfig{
+					Path: "some-path",
+					Args: []string{"some", "args"},
+					Dir:  "some-input",
+					User: "some-user",
+				},
+				Params: atc.TaskEnv{"SOME": "param"},
+			},
+			Inputs: []localexec.Artifact{
+				{Name: "some-input", Path: inputDir},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(tmpDir)).To(Succeed())
+	})
+
+	Describe("NewWorkspace", func() {
+		It("mounts inputs read-only and outputs and caches read-write", func() {
+			workspace, err := localexec.NewWorkspace(task, scratchDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			outputDir := filepath.Join(scratchDir, "outputs", "some-output")
+			Expect(workspace.OutputDirs).To(Equal(map[string]string{"some-output": outputDir}))
+
+			Expect(workspace.BindMounts).To(ConsistOf(
+				garden.BindMount{
+					SrcPath: outputDir,
+					DstPath: "/tmp/build/local/out",
+					Mode:    garden.BindMountModeRW,
+					Origin:  garden.BindMountOriginHost,
+				},
+				garden.BindMount{
+					SrcPath: inputDir,
+					DstPath: "/tmp/build/local/some-input",
+					Mode:    garden.BindMountModeRO,
+					Origin:  garden.BindMountOriginHost,
+				},
+				garden.BindMount{
+					SrcPath: filepath.Join(scratchDir, "caches", "0"),
+					DstPath: "/tmp/build/local/some-cache",
+					Mode:    garden.BindMountModeRW,
+					Origin:  garden.BindMountOriginHost,
+				},
+			))
+
+			info, err := os.Stat(outputDir)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0777)))
+		})
+
+		Context("when an output has the same path as an input", func() {
+			BeforeEach(func() {
+				task.Config.Outputs = []atc.TaskOutputConfig{
+					{Name: "some-output", Path: "some-input"},
+				}
+			})
+
+			It("mounts a copy of the input as the output", func() {
+				workspace, err := localexec.NewWorkspace(task, scratchDir)
+				Expect(err).ToNot(HaveOccurred())
+
+				outputDir := filepath.Join(scratchDir, "outputs", "some-output")
+				Expect(workspace.BindMounts).To(Equal([]garden.BindMount{
+					{
+						SrcPath: outputDir,
+						DstPath: "/tmp/build/local/some-input",
+						Mode:    garden.BindMountModeRW,
+						Origin:  garden.BindMountOriginHost,
+					},
+					{
+						SrcPath: filepath.Join(scratchDir, "caches", "0"),
+						DstPath: "/tmp/build/local/some-cache",
+						Mode:    garden.BindMountModeRW,
+						Origin:  garden.BindMountOriginHost,
+					},
+				}))
+
+				contents, err := ioutil.ReadFile(filepath.Join(outputDir, "sub", "some-file"))
+				Expect(err).ToNot(HaveOccurred())
+				Expect(string(contents)).To(Equal("some-contents"))
+			})
+		})
+	})
+
+	Describe("CollectOutputs", func() {
+		var workspace localexec.Workspace
+
+		BeforeEach(func() {
+			var err error
+			workspace, err = localexec.NewWorkspace(task, scratchDir)
+			Expect(err).ToNot(HaveOccurred())
+
+			outputDir := workspace.OutputDirs["some-output"]
+			Expect(ioutil.WriteFile(filepath.Join(outputDir, "result"), []byte("some-result"), 0644)).To(Succeed())
+		})
+
+		It("copies the outputs to their requested paths", func() {
+			dst := filepath.Join(tmpDir, "collected")
+
+			err := workspace.CollectOutputs([]localexec.Artifact{
+				{Name: "some-output", Path: dst},
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			contents, err := ioutil.ReadFile(filepath.Join(dst, "result"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(contents)).To(Equal("some-result"))
+		})
+
+		It("errors for an unknown output", func() {
+			err := workspace.CollectOutputs([]localexec.Artifact{
+				{Name: "bogus", Path: tmpDir},
+			})
+			Expect(err).To(MatchError("unknown output 'bogus'"))
+		})
+	})
+
+	Describe("ContainerSpec", func() {
+		It("creates the container from the rootfs with the task's params and limits", func() {
+			cpu := atc.CPULimit(512)
+			memory := atc.MemoryLimit(1024)
+			task.Config.Limits = &atc.ContainerLimits{CPU: &cpu, Memory: &memory}
+			task.Privileged = true
+
+			workspace := localexec.Workspace{
+				BindMounts: []garden.BindMount{{SrcPath: "/src", DstPath: "/dst"}},
+			}
+
+			spec := localexec.ContainerSpec(task, "some-handle", "/some/rootfs", workspace)
+			Expect(spec.Handle).To(Equal("some-handle"))
+			Expect(spec.Image).To(Equal(garden.ImageRef{URI: "raw:///some/rootfs"}))
+			Expect(spec.Privileged).To(BeTrue())
+			Expect(spec.BindMounts).To(Equal(workspace.BindMounts))
+			Expect(spec.Env).To(Equal([]string{"SOME=param"}))
+			Expect(spec.Limits.CPU.LimitInShares).To(Equal(uint64(512)))
+			Expect(spec.Limits.Memory.LimitInBytes).To(Equal(uint64(1024)))
+		})
+	})
+
+	Describe("ProcessSpec", func() {
+		It("runs the task's command relative to the working directory", func() {
+			Expect(localexec.ProcessSpec(task)).To(Equal(garden.ProcessSpec{
+				Path: "some-path",
+				Args: []string{"some", "args"},
+				Dir:  "/tmp/build/local/some-input",
+				User: "some-user",
+			}))
+		})
+	})
+
+	Describe("Image", func() {
+		var (
+			config     atc.TaskConfig
+			rootfsPath string
+			imageRef   string
+			err        error
+		)
+
+		JustBeforeEach(func() {
+			rootfsPath, imageRef, err = localexec.Image(config)
+		})
+
+		Context("with a raw rootfs_uri", func() {
+			BeforeEach(func() {
+				config = atc.TaskConfig{RootfsURI: "raw:///some/rootfs"}
+			})
+
+			It("uses the path as the rootfs", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rootfsPath).To(Equal("/some/rootfs"))
+				Expect(imageRef).To(BeEmpty())
+			})
+		})
+
+		Context("with a docker rootfs_uri", func() {
+			BeforeEach(func() {
+				config = atc.TaskConfig{RootfsURI: "docker:///busybox#1.32"}
+			})
+
+			It("pulls the image", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(rootfsPath).To(BeEmpty())
+				Expect(imageRef).To(Equal("docker.io/library/busybox:1.32"))
+			})
+		})
+
+		Context("with an unsupported rootfs_uri", func() {
+			BeforeEach(func() {
+				config = atc.TaskConfig{RootfsURI: "s3://bucket/rootfs.tgz"}
+			})
+
+			It("errors", func() {
+				Expect(err).To(MatchError("unsupported rootfs_uri scheme 's3'"))
+			})
+		})
+
+		Context("with a registry-image image_resource", func() {
+			BeforeEach(func() {
+				config = atc.TaskConfig{
+					ImageResource: &atc.ImageResource{
+						Type:   "registry-image",
+						Source: atc.Source{"repository": "concourse/unit"},
+					},
+				}
+			})
+
+			It("pulls the latest tag", func() {
+				Expect(err).ToNot(HaveOccurred())
+				Expect(imageRef).To(Equal("docker.io/concourse/unit:latest"))
+			})
+
+			Context("with a version", func() {
+				BeforeEach(func() {
+					config.ImageResource.Version = atc.Version{"digest": "sha256:abc"}
+				})
+
+				It("pulls the digest", func() {
+					Expect(err).ToNot(HaveOccurred())
+					Expect(imageRef).To(Equal("docker.io/concourse/unit@sha256:abc"))
+				})
+			})
+		})
+
+		Context("with an image_resource of another type", func() {
+			BeforeEach(func() {
+				config = atc.TaskConfig{
+					ImageResource: &atc.ImageResource{Type: "some-type"},
+				}
+			})
+
+			It("errors", func() {
+				Expect(err).To(MatchError("image resource type 'some-type' can not be run locally"))
+			})
+		})
+
+		Context("with no image", func() {
+			BeforeEach(func() {
+				config = atc.TaskConfig{}
+			})
+
+			It("errors", func() {
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("NormalizeImageRef", func() {
+		It("qualifies references the way docker does", func() {
+			Expect(localexec.NormalizeImageRef("busybox")).To(Equal("docker.io/library/busybox"))
+			Expect(localexec.NormalizeImageRef("concourse/unit:tag")).To(Equal("docker.io/concourse/unit:tag"))
+			Expect(localexec.NormalizeImageRef("localhost/img")).To(Equal("localhost/img"))
+			Expect(localexec.NormalizeImageRef("registry:5000/img")).To(Equal("registry:5000/img"))
+			Expect(localexec.NormalizeImageRef("gcr.io/project/img")).To(Equal("gcr.io/project/img"))
+		})
+	})
+})
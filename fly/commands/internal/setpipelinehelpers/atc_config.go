@@ -51,6 +51,18 @@ func (atcConfig ATCConfig) Set(yamlTemplateWithParams templatehelpers.YamlTempla
 		return err
 	}
 
+	evaluatedTemplate, err = yamlTemplateWithParams.ResolveIncludes(evaluatedTemplate)
+	if err != nil {
+		return err
+	}
+
+	return atcConfig.Apply(evaluatedTemplate)
+}
+
+// Apply shows the diff between the pipeline's current config and the given
+// one and, once confirmed, saves it. The config goes through the same
+// validation as any other config being set.
+func (atcConfig ATCConfig) Apply(evaluatedTemplate []byte) error {
 	existingConfig, existingConfigVersion, _, err := atcConfig.Team.PipelineConfig(atcConfig.PipelineRef)
 	if err != nil {
 		return err
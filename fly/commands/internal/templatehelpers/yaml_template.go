@@ -5,6 +5,7 @@ import (
 	"io/ioutil"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/configinclude"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/vars"
 	"sigs.k8s.io/yaml"
@@ -55,6 +56,32 @@ func (yamlTemplate YamlTemplateWithParams) Evaluate(
 		}
 	}
 
+	params, err := yamlTemplate.params()
+	if err != nil {
+		return nil, err
+	}
+
+	evaluatedConfig, err := vars.NewTemplateResolver(config, params).Resolve(false, allowEmpty)
+	if err != nil {
+		return nil, err
+	}
+
+	return evaluatedConfig, nil
+}
+
+// ResolveIncludes expands the fragments the evaluated pipeline config
+// includes from other files, relative to the pipeline config's own file.
+// The fragments are interpolated with the same vars as the pipeline config.
+func (yamlTemplate YamlTemplateWithParams) ResolveIncludes(evaluatedConfig []byte) ([]byte, error) {
+	params, err := yamlTemplate.params()
+	if err != nil {
+		return nil, err
+	}
+
+	return configinclude.Resolve(evaluatedConfig, string(yamlTemplate.filePath), configinclude.FileSource{}, params)
+}
+
+func (yamlTemplate YamlTemplateWithParams) params() ([]vars.Variables, error) {
 	var params []vars.Variables
 
 	// first, we take explicitly specified variables on the command line
@@ -89,10 +116,5 @@ func (yamlTemplate YamlTemplateWithParams) Evaluate(
 		params = append(params, staticVars)
 	}
 
-	evaluatedConfig, err := vars.NewTemplateResolver(config, params).Resolve(false, allowEmpty)
-	if err != nil {
-		return nil, err
-	}
-
-	return evaluatedConfig, nil
+	return params, nil
 }
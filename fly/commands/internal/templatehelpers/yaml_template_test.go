@@ -73,6 +73,63 @@ var _ = Describe("YAML Template With Params", func() {
   param2: value2
   param3:
     nested: ((param3))
+`))
+		})
+	})
+
+	Describe("resolve includes", func() {
+		var tmpdir string
+
+		BeforeEach(func() {
+			var err error
+
+			tmpdir, err = ioutil.TempDir("", "yaml-template-test")
+			Expect(err).NotTo(HaveOccurred())
+
+			err = os.Mkdir(filepath.Join(tmpdir, "modules"), 0755)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(
+				filepath.Join(tmpdir, "pipeline.yml"),
+				[]byte(`include:
+- file: modules/job.yml
+  vars: {job: ((job))}
+`),
+				0644,
+			)
+			Expect(err).NotTo(HaveOccurred())
+
+			err = ioutil.WriteFile(
+				filepath.Join(tmpdir, "modules", "job.yml"),
+				[]byte(`jobs:
+- name: ((job))
+  serial: ((serial))
+`),
+				0644,
+			)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		AfterEach(func() {
+			os.RemoveAll(tmpdir)
+		})
+
+		It("merges the included files, interpolated with the same variables", func() {
+			variables := []flaghelpers.VariablePairFlag{
+				{Ref: vars.Reference{Path: "job"}, Value: "some-job"},
+			}
+			yamlVariables := []flaghelpers.YAMLVariablePairFlag{
+				{Ref: vars.Reference{Path: "serial"}, Value: true},
+			}
+			pipelineYaml := templatehelpers.NewYamlTemplateWithParams(atc.PathFlag(filepath.Join(tmpdir, "pipeline.yml")), nil, variables, yamlVariables, nil)
+			evaluated, err := pipelineYaml.Evaluate(false, false)
+			Expect(err).NotTo(HaveOccurred())
+
+			result, err := pipelineYaml.ResolveIncludes(evaluated)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(result)).To(Equal(`jobs:
+- name: some-job
+  serial: true
 `))
 		})
 	})
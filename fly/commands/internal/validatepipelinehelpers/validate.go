@@ -19,6 +19,11 @@ func Validate(yamlTemplate templatehelpers.YamlTemplateWithParams, strict bool,
 		return err
 	}
 
+	evaluatedTemplate, err = yamlTemplate.ResolveIncludes(evaluatedTemplate)
+	if err != nil {
+		return err
+	}
+
 	var unmarshalledTemplate atc.Config
 	if strict {
 		// UnmarshalStrict will pick up fields in structs that have the wrong names, as well as any duplicate keys in maps
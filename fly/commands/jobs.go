@@ -4,7 +4,6 @@ import (
 	"os"
 
 	"github.com/concourse/concourse/atc"
-	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
@@ -14,8 +13,9 @@ import (
 
 type JobsCommand struct {
 	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Get jobs in this pipeline"`
-	Json     bool                     `long:"json" description:"Print command result as JSON"`
 	Team     string                   `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+
+	ui.OutputFlags
 }
 
 func (command *JobsCommand) Execute([]string) error {
@@ -49,12 +49,8 @@ func (command *JobsCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(jobs)
-		if err != nil {
-			return err
-		}
-		return nil
+	if format := command.Format(); !format.IsTable() {
+		return format.Print(os.Stdout, jobs)
 	}
 
 	headers = []string{"name", "paused", "status", "next"}
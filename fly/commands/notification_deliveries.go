@@ -0,0 +1,97 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type NotificationDeliveriesCommand struct {
+	Name  string `short:"n" long:"name"  required:"true" description:"Name of the notification subscription whose deliveries to list"`
+	Count int    `short:"c" long:"count" default:"50"    description:"Number of deliveries you want to limit the return to"`
+
+	Team string `long:"team" description:"Name of the team to which the notification subscription belongs, if different from the target default"`
+
+	ui.OutputFlags
+}
+
+func (command *NotificationDeliveriesCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	deliveries, found, err := team.NotificationDeliveries(command.Name, command.Count)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("notification subscription '%s' not found", command.Name)
+	}
+
+	if format := command.Format(); !format.IsTable() {
+		return format.Print(os.Stdout, deliveries)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "id", Color: color.New(color.Bold)},
+			{Contents: "event", Color: color.New(color.Bold)},
+			{Contents: "status", Color: color.New(color.Bold)},
+			{Contents: "attempts", Color: color.New(color.Bold)},
+			{Contents: "created", Color: color.New(color.Bold)},
+			{Contents: "last error", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, delivery := range deliveries {
+		statusCell := ui.TableCell{Contents: string(delivery.Status)}
+		switch delivery.Status {
+		case atc.NotificationDeliverySucceeded:
+			statusCell.Color = ui.SucceededColor
+		case atc.NotificationDeliveryFailed:
+			statusCell.Color = ui.FailedColor
+		case atc.NotificationDeliveryPending:
+			statusCell.Color = ui.PendingColor
+		}
+
+		lastErrorCell := ui.TableCell{Contents: delivery.LastError}
+		if delivery.LastError == "" {
+			lastErrorCell = ui.TableCell{Contents: "none", Color: color.New(color.Faint)}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(delivery.ID)},
+			{Contents: string(delivery.Event)},
+			statusCell,
+			{Contents: strconv.Itoa(delivery.Attempts)},
+			{Contents: time.Unix(delivery.CreatedAt, 0).Format(timeDateLayout)},
+			lastErrorCell,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
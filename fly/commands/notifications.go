@@ -0,0 +1,71 @@
+package commands
+
+import (
+	"os"
+	"strings"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type NotificationsCommand struct {
+	Team string `long:"team" description:"Name of the team whose notifications to list, if different from the target default"`
+
+	ui.OutputFlags
+}
+
+func (command *NotificationsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	subscriptions, err := team.NotificationSubscriptions()
+	if err != nil {
+		return err
+	}
+
+	if format := command.Format(); !format.IsTable() {
+		return format.Print(os.Stdout, subscriptions)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "url", Color: color.New(color.Bold)},
+			{Contents: "events", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, subscription := range subscriptions {
+		events := make([]string, len(subscription.Events))
+		for i, ev := range subscription.Events {
+			events[i] = string(ev)
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: subscription.Name},
+			{Contents: subscription.URL},
+			{Contents: strings.Join(events, ",")},
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
@@ -0,0 +1,135 @@
+package commands
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type PipelineHistoryCommand struct {
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline to show the config history of"`
+	Revision int                      `short:"r" long:"revision"                 description:"Show the changes made by this revision instead of listing all revisions"`
+	Team     string                   `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+
+	ui.OutputFlags
+}
+
+func (command *PipelineHistoryCommand) Validate() error {
+	_, err := command.Pipeline.Validate()
+	return err
+}
+
+func (command *PipelineHistoryCommand) Execute([]string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	if command.Revision != 0 {
+		return command.showRevision(team)
+	}
+
+	history, found, err := team.PipelineConfigHistory(command.Pipeline.Ref())
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return errors.New("pipeline not found")
+	}
+
+	if format := command.Format(); !format.IsTable() {
+		return format.Print(os.Stdout, history)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "revision", Color: color.New(color.Bold)},
+			{Contents: "created at", Color: color.New(color.Bold)},
+			{Contents: "created by", Color: color.New(color.Bold)},
+			{Contents: "source", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, revision := range history {
+		createdBy := ui.TableCell{Contents: revision.CreatedBy}
+		if revision.CreatedBy == "" {
+			createdBy = ui.TableCell{Contents: "n/a", Color: color.New(color.Faint)}
+		}
+
+		source := ui.TableCell{Contents: "api"}
+		if revision.BuildID != 0 {
+			source = ui.TableCell{Contents: "build " + strconv.Itoa(revision.BuildID)}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: strconv.Itoa(revision.Revision)},
+			{Contents: time.Unix(revision.CreatedAt, 0).Format(timeDateLayout)},
+			createdBy,
+			source,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
+
+func (command *PipelineHistoryCommand) showRevision(team concourse.Team) error {
+	revision, found, err := team.PipelineConfigRevision(command.Pipeline.Ref(), command.Revision)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("revision %d not found", command.Revision)
+	}
+
+	// the first revision is shown as adding everything in it
+	previousConfig := atc.Config{}
+	if command.Revision > 1 {
+		previous, found, err := team.PipelineConfigRevision(command.Pipeline.Ref(), command.Revision-1)
+		if err != nil {
+			return err
+		}
+
+		if found {
+			previousConfig = *previous.Config
+		}
+	}
+
+	stdout, _ := ui.ForTTY(os.Stdout)
+	if !previousConfig.Diff(stdout, *revision.Config) {
+		fmt.Println("no changes")
+	}
+
+	return nil
+}
@@ -6,7 +6,6 @@ import (
 	"time"
 
 	"github.com/concourse/concourse/atc"
-	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/fatih/color"
@@ -15,7 +14,8 @@ import (
 type PipelinesCommand struct {
 	All             bool `short:"a"  long:"all" description:"Show pipelines across all teams"`
 	IncludeArchived bool `long:"include-archived" description:"Show archived pipelines"`
-	Json            bool `long:"json" description:"Print command result as JSON"`
+
+	ui.OutputFlags
 }
 
 func (command *PipelinesCommand) Execute([]string) error {
@@ -43,12 +43,8 @@ func (command *PipelinesCommand) Execute([]string) error {
 	headers := command.buildHeader()
 	pipelines := command.filterPipelines(unfilteredPipelines)
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(pipelines)
-		if err != nil {
-			return err
-		}
-		return nil
+	if format := command.Format(); !format.IsTable() {
+		return format.Print(os.Stdout, pipelines)
 	}
 
 	table := ui.Table{Headers: ui.TableRow{}}
@@ -0,0 +1,50 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type RedeliverNotificationCommand struct {
+	Name     string `short:"n" long:"name"     required:"true" description:"Name of the notification subscription the delivery was made to"`
+	Delivery int    `short:"d" long:"delivery" required:"true" description:"ID of the delivery to send again"`
+
+	Team string `long:"team" description:"Name of the team to which the notification subscription belongs, if different from the target default"`
+}
+
+func (command *RedeliverNotificationCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	found, err := team.RedeliverNotification(command.Name, command.Delivery)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("delivery %d of notification subscription '%s' not found", command.Delivery, command.Name)
+	}
+
+	fmt.Printf("delivery %d queued to be sent again\n", command.Delivery)
+
+	return nil
+}
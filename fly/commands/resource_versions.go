@@ -7,7 +7,6 @@ import (
 	"strings"
 
 	"github.com/concourse/concourse/atc"
-	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
@@ -18,7 +17,8 @@ import (
 type ResourceVersionsCommand struct {
 	Count    int                      `short:"c" long:"count" default:"50" description:"Number of versions you want to limit the return to"`
 	Resource flaghelpers.ResourceFlag `short:"r" long:"resource" required:"true" value-name:"PIPELINE/RESOURCE" description:"Name of a resource to get versions for"`
-	Json     bool                     `long:"json" description:"Print command result as JSON"`
+
+	ui.OutputFlags
 }
 
 func (command *ResourceVersionsCommand) Execute([]string) error {
@@ -41,12 +41,8 @@ func (command *ResourceVersionsCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(versions)
-		if err != nil {
-			return err
-		}
-		return nil
+	if format := command.Format(); !format.IsTable() {
+		return format.Print(os.Stdout, versions)
 	}
 
 	table := ui.Table{
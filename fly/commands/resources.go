@@ -4,7 +4,6 @@ import (
 	"os"
 
 	"github.com/concourse/concourse/atc"
-	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
@@ -13,7 +12,8 @@ import (
 
 type ResourcesCommand struct {
 	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Get resources in this pipeline"`
-	Json     bool                     `long:"json" description:"Print command result as JSON"`
+
+	ui.OutputFlags
 }
 
 func (command *ResourcesCommand) Execute([]string) error {
@@ -35,12 +35,8 @@ func (command *ResourcesCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(resources)
-		if err != nil {
-			return err
-		}
-		return nil
+	if format := command.Format(); !format.IsTable() {
+		return format.Print(os.Stdout, resources)
 	}
 
 	headers = []string{"name", "type", "pinned", "check status"}
@@ -0,0 +1,85 @@
+package commands
+
+import (
+	"fmt"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/fly/commands/internal/flaghelpers"
+	"github.com/concourse/concourse/fly/commands/internal/setpipelinehelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/mgutz/ansi"
+)
+
+type RollbackPipelineCommand struct {
+	SkipInteractive  bool `short:"n"  long:"non-interactive"  description:"Skips interactions, uses default values"`
+	DisableAnsiColor bool `long:"no-color"                     description:"Disable color output"`
+
+	CheckCredentials bool `long:"check-creds"  description:"Validate credential variables against credential manager"`
+
+	Pipeline flaghelpers.PipelineFlag `short:"p" long:"pipeline" required:"true" description:"Pipeline to roll back"`
+	To       int                      `long:"to"                 required:"true" description:"Revision of the pipeline's config to apply again, as listed by pipeline-history"`
+	Team     string                   `long:"team" description:"Name of the team to which the pipeline belongs, if different from the target default"`
+}
+
+func (command *RollbackPipelineCommand) Validate() error {
+	_, err := command.Pipeline.Validate()
+	return err
+}
+
+func (command *RollbackPipelineCommand) Execute([]string) error {
+	err := command.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	ansi.DisableColors(command.DisableAnsiColor)
+
+	revision, found, err := team.PipelineConfigRevision(command.Pipeline.Ref(), command.To)
+	if err != nil {
+		return err
+	}
+
+	if !found {
+		return fmt.Errorf("revision %d not found", command.To)
+	}
+
+	payload, err := yaml.Marshal(revision.Config)
+	if err != nil {
+		return err
+	}
+
+	atcConfig := setpipelinehelpers.ATCConfig{
+		Team:             team,
+		PipelineRef:      command.Pipeline.Ref(),
+		TargetName:       Fly.Target,
+		Target:           target.Client().URL(),
+		SkipInteraction:  command.SkipInteractive,
+		CheckCredentials: command.CheckCredentials,
+		GivenTeamName:    command.Team,
+	}
+
+	return atcConfig.Apply(payload)
+}
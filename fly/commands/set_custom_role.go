@@ -0,0 +1,59 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type SetCustomRoleCommand struct {
+	Name        string   `short:"n" long:"name"       required:"true" description:"Name of the custom role to create or update"`
+	Permissions []string `short:"p" long:"permission" required:"true" description:"Name of an API action the role may perform, e.g. CreateJobBuild. Can be specified multiple times."`
+	Pipelines   []string `long:"pipeline" description:"Glob pattern matching the names of the pipelines the role is limited to. Can be specified multiple times."`
+
+	Team string `long:"team" description:"Name of the team to which the custom role belongs, if different from the target default"`
+}
+
+func (command *SetCustomRoleCommand) Execute([]string) error {
+	role := atc.CustomRole{
+		Name:        command.Name,
+		Permissions: command.Permissions,
+		Pipelines:   command.Pipelines,
+	}
+
+	err := role.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	err = team.SetCustomRole(role)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("custom role '%s' set\n", command.Name)
+
+	return nil
+}
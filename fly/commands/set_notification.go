@@ -0,0 +1,61 @@
+package commands
+
+import (
+	"fmt"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+)
+
+type SetNotificationCommand struct {
+	Name   string                  `short:"n" long:"name"   required:"true" description:"Name of the notification subscription to create or update"`
+	URL    string                  `short:"u" long:"url"    required:"true" description:"URL to POST the notifications to"`
+	Secret string                  `short:"s" long:"secret" required:"true" description:"Secret with which the notifications are signed, sent as an HMAC-SHA256 of the payload in the X-Concourse-Signature header"`
+	Events []atc.NotificationEvent `short:"e" long:"event"  required:"true" description:"Event to send notifications of (build_started, build_finished, build_errored, build_aborted or resource_check_failed). Can be specified multiple times."`
+
+	Team string `long:"team" description:"Name of the team to which the notification subscription belongs, if different from the target default"`
+}
+
+func (command *SetNotificationCommand) Execute([]string) error {
+	subscription := atc.NotificationSubscription{
+		Name:   command.Name,
+		URL:    command.URL,
+		Secret: command.Secret,
+		Events: command.Events,
+	}
+
+	err := subscription.Validate()
+	if err != nil {
+		return err
+	}
+
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	err = team.SetNotificationSubscription(subscription)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("notification subscription '%s' set\n", command.Name)
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"os"
 	"sort"
+	"strconv"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
@@ -29,6 +30,9 @@ type SetTeamCommand struct {
 	Team            flaghelpers.TeamFlag `short:"n" long:"team-name" required:"true" description:"The team to create or modify"`
 	SkipInteractive bool                 `long:"non-interactive" description:"Force apply configuration"`
 	AuthFlags       skycmd.AuthTeamFlags `group:"Authentication"`
+
+	MaxActiveTasks      *int `long:"max-active-tasks" description:"Maximum number of tasks the team's builds may run on shared workers at once (0 for no limit). The quota is left unchanged unless a limit is given."`
+	MaxActiveContainers *int `long:"max-active-containers" description:"Maximum number of containers the team's builds may have on shared workers at once (0 for no limit). The quota is left unchanged unless a limit is given."`
 }
 
 func (command *SetTeamCommand) Validate() ([]concourse.ConfigWarning, error) {
@@ -43,9 +47,35 @@ func (command *SetTeamCommand) Validate() ([]concourse.ConfigWarning, error) {
 			Message: warning.Message,
 		})
 	}
+
+	if quota := command.quota(); quota != nil {
+		err = quota.Validate()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return warnings, nil
 }
 
+// quota returns the quota given on the command line, or nil if no limit was
+// given at all, in which case the team's current quota is left alone.
+func (command *SetTeamCommand) quota() *atc.TeamQuota {
+	if command.MaxActiveTasks == nil && command.MaxActiveContainers == nil {
+		return nil
+	}
+
+	var quota atc.TeamQuota
+	if command.MaxActiveTasks != nil {
+		quota.MaxActiveTasks = *command.MaxActiveTasks
+	}
+	if command.MaxActiveContainers != nil {
+		quota.MaxActiveContainers = *command.MaxActiveContainers
+	}
+
+	return &quota
+}
+
 func (command *SetTeamCommand) Execute([]string) error {
 	warnings, err := command.Validate()
 	if err != nil {
@@ -103,6 +133,19 @@ func (command *SetTeamCommand) Execute([]string) error {
 		}
 	}
 
+	quota := command.quota()
+
+	fmt.Println()
+	fmt.Printf("quota:\n")
+	if quota == nil {
+		fmt.Printf("  %s\n", ui.OffColor.Sprint("unchanged"))
+	} else if quota.IsZero() {
+		fmt.Printf("  %s\n", ui.OffColor.Sprint("none"))
+	} else {
+		fmt.Printf("  max active tasks: %s\n", formatQuotaLimit(quota.MaxActiveTasks))
+		fmt.Printf("  max active containers: %s\n", formatQuotaLimit(quota.MaxActiveContainers))
+	}
+
 	if len(warnings) > 0 {
 		displayhelpers.ShowWarnings(warnings)
 	}
@@ -120,7 +163,7 @@ func (command *SetTeamCommand) Execute([]string) error {
 		displayhelpers.Failf("bailing out")
 	}
 
-	team := atc.Team{Auth: authRoles}
+	team := atc.Team{Auth: authRoles, Quota: quota}
 
 	_, created, updated, warnings, err := target.Client().Team(teamName).CreateOrUpdate(team)
 	if err != nil {
@@ -139,3 +182,11 @@ func (command *SetTeamCommand) Execute([]string) error {
 
 	return nil
 }
+
+func formatQuotaLimit(limit int) string {
+	if limit == 0 {
+		return ui.OffColor.Sprint("unlimited")
+	}
+
+	return strconv.Itoa(limit)
+}
@@ -0,0 +1,117 @@
+package commands
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/concourse/concourse/skymarshal/skycmd"
+	"github.com/vito/go-interact/interact"
+)
+
+type SetTeamsCommand struct {
+	Config          atc.PathFlag `short:"c" long:"config" required:"true" description:"Configuration file listing the roles and quota of every team"`
+	DeleteUnlisted  bool         `long:"delete-unlisted" description:"Destroy teams which are not listed in the configuration file, along with all of their data"`
+	SkipInteractive bool         `long:"non-interactive" description:"Force apply configuration"`
+}
+
+func (command *SetTeamsCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	teams, err := skycmd.FormatTeamsFile(string(command.Config))
+	if err != nil {
+		return err
+	}
+
+	err = teams.Validate()
+	if err != nil {
+		return err
+	}
+
+	var warnings []concourse.ConfigWarning
+	for _, team := range teams {
+		warning, err := atc.ValidateIdentifier(team.Name, "team")
+		if err != nil {
+			return err
+		}
+		if warning != nil {
+			warnings = append(warnings, concourse.ConfigWarning{
+				Type:    warning.Type,
+				Message: warning.Message,
+			})
+		}
+	}
+
+	existingTeams, err := target.Client().ListTeams()
+	if err != nil {
+		return err
+	}
+
+	// teams which are not listed are only part of the diff if they are going
+	// to be destroyed
+	var currentTeams atc.Teams
+	for _, team := range existingTeams {
+		if _, found := teams.Lookup(team.Name); found || command.DeleteUnlisted {
+			currentTeams = append(currentTeams, team)
+		}
+	}
+
+	diffExists := currentTeams.Diff(os.Stdout, teams)
+
+	if len(warnings) > 0 {
+		displayhelpers.ShowWarnings(warnings)
+	}
+
+	if !diffExists {
+		fmt.Println("no changes to apply")
+		return nil
+	}
+
+	confirm := true
+	if !command.SkipInteractive {
+		confirm = false
+		err = interact.NewInteraction("\napply teams configuration?").Resolve(&confirm)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !confirm {
+		displayhelpers.Failf("bailing out")
+	}
+
+	result, warnings, err := target.Client().SetTeams(teams, command.DeleteUnlisted)
+	if err != nil {
+		return err
+	}
+
+	if len(warnings) > 0 {
+		displayhelpers.ShowWarnings(warnings)
+	}
+
+	for _, name := range result.Created {
+		fmt.Printf("team %s created\n", ui.Embolden("%s", name))
+	}
+
+	for _, name := range result.Updated {
+		fmt.Printf("team %s updated\n", ui.Embolden("%s", name))
+	}
+
+	for _, name := range result.Deleted {
+		fmt.Printf("team %s destroyed\n", ui.Embolden("%s", name))
+	}
+
+	return nil
+}
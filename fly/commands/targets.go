@@ -11,7 +11,18 @@ import (
 	"github.com/fatih/color"
 )
 
-type TargetsCommand struct{}
+type TargetsCommand struct {
+	ui.OutputFlags
+}
+
+// presentedTarget is how a target is printed in structured output, leaving
+// out its token so that it does not leak into logs.
+type presentedTarget struct {
+	Name   string     `json:"name"`
+	URL    string     `json:"url"`
+	Team   string     `json:"team"`
+	Expiry *time.Time `json:"expiry,omitempty"`
+}
 
 func (command *TargetsCommand) Execute([]string) error {
 	targets, err := rc.LoadTargets()
@@ -19,6 +30,33 @@ func (command *TargetsCommand) Execute([]string) error {
 		return err
 	}
 
+	if format := command.Format(); !format.IsTable() {
+		presented := []presentedTarget{}
+		for targetName, targetValues := range targets {
+			t := presentedTarget{
+				Name: string(targetName),
+				URL:  targetValues.API,
+				Team: targetValues.TeamName,
+			}
+
+			if targetValues.Token != nil && targetValues.Token.Value != "" {
+				expiry, err := token.Factory{}.ParseExpiry(targetValues.Token.Value)
+				if err == nil {
+					expiry = expiry.UTC()
+					t.Expiry = &expiry
+				}
+			}
+
+			presented = append(presented, t)
+		}
+
+		sort.Slice(presented, func(i, j int) bool {
+			return presented[i].Name < presented[j].Name
+		})
+
+		return format.Print(os.Stdout, presented)
+	}
+
 	table := ui.Table{
 		Headers: ui.TableRow{
 			{Contents: "name", Color: color.New(color.Bold)},
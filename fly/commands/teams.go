@@ -7,15 +7,15 @@ import (
 
 	"strings"
 
-	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/fatih/color"
 )
 
 type TeamsCommand struct {
-	Json    bool `long:"json" description:"Print command result as JSON"`
 	Details bool `short:"d" long:"details" description:"Print authentication configuration"`
+
+	ui.OutputFlags
 }
 
 func (command *TeamsCommand) Execute([]string) error {
@@ -34,12 +34,8 @@ func (command *TeamsCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(teams)
-		if err != nil {
-			return err
-		}
-		return nil
+	if format := command.Format(); !format.IsTable() {
+		return format.Print(os.Stdout, teams)
 	}
 
 	var headers ui.TableRow
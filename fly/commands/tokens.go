@@ -0,0 +1,84 @@
+package commands
+
+import (
+	"os"
+	"time"
+
+	"github.com/concourse/concourse/fly/rc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/fatih/color"
+)
+
+type TokensCommand struct {
+	Team string `long:"team" description:"Name of the team whose tokens to list, if different from the target default"`
+
+	ui.OutputFlags
+}
+
+func (command *TokensCommand) Execute([]string) error {
+	target, err := rc.LoadTarget(Fly.Target, Fly.Verbose)
+	if err != nil {
+		return err
+	}
+
+	err = target.Validate()
+	if err != nil {
+		return err
+	}
+
+	var team concourse.Team
+	if command.Team != "" {
+		team, err = target.FindTeam(command.Team)
+		if err != nil {
+			return err
+		}
+	} else {
+		team = target.Team()
+	}
+
+	tokens, err := team.APITokens()
+	if err != nil {
+		return err
+	}
+
+	if format := command.Format(); !format.IsTable() {
+		return format.Print(os.Stdout, tokens)
+	}
+
+	table := ui.Table{
+		Headers: ui.TableRow{
+			{Contents: "name", Color: color.New(color.Bold)},
+			{Contents: "role", Color: color.New(color.Bold)},
+			{Contents: "created by", Color: color.New(color.Bold)},
+			{Contents: "created at", Color: color.New(color.Bold)},
+			{Contents: "expires at", Color: color.New(color.Bold)},
+		},
+	}
+
+	for _, token := range tokens {
+		createdBy := ui.TableCell{Contents: token.CreatedBy}
+		if token.CreatedBy == "" {
+			createdBy = ui.TableCell{Contents: "n/a", Color: color.New(color.Faint)}
+		}
+
+		expiresAt := ui.TableCell{Contents: "never", Color: color.New(color.Faint)}
+		if token.ExpiresAt != 0 {
+			expiry := time.Unix(token.ExpiresAt, 0)
+			expiresAt = ui.TableCell{Contents: expiry.Format(timeDateLayout)}
+			if expiry.Before(time.Now()) {
+				expiresAt.Color = color.New(color.FgRed)
+			}
+		}
+
+		table.Data = append(table.Data, ui.TableRow{
+			{Contents: token.Name},
+			{Contents: token.Role},
+			createdBy,
+			{Contents: time.Unix(token.CreatedAt, 0).Format(timeDateLayout)},
+			expiresAt,
+		})
+	}
+
+	return table.Render(os.Stdout, Fly.PrintTableHeaders)
+}
@@ -5,14 +5,13 @@ import (
 	"sort"
 	"strings"
 
-	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/fatih/color"
 )
 
 type UserinfoCommand struct {
-	Json bool `long:"json" description:"Print command result as JSON"`
+	ui.OutputFlags
 }
 
 func (command *UserinfoCommand) Execute([]string) error {
@@ -31,12 +30,8 @@ func (command *UserinfoCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(userinfo)
-		if err != nil {
-			return err
-		}
-		return nil
+	if format := command.Format(); !format.IsTable() {
+		return format.Print(os.Stdout, userinfo)
 	}
 
 	headers := ui.TableRow{
@@ -5,7 +5,6 @@ import (
 	"os"
 	"time"
 
-	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/fatih/color"
@@ -15,7 +14,8 @@ const inputDateLayout = "2006-01-02"
 
 type ActiveUsersCommand struct {
 	Since string `long:"since" description:"Start date range of returned users' last login, defaults to 2 months from today'"`
-	Json  bool   `long:"json" description:"Print command result as JSON"`
+
+	ui.OutputFlags
 }
 
 func (command *ActiveUsersCommand) Execute([]string) error {
@@ -47,12 +47,8 @@ func (command *ActiveUsersCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(users)
-		if err != nil {
-			return err
-		}
-		return nil
+	if format := command.Format(); !format.IsTable() {
+		return format.Print(os.Stdout, users)
 	}
 
 	headers := ui.TableRow{
@@ -9,7 +9,6 @@ import (
 	"sigs.k8s.io/yaml"
 
 	"github.com/concourse/concourse/atc"
-	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/fatih/color"
@@ -17,7 +16,8 @@ import (
 
 type VolumesCommand struct {
 	Details bool `short:"d" long:"details" description:"Print additional information for each volume"`
-	Json    bool `long:"json" description:"Print command result as JSON"`
+
+	ui.OutputFlags
 }
 
 func (command *VolumesCommand) Execute([]string) error {
@@ -36,12 +36,8 @@ func (command *VolumesCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(volumes)
-		if err != nil {
-			return err
-		}
-		return nil
+	if format := command.Format(); !format.IsTable() {
+		return format.Print(os.Stdout, volumes)
 	}
 
 	table := ui.Table{
@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"errors"
 	"fmt"
 	"net/url"
 	"os"
@@ -18,6 +19,9 @@ type WatchCommand struct {
 	Url                      string              `short:"u" long:"url"                                    description:"URL for the build or job to watch"`
 	Timestamp                bool                `short:"t" long:"timestamps"                             description:"Print with local timestamp"`
 	IgnoreEventParsingErrors bool                `long:"ignore-event-parsing-errors"                      description:"Ignore event parsing errors"`
+
+	Team      string   `long:"team"                             description:"Watches all builds of the given team as they are created, started and finished, narrowed down by --job and --pipeline"`
+	Pipelines []string `short:"p" long:"pipeline" value-name:"NAME" description:"Only watch the builds of these pipelines (with --team)"`
 }
 
 func getBuildIDFromURL(target rc.Target, urlParam string) (int, error) {
@@ -83,6 +87,14 @@ func (command *WatchCommand) Execute(args []string) error {
 		return err
 	}
 
+	if command.Team != "" {
+		return command.watchTeam(target)
+	}
+
+	if len(command.Pipelines) > 0 {
+		return errors.New("--pipeline can only be used with --team")
+	}
+
 	var buildId int
 	client := target.Client()
 	if command.Job.JobName != "" || command.Build == "" && command.Url == "" {
@@ -123,3 +135,39 @@ func (command *WatchCommand) Execute(args []string) error {
 
 	return nil
 }
+
+func (command *WatchCommand) watchTeam(target rc.Target) error {
+	if command.Build != "" || command.Url != "" {
+		return errors.New("--team can not be used with --build or --url")
+	}
+
+	team, err := target.FindTeam(command.Team)
+	if err != nil {
+		return err
+	}
+
+	pipelines := command.Pipelines
+
+	var jobs []string
+	if command.Job.JobName != "" {
+		pipelines = append(pipelines, command.Job.PipelineRef.Name)
+		jobs = append(jobs, command.Job.JobName)
+	}
+
+	stream, err := team.BuildLifecycleEvents(pipelines, jobs)
+	if err != nil {
+		return err
+	}
+
+	renderOptions := eventstream.RenderOptions{
+		ShowTimestamp: command.Timestamp,
+	}
+
+	exitCode := eventstream.RenderBuildLifecycle(os.Stdout, stream, renderOptions)
+
+	stream.Close()
+
+	os.Exit(exitCode)
+
+	return nil
+}
@@ -9,7 +9,6 @@ import (
 	"time"
 
 	"github.com/concourse/concourse/atc"
-	"github.com/concourse/concourse/fly/commands/internal/displayhelpers"
 	"github.com/concourse/concourse/fly/rc"
 	"github.com/concourse/concourse/fly/ui"
 	"github.com/fatih/color"
@@ -17,7 +16,8 @@ import (
 
 type WorkersCommand struct {
 	Details bool `short:"d" long:"details" description:"Print additional information for each worker"`
-	Json    bool `long:"json" description:"Print command result as JSON"`
+
+	ui.OutputFlags
 }
 
 func (command *WorkersCommand) Execute([]string) error {
@@ -36,12 +36,8 @@ func (command *WorkersCommand) Execute([]string) error {
 		return err
 	}
 
-	if command.Json {
-		err = displayhelpers.JsonPrint(workers)
-		if err != nil {
-			return err
-		}
-		return nil
+	if format := command.Format(); !format.IsTable() {
+		return format.Print(os.Stdout, workers)
 	}
 
 	sort.Sort(byWorkerName(workers))
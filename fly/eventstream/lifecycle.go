@@ -0,0 +1,56 @@
+package eventstream
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/concourse/concourse/go-concourse/concourse/eventstream"
+)
+
+// RenderBuildLifecycle prints a line for each build which is created,
+// started or finished, until the stream ends.
+func RenderBuildLifecycle(dst io.Writer, src eventstream.BuildLifecycleStream, options RenderOptions) int {
+	dstImpl := NewTimestampedWriter(dst, options.ShowTimestamp)
+
+	for {
+		ev, err := src.NextEvent()
+		if err != nil {
+			if err == io.EOF {
+				return 0
+			}
+
+			dstImpl.SetTimestamp(0)
+			fmt.Fprintf(dstImpl, "failed to get next event: %s\n", ui.ErroredColor.Sprint(err))
+			return 255
+		}
+
+		switch ev.Type {
+		case atc.BuildStarted:
+			dstImpl.SetTimestamp(ev.Build.StartTime)
+		case atc.BuildFinished:
+			dstImpl.SetTimestamp(ev.Build.EndTime)
+		default:
+			dstImpl.SetTimestamp(time.Now().Unix())
+		}
+
+		status := ui.BuildStatusCell(ev.Build.Status)
+
+		fmt.Fprintf(dstImpl, "%-8s %s %s\n", ev.Type, buildDisplayName(ev.Build), status.Color.Sprint(status.Contents))
+	}
+}
+
+func buildDisplayName(build atc.Build) string {
+	if build.JobName == "" {
+		return fmt.Sprintf("one-off #%d", build.ID)
+	}
+
+	pipelineRef := atc.PipelineRef{
+		Name:         build.PipelineName,
+		InstanceVars: build.PipelineInstanceVars,
+	}
+
+	return fmt.Sprintf("%s/%s #%s", pipelineRef.String(), build.JobName, build.Name)
+}
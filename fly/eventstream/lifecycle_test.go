@@ -0,0 +1,88 @@
+package eventstream_test
+
+import (
+	"errors"
+	"io"
+
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/eventstream"
+	"github.com/concourse/concourse/go-concourse/concourse/eventstream/eventstreamfakes"
+)
+
+var _ = Describe("RenderBuildLifecycle", func() {
+	var (
+		out    *gbytes.Buffer
+		stream *eventstreamfakes.FakeBuildLifecycleStream
+
+		exitStatus int
+	)
+
+	BeforeEach(func() {
+		color.NoColor = true
+		out = gbytes.NewBuffer()
+		stream = new(eventstreamfakes.FakeBuildLifecycleStream)
+
+		stream.NextEventReturnsOnCall(0, atc.BuildLifecycleEvent{
+			Type: atc.BuildCreated,
+			Build: atc.Build{
+				ID:           1,
+				Name:         "12",
+				Status:       atc.StatusPending,
+				PipelineName: "some-pipeline",
+				JobName:      "some-job",
+			},
+		}, nil)
+
+		stream.NextEventReturnsOnCall(1, atc.BuildLifecycleEvent{
+			Type: atc.BuildFinished,
+			Build: atc.Build{
+				ID:                   2,
+				Name:                 "3",
+				Status:               atc.StatusFailed,
+				PipelineName:         "other-pipeline",
+				PipelineInstanceVars: atc.InstanceVars{"branch": "feature"},
+				JobName:              "other-job",
+			},
+		}, nil)
+
+		stream.NextEventReturnsOnCall(2, atc.BuildLifecycleEvent{
+			Type:  atc.BuildStarted,
+			Build: atc.Build{ID: 3, Name: "3", Status: atc.StatusStarted},
+		}, nil)
+
+		stream.NextEventReturns(atc.BuildLifecycleEvent{}, io.EOF)
+	})
+
+	AfterEach(func() {
+		color.NoColor = false
+	})
+
+	JustBeforeEach(func() {
+		exitStatus = eventstream.RenderBuildLifecycle(out, stream, eventstream.RenderOptions{})
+	})
+
+	It("prints a line for each event", func() {
+		Expect(out.Contents()).To(BeEquivalentTo("" +
+			"created  some-pipeline/some-job #12 pending\n" +
+			"finished other-pipeline/branch:feature/other-job #3 failed\n" +
+			"started  one-off #3 started\n"))
+
+		Expect(exitStatus).To(Equal(0))
+	})
+
+	Context("when the stream fails", func() {
+		BeforeEach(func() {
+			stream.NextEventReturns(atc.BuildLifecycleEvent{}, errors.New("connection reset"))
+		})
+
+		It("prints the error and exits 255", func() {
+			Expect(out).To(gbytes.Say("failed to get next event: connection reset"))
+			Expect(exitStatus).To(Equal(255))
+		})
+	})
+})
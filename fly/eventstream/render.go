@@ -42,7 +42,11 @@ func Render(dst io.Writer, src eventstream.EventStream, options RenderOptions) i
 
 		case event.WaitingForWorker:
 			dstImpl.SetTimestamp(e.Time)
-			fmt.Fprintf(dstImpl, "\x1b[1mno suitable workers found, waiting for worker...\x1b[0m\n")
+			if e.Reason != "" {
+				fmt.Fprintf(dstImpl, "\x1b[1m%s, waiting for worker...\x1b[0m\n", e.Reason)
+			} else {
+				fmt.Fprintf(dstImpl, "\x1b[1mno suitable workers found, waiting for worker...\x1b[0m\n")
+			}
 
 		case event.SelectedWorker:
 			dstImpl.SetTimestamp(e.Time)
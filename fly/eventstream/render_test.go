@@ -296,6 +296,19 @@ var _ = Describe("V1.0 Renderer", func() {
 		})
 	})
 
+	Context("when a WaitingForWorker event with a reason is received", func() {
+		BeforeEach(func() {
+			receivedEvents <- event.WaitingForWorker{
+				Time:   time.Now().Unix(),
+				Reason: "team has reached its quota of 2 active tasks on shared workers",
+			}
+		})
+
+		It("prints the reason", func() {
+			Expect(out.Contents()).To(ContainSubstring("\x1b[1mteam has reached its quota of 2 active tasks on shared workers, waiting for worker...\x1b[0m\n"))
+		})
+	})
+
 	Context("when a WaitingForWorker event is received", func() {
 		BeforeEach(func() {
 			receivedEvents <- event.WaitingForWorker{
@@ -0,0 +1,176 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("tokens", func() {
+		var createdAt time.Time
+
+		BeforeEach(func() {
+			createdAt = time.Date(2021, 7, 13, 10, 0, 0, 0, time.Local)
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/tokens"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.APIToken{
+						{
+							Name:      "ci",
+							TeamName:  "main",
+							Role:      "member",
+							CreatedBy: "some-user",
+							CreatedAt: createdAt.Unix(),
+							ExpiresAt: createdAt.Add(time.Hour).Unix(),
+						},
+						{
+							Name:      "deployer",
+							TeamName:  "main",
+							Role:      "releaser",
+							CreatedAt: createdAt.Unix(),
+						},
+					}),
+				),
+			)
+		})
+
+		It("lists the tokens without their values", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "tokens")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(PrintTable(ui.Table{
+				Headers: ui.TableRow{
+					{Contents: "name", Color: color.New(color.Bold)},
+					{Contents: "role", Color: color.New(color.Bold)},
+					{Contents: "created by", Color: color.New(color.Bold)},
+					{Contents: "created at", Color: color.New(color.Bold)},
+					{Contents: "expires at", Color: color.New(color.Bold)},
+				},
+				Data: []ui.TableRow{
+					{
+						{Contents: "ci"},
+						{Contents: "member"},
+						{Contents: "some-user"},
+						{Contents: createdAt.Format("2006-01-02@15:04:05-0700")},
+						{Contents: createdAt.Add(time.Hour).Format("2006-01-02@15:04:05-0700"), Color: color.New(color.FgRed)},
+					},
+					{
+						{Contents: "deployer"},
+						{Contents: "releaser"},
+						{Contents: "n/a", Color: color.New(color.Faint)},
+						{Contents: createdAt.Format("2006-01-02@15:04:05-0700")},
+						{Contents: "never", Color: color.New(color.Faint)},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("create-token", func() {
+		var received atc.APIToken
+
+		BeforeEach(func() {
+			received = atc.APIToken{}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/teams/main/tokens"),
+					func(w http.ResponseWriter, r *http.Request) {
+						err := json.NewDecoder(r.Body).Decode(&received)
+						Expect(err).NotTo(HaveOccurred())
+					},
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, atc.APIToken{
+						ID:       42,
+						Name:     "ci",
+						TeamName: "main",
+						Role:     "member",
+						Token:    "concourse_some-value",
+					}),
+				),
+			)
+		})
+
+		It("prints the token's value", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "create-token", "-n", "ci", "-r", "member")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("token 'ci' created with role 'member' on team 'main'"))
+			Expect(sess.Out).To(gbytes.Say("concourse_some-value"))
+
+			Expect(received).To(Equal(atc.APIToken{Name: "ci", Role: "member"}))
+		})
+
+		It("sets the expiry relative to now", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "create-token", "-n", "ci", "-r", "member", "--expires-in", "24h")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(time.Unix(received.ExpiresAt, 0)).To(BeTemporally("~", time.Now().Add(24*time.Hour), time.Minute))
+		})
+	})
+
+	Describe("revoke-token", func() {
+		var status int
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/main/tokens/ci"),
+					ghttp.RespondWith(status, nil),
+				),
+			)
+		})
+
+		Context("when the token exists", func() {
+			BeforeEach(func() {
+				status = http.StatusNoContent
+			})
+
+			It("revokes it", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "revoke-token", "-n", "ci")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("token 'ci' revoked"))
+			})
+		})
+
+		Context("when the token does not exist", func() {
+			BeforeEach(func() {
+				status = http.StatusNotFound
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "revoke-token", "-n", "ci")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("token 'ci' not found"))
+			})
+		})
+	})
+})
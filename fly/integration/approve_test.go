@@ -0,0 +1,212 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("Approve and Reject", func() {
+	var (
+		expectedBuild = atc.Build{
+			ID:      23,
+			Name:    "42",
+			Status:  "started",
+			JobName: "my-job",
+			APIURL:  "api/v1/builds/23",
+		}
+
+		plan string
+	)
+
+	publicPlan := func() atc.PublicBuildPlan {
+		raw := json.RawMessage(plan)
+		return atc.PublicBuildPlan{Schema: "exec.v2", Plan: &raw}
+	}
+
+	BeforeEach(func() {
+		plan = `{
+			"id": "1",
+			"do": [
+				{"id": "2", "approval": {"name": "deploy"}},
+				{"id": "3", "approval": {"name": "release", "roles": ["owner"]}}
+			]
+		}`
+	})
+
+	Context("when the step is specified", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23/plan"),
+					func(w http.ResponseWriter, r *http.Request) {
+						json.NewEncoder(w).Encode(publicPlan())
+					},
+				),
+			)
+		})
+
+		It("approves the step", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/builds/23/approvals/3"),
+					ghttp.VerifyJSON(`{"approved":true,"comment":"lgtm"}`),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "approve", "-b", "23", "-s", "release", "-c", "lgtm")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("step 'release' approved"))
+		})
+
+		It("rejects the step", func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/builds/23/approvals/2"),
+					ghttp.VerifyJSON(`{"approved":false}`),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+
+			flyCmd := exec.Command(flyPath, "-t", targetName, "reject", "-b", "23", "-s", "deploy")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("step 'deploy' rejected"))
+		})
+
+		Context("when the step has already been decided", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/builds/23/approvals/2"),
+						ghttp.RespondWith(http.StatusConflict, "step has already been decided"),
+					),
+				)
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "approve", "-b", "23", "-s", "deploy")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("step has already been decided"))
+			})
+		})
+
+		Context("when the requester is not allowed to decide the step", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/builds/23/approvals/3"),
+						ghttp.RespondWith(http.StatusForbidden, ""),
+					),
+				)
+			})
+
+			It("fails", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "approve", "-b", "23", "-s", "release")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("forbidden"))
+			})
+		})
+	})
+
+	Context("when the build has more than one approval step and none is specified", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23/plan"),
+					func(w http.ResponseWriter, r *http.Request) {
+						json.NewEncoder(w).Encode(publicPlan())
+					},
+				),
+			)
+		})
+
+		It("asks the user to specify the step", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "approve", "-b", "23")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("build has more than one wait_for_approval step, specify one with --step"))
+		})
+	})
+
+	Context("when the build is specified by job", func() {
+		BeforeEach(func() {
+			plan = `{"id": "1", "approval": {"name": "deploy"}}`
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/my-pipeline/jobs/my-job/builds/42"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23/plan"),
+					func(w http.ResponseWriter, r *http.Request) {
+						json.NewEncoder(w).Encode(publicPlan())
+					},
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/builds/23/approvals/1"),
+					ghttp.RespondWith(http.StatusNoContent, ""),
+				),
+			)
+		})
+
+		It("approves the only approval step", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "approve", "-j", "my-pipeline/my-job", "-b", "42")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("step 'deploy' approved"))
+		})
+	})
+
+	Context("when the build id is not specified", func() {
+		It("asks the user to specify a build id", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "reject")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("error: the required flag `" + osFlag("b", "build") + "' was not specified"))
+		})
+	})
+})
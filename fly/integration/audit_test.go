@@ -0,0 +1,153 @@
+package integration_test
+
+import (
+	"os/exec"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("audit", func() {
+		var (
+			flyCmd *exec.Cmd
+			events []atc.AuditEvent
+		)
+
+		BeforeEach(func() {
+			flyCmd = exec.Command(flyPath, "-t", targetName, "audit")
+
+			events = []atc.AuditEvent{
+				{
+					ID:     3,
+					Time:   time.Date(2021, 7, 11, 11, 0, 0, 0, time.Local).Unix(),
+					User:   "some-user",
+					Team:   "main",
+					Action: atc.HijackContainer,
+					Target: "/api/v1/containers/some-handle/hijack",
+				},
+				{
+					ID:     2,
+					Time:   time.Date(2021, 7, 11, 10, 0, 0, 0, time.Local).Unix(),
+					User:   "some-user",
+					Team:   "main",
+					Action: atc.SaveConfig,
+					Target: "/api/v1/teams/main/pipelines/some-pipeline/config",
+					Status: 200,
+				},
+				{
+					ID:     1,
+					Time:   time.Date(2021, 7, 11, 9, 0, 0, 0, time.Local).Unix(),
+					User:   "other-user",
+					Action: atc.SetWall,
+					Target: "/api/v1/wall",
+					Status: 403,
+				},
+			}
+		})
+
+		Context("when the events are returned from the API", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit", "limit=50"),
+						ghttp.RespondWithJSONEncoded(200, events),
+					),
+				)
+			})
+
+			It("shows the events", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "time", Color: color.New(color.Bold)},
+						{Contents: "user", Color: color.New(color.Bold)},
+						{Contents: "team", Color: color.New(color.Bold)},
+						{Contents: "action", Color: color.New(color.Bold)},
+						{Contents: "target", Color: color.New(color.Bold)},
+						{Contents: "status", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: time.Unix(events[0].Time, 0).Format("2006-01-02@15:04:05-0700")},
+							{Contents: "some-user"},
+							{Contents: "main"},
+							{Contents: "HijackContainer"},
+							{Contents: "/api/v1/containers/some-handle/hijack"},
+							{Contents: "n/a", Color: color.New(color.Faint)},
+						},
+						{
+							{Contents: time.Unix(events[1].Time, 0).Format("2006-01-02@15:04:05-0700")},
+							{Contents: "some-user"},
+							{Contents: "main"},
+							{Contents: "SaveConfig"},
+							{Contents: "/api/v1/teams/main/pipelines/some-pipeline/config"},
+							{Contents: "200"},
+						},
+						{
+							{Contents: time.Unix(events[2].Time, 0).Format("2006-01-02@15:04:05-0700")},
+							{Contents: "other-user"},
+							{Contents: "n/a", Color: color.New(color.Faint)},
+							{Contents: "SetWall"},
+							{Contents: "/api/v1/wall"},
+							{Contents: "403", Color: color.New(color.FgRed)},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when filters are given", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--team", "main", "--user", "some-user", "--action", "SaveConfig", "--since", "2021-07-11 09:30:00", "-c", "10")
+
+				since := time.Date(2021, 7, 11, 9, 30, 0, 0, time.Local).Unix()
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit"),
+						ghttp.VerifyFormKV("team", "main"),
+						ghttp.VerifyFormKV("user", "some-user"),
+						ghttp.VerifyFormKV("action", "SaveConfig"),
+						ghttp.VerifyFormKV("since", strconv.FormatInt(since, 10)),
+						ghttp.VerifyFormKV("limit", "10"),
+						ghttp.RespondWithJSONEncoded(200, events[1:2]),
+					),
+				)
+			})
+
+			It("sends them to the API", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say("SaveConfig"))
+			})
+		})
+
+		Context("when the since time is malformed", func() {
+			BeforeEach(func() {
+				flyCmd.Args = append(flyCmd.Args, "--since", "yesterday")
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("since time should be in the format: 2006-01-02 15:04:05"))
+			})
+		})
+	})
+})
@@ -0,0 +1,162 @@
+package integration_test
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("DownloadArtifact", func() {
+	var (
+		outputDir string
+		tarball   []byte
+	)
+
+	expectedBuild := atc.Build{
+		ID:      23,
+		Name:    "42",
+		Status:  "succeeded",
+		JobName: "myjob",
+		APIURL:  "api/v1/builds/23",
+	}
+
+	BeforeEach(func() {
+		var err error
+		outputDir, err = ioutil.TempDir("", "fly-download-artifact")
+		Expect(err).NotTo(HaveOccurred())
+
+		buf := new(bytes.Buffer)
+		gw := gzip.NewWriter(buf)
+		tw := tar.NewWriter(gw)
+
+		contents := []byte("some-contents")
+		Expect(tw.WriteHeader(&tar.Header{
+			Name: "some-file",
+			Mode: 0644,
+			Size: int64(len(contents)),
+		})).To(Succeed())
+		_, err = tw.Write(contents)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(tw.Close()).To(Succeed())
+		Expect(gw.Close()).To(Succeed())
+
+		tarball = buf.Bytes()
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(outputDir)
+	})
+
+	Context("when the build archived the artifact", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23/artifacts/some-output"),
+					ghttp.RespondWith(http.StatusOK, tarball),
+				),
+			)
+		})
+
+		It("extracts the artifact into the output directory", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "download-artifact", "-b", "23", "-a", "some-output", "-o", outputDir)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("downloaded artifact 'some-output' to " + outputDir))
+
+			Expect(ioutil.ReadFile(filepath.Join(outputDir, "some-file"))).To(Equal([]byte("some-contents")))
+		})
+	})
+
+	Context("when the job is specified", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/my-pipeline/jobs/myjob/builds/42"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23/artifacts/some-output"),
+					ghttp.RespondWith(http.StatusOK, tarball),
+				),
+			)
+		})
+
+		It("downloads the artifact of the job's build", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "download-artifact", "-j", "my-pipeline/myjob", "-b", "42", "-a", "some-output", "-o", outputDir)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(ioutil.ReadFile(filepath.Join(outputDir, "some-file"))).To(Equal([]byte("some-contents")))
+		})
+	})
+
+	Context("when the artifact was not archived", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedBuild),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23/artifacts/some-output"),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("errors", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "download-artifact", "-b", "23", "-a", "some-output", "-o", outputDir)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("artifact 'some-output' was not archived by build 23"))
+		})
+	})
+
+	Context("when the build does not exist", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/builds/23"),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("errors", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "download-artifact", "-b", "23", "-a", "some-output")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gexec.Exit(1))
+			Expect(sess.Err).To(gbytes.Say("build does not exist"))
+		})
+	})
+})
@@ -795,6 +795,47 @@ run:
 		})
 	})
 
+	Context("when running locally", func() {
+		It("does not support --inputs-from", func() {
+			flyCmd := exec.Command(flyPath, "e", "--local", "-c", taskConfigPath, "-j", "some-pipeline/some-job")
+			flyCmd.Dir = buildDir
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Err).Should(gbytes.Say("--inputs-from can not be used with --local"))
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+		})
+
+		It("does not support --image", func() {
+			flyCmd := exec.Command(flyPath, "e", "--local", "-c", taskConfigPath, "--image", "some-image")
+			flyCmd.Dir = buildDir
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Err).Should(gbytes.Say("--image can not be used with --local"))
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+		})
+
+		It("validates the inputs", func() {
+			flyCmd := exec.Command(flyPath, "e", "--local", "-c", taskConfigPath, "-i", "fixture=.", "-i", "evan=.")
+			flyCmd.Dir = buildDir
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess.Err).Should(gbytes.Say("unknown input `evan`"))
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+		})
+	})
+
 	Context("when the task specifies no input", func() {
 		BeforeEach(func() {
 			err := ioutil.WriteFile(
@@ -0,0 +1,146 @@
+package integration_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+	"github.com/fatih/color"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("explain-job", func() {
+		var (
+			flyCmd      *exec.Cmd
+			apiPath     string
+			queryParams string
+			explanation atc.SchedulingExplanation
+		)
+
+		BeforeEach(func() {
+			apiPath = "/api/v1/teams/main/pipelines/pipeline/jobs/some-job/scheduling-explanation"
+			queryParams = "vars.branch=%22master%22"
+
+			explanation = atc.SchedulingExplanation{
+				Resolved: false,
+				Inputs: []atc.InputExplanation{
+					{
+						Name:     "some-input",
+						Resource: "some-resource",
+						Trigger:  true,
+						Version:  atc.Version{"ref": "abc", "branch": "master"},
+					},
+					{
+						Name:             "other-input",
+						Resource:         "other-resource",
+						Passed:           []string{"upstream-job"},
+						DisabledVersions: []atc.Version{{"ref": "def"}},
+						ResolveError:     "no satisfiable builds from passed jobs found for set of inputs",
+						FailedPassed:     []string{"upstream-job"},
+					},
+				},
+				Paused:        true,
+				PendingBuilds: 1,
+				BlockedBy:     []string{"job is paused"},
+			}
+
+			flyCmd = exec.Command(flyPath, "-t", targetName, "explain-job", "-j", "pipeline/branch:master/some-job")
+		})
+
+		Context("when the explanation is returned from the API", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", apiPath, queryParams),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, explanation),
+					),
+				)
+			})
+
+			It("shows how each input resolved", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Data: []ui.TableRow{
+						{{Contents: "some-input"}, {Contents: "some-resource"}, {Contents: "branch:master,ref:abc"}, {Contents: "resolved", Color: color.New(color.FgGreen)}},
+						{{Contents: "other-input"}, {Contents: "other-resource"}, {Contents: "n/a", Color: color.New(color.Faint)}, {Contents: "no satisfiable builds from passed jobs found for set of inputs (passed: upstream-job) (1 disabled versions)", Color: color.New(color.FgRed)}},
+					},
+				}))
+			})
+
+			It("shows what is blocking the job", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("inputs: not resolved"))
+				Expect(sess.Out).To(gbytes.Say("pending builds: 1"))
+				Expect(sess.Out).To(gbytes.Say("blocked by:"))
+				Expect(sess.Out).To(gbytes.Say("job is paused"))
+			})
+
+			Context("when --json is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--json")
+				})
+
+				It("prints the explanation as json", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+					Eventually(sess).Should(gexec.Exit(0))
+
+					var printed atc.SchedulingExplanation
+					Expect(json.Unmarshal(sess.Out.Contents(), &printed)).To(Succeed())
+					Expect(printed).To(Equal(explanation))
+				})
+			})
+		})
+
+		Context("when the job is not found", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", apiPath, queryParams),
+						ghttp.RespondWith(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+				Eventually(sess).Should(gexec.Exit(1))
+
+				Expect(sess.Err).To(gbytes.Say("pipeline/branch:master/some-job not found"))
+			})
+		})
+
+		Context("when the api returns an internal server error", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", apiPath, queryParams),
+						ghttp.RespondWith(http.StatusInternalServerError, ""),
+					),
+				)
+			})
+
+			It("writes an error message to stderr", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Eventually(sess.Err).Should(gbytes.Say("Unexpected Response"))
+			})
+		})
+	})
+})
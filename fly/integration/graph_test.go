@@ -0,0 +1,155 @@
+package integration_test
+
+import (
+	"net/http"
+	"os/exec"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+
+	"github.com/concourse/concourse/atc"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("graph", func() {
+		var graph atc.PipelineGraph
+
+		BeforeEach(func() {
+			graph = atc.PipelineGraph{
+				Nodes: []atc.PipelineGraphNode{
+					{ID: "job:build", Kind: atc.PipelineGraphJob, Name: "build"},
+					{ID: "job:deploy", Kind: atc.PipelineGraphJob, Name: "deploy"},
+					{ID: "resource:repo", Kind: atc.PipelineGraphResource, Name: "repo", ResourceType: "git"},
+				},
+				Edges: []atc.PipelineGraphEdge{
+					{Source: "resource:repo", Target: "job:build", Resource: "repo", Trigger: true},
+					{Source: "job:build", Target: "job:deploy", Resource: "repo"},
+				},
+			}
+		})
+
+		Context("when the pipeline exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/graph", ""),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, graph),
+					),
+				)
+			})
+
+			It("prints the graph in the DOT language", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "graph", "-p", "some-pipeline")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(string(sess.Out.Contents())).To(Equal(`digraph "some-pipeline" {
+  rankdir=LR;
+  "job:build" [label="build", shape=box];
+  "job:deploy" [label="deploy", shape=box];
+  "resource:repo" [label="repo", shape=ellipse];
+  "resource:repo" -> "job:build";
+  "job:build" -> "job:deploy" [label="repo", style=dashed];
+}
+`))
+			})
+
+			It("prints the graph as a Mermaid flowchart", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "graph", "-p", "some-pipeline", "--format", "mermaid")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(string(sess.Out.Contents())).To(Equal(`flowchart LR
+  n0["build"]
+  n1["deploy"]
+  n2(["repo"])
+  n2 --> n0
+  n0 -.->|repo| n1
+`))
+			})
+		})
+
+		Context("when a job has passed constraints on a job in another pipeline", func() {
+			BeforeEach(func() {
+				graph.Nodes = append(graph.Nodes, atc.PipelineGraphNode{
+					ID:       "job:other-pipeline/test",
+					Kind:     atc.PipelineGraphJob,
+					Name:     "test",
+					Pipeline: "other-pipeline",
+				})
+				graph.Edges = append(graph.Edges, atc.PipelineGraphEdge{
+					Source:   "job:other-pipeline/test",
+					Target:   "job:deploy",
+					Resource: "repo",
+				})
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/graph", ""),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, graph),
+					),
+				)
+			})
+
+			It("labels the job with its pipeline", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "graph", "-p", "some-pipeline")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say(`"job:other-pipeline/test" \[label="other-pipeline/test", shape=box, style=dashed\];`))
+			})
+		})
+
+		Context("when traversing from a job", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/graph", "direction=upstream&from=deploy"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, graph),
+					),
+				)
+			})
+
+			It("asks for the part of the graph in that direction", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "graph", "-p", "some-pipeline", "-j", "deploy", "--direction", "upstream")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out).To(gbytes.Say(`digraph "some-pipeline"`))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/main/pipelines/some-pipeline/graph"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "graph", "-p", "some-pipeline")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("pipeline 'some-pipeline' not found"))
+			})
+		})
+	})
+})
@@ -0,0 +1,259 @@
+package integration_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/fatih/color"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/tedsuo/rata"
+	"sigs.k8s.io/yaml"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/fly/ui"
+)
+
+var _ = Describe("Fly CLI", func() {
+	var (
+		firstConfig  atc.Config
+		secondConfig atc.Config
+	)
+
+	BeforeEach(func() {
+		firstConfig = atc.Config{
+			Resources: atc.ResourceConfigs{
+				{Name: "some-resource", Type: "some-type", Source: atc.Source{"uri": "some-uri"}},
+			},
+			Jobs: atc.JobConfigs{
+				{Name: "some-job"},
+			},
+		}
+
+		secondConfig = atc.Config{
+			Resources: atc.ResourceConfigs{
+				{Name: "some-resource", Type: "some-type", Source: atc.Source{"uri": "some-uri"}},
+				{Name: "another-resource", Type: "some-type", Source: atc.Source{"uri": "another-uri"}},
+			},
+			Jobs: atc.JobConfigs{
+				{Name: "some-job"},
+			},
+		}
+	})
+
+	revisionPath := func(revision int) string {
+		path, err := atc.Routes.CreatePathForRoute(atc.GetPipelineConfigRevision, rata.Params{
+			"pipeline_name": "some-pipeline",
+			"team_name":     "main",
+			"revision":      fmt.Sprintf("%d", revision),
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return path
+	}
+
+	Describe("pipeline-history", func() {
+		Context("when listing the revisions", func() {
+			BeforeEach(func() {
+				path, err := atc.Routes.CreatePathForRoute(atc.ListPipelineConfigHistory, rata.Params{
+					"pipeline_name": "some-pipeline",
+					"team_name":     "main",
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", path),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.PipelineConfigRevision{
+							{Revision: 2, CreatedBy: "some-user", CreatedAt: time.Date(2021, 7, 12, 10, 0, 0, 0, time.UTC).Unix()},
+							{Revision: 1, BuildID: 42, CreatedAt: time.Date(2021, 7, 11, 10, 0, 0, 0, time.UTC).Unix()},
+						}),
+					),
+				)
+			})
+
+			It("prints them in a table", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pipeline-history", "-p", "some-pipeline")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(PrintTable(ui.Table{
+					Headers: ui.TableRow{
+						{Contents: "revision", Color: color.New(color.Bold)},
+						{Contents: "created at", Color: color.New(color.Bold)},
+						{Contents: "created by", Color: color.New(color.Bold)},
+						{Contents: "source", Color: color.New(color.Bold)},
+					},
+					Data: []ui.TableRow{
+						{
+							{Contents: "2"},
+							{Contents: time.Date(2021, 7, 12, 10, 0, 0, 0, time.UTC).Local().Format("2006-01-02@15:04:05-0700")},
+							{Contents: "some-user"},
+							{Contents: "api"},
+						},
+						{
+							{Contents: "1"},
+							{Contents: time.Date(2021, 7, 11, 10, 0, 0, 0, time.UTC).Local().Format("2006-01-02@15:04:05-0700")},
+							{Contents: "n/a", Color: color.New(color.Faint)},
+							{Contents: "build 42"},
+						},
+					},
+				}))
+			})
+		})
+
+		Context("when showing a revision", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", revisionPath(2)),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.PipelineConfigRevision{Revision: 2, Config: &secondConfig}),
+					),
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", revisionPath(1)),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, atc.PipelineConfigRevision{Revision: 1, Config: &firstConfig}),
+					),
+				)
+			})
+
+			It("prints the changes it made", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pipeline-history", "-p", "some-pipeline", "-r", "2")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+
+				Expect(sess.Out).To(gbytes.Say("resource another-resource has been added"))
+				Expect(string(sess.Out.Contents())).ToNot(ContainSubstring("some-resource"))
+			})
+		})
+
+		Context("when the revision does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", revisionPath(3)),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "pipeline-history", "-p", "some-pipeline", "-r", "3")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("revision 3 not found"))
+			})
+		})
+	})
+
+	Describe("rollback-pipeline", func() {
+		var saved bool
+
+		BeforeEach(func() {
+			saved = false
+
+			configPath, err := atc.Routes.CreatePathForRoute(atc.GetConfig, rata.Params{"pipeline_name": "some-pipeline", "team_name": "main"})
+			Expect(err).NotTo(HaveOccurred())
+
+			pipelinePath, err := atc.Routes.CreatePathForRoute(atc.GetPipeline, rata.Params{"pipeline_name": "some-pipeline", "team_name": "main"})
+			Expect(err).NotTo(HaveOccurred())
+
+			atcServer.RouteToHandler("GET", revisionPath(1),
+				ghttp.RespondWithJSONEncoded(http.StatusOK, atc.PipelineConfigRevision{Revision: 1, Config: &firstConfig}),
+			)
+
+			atcServer.RouteToHandler("GET", configPath,
+				ghttp.RespondWithJSONEncoded(http.StatusOK, atc.ConfigResponse{Config: secondConfig}, http.Header{atc.ConfigVersionHeader: {"42"}}),
+			)
+
+			atcServer.RouteToHandler("GET", pipelinePath,
+				ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Pipeline{Name: "some-pipeline", TeamName: "main"}),
+			)
+
+			atcServer.RouteToHandler("PUT", configPath,
+				ghttp.CombineHandlers(
+					ghttp.VerifyHeaderKV(atc.ConfigVersionHeader, "42"),
+					func(w http.ResponseWriter, r *http.Request) {
+						var receivedConfig atc.Config
+						err := yaml.Unmarshal(getConfig(r), &receivedConfig)
+						Expect(err).NotTo(HaveOccurred())
+						Expect(receivedConfig).To(Equal(firstConfig))
+
+						saved = true
+
+						w.WriteHeader(http.StatusOK)
+						w.Write([]byte(`{}`))
+					},
+				),
+			)
+		})
+
+		It("shows the diff and applies the revision once confirmed", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "rollback-pipeline", "-p", "some-pipeline", "--to", "1")
+
+			stdin, err := flyCmd.StdinPipe()
+			Expect(err).NotTo(HaveOccurred())
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gbytes.Say("resource another-resource has been removed"))
+			Eventually(sess).Should(gbytes.Say(`apply configuration\? \[yN\]: `))
+			io.WriteString(stdin, "y\n")
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("configuration updated"))
+			Expect(saved).To(BeTrue())
+		})
+
+		It("does not apply the revision when not confirmed", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "rollback-pipeline", "-p", "some-pipeline", "--to", "1")
+
+			stdin, err := flyCmd.StdinPipe()
+			Expect(err).NotTo(HaveOccurred())
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gbytes.Say(`apply configuration\? \[yN\]: `))
+			io.WriteString(stdin, "n\n")
+
+			Eventually(sess).Should(gexec.Exit(0))
+			Expect(sess.Out).To(gbytes.Say("bailing out"))
+			Expect(saved).To(BeFalse())
+		})
+
+		Context("when the revision does not exist", func() {
+			BeforeEach(func() {
+				atcServer.RouteToHandler("GET", revisionPath(2),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				)
+			})
+
+			It("errors", func() {
+				flyCmd := exec.Command(flyPath, "-t", targetName, "rollback-pipeline", "-p", "some-pipeline", "--to", "2", "-n")
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("revision 2 not found"))
+				Expect(saved).To(BeFalse())
+			})
+		})
+	})
+})
@@ -717,6 +717,132 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Describe("quota", func() {
+			Context("when a quota is given", func() {
+				BeforeEach(func() {
+					cmdParams = []string{
+						"--local-user", "brock-obama",
+						"--max-active-tasks", "3",
+					}
+
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+							ghttp.VerifyJSON(`{
+								"auth": {
+									"owner":{
+										"users": ["local:brock-obama"],
+										"groups": []
+									}
+								},
+								"quota": {
+									"max_active_tasks": 3
+								}
+							}`),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+								Name: "venture",
+								ID:   8,
+							}),
+						),
+					)
+				})
+
+				It("shows and sends the quota", func() {
+					stdin, err := flyCmd.StdinPipe()
+					Expect(err).NotTo(HaveOccurred())
+
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say("quota:"))
+					Eventually(sess.Out).Should(gbytes.Say("max active tasks: 3"))
+					Eventually(sess.Out).Should(gbytes.Say("max active containers: unlimited"))
+
+					Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+					yes(stdin)
+
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+			})
+
+			Context("when no quota is given", func() {
+				BeforeEach(func() {
+					cmdParams = []string{"--local-user", "brock-obama"}
+				})
+
+				It("shows that the quota is left unchanged", func() {
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say("quota:"))
+					Eventually(sess.Out).Should(gbytes.Say("unchanged"))
+
+					Eventually(sess).Should(gexec.Exit(1))
+				})
+			})
+
+			Context("when a limit of zero is given", func() {
+				BeforeEach(func() {
+					cmdParams = []string{
+						"--local-user", "brock-obama",
+						"--max-active-tasks", "0",
+					}
+
+					atcServer.AppendHandlers(
+						ghttp.CombineHandlers(
+							ghttp.VerifyRequest("PUT", "/api/v1/teams/venture"),
+							ghttp.VerifyJSON(`{
+								"auth": {
+									"owner":{
+										"users": ["local:brock-obama"],
+										"groups": []
+									}
+								},
+								"quota": {}
+							}`),
+							ghttp.RespondWithJSONEncoded(http.StatusOK, atc.Team{
+								Name: "venture",
+								ID:   8,
+							}),
+						),
+					)
+				})
+
+				It("sends an empty quota to clear it", func() {
+					stdin, err := flyCmd.StdinPipe()
+					Expect(err).NotTo(HaveOccurred())
+
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Out).Should(gbytes.Say("quota:"))
+					Eventually(sess.Out).Should(gbytes.Say("none"))
+
+					Eventually(sess).Should(gbytes.Say(`apply team configuration\? \[yN\]: `))
+					yes(stdin)
+
+					Eventually(sess).Should(gexec.Exit(0))
+				})
+			})
+
+			Context("when the quota is negative", func() {
+				BeforeEach(func() {
+					cmdParams = []string{
+						"--local-user", "brock-obama",
+						"--max-active-containers", "-1",
+					}
+				})
+
+				It("returns an error", func() {
+					sess, err := gexec.Start(flyCmd, ginkgo.GinkgoWriter, ginkgo.GinkgoWriter)
+					Expect(err).ToNot(HaveOccurred())
+
+					Eventually(sess.Err).Should(gbytes.Say("quota for the team must not be negative"))
+					Eventually(sess).Should(gexec.Exit(1))
+				})
+			})
+		})
+
 		Describe("handling server response", func() {
 			BeforeEach(func() {
 				cmdParams = []string{"--local-user", "brock-obama"}
@@ -0,0 +1,178 @@
+package integration_test
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"os/exec"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/api/teamserver"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gbytes"
+	"github.com/onsi/gomega/gexec"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("Fly CLI", func() {
+	Describe("set-teams", func() {
+		var (
+			flyCmd        *exec.Cmd
+			cmdParams     []string
+			existingTeams []atc.Team
+		)
+
+		yes := func(stdin io.Writer) {
+			fmt.Fprintf(stdin, "y\n")
+		}
+
+		no := func(stdin io.Writer) {
+			fmt.Fprintf(stdin, "n\n")
+		}
+
+		BeforeEach(func() {
+			cmdParams = []string{"-c", "fixtures/teams_config.yml"}
+
+			existingTeams = []atc.Team{
+				{
+					ID:   1,
+					Name: "main",
+					Auth: atc.TeamAuth{
+						"owner": {"users": []string{"local:some-admin"}, "groups": []string{}},
+					},
+				},
+				{
+					ID:   2,
+					Name: "legacy",
+					Auth: atc.TeamAuth{
+						"owner": {"users": []string{"local:some-legacy-user"}, "groups": []string{}},
+					},
+				},
+			}
+
+			status := http.StatusOK
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams"),
+					ghttp.RespondWithJSONEncodedPtr(&status, &existingTeams),
+				),
+			)
+		})
+
+		JustBeforeEach(func() {
+			params := append([]string{"-t", targetName, "set-teams"}, cmdParams...)
+			flyCmd = exec.Command(flyPath, params...)
+		})
+
+		It("shows the teams which will change", func() {
+			stdin, err := flyCmd.StdinPipe()
+			Expect(err).NotTo(HaveOccurred())
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gbytes.Say("team venture has been added:"))
+			Eventually(sess).Should(gbytes.Say(`github:some-github-user`))
+			Eventually(sess).Should(gbytes.Say(`max_active_tasks: 5`))
+
+			Eventually(sess).Should(gbytes.Say(`apply teams configuration\? \[yN\]: `))
+			no(stdin)
+
+			Eventually(sess.Err).Should(gbytes.Say("bailing out"))
+			Eventually(sess).Should(gexec.Exit(1))
+
+			Expect(sess.Out.Contents()).NotTo(ContainSubstring("team main"))
+			Expect(sess.Out.Contents()).NotTo(ContainSubstring("team legacy"))
+		})
+
+		Context("when unlisted teams are to be deleted", func() {
+			BeforeEach(func() {
+				cmdParams = append(cmdParams, "--delete-unlisted")
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams"),
+						ghttp.VerifyJSON(`{
+							"teams": [
+								{
+									"name": "main",
+									"auth": {
+										"owner": {"users": ["local:some-admin"], "groups": []}
+									}
+								},
+								{
+									"name": "venture",
+									"auth": {
+										"owner": {"users": ["github:some-github-user"], "groups": []},
+										"viewer": {"users": ["local:some-viewer"], "groups": []}
+									},
+									"quota": {"max_active_tasks": 5}
+								}
+							],
+							"delete_unlisted": true
+						}`),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, teamserver.SetTeamsResponse{
+							Created: []string{"venture"},
+							Deleted: []string{"legacy"},
+						}),
+					),
+				)
+			})
+
+			It("shows them as removed and applies the config", func() {
+				stdin, err := flyCmd.StdinPipe()
+				Expect(err).NotTo(HaveOccurred())
+
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gbytes.Say("team legacy has been removed:"))
+				Eventually(sess).Should(gbytes.Say("team venture has been added:"))
+
+				Eventually(sess).Should(gbytes.Say(`apply teams configuration\? \[yN\]: `))
+				yes(stdin)
+
+				Eventually(sess).Should(gbytes.Say("team venture created"))
+				Eventually(sess).Should(gbytes.Say("team legacy destroyed"))
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Context("when nothing has changed", func() {
+			BeforeEach(func() {
+				existingTeams = append(existingTeams, atc.Team{
+					ID:   3,
+					Name: "venture",
+					Auth: atc.TeamAuth{
+						"owner":  {"users": []string{"github:some-github-user"}, "groups": []string{}},
+						"viewer": {"users": []string{"local:some-viewer"}, "groups": []string{}},
+					},
+					Quota: &atc.TeamQuota{MaxActiveTasks: 5},
+				})
+			})
+
+			It("says there are no changes to apply", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gbytes.Say("no changes to apply"))
+				Eventually(sess).Should(gexec.Exit(0))
+			})
+		})
+
+		Context("when a team is listed twice", func() {
+			BeforeEach(func() {
+				cmdParams = []string{"-c", "fixtures/teams_config_duplicate.yml"}
+			})
+
+			It("fails without contacting the server", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess.Err).Should(gbytes.Say("team 'Venture' is configured more than once"))
+				Eventually(sess).Should(gexec.Exit(1))
+			})
+		})
+	})
+})
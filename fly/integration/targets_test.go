@@ -17,15 +17,18 @@ var _ = Describe("Fly CLI", func() {
 	var (
 		flyCmd  *exec.Cmd
 		targets rc.Targets
+		args    []string
 	)
 
 	JustBeforeEach(func() {
 		createFlyRc(targets)
 
-		flyCmd = exec.Command(flyPath, "targets")
+		flyCmd = exec.Command(flyPath, append([]string{"targets"}, args...)...)
 	})
 
 	BeforeEach(func() {
+		args = nil
+
 		targets = rc.Targets{
 			"another-test": {
 				API:      "https://example.com/another-test",
@@ -75,6 +78,53 @@ var _ = Describe("Fly CLI", func() {
 			})
 		})
 
+		Context("when --output=json is given", func() {
+			BeforeEach(func() {
+				args = []string{"--output", "json"}
+			})
+
+			It("prints the targets without their tokens", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(sess.Out.Contents()).To(MatchJSON(`[
+					{"name": "another-test", "url": "https://example.com/another-test", "team": "test", "expiry": "2020-01-01T00:00:00Z"},
+					{"name": "no-token", "url": "https://example.com/no-token", "team": "main"},
+					{"name": "omt", "url": "https://example.com/omt", "team": "main", "expiry": "2020-01-02T00:00:00Z"},
+					{"name": "test", "url": "https://example.com/test", "team": "test", "expiry": "2020-01-03T00:00:00Z"}
+				]`))
+			})
+		})
+
+		Context("when --output=jsonpath is given", func() {
+			BeforeEach(func() {
+				args = []string{"--output", `jsonpath={range [*]}{.name}{"\n"}{end}`}
+			})
+
+			It("prints the expression for the targets", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(0))
+				Expect(string(sess.Out.Contents())).To(Equal("another-test\nno-token\nomt\ntest\n"))
+			})
+		})
+
+		Context("when an unknown output format is given", func() {
+			BeforeEach(func() {
+				args = []string{"--output", "xml"}
+			})
+
+			It("errors", func() {
+				sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+				Expect(err).NotTo(HaveOccurred())
+
+				Eventually(sess).Should(gexec.Exit(1))
+				Expect(sess.Err).To(gbytes.Say("unknown output format 'xml'"))
+			})
+		})
+
 		Context("when the .flyrc contains a target with an invalid token", func() {
 			BeforeEach(func() {
 				targets = rc.Targets{
@@ -116,5 +116,26 @@ var _ = Describe("Fly CLI", func() {
 			<-sess.Exited
 			Expect(sess.ExitCode()).To(Equal(0))
 		})
+
+		It("returns valid on a pipeline that includes other files", func() {
+			flyCmd := exec.Command(
+				flyPath,
+				"validate-pipeline",
+				"-c", "fixtures/include-pipeline.yml",
+				"-v", "uri=https://example.com/repo.git",
+				"-o",
+			)
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(sess).Should(gbytes.Say("jobs:"))
+			Eventually(sess).Should(gbytes.Say("name: unit-atc"))
+			Eventually(sess).Should(gbytes.Say("name: unit-fly"))
+			Eventually(sess).Should(gbytes.Say("uri: https://example.com/repo.git"))
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+		})
 	})
 })
@@ -241,4 +241,93 @@ var _ = Describe("Watching", func() {
 			})
 		})
 	})
+
+	Context("with a team", func() {
+		var lifecycleEvents chan atc.BuildLifecycleEvent
+
+		BeforeEach(func() {
+			lifecycleEvents = make(chan atc.BuildLifecycleEvent)
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/other-team"),
+					ghttp.RespondWithJSONEncoded(200, atc.Team{
+						ID:   2,
+						Name: "other-team",
+					}),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/other-team/events", "pipeline=some-pipeline&job=some-job"),
+					func(w http.ResponseWriter, r *http.Request) {
+						flusher := w.(http.Flusher)
+
+						w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+						w.WriteHeader(http.StatusOK)
+
+						flusher.Flush()
+
+						close(streaming)
+
+						id := 0
+
+						for e := range lifecycleEvents {
+							payload, err := json.Marshal(e)
+							Expect(err).NotTo(HaveOccurred())
+
+							err = sse.Event{
+								ID:   fmt.Sprintf("%d", id),
+								Name: "event",
+								Data: payload,
+							}.Write(w)
+							Expect(err).NotTo(HaveOccurred())
+
+							flusher.Flush()
+
+							id++
+						}
+					},
+				),
+			)
+		})
+
+		It("prints the team's builds as they are created, started and finished", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--team", "other-team", "--job", "some-pipeline/some-job")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			Eventually(streaming).Should(BeClosed())
+
+			build := atc.Build{
+				ID:           3,
+				Name:         "7",
+				Status:       "pending",
+				PipelineName: "some-pipeline",
+				JobName:      "some-job",
+			}
+
+			lifecycleEvents <- atc.BuildLifecycleEvent{Type: atc.BuildCreated, Build: build}
+			Eventually(sess.Out).Should(gbytes.Say(`created  some-pipeline/some-job #7 pending`))
+
+			build.Status = "succeeded"
+			lifecycleEvents <- atc.BuildLifecycleEvent{Type: atc.BuildFinished, Build: build}
+			Eventually(sess.Out).Should(gbytes.Say(`finished some-pipeline/some-job #7 succeeded`))
+
+			close(lifecycleEvents)
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(0))
+		})
+
+		It("cannot be combined with --build", func() {
+			flyCmd := exec.Command(flyPath, "-t", targetName, "watch", "--team", "other-team", "--build", "3")
+
+			sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+			Expect(err).NotTo(HaveOccurred())
+
+			<-sess.Exited
+			Expect(sess.ExitCode()).To(Equal(1))
+			Expect(sess.Err).To(gbytes.Say("--team can not be used with --build or --url"))
+		})
+	})
 })
@@ -176,6 +176,36 @@ var _ = Describe("Fly CLI", func() {
 				}))
 			})
 
+			Context("when --output=yaml is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--output=yaml")
+				})
+
+				It("prints response in yaml as stdout", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(sess.Out).To(gbytes.Say(`- active_containers: 0`))
+					Expect(sess.Out).To(gbytes.Say(`  addr: 1.2.3.4:7777`))
+					Expect(sess.Out).To(gbytes.Say(`  name: worker-2`))
+				})
+			})
+
+			Context("when --output=go-template is given", func() {
+				BeforeEach(func() {
+					flyCmd.Args = append(flyCmd.Args, "--output=go-template={{range .}}{{.name}}={{.state}} {{end}}")
+				})
+
+				It("prints the template for the workers as returned by the API", func() {
+					sess, err := gexec.Start(flyCmd, GinkgoWriter, GinkgoWriter)
+					Expect(err).NotTo(HaveOccurred())
+
+					Eventually(sess).Should(gexec.Exit(0))
+					Expect(string(sess.Out.Contents())).To(HavePrefix("worker-2=running worker-6=running worker-7=running worker-1=landing "))
+				})
+			})
+
 			Context("when --json is given", func() {
 				BeforeEach(func() {
 					flyCmd.Args = append(flyCmd.Args, "--json")
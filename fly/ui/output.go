@@ -0,0 +1,179 @@
+package ui
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"k8s.io/client-go/util/jsonpath"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	TableOutput      = "table"
+	JSONOutput       = "json"
+	YAMLOutput       = "yaml"
+	JSONPathOutput   = "jsonpath"
+	GoTemplateOutput = "go-template"
+)
+
+// OutputFormat is how a command prints what it lists or gets. Structured
+// formats print the same objects as the API returns them, so that scripts do
+// not have to scrape tables.
+type OutputFormat struct {
+	Kind     string
+	Template string
+}
+
+func (format *OutputFormat) UnmarshalFlag(value string) error {
+	kind, tmpl := value, ""
+	if i := strings.Index(value, "="); i != -1 {
+		kind, tmpl = value[:i], value[i+1:]
+	}
+
+	switch kind {
+	case TableOutput, JSONOutput, YAMLOutput:
+		if tmpl != "" {
+			return fmt.Errorf("output format '%s' does not take a template", kind)
+		}
+	case JSONPathOutput:
+		if tmpl == "" {
+			return fmt.Errorf("output format '%s' requires an expression, e.g. %s='{.name}'", kind, kind)
+		}
+
+		_, err := parseJSONPath(tmpl)
+		if err != nil {
+			return fmt.Errorf("invalid jsonpath expression: %s", err)
+		}
+	case GoTemplateOutput:
+		if tmpl == "" {
+			return fmt.Errorf("output format '%s' requires a template, e.g. %s='{{.name}}'", kind, kind)
+		}
+
+		_, err := template.New("output").Parse(tmpl)
+		if err != nil {
+			return fmt.Errorf("invalid go-template: %s", err)
+		}
+	default:
+		return fmt.Errorf("unknown output format '%s' (expected table, json, yaml, jsonpath=<expr> or go-template=<template>)", kind)
+	}
+
+	format.Kind = kind
+	format.Template = tmpl
+
+	return nil
+}
+
+// IsTable returns whether the command should print its usual table.
+func (format OutputFormat) IsTable() bool {
+	return format.Kind == "" || format.Kind == TableOutput
+}
+
+// Print writes obj to dst in a structured format. It must not be called for
+// the table format, which each command renders itself.
+func (format OutputFormat) Print(dst io.Writer, obj interface{}) error {
+	switch format.Kind {
+	case JSONOutput:
+		payload, err := json.MarshalIndent(obj, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		_, err = fmt.Fprintln(dst, string(payload))
+		return err
+
+	case YAMLOutput:
+		payload, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+
+		_, err = dst.Write(payload)
+		return err
+
+	case JSONPathOutput:
+		data, err := genericValue(obj)
+		if err != nil {
+			return err
+		}
+
+		jp, err := parseJSONPath(format.Template)
+		if err != nil {
+			return err
+		}
+
+		return jp.Execute(dst, data)
+
+	case GoTemplateOutput:
+		data, err := genericValue(obj)
+		if err != nil {
+			return err
+		}
+
+		tmpl, err := template.New("output").Parse(format.Template)
+		if err != nil {
+			return err
+		}
+
+		return tmpl.Execute(dst, data)
+
+	default:
+		return fmt.Errorf("can not print in output format '%s'", format.Kind)
+	}
+}
+
+// OutputFlags are the flags shared by commands which list or get objects.
+type OutputFlags struct {
+	Output OutputFormat `long:"output" value-name:"FORMAT" default:"table" description:"Output format: table, json, yaml, jsonpath=<expr> or go-template=<template>"`
+	JSON   bool         `long:"json"                                         description:"Print command result as JSON (same as --output=json)"`
+}
+
+// Format returns the format the command's result should be printed in.
+func (flags OutputFlags) Format() OutputFormat {
+	if flags.JSON {
+		return OutputFormat{Kind: JSONOutput}
+	}
+
+	return flags.Output
+}
+
+// parseJSONPath parses an expression the way kubectl does, allowing the
+// surrounding braces to be omitted, e.g. ".name" for "{.name}".
+func parseJSONPath(expr string) (*jsonpath.JSONPath, error) {
+	if !strings.Contains(expr, "{") {
+		expr = "{" + expr + "}"
+	}
+
+	jp := jsonpath.New("output").AllowMissingKeys(true)
+
+	err := jp.Parse(expr)
+	if err != nil {
+		return nil, err
+	}
+
+	return jp, nil
+}
+
+// genericValue converts obj to the values it would be decoded to from JSON,
+// so that templates refer to fields by the same names as the JSON output.
+// Numbers are kept as they were written so that large IDs print in full.
+func genericValue(obj interface{}) (interface{}, error) {
+	payload, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(payload))
+	decoder.UseNumber()
+
+	var data interface{}
+	err = decoder.Decode(&data)
+	if err != nil {
+		return nil, err
+	}
+
+	return data, nil
+}
@@ -0,0 +1,147 @@
+package ui_test
+
+import (
+	"bytes"
+
+	. "github.com/concourse/concourse/fly/ui"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("OutputFormat", func() {
+	type object struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+
+	objects := []object{
+		{ID: 12345678, Name: "some-name"},
+		{ID: 2, Name: "some-other-name"},
+	}
+
+	Describe("UnmarshalFlag", func() {
+		var format OutputFormat
+
+		BeforeEach(func() {
+			format = OutputFormat{}
+		})
+
+		for _, kind := range []string{"table", "json", "yaml"} {
+			kind := kind
+
+			It("accepts "+kind, func() {
+				Expect(format.UnmarshalFlag(kind)).To(Succeed())
+				Expect(format).To(Equal(OutputFormat{Kind: kind}))
+			})
+		}
+
+		It("accepts jsonpath with an expression", func() {
+			Expect(format.UnmarshalFlag("jsonpath={.name}")).To(Succeed())
+			Expect(format).To(Equal(OutputFormat{Kind: "jsonpath", Template: "{.name}"}))
+		})
+
+		It("accepts go-template with a template", func() {
+			Expect(format.UnmarshalFlag("go-template={{.name}}={{.id}}")).To(Succeed())
+			Expect(format).To(Equal(OutputFormat{Kind: "go-template", Template: "{{.name}}={{.id}}"}))
+		})
+
+		It("rejects unknown formats", func() {
+			Expect(format.UnmarshalFlag("xml")).To(MatchError(ContainSubstring("unknown output format 'xml'")))
+		})
+
+		It("rejects templates for formats which do not take one", func() {
+			Expect(format.UnmarshalFlag("json=.name")).To(MatchError("output format 'json' does not take a template"))
+		})
+
+		It("requires an expression for jsonpath", func() {
+			Expect(format.UnmarshalFlag("jsonpath")).To(MatchError(ContainSubstring("output format 'jsonpath' requires an expression")))
+		})
+
+		It("rejects invalid jsonpath expressions", func() {
+			Expect(format.UnmarshalFlag("jsonpath={.name")).To(MatchError(ContainSubstring("invalid jsonpath expression")))
+		})
+
+		It("rejects invalid go-templates", func() {
+			Expect(format.UnmarshalFlag("go-template={{.name")).To(MatchError(ContainSubstring("invalid go-template")))
+		})
+	})
+
+	Describe("IsTable", func() {
+		It("is true for the table format and the zero value", func() {
+			Expect(OutputFormat{}.IsTable()).To(BeTrue())
+			Expect(OutputFormat{Kind: TableOutput}.IsTable()).To(BeTrue())
+			Expect(OutputFormat{Kind: JSONOutput}.IsTable()).To(BeFalse())
+		})
+	})
+
+	Describe("Print", func() {
+		var buf *bytes.Buffer
+
+		BeforeEach(func() {
+			buf = new(bytes.Buffer)
+		})
+
+		It("prints indented json", func() {
+			Expect(OutputFormat{Kind: JSONOutput}.Print(buf, objects)).To(Succeed())
+			Expect(buf.String()).To(Equal(`[
+  {
+    "id": 12345678,
+    "name": "some-name"
+  },
+  {
+    "id": 2,
+    "name": "some-other-name"
+  }
+]
+`))
+		})
+
+		It("prints yaml", func() {
+			Expect(OutputFormat{Kind: YAMLOutput}.Print(buf, objects)).To(Succeed())
+			Expect(buf.String()).To(Equal(`- id: 12345678
+  name: some-name
+- id: 2
+  name: some-other-name
+`))
+		})
+
+		It("prints jsonpath expressions, with or without braces", func() {
+			Expect(OutputFormat{Kind: JSONPathOutput, Template: `{range [*]}{.id}{"\n"}{end}`}.Print(buf, objects)).To(Succeed())
+			Expect(buf.String()).To(Equal("12345678\n2\n"))
+
+			buf.Reset()
+
+			Expect(OutputFormat{Kind: JSONPathOutput, Template: `[0].name`}.Print(buf, objects)).To(Succeed())
+			Expect(buf.String()).To(Equal("some-name"))
+		})
+
+		It("prints go-templates against the json field names", func() {
+			Expect(OutputFormat{Kind: GoTemplateOutput, Template: `{{range .}}{{.name}}:{{.id}} {{end}}`}.Print(buf, objects)).To(Succeed())
+			Expect(buf.String()).To(Equal("some-name:12345678 some-other-name:2 "))
+		})
+
+		It("can not print tables", func() {
+			Expect(OutputFormat{Kind: TableOutput}.Print(buf, objects)).To(HaveOccurred())
+		})
+	})
+})
+
+var _ = Describe("OutputFlags", func() {
+	It("prefers --json", func() {
+		flags := OutputFlags{
+			Output: OutputFormat{Kind: YAMLOutput},
+			JSON:   true,
+		}
+
+		Expect(flags.Format()).To(Equal(OutputFormat{Kind: JSONOutput}))
+	})
+
+	It("otherwise uses --output", func() {
+		flags := OutputFlags{
+			Output: OutputFormat{Kind: YAMLOutput},
+		}
+
+		Expect(flags.Format()).To(Equal(OutputFormat{Kind: YAMLOutput}))
+	})
+})
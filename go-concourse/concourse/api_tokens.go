@@ -0,0 +1,65 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) APITokens() ([]atc.APIToken, error) {
+	var tokens []atc.APIToken
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListAPITokens,
+		Params: rata.Params{
+			"team_name": team.Name(),
+		},
+	}, &internal.Response{
+		Result: &tokens,
+	})
+
+	return tokens, err
+}
+
+func (team *team) CreateAPIToken(token atc.APIToken) (atc.APIToken, error) {
+	jsonBytes, err := json.Marshal(token)
+	if err != nil {
+		return atc.APIToken{}, err
+	}
+
+	var created atc.APIToken
+	err = team.connection.Send(internal.Request{
+		RequestName: atc.CreateAPIToken,
+		Params: rata.Params{
+			"team_name": team.Name(),
+		},
+		Body:   bytes.NewBuffer(jsonBytes),
+		Header: http.Header{"Content-Type": []string{"application/json"}},
+	}, &internal.Response{
+		Result: &created,
+	})
+
+	return created, err
+}
+
+func (team *team) RevokeAPIToken(name string) (bool, error) {
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.RevokeAPIToken,
+		Params: rata.Params{
+			"team_name":  team.Name(),
+			"token_name": name,
+		},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
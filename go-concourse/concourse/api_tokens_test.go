@@ -0,0 +1,119 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler API Tokens", func() {
+	Describe("APITokens", func() {
+		var expectedTokens []atc.APIToken
+
+		BeforeEach(func() {
+			expectedTokens = []atc.APIToken{
+				{
+					ID:        42,
+					Name:      "ci",
+					TeamName:  "some-team",
+					Role:      "member",
+					CreatedBy: "some-user",
+					CreatedAt: 100,
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/tokens"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedTokens),
+				),
+			)
+		})
+
+		It("returns the team's tokens", func() {
+			tokens, err := team.APITokens()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tokens).To(Equal(expectedTokens))
+		})
+	})
+
+	Describe("CreateAPIToken", func() {
+		var (
+			token         atc.APIToken
+			expectedToken atc.APIToken
+		)
+
+		BeforeEach(func() {
+			token = atc.APIToken{
+				Name:      "ci",
+				Role:      "member",
+				ExpiresAt: 200,
+			}
+
+			expectedToken = atc.APIToken{
+				ID:        42,
+				Name:      "ci",
+				TeamName:  "some-team",
+				Role:      "member",
+				CreatedAt: 100,
+				ExpiresAt: 200,
+				Token:     "concourse_some-value",
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("POST", "/api/v1/teams/some-team/tokens"),
+					ghttp.VerifyJSONRepresenting(token),
+					ghttp.RespondWithJSONEncoded(http.StatusCreated, expectedToken),
+				),
+			)
+		})
+
+		It("returns the created token", func() {
+			created, err := team.CreateAPIToken(token)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(created).To(Equal(expectedToken))
+		})
+	})
+
+	Describe("RevokeAPIToken", func() {
+		var status int
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/tokens/ci"),
+					ghttp.RespondWith(status, nil),
+				),
+			)
+		})
+
+		Context("when the token exists", func() {
+			BeforeEach(func() {
+				status = http.StatusNoContent
+			})
+
+			It("returns true", func() {
+				revoked, err := team.RevokeAPIToken("ci")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(revoked).To(BeTrue())
+			})
+		})
+
+		Context("when the token does not exist", func() {
+			BeforeEach(func() {
+				status = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				revoked, err := team.RevokeAPIToken("ci")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(revoked).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,57 @@
+package concourse
+
+import (
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+)
+
+// AuditEventFilter narrows down the events returned by ListAuditEvents. Each
+// zero-valued field matches every event.
+type AuditEventFilter struct {
+	Team   string
+	User   string
+	Action string
+	Since  time.Time
+	Limit  int
+}
+
+func (client *client) ListAuditEvents(filter AuditEventFilter) ([]atc.AuditEvent, error) {
+	query := url.Values{}
+
+	if filter.Team != "" {
+		query.Set("team", filter.Team)
+	}
+
+	if filter.User != "" {
+		query.Set("user", filter.User)
+	}
+
+	if filter.Action != "" {
+		query.Set("action", filter.Action)
+	}
+
+	if !filter.Since.IsZero() {
+		query.Set("since", strconv.FormatInt(filter.Since.Unix(), 10))
+	}
+
+	if filter.Limit > 0 {
+		query.Set("limit", strconv.Itoa(filter.Limit))
+	}
+
+	var events []atc.AuditEvent
+	err := client.connection.Send(internal.Request{
+		RequestName: atc.ListAuditEvents,
+		Query:       query,
+	}, &internal.Response{
+		Result: &events,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return events, nil
+}
@@ -0,0 +1,73 @@
+package concourse_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Audit Handler", func() {
+	Describe("ListAuditEvents", func() {
+		var expectedEvents []atc.AuditEvent
+
+		BeforeEach(func() {
+			expectedEvents = []atc.AuditEvent{
+				{
+					ID:     1,
+					Time:   1625961600,
+					User:   "some-user",
+					Team:   "some-team",
+					Action: atc.SaveConfig,
+					Target: "/api/v1/teams/some-team/pipelines/some-pipeline/config",
+					Status: http.StatusOK,
+				},
+			}
+		})
+
+		Context("when no filter is given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit", ""),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedEvents),
+					),
+				)
+			})
+
+			It("returns the events", func() {
+				events, err := client.ListAuditEvents(concourse.AuditEventFilter{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(events).To(Equal(expectedEvents))
+			})
+		})
+
+		Context("when a filter is given", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/audit", "action=SaveConfig&limit=5&since=1625961600&team=some-team&user=some-user"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedEvents),
+					),
+				)
+			})
+
+			It("sends the filter as query parameters", func() {
+				_, err := client.ListAuditEvents(concourse.AuditEventFilter{
+					Team:   "some-team",
+					User:   "some-user",
+					Action: atc.SaveConfig,
+					Since:  time.Unix(1625961600, 0),
+					Limit:  5,
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+	})
+})
@@ -0,0 +1,62 @@
+package concourse_test
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/concourse/concourse/atc"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Build Resource Usage", func() {
+	Describe("BuildResourceUsage", func() {
+		expectedURL := "/api/v1/builds/1234/resource-usage"
+
+		Context("when the build exists", func() {
+			expectedUsage := atc.BuildResourceUsage{
+				BuildID: 1234,
+				Steps:   1,
+				ResourceUsage: atc.ResourceUsage{
+					CPUUsage:   300,
+					MemoryPeak: 2048,
+					Duration:   time.Minute,
+				},
+			}
+
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedUsage),
+					),
+				)
+			})
+
+			It("returns the build's resource usage", func() {
+				usage, found, err := client.BuildResourceUsage(1234)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(usage).To(Equal(expectedUsage))
+			})
+		})
+
+		Context("when the build does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWithJSONEncoded(http.StatusNotFound, nil),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := client.BuildResourceUsage(1234)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
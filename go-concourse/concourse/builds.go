@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 
 	"github.com/concourse/concourse/atc"
@@ -160,6 +161,35 @@ func (client *client) AbortBuild(buildID string) error {
 	}, nil)
 }
 
+func (client *client) SetBuildApproval(buildID string, planID atc.PlanID, approval atc.BuildApproval) (bool, error) {
+	buffer := &bytes.Buffer{}
+	err := json.NewEncoder(buffer).Encode(approval)
+	if err != nil {
+		return false, fmt.Errorf("Unable to marshal approval: %s", err)
+	}
+
+	err = client.connection.Send(internal.Request{
+		RequestName: atc.SetBuildApproval,
+		Body:        buffer,
+		Params: rata.Params{
+			"build_id": buildID,
+			"plan_id":  string(planID),
+		},
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
 func (team *team) Builds(page Page) ([]atc.Build, Pagination, error) {
 	var builds []atc.Build
 
@@ -207,3 +237,26 @@ func (client *client) ListBuildArtifacts(buildID string) ([]atc.WorkerArtifact,
 
 	return artifacts, err
 }
+
+func (client *client) GetBuildArtifact(buildID string, name string) (io.ReadCloser, bool, error) {
+	params := rata.Params{
+		"build_id":      buildID,
+		"artifact_name": name,
+	}
+
+	response := internal.Response{}
+	err := client.connection.Send(internal.Request{
+		RequestName:        atc.GetBuildArtifact,
+		Params:             params,
+		ReturnResponseBody: true,
+	}, &response)
+
+	switch err.(type) {
+	case nil:
+		return response.Result.(io.ReadCloser), true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
@@ -2,6 +2,7 @@ package concourse_test
 
 import (
 	"fmt"
+	"io/ioutil"
 	"net/http"
 
 	"github.com/concourse/concourse/atc"
@@ -468,6 +469,72 @@ var _ = Describe("ATC Handler Builds", func() {
 		})
 	})
 
+	Describe("SetBuildApproval", func() {
+		var (
+			expectedURL = "/api/v1/builds/123/approvals/some-plan"
+
+			found bool
+			err   error
+		)
+
+		JustBeforeEach(func() {
+			found, err = client.SetBuildApproval("123", "some-plan", atc.BuildApproval{
+				Approved: true,
+				Comment:  "ship it",
+			})
+		})
+
+		Context("when the step is decided", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.VerifyJSON(`{"approved":true,"comment":"ship it"}`),
+						ghttp.RespondWith(http.StatusNoContent, ""),
+					),
+				)
+			})
+
+			It("sends the approval to ATC", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+			})
+		})
+
+		Context("when the step can not be found", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the step has already been decided", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", expectedURL),
+						ghttp.RespondWith(http.StatusConflict, "step has already been decided"),
+					),
+				)
+			})
+
+			It("returns an error", func() {
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("step has already been decided"))
+			})
+		})
+	})
+
 	Describe("team.Builds", func() {
 		expectedURL := "/api/v1/teams/some-team/builds"
 
@@ -668,4 +735,57 @@ var _ = Describe("ATC Handler Builds", func() {
 			})
 		})
 	})
+
+	Describe("GetBuildArtifact", func() {
+		Context("when the artifact exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/123/artifacts/some-output"),
+						ghttp.RespondWith(http.StatusOK, "some-contents"),
+					),
+				)
+			})
+
+			It("returns the contents", func() {
+				contents, found, err := client.GetBuildArtifact("123", "some-output")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(ioutil.ReadAll(contents)).To(Equal([]byte("some-contents")))
+			})
+		})
+
+		Context("when the artifact does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/123/artifacts/some-output"),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false", func() {
+				_, found, err := client.GetBuildArtifact("123", "some-output")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when getting the artifact fails", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/123/artifacts/some-output"),
+						ghttp.RespondWith(http.StatusInternalServerError, ""),
+					),
+				)
+			})
+
+			It("errors", func() {
+				_, _, err := client.GetBuildArtifact("123", "some-output")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
 })
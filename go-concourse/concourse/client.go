@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/eventstream"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
 )
 
@@ -18,10 +19,14 @@ type Client interface {
 	Builds(Page) ([]atc.Build, Pagination, error)
 	Build(buildID string) (atc.Build, bool, error)
 	BuildEvents(buildID string) (Events, error)
+	StreamBuildEvents() (eventstream.BuildEventStream, error)
 	BuildResources(buildID int) (atc.BuildInputsOutputs, bool, error)
 	ListBuildArtifacts(buildID string) ([]atc.WorkerArtifact, error)
+	GetBuildArtifact(buildID string, name string) (io.ReadCloser, bool, error)
 	AbortBuild(buildID string) error
+	SetBuildApproval(buildID string, planID atc.PlanID, approval atc.BuildApproval) (bool, error)
 	BuildPlan(buildID int) (atc.PublicBuildPlan, bool, error)
+	BuildResourceUsage(buildID int) (atc.BuildResourceUsage, bool, error)
 	SaveWorker(atc.Worker, *time.Duration) (*atc.Worker, error)
 	ListWorkers() ([]atc.Worker, error)
 	PruneWorker(workerName string) error
@@ -31,10 +36,12 @@ type Client interface {
 	ListPipelines() ([]atc.Pipeline, error)
 	ListAllJobs() ([]atc.Job, error)
 	ListTeams() ([]atc.Team, error)
+	SetTeams(teams atc.Teams, deleteUnlisted bool) (SetTeamsResult, []ConfigWarning, error)
 	FindTeam(teamName string) (Team, error)
 	Team(teamName string) Team
 	UserInfo() (atc.UserInfo, error)
 	ListActiveUsersSince(since time.Time) ([]atc.User, error)
+	ListAuditEvents(filter AuditEventFilter) ([]atc.AuditEvent, error)
 }
 
 type client struct {
@@ -9,6 +9,7 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/concourse/concourse/go-concourse/concourse/eventstream"
 )
 
 type FakeClient struct {
@@ -66,6 +67,21 @@ type FakeClient struct {
 		result2 bool
 		result3 error
 	}
+	BuildResourceUsageStub        func(int) (atc.BuildResourceUsage, bool, error)
+	buildResourceUsageMutex       sync.RWMutex
+	buildResourceUsageArgsForCall []struct {
+		arg1 int
+	}
+	buildResourceUsageReturns struct {
+		result1 atc.BuildResourceUsage
+		result2 bool
+		result3 error
+	}
+	buildResourceUsageReturnsOnCall map[int]struct {
+		result1 atc.BuildResourceUsage
+		result2 bool
+		result3 error
+	}
 	BuildResourcesStub        func(int) (atc.BuildInputsOutputs, bool, error)
 	buildResourcesMutex       sync.RWMutex
 	buildResourcesArgsForCall []struct {
@@ -109,6 +125,22 @@ type FakeClient struct {
 		result1 concourse.Team
 		result2 error
 	}
+	GetBuildArtifactStub        func(string, string) (io.ReadCloser, bool, error)
+	getBuildArtifactMutex       sync.RWMutex
+	getBuildArtifactArgsForCall []struct {
+		arg1 string
+		arg2 string
+	}
+	getBuildArtifactReturns struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
+	getBuildArtifactReturnsOnCall map[int]struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}
 	GetCLIReaderStub        func(string, string) (io.ReadCloser, http.Header, error)
 	getCLIReaderMutex       sync.RWMutex
 	getCLIReaderArgsForCall []struct {
@@ -183,6 +215,19 @@ type FakeClient struct {
 		result1 []atc.Job
 		result2 error
 	}
+	ListAuditEventsStub        func(concourse.AuditEventFilter) ([]atc.AuditEvent, error)
+	listAuditEventsMutex       sync.RWMutex
+	listAuditEventsArgsForCall []struct {
+		arg1 concourse.AuditEventFilter
+	}
+	listAuditEventsReturns struct {
+		result1 []atc.AuditEvent
+		result2 error
+	}
+	listAuditEventsReturnsOnCall map[int]struct {
+		result1 []atc.AuditEvent
+		result2 error
+	}
 	ListBuildArtifactsStub        func(string) ([]atc.WorkerArtifact, error)
 	listBuildArtifactsMutex       sync.RWMutex
 	listBuildArtifactsArgsForCall []struct {
@@ -257,6 +302,49 @@ type FakeClient struct {
 		result1 *atc.Worker
 		result2 error
 	}
+	SetBuildApprovalStub        func(string, atc.PlanID, atc.BuildApproval) (bool, error)
+	setBuildApprovalMutex       sync.RWMutex
+	setBuildApprovalArgsForCall []struct {
+		arg1 string
+		arg2 atc.PlanID
+		arg3 atc.BuildApproval
+	}
+	setBuildApprovalReturns struct {
+		result1 bool
+		result2 error
+	}
+	setBuildApprovalReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	SetTeamsStub        func(atc.Teams, bool) (concourse.SetTeamsResult, []concourse.ConfigWarning, error)
+	setTeamsMutex       sync.RWMutex
+	setTeamsArgsForCall []struct {
+		arg1 atc.Teams
+		arg2 bool
+	}
+	setTeamsReturns struct {
+		result1 concourse.SetTeamsResult
+		result2 []concourse.ConfigWarning
+		result3 error
+	}
+	setTeamsReturnsOnCall map[int]struct {
+		result1 concourse.SetTeamsResult
+		result2 []concourse.ConfigWarning
+		result3 error
+	}
+	StreamBuildEventsStub        func() (eventstream.BuildEventStream, error)
+	streamBuildEventsMutex       sync.RWMutex
+	streamBuildEventsArgsForCall []struct {
+	}
+	streamBuildEventsReturns struct {
+		result1 eventstream.BuildEventStream
+		result2 error
+	}
+	streamBuildEventsReturnsOnCall map[int]struct {
+		result1 eventstream.BuildEventStream
+		result2 error
+	}
 	TeamStub        func(string) concourse.Team
 	teamMutex       sync.RWMutex
 	teamArgsForCall []struct {
@@ -553,6 +641,73 @@ func (fake *FakeClient) BuildPlanReturnsOnCall(i int, result1 atc.PublicBuildPla
 	}{result1, result2, result3}
 }
 
+func (fake *FakeClient) BuildResourceUsage(arg1 int) (atc.BuildResourceUsage, bool, error) {
+	fake.buildResourceUsageMutex.Lock()
+	ret, specificReturn := fake.buildResourceUsageReturnsOnCall[len(fake.buildResourceUsageArgsForCall)]
+	fake.buildResourceUsageArgsForCall = append(fake.buildResourceUsageArgsForCall, struct {
+		arg1 int
+	}{arg1})
+	stub := fake.BuildResourceUsageStub
+	fakeReturns := fake.buildResourceUsageReturns
+	fake.recordInvocation("BuildResourceUsage", []interface{}{arg1})
+	fake.buildResourceUsageMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) BuildResourceUsageCallCount() int {
+	fake.buildResourceUsageMutex.RLock()
+	defer fake.buildResourceUsageMutex.RUnlock()
+	return len(fake.buildResourceUsageArgsForCall)
+}
+
+func (fake *FakeClient) BuildResourceUsageCalls(stub func(int) (atc.BuildResourceUsage, bool, error)) {
+	fake.buildResourceUsageMutex.Lock()
+	defer fake.buildResourceUsageMutex.Unlock()
+	fake.BuildResourceUsageStub = stub
+}
+
+func (fake *FakeClient) BuildResourceUsageArgsForCall(i int) int {
+	fake.buildResourceUsageMutex.RLock()
+	defer fake.buildResourceUsageMutex.RUnlock()
+	argsForCall := fake.buildResourceUsageArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) BuildResourceUsageReturns(result1 atc.BuildResourceUsage, result2 bool, result3 error) {
+	fake.buildResourceUsageMutex.Lock()
+	defer fake.buildResourceUsageMutex.Unlock()
+	fake.BuildResourceUsageStub = nil
+	fake.buildResourceUsageReturns = struct {
+		result1 atc.BuildResourceUsage
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) BuildResourceUsageReturnsOnCall(i int, result1 atc.BuildResourceUsage, result2 bool, result3 error) {
+	fake.buildResourceUsageMutex.Lock()
+	defer fake.buildResourceUsageMutex.Unlock()
+	fake.BuildResourceUsageStub = nil
+	if fake.buildResourceUsageReturnsOnCall == nil {
+		fake.buildResourceUsageReturnsOnCall = make(map[int]struct {
+			result1 atc.BuildResourceUsage
+			result2 bool
+			result3 error
+		})
+	}
+	fake.buildResourceUsageReturnsOnCall[i] = struct {
+		result1 atc.BuildResourceUsage
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) BuildResources(arg1 int) (atc.BuildInputsOutputs, bool, error) {
 	fake.buildResourcesMutex.Lock()
 	ret, specificReturn := fake.buildResourcesReturnsOnCall[len(fake.buildResourcesArgsForCall)]
@@ -751,6 +906,74 @@ func (fake *FakeClient) FindTeamReturnsOnCall(i int, result1 concourse.Team, res
 	}{result1, result2}
 }
 
+func (fake *FakeClient) GetBuildArtifact(arg1 string, arg2 string) (io.ReadCloser, bool, error) {
+	fake.getBuildArtifactMutex.Lock()
+	ret, specificReturn := fake.getBuildArtifactReturnsOnCall[len(fake.getBuildArtifactArgsForCall)]
+	fake.getBuildArtifactArgsForCall = append(fake.getBuildArtifactArgsForCall, struct {
+		arg1 string
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.GetBuildArtifactStub
+	fakeReturns := fake.getBuildArtifactReturns
+	fake.recordInvocation("GetBuildArtifact", []interface{}{arg1, arg2})
+	fake.getBuildArtifactMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) GetBuildArtifactCallCount() int {
+	fake.getBuildArtifactMutex.RLock()
+	defer fake.getBuildArtifactMutex.RUnlock()
+	return len(fake.getBuildArtifactArgsForCall)
+}
+
+func (fake *FakeClient) GetBuildArtifactCalls(stub func(string, string) (io.ReadCloser, bool, error)) {
+	fake.getBuildArtifactMutex.Lock()
+	defer fake.getBuildArtifactMutex.Unlock()
+	fake.GetBuildArtifactStub = stub
+}
+
+func (fake *FakeClient) GetBuildArtifactArgsForCall(i int) (string, string) {
+	fake.getBuildArtifactMutex.RLock()
+	defer fake.getBuildArtifactMutex.RUnlock()
+	argsForCall := fake.getBuildArtifactArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) GetBuildArtifactReturns(result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.getBuildArtifactMutex.Lock()
+	defer fake.getBuildArtifactMutex.Unlock()
+	fake.GetBuildArtifactStub = nil
+	fake.getBuildArtifactReturns = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) GetBuildArtifactReturnsOnCall(i int, result1 io.ReadCloser, result2 bool, result3 error) {
+	fake.getBuildArtifactMutex.Lock()
+	defer fake.getBuildArtifactMutex.Unlock()
+	fake.GetBuildArtifactStub = nil
+	if fake.getBuildArtifactReturnsOnCall == nil {
+		fake.getBuildArtifactReturnsOnCall = make(map[int]struct {
+			result1 io.ReadCloser
+			result2 bool
+			result3 error
+		})
+	}
+	fake.getBuildArtifactReturnsOnCall[i] = struct {
+		result1 io.ReadCloser
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeClient) GetCLIReader(arg1 string, arg2 string) (io.ReadCloser, http.Header, error) {
 	fake.getCLIReaderMutex.Lock()
 	ret, specificReturn := fake.getCLIReaderReturnsOnCall[len(fake.getCLIReaderArgsForCall)]
@@ -1109,6 +1332,70 @@ func (fake *FakeClient) ListAllJobsReturnsOnCall(i int, result1 []atc.Job, resul
 	}{result1, result2}
 }
 
+func (fake *FakeClient) ListAuditEvents(arg1 concourse.AuditEventFilter) ([]atc.AuditEvent, error) {
+	fake.listAuditEventsMutex.Lock()
+	ret, specificReturn := fake.listAuditEventsReturnsOnCall[len(fake.listAuditEventsArgsForCall)]
+	fake.listAuditEventsArgsForCall = append(fake.listAuditEventsArgsForCall, struct {
+		arg1 concourse.AuditEventFilter
+	}{arg1})
+	stub := fake.ListAuditEventsStub
+	fakeReturns := fake.listAuditEventsReturns
+	fake.recordInvocation("ListAuditEvents", []interface{}{arg1})
+	fake.listAuditEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) ListAuditEventsCallCount() int {
+	fake.listAuditEventsMutex.RLock()
+	defer fake.listAuditEventsMutex.RUnlock()
+	return len(fake.listAuditEventsArgsForCall)
+}
+
+func (fake *FakeClient) ListAuditEventsCalls(stub func(concourse.AuditEventFilter) ([]atc.AuditEvent, error)) {
+	fake.listAuditEventsMutex.Lock()
+	defer fake.listAuditEventsMutex.Unlock()
+	fake.ListAuditEventsStub = stub
+}
+
+func (fake *FakeClient) ListAuditEventsArgsForCall(i int) concourse.AuditEventFilter {
+	fake.listAuditEventsMutex.RLock()
+	defer fake.listAuditEventsMutex.RUnlock()
+	argsForCall := fake.listAuditEventsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClient) ListAuditEventsReturns(result1 []atc.AuditEvent, result2 error) {
+	fake.listAuditEventsMutex.Lock()
+	defer fake.listAuditEventsMutex.Unlock()
+	fake.ListAuditEventsStub = nil
+	fake.listAuditEventsReturns = struct {
+		result1 []atc.AuditEvent
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) ListAuditEventsReturnsOnCall(i int, result1 []atc.AuditEvent, result2 error) {
+	fake.listAuditEventsMutex.Lock()
+	defer fake.listAuditEventsMutex.Unlock()
+	fake.ListAuditEventsStub = nil
+	if fake.listAuditEventsReturnsOnCall == nil {
+		fake.listAuditEventsReturnsOnCall = make(map[int]struct {
+			result1 []atc.AuditEvent
+			result2 error
+		})
+	}
+	fake.listAuditEventsReturnsOnCall[i] = struct {
+		result1 []atc.AuditEvent
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) ListBuildArtifacts(arg1 string) ([]atc.WorkerArtifact, error) {
 	fake.listBuildArtifactsMutex.Lock()
 	ret, specificReturn := fake.listBuildArtifactsReturnsOnCall[len(fake.listBuildArtifactsArgsForCall)]
@@ -1467,6 +1754,196 @@ func (fake *FakeClient) SaveWorkerReturnsOnCall(i int, result1 *atc.Worker, resu
 	}{result1, result2}
 }
 
+func (fake *FakeClient) SetBuildApproval(arg1 string, arg2 atc.PlanID, arg3 atc.BuildApproval) (bool, error) {
+	fake.setBuildApprovalMutex.Lock()
+	ret, specificReturn := fake.setBuildApprovalReturnsOnCall[len(fake.setBuildApprovalArgsForCall)]
+	fake.setBuildApprovalArgsForCall = append(fake.setBuildApprovalArgsForCall, struct {
+		arg1 string
+		arg2 atc.PlanID
+		arg3 atc.BuildApproval
+	}{arg1, arg2, arg3})
+	stub := fake.SetBuildApprovalStub
+	fakeReturns := fake.setBuildApprovalReturns
+	fake.recordInvocation("SetBuildApproval", []interface{}{arg1, arg2, arg3})
+	fake.setBuildApprovalMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) SetBuildApprovalCallCount() int {
+	fake.setBuildApprovalMutex.RLock()
+	defer fake.setBuildApprovalMutex.RUnlock()
+	return len(fake.setBuildApprovalArgsForCall)
+}
+
+func (fake *FakeClient) SetBuildApprovalCalls(stub func(string, atc.PlanID, atc.BuildApproval) (bool, error)) {
+	fake.setBuildApprovalMutex.Lock()
+	defer fake.setBuildApprovalMutex.Unlock()
+	fake.SetBuildApprovalStub = stub
+}
+
+func (fake *FakeClient) SetBuildApprovalArgsForCall(i int) (string, atc.PlanID, atc.BuildApproval) {
+	fake.setBuildApprovalMutex.RLock()
+	defer fake.setBuildApprovalMutex.RUnlock()
+	argsForCall := fake.setBuildApprovalArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeClient) SetBuildApprovalReturns(result1 bool, result2 error) {
+	fake.setBuildApprovalMutex.Lock()
+	defer fake.setBuildApprovalMutex.Unlock()
+	fake.SetBuildApprovalStub = nil
+	fake.setBuildApprovalReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SetBuildApprovalReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.setBuildApprovalMutex.Lock()
+	defer fake.setBuildApprovalMutex.Unlock()
+	fake.SetBuildApprovalStub = nil
+	if fake.setBuildApprovalReturnsOnCall == nil {
+		fake.setBuildApprovalReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.setBuildApprovalReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) SetTeams(arg1 atc.Teams, arg2 bool) (concourse.SetTeamsResult, []concourse.ConfigWarning, error) {
+	fake.setTeamsMutex.Lock()
+	ret, specificReturn := fake.setTeamsReturnsOnCall[len(fake.setTeamsArgsForCall)]
+	fake.setTeamsArgsForCall = append(fake.setTeamsArgsForCall, struct {
+		arg1 atc.Teams
+		arg2 bool
+	}{arg1, arg2})
+	stub := fake.SetTeamsStub
+	fakeReturns := fake.setTeamsReturns
+	fake.recordInvocation("SetTeams", []interface{}{arg1, arg2})
+	fake.setTeamsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeClient) SetTeamsCallCount() int {
+	fake.setTeamsMutex.RLock()
+	defer fake.setTeamsMutex.RUnlock()
+	return len(fake.setTeamsArgsForCall)
+}
+
+func (fake *FakeClient) SetTeamsCalls(stub func(atc.Teams, bool) (concourse.SetTeamsResult, []concourse.ConfigWarning, error)) {
+	fake.setTeamsMutex.Lock()
+	defer fake.setTeamsMutex.Unlock()
+	fake.SetTeamsStub = stub
+}
+
+func (fake *FakeClient) SetTeamsArgsForCall(i int) (atc.Teams, bool) {
+	fake.setTeamsMutex.RLock()
+	defer fake.setTeamsMutex.RUnlock()
+	argsForCall := fake.setTeamsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeClient) SetTeamsReturns(result1 concourse.SetTeamsResult, result2 []concourse.ConfigWarning, result3 error) {
+	fake.setTeamsMutex.Lock()
+	defer fake.setTeamsMutex.Unlock()
+	fake.SetTeamsStub = nil
+	fake.setTeamsReturns = struct {
+		result1 concourse.SetTeamsResult
+		result2 []concourse.ConfigWarning
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) SetTeamsReturnsOnCall(i int, result1 concourse.SetTeamsResult, result2 []concourse.ConfigWarning, result3 error) {
+	fake.setTeamsMutex.Lock()
+	defer fake.setTeamsMutex.Unlock()
+	fake.SetTeamsStub = nil
+	if fake.setTeamsReturnsOnCall == nil {
+		fake.setTeamsReturnsOnCall = make(map[int]struct {
+			result1 concourse.SetTeamsResult
+			result2 []concourse.ConfigWarning
+			result3 error
+		})
+	}
+	fake.setTeamsReturnsOnCall[i] = struct {
+		result1 concourse.SetTeamsResult
+		result2 []concourse.ConfigWarning
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeClient) StreamBuildEvents() (eventstream.BuildEventStream, error) {
+	fake.streamBuildEventsMutex.Lock()
+	ret, specificReturn := fake.streamBuildEventsReturnsOnCall[len(fake.streamBuildEventsArgsForCall)]
+	fake.streamBuildEventsArgsForCall = append(fake.streamBuildEventsArgsForCall, struct {
+	}{})
+	stub := fake.StreamBuildEventsStub
+	fakeReturns := fake.streamBuildEventsReturns
+	fake.recordInvocation("StreamBuildEvents", []interface{}{})
+	fake.streamBuildEventsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeClient) StreamBuildEventsCallCount() int {
+	fake.streamBuildEventsMutex.RLock()
+	defer fake.streamBuildEventsMutex.RUnlock()
+	return len(fake.streamBuildEventsArgsForCall)
+}
+
+func (fake *FakeClient) StreamBuildEventsCalls(stub func() (eventstream.BuildEventStream, error)) {
+	fake.streamBuildEventsMutex.Lock()
+	defer fake.streamBuildEventsMutex.Unlock()
+	fake.StreamBuildEventsStub = stub
+}
+
+func (fake *FakeClient) StreamBuildEventsReturns(result1 eventstream.BuildEventStream, result2 error) {
+	fake.streamBuildEventsMutex.Lock()
+	defer fake.streamBuildEventsMutex.Unlock()
+	fake.StreamBuildEventsStub = nil
+	fake.streamBuildEventsReturns = struct {
+		result1 eventstream.BuildEventStream
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeClient) StreamBuildEventsReturnsOnCall(i int, result1 eventstream.BuildEventStream, result2 error) {
+	fake.streamBuildEventsMutex.Lock()
+	defer fake.streamBuildEventsMutex.Unlock()
+	fake.StreamBuildEventsStub = nil
+	if fake.streamBuildEventsReturnsOnCall == nil {
+		fake.streamBuildEventsReturnsOnCall = make(map[int]struct {
+			result1 eventstream.BuildEventStream
+			result2 error
+		})
+	}
+	fake.streamBuildEventsReturnsOnCall[i] = struct {
+		result1 eventstream.BuildEventStream
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeClient) Team(arg1 string) concourse.Team {
 	fake.teamMutex.Lock()
 	ret, specificReturn := fake.teamReturnsOnCall[len(fake.teamArgsForCall)]
@@ -1648,12 +2125,16 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.buildEventsMutex.RUnlock()
 	fake.buildPlanMutex.RLock()
 	defer fake.buildPlanMutex.RUnlock()
+	fake.buildResourceUsageMutex.RLock()
+	defer fake.buildResourceUsageMutex.RUnlock()
 	fake.buildResourcesMutex.RLock()
 	defer fake.buildResourcesMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
 	fake.findTeamMutex.RLock()
 	defer fake.findTeamMutex.RUnlock()
+	fake.getBuildArtifactMutex.RLock()
+	defer fake.getBuildArtifactMutex.RUnlock()
 	fake.getCLIReaderMutex.RLock()
 	defer fake.getCLIReaderMutex.RUnlock()
 	fake.getInfoMutex.RLock()
@@ -1666,6 +2147,8 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.listActiveUsersSinceMutex.RUnlock()
 	fake.listAllJobsMutex.RLock()
 	defer fake.listAllJobsMutex.RUnlock()
+	fake.listAuditEventsMutex.RLock()
+	defer fake.listAuditEventsMutex.RUnlock()
 	fake.listBuildArtifactsMutex.RLock()
 	defer fake.listBuildArtifactsMutex.RUnlock()
 	fake.listPipelinesMutex.RLock()
@@ -1678,6 +2161,12 @@ func (fake *FakeClient) Invocations() map[string][][]interface{} {
 	defer fake.pruneWorkerMutex.RUnlock()
 	fake.saveWorkerMutex.RLock()
 	defer fake.saveWorkerMutex.RUnlock()
+	fake.setBuildApprovalMutex.RLock()
+	defer fake.setBuildApprovalMutex.RUnlock()
+	fake.setTeamsMutex.RLock()
+	defer fake.setTeamsMutex.RUnlock()
+	fake.streamBuildEventsMutex.RLock()
+	defer fake.streamBuildEventsMutex.RUnlock()
 	fake.teamMutex.RLock()
 	defer fake.teamMutex.RUnlock()
 	fake.uRLMutex.RLock()
@@ -7,9 +7,22 @@ import (
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/concourse/concourse/go-concourse/concourse/eventstream"
 )
 
 type FakeTeam struct {
+	APITokensStub        func() ([]atc.APIToken, error)
+	aPITokensMutex       sync.RWMutex
+	aPITokensArgsForCall []struct {
+	}
+	aPITokensReturns struct {
+		result1 []atc.APIToken
+		result2 error
+	}
+	aPITokensReturnsOnCall map[int]struct {
+		result1 []atc.APIToken
+		result2 error
+	}
 	ATCTeamStub        func() atc.Team
 	aTCTeamMutex       sync.RWMutex
 	aTCTeamArgsForCall []struct {
@@ -59,6 +72,20 @@ type FakeTeam struct {
 		result2 bool
 		result3 error
 	}
+	BuildLifecycleEventsStub        func([]string, []string) (eventstream.BuildLifecycleStream, error)
+	buildLifecycleEventsMutex       sync.RWMutex
+	buildLifecycleEventsArgsForCall []struct {
+		arg1 []string
+		arg2 []string
+	}
+	buildLifecycleEventsReturns struct {
+		result1 eventstream.BuildLifecycleStream
+		result2 error
+	}
+	buildLifecycleEventsReturnsOnCall map[int]struct {
+		result1 eventstream.BuildLifecycleStream
+		result2 error
+	}
 	BuildsStub        func(concourse.Page) ([]atc.Build, concourse.Pagination, error)
 	buildsMutex       sync.RWMutex
 	buildsArgsForCall []struct {
@@ -190,6 +217,19 @@ type FakeTeam struct {
 		result1 int64
 		result2 error
 	}
+	CreateAPITokenStub        func(atc.APIToken) (atc.APIToken, error)
+	createAPITokenMutex       sync.RWMutex
+	createAPITokenArgsForCall []struct {
+		arg1 atc.APIToken
+	}
+	createAPITokenReturns struct {
+		result1 atc.APIToken
+		result2 error
+	}
+	createAPITokenReturnsOnCall map[int]struct {
+		result1 atc.APIToken
+		result2 error
+	}
 	CreateArtifactStub        func(io.Reader, string, []string) (atc.WorkerArtifact, error)
 	createArtifactMutex       sync.RWMutex
 	createArtifactArgsForCall []struct {
@@ -285,6 +325,18 @@ type FakeTeam struct {
 		result1 atc.Build
 		result2 error
 	}
+	CustomRolesStub        func() (atc.CustomRoles, error)
+	customRolesMutex       sync.RWMutex
+	customRolesArgsForCall []struct {
+	}
+	customRolesReturns struct {
+		result1 atc.CustomRoles
+		result2 error
+	}
+	customRolesReturnsOnCall map[int]struct {
+		result1 atc.CustomRoles
+		result2 error
+	}
 	DeletePipelineStub        func(atc.PipelineRef) (bool, error)
 	deletePipelineMutex       sync.RWMutex
 	deletePipelineArgsForCall []struct {
@@ -298,6 +350,32 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	DestroyCustomRoleStub        func(string) (bool, error)
+	destroyCustomRoleMutex       sync.RWMutex
+	destroyCustomRoleArgsForCall []struct {
+		arg1 string
+	}
+	destroyCustomRoleReturns struct {
+		result1 bool
+		result2 error
+	}
+	destroyCustomRoleReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
+	DestroyNotificationSubscriptionStub        func(string) (bool, error)
+	destroyNotificationSubscriptionMutex       sync.RWMutex
+	destroyNotificationSubscriptionArgsForCall []struct {
+		arg1 string
+	}
+	destroyNotificationSubscriptionReturns struct {
+		result1 bool
+		result2 error
+	}
+	destroyNotificationSubscriptionReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	DestroyTeamStub        func(string) error
 	destroyTeamMutex       sync.RWMutex
 	destroyTeamArgsForCall []struct {
@@ -453,6 +531,22 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	JobSchedulingExplanationStub        func(atc.PipelineRef, string) (atc.SchedulingExplanation, bool, error)
+	jobSchedulingExplanationMutex       sync.RWMutex
+	jobSchedulingExplanationArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+	}
+	jobSchedulingExplanationReturns struct {
+		result1 atc.SchedulingExplanation
+		result2 bool
+		result3 error
+	}
+	jobSchedulingExplanationReturnsOnCall map[int]struct {
+		result1 atc.SchedulingExplanation
+		result2 bool
+		result3 error
+	}
 	ListContainersStub        func(map[string]string) ([]atc.Container, error)
 	listContainersMutex       sync.RWMutex
 	listContainersArgsForCall []struct {
@@ -526,6 +620,34 @@ type FakeTeam struct {
 	nameReturnsOnCall map[int]struct {
 		result1 string
 	}
+	NotificationDeliveriesStub        func(string, int) ([]atc.NotificationDelivery, bool, error)
+	notificationDeliveriesMutex       sync.RWMutex
+	notificationDeliveriesArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	notificationDeliveriesReturns struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}
+	notificationDeliveriesReturnsOnCall map[int]struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}
+	NotificationSubscriptionsStub        func() ([]atc.NotificationSubscription, error)
+	notificationSubscriptionsMutex       sync.RWMutex
+	notificationSubscriptionsArgsForCall []struct {
+	}
+	notificationSubscriptionsReturns struct {
+		result1 []atc.NotificationSubscription
+		result2 error
+	}
+	notificationSubscriptionsReturnsOnCall map[int]struct {
+		result1 []atc.NotificationSubscription
+		result2 error
+	}
 	OrderingPipelinesStub        func([]string) error
 	orderingPipelinesMutex       sync.RWMutex
 	orderingPipelinesArgsForCall []struct {
@@ -641,6 +763,68 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	PipelineConfigHistoryStub        func(atc.PipelineRef) ([]atc.PipelineConfigRevision, bool, error)
+	pipelineConfigHistoryMutex       sync.RWMutex
+	pipelineConfigHistoryArgsForCall []struct {
+		arg1 atc.PipelineRef
+	}
+	pipelineConfigHistoryReturns struct {
+		result1 []atc.PipelineConfigRevision
+		result2 bool
+		result3 error
+	}
+	pipelineConfigHistoryReturnsOnCall map[int]struct {
+		result1 []atc.PipelineConfigRevision
+		result2 bool
+		result3 error
+	}
+	PipelineConfigRevisionStub        func(atc.PipelineRef, int) (atc.PipelineConfigRevision, bool, error)
+	pipelineConfigRevisionMutex       sync.RWMutex
+	pipelineConfigRevisionArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 int
+	}
+	pipelineConfigRevisionReturns struct {
+		result1 atc.PipelineConfigRevision
+		result2 bool
+		result3 error
+	}
+	pipelineConfigRevisionReturnsOnCall map[int]struct {
+		result1 atc.PipelineConfigRevision
+		result2 bool
+		result3 error
+	}
+	PipelineGraphStub        func(atc.PipelineRef, string, string) (atc.PipelineGraph, bool, error)
+	pipelineGraphMutex       sync.RWMutex
+	pipelineGraphArgsForCall []struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 string
+	}
+	pipelineGraphReturns struct {
+		result1 atc.PipelineGraph
+		result2 bool
+		result3 error
+	}
+	pipelineGraphReturnsOnCall map[int]struct {
+		result1 atc.PipelineGraph
+		result2 bool
+		result3 error
+	}
+	RedeliverNotificationStub        func(string, int) (bool, error)
+	redeliverNotificationMutex       sync.RWMutex
+	redeliverNotificationArgsForCall []struct {
+		arg1 string
+		arg2 int
+	}
+	redeliverNotificationReturns struct {
+		result1 bool
+		result2 error
+	}
+	redeliverNotificationReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	RenamePipelineStub        func(string, string) (bool, []concourse.ConfigWarning, error)
 	renamePipelineMutex       sync.RWMutex
 	renamePipelineArgsForCall []struct {
@@ -724,6 +908,19 @@ type FakeTeam struct {
 		result3 bool
 		result4 error
 	}
+	RevokeAPITokenStub        func(string) (bool, error)
+	revokeAPITokenMutex       sync.RWMutex
+	revokeAPITokenArgsForCall []struct {
+		arg1 string
+	}
+	revokeAPITokenReturns struct {
+		result1 bool
+		result2 error
+	}
+	revokeAPITokenReturnsOnCall map[int]struct {
+		result1 bool
+		result2 error
+	}
 	ScheduleJobStub        func(atc.PipelineRef, string) (bool, error)
 	scheduleJobMutex       sync.RWMutex
 	scheduleJobArgsForCall []struct {
@@ -738,6 +935,28 @@ type FakeTeam struct {
 		result1 bool
 		result2 error
 	}
+	SetCustomRoleStub        func(atc.CustomRole) error
+	setCustomRoleMutex       sync.RWMutex
+	setCustomRoleArgsForCall []struct {
+		arg1 atc.CustomRole
+	}
+	setCustomRoleReturns struct {
+		result1 error
+	}
+	setCustomRoleReturnsOnCall map[int]struct {
+		result1 error
+	}
+	SetNotificationSubscriptionStub        func(atc.NotificationSubscription) error
+	setNotificationSubscriptionMutex       sync.RWMutex
+	setNotificationSubscriptionArgsForCall []struct {
+		arg1 atc.NotificationSubscription
+	}
+	setNotificationSubscriptionReturns struct {
+		result1 error
+	}
+	setNotificationSubscriptionReturnsOnCall map[int]struct {
+		result1 error
+	}
 	SetPinCommentStub        func(atc.PipelineRef, string, string) (bool, error)
 	setPinCommentMutex       sync.RWMutex
 	setPinCommentArgsForCall []struct {
@@ -813,6 +1032,62 @@ type FakeTeam struct {
 	invocationsMutex sync.RWMutex
 }
 
+func (fake *FakeTeam) APITokens() ([]atc.APIToken, error) {
+	fake.aPITokensMutex.Lock()
+	ret, specificReturn := fake.aPITokensReturnsOnCall[len(fake.aPITokensArgsForCall)]
+	fake.aPITokensArgsForCall = append(fake.aPITokensArgsForCall, struct {
+	}{})
+	stub := fake.APITokensStub
+	fakeReturns := fake.aPITokensReturns
+	fake.recordInvocation("APITokens", []interface{}{})
+	fake.aPITokensMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) APITokensCallCount() int {
+	fake.aPITokensMutex.RLock()
+	defer fake.aPITokensMutex.RUnlock()
+	return len(fake.aPITokensArgsForCall)
+}
+
+func (fake *FakeTeam) APITokensCalls(stub func() ([]atc.APIToken, error)) {
+	fake.aPITokensMutex.Lock()
+	defer fake.aPITokensMutex.Unlock()
+	fake.APITokensStub = stub
+}
+
+func (fake *FakeTeam) APITokensReturns(result1 []atc.APIToken, result2 error) {
+	fake.aPITokensMutex.Lock()
+	defer fake.aPITokensMutex.Unlock()
+	fake.APITokensStub = nil
+	fake.aPITokensReturns = struct {
+		result1 []atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) APITokensReturnsOnCall(i int, result1 []atc.APIToken, result2 error) {
+	fake.aPITokensMutex.Lock()
+	defer fake.aPITokensMutex.Unlock()
+	fake.APITokensStub = nil
+	if fake.aPITokensReturnsOnCall == nil {
+		fake.aPITokensReturnsOnCall = make(map[int]struct {
+			result1 []atc.APIToken
+			result2 error
+		})
+	}
+	fake.aPITokensReturnsOnCall[i] = struct {
+		result1 []atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ATCTeam() atc.Team {
 	fake.aTCTeamMutex.Lock()
 	ret, specificReturn := fake.aTCTeamReturnsOnCall[len(fake.aTCTeamArgsForCall)]
@@ -1051,6 +1326,81 @@ func (fake *FakeTeam) BuildInputsForJobReturnsOnCall(i int, result1 []atc.BuildI
 	}{result1, result2, result3}
 }
 
+func (fake *FakeTeam) BuildLifecycleEvents(arg1 []string, arg2 []string) (eventstream.BuildLifecycleStream, error) {
+	var arg1Copy []string
+	if arg1 != nil {
+		arg1Copy = make([]string, len(arg1))
+		copy(arg1Copy, arg1)
+	}
+	var arg2Copy []string
+	if arg2 != nil {
+		arg2Copy = make([]string, len(arg2))
+		copy(arg2Copy, arg2)
+	}
+	fake.buildLifecycleEventsMutex.Lock()
+	ret, specificReturn := fake.buildLifecycleEventsReturnsOnCall[len(fake.buildLifecycleEventsArgsForCall)]
+	fake.buildLifecycleEventsArgsForCall = append(fake.buildLifecycleEventsArgsForCall, struct {
+		arg1 []string
+		arg2 []string
+	}{arg1Copy, arg2Copy})
+	stub := fake.BuildLifecycleEventsStub
+	fakeReturns := fake.buildLifecycleEventsReturns
+	fake.recordInvocation("BuildLifecycleEvents", []interface{}{arg1Copy, arg2Copy})
+	fake.buildLifecycleEventsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) BuildLifecycleEventsCallCount() int {
+	fake.buildLifecycleEventsMutex.RLock()
+	defer fake.buildLifecycleEventsMutex.RUnlock()
+	return len(fake.buildLifecycleEventsArgsForCall)
+}
+
+func (fake *FakeTeam) BuildLifecycleEventsCalls(stub func([]string, []string) (eventstream.BuildLifecycleStream, error)) {
+	fake.buildLifecycleEventsMutex.Lock()
+	defer fake.buildLifecycleEventsMutex.Unlock()
+	fake.BuildLifecycleEventsStub = stub
+}
+
+func (fake *FakeTeam) BuildLifecycleEventsArgsForCall(i int) ([]string, []string) {
+	fake.buildLifecycleEventsMutex.RLock()
+	defer fake.buildLifecycleEventsMutex.RUnlock()
+	argsForCall := fake.buildLifecycleEventsArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) BuildLifecycleEventsReturns(result1 eventstream.BuildLifecycleStream, result2 error) {
+	fake.buildLifecycleEventsMutex.Lock()
+	defer fake.buildLifecycleEventsMutex.Unlock()
+	fake.BuildLifecycleEventsStub = nil
+	fake.buildLifecycleEventsReturns = struct {
+		result1 eventstream.BuildLifecycleStream
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) BuildLifecycleEventsReturnsOnCall(i int, result1 eventstream.BuildLifecycleStream, result2 error) {
+	fake.buildLifecycleEventsMutex.Lock()
+	defer fake.buildLifecycleEventsMutex.Unlock()
+	fake.BuildLifecycleEventsStub = nil
+	if fake.buildLifecycleEventsReturnsOnCall == nil {
+		fake.buildLifecycleEventsReturnsOnCall = make(map[int]struct {
+			result1 eventstream.BuildLifecycleStream
+			result2 error
+		})
+	}
+	fake.buildLifecycleEventsReturnsOnCall[i] = struct {
+		result1 eventstream.BuildLifecycleStream
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) Builds(arg1 concourse.Page) ([]atc.Build, concourse.Pagination, error) {
 	fake.buildsMutex.Lock()
 	ret, specificReturn := fake.buildsReturnsOnCall[len(fake.buildsArgsForCall)]
@@ -1596,6 +1946,70 @@ func (fake *FakeTeam) ClearTaskCacheReturnsOnCall(i int, result1 int64, result2
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CreateAPIToken(arg1 atc.APIToken) (atc.APIToken, error) {
+	fake.createAPITokenMutex.Lock()
+	ret, specificReturn := fake.createAPITokenReturnsOnCall[len(fake.createAPITokenArgsForCall)]
+	fake.createAPITokenArgsForCall = append(fake.createAPITokenArgsForCall, struct {
+		arg1 atc.APIToken
+	}{arg1})
+	stub := fake.CreateAPITokenStub
+	fakeReturns := fake.createAPITokenReturns
+	fake.recordInvocation("CreateAPIToken", []interface{}{arg1})
+	fake.createAPITokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) CreateAPITokenCallCount() int {
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	return len(fake.createAPITokenArgsForCall)
+}
+
+func (fake *FakeTeam) CreateAPITokenCalls(stub func(atc.APIToken) (atc.APIToken, error)) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = stub
+}
+
+func (fake *FakeTeam) CreateAPITokenArgsForCall(i int) atc.APIToken {
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
+	argsForCall := fake.createAPITokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) CreateAPITokenReturns(result1 atc.APIToken, result2 error) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = nil
+	fake.createAPITokenReturns = struct {
+		result1 atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CreateAPITokenReturnsOnCall(i int, result1 atc.APIToken, result2 error) {
+	fake.createAPITokenMutex.Lock()
+	defer fake.createAPITokenMutex.Unlock()
+	fake.CreateAPITokenStub = nil
+	if fake.createAPITokenReturnsOnCall == nil {
+		fake.createAPITokenReturnsOnCall = make(map[int]struct {
+			result1 atc.APIToken
+			result2 error
+		})
+	}
+	fake.createAPITokenReturnsOnCall[i] = struct {
+		result1 atc.APIToken
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) CreateArtifact(arg1 io.Reader, arg2 string, arg3 []string) (atc.WorkerArtifact, error) {
 	var arg3Copy []string
 	if arg3 != nil {
@@ -2012,6 +2426,62 @@ func (fake *FakeTeam) CreatePipelineBuildReturnsOnCall(i int, result1 atc.Build,
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) CustomRoles() (atc.CustomRoles, error) {
+	fake.customRolesMutex.Lock()
+	ret, specificReturn := fake.customRolesReturnsOnCall[len(fake.customRolesArgsForCall)]
+	fake.customRolesArgsForCall = append(fake.customRolesArgsForCall, struct {
+	}{})
+	stub := fake.CustomRolesStub
+	fakeReturns := fake.customRolesReturns
+	fake.recordInvocation("CustomRoles", []interface{}{})
+	fake.customRolesMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) CustomRolesCallCount() int {
+	fake.customRolesMutex.RLock()
+	defer fake.customRolesMutex.RUnlock()
+	return len(fake.customRolesArgsForCall)
+}
+
+func (fake *FakeTeam) CustomRolesCalls(stub func() (atc.CustomRoles, error)) {
+	fake.customRolesMutex.Lock()
+	defer fake.customRolesMutex.Unlock()
+	fake.CustomRolesStub = stub
+}
+
+func (fake *FakeTeam) CustomRolesReturns(result1 atc.CustomRoles, result2 error) {
+	fake.customRolesMutex.Lock()
+	defer fake.customRolesMutex.Unlock()
+	fake.CustomRolesStub = nil
+	fake.customRolesReturns = struct {
+		result1 atc.CustomRoles
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) CustomRolesReturnsOnCall(i int, result1 atc.CustomRoles, result2 error) {
+	fake.customRolesMutex.Lock()
+	defer fake.customRolesMutex.Unlock()
+	fake.CustomRolesStub = nil
+	if fake.customRolesReturnsOnCall == nil {
+		fake.customRolesReturnsOnCall = make(map[int]struct {
+			result1 atc.CustomRoles
+			result2 error
+		})
+	}
+	fake.customRolesReturnsOnCall[i] = struct {
+		result1 atc.CustomRoles
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) DeletePipeline(arg1 atc.PipelineRef) (bool, error) {
 	fake.deletePipelineMutex.Lock()
 	ret, specificReturn := fake.deletePipelineReturnsOnCall[len(fake.deletePipelineArgsForCall)]
@@ -2076,51 +2546,179 @@ func (fake *FakeTeam) DeletePipelineReturnsOnCall(i int, result1 bool, result2 e
 	}{result1, result2}
 }
 
-func (fake *FakeTeam) DestroyTeam(arg1 string) error {
-	fake.destroyTeamMutex.Lock()
-	ret, specificReturn := fake.destroyTeamReturnsOnCall[len(fake.destroyTeamArgsForCall)]
-	fake.destroyTeamArgsForCall = append(fake.destroyTeamArgsForCall, struct {
+func (fake *FakeTeam) DestroyCustomRole(arg1 string) (bool, error) {
+	fake.destroyCustomRoleMutex.Lock()
+	ret, specificReturn := fake.destroyCustomRoleReturnsOnCall[len(fake.destroyCustomRoleArgsForCall)]
+	fake.destroyCustomRoleArgsForCall = append(fake.destroyCustomRoleArgsForCall, struct {
 		arg1 string
 	}{arg1})
-	stub := fake.DestroyTeamStub
-	fakeReturns := fake.destroyTeamReturns
-	fake.recordInvocation("DestroyTeam", []interface{}{arg1})
-	fake.destroyTeamMutex.Unlock()
+	stub := fake.DestroyCustomRoleStub
+	fakeReturns := fake.destroyCustomRoleReturns
+	fake.recordInvocation("DestroyCustomRole", []interface{}{arg1})
+	fake.destroyCustomRoleMutex.Unlock()
 	if stub != nil {
 		return stub(arg1)
 	}
 	if specificReturn {
-		return ret.result1
+		return ret.result1, ret.result2
 	}
-	return fakeReturns.result1
+	return fakeReturns.result1, fakeReturns.result2
 }
 
-func (fake *FakeTeam) DestroyTeamCallCount() int {
-	fake.destroyTeamMutex.RLock()
-	defer fake.destroyTeamMutex.RUnlock()
-	return len(fake.destroyTeamArgsForCall)
+func (fake *FakeTeam) DestroyCustomRoleCallCount() int {
+	fake.destroyCustomRoleMutex.RLock()
+	defer fake.destroyCustomRoleMutex.RUnlock()
+	return len(fake.destroyCustomRoleArgsForCall)
 }
 
-func (fake *FakeTeam) DestroyTeamCalls(stub func(string) error) {
-	fake.destroyTeamMutex.Lock()
-	defer fake.destroyTeamMutex.Unlock()
-	fake.DestroyTeamStub = stub
+func (fake *FakeTeam) DestroyCustomRoleCalls(stub func(string) (bool, error)) {
+	fake.destroyCustomRoleMutex.Lock()
+	defer fake.destroyCustomRoleMutex.Unlock()
+	fake.DestroyCustomRoleStub = stub
 }
 
-func (fake *FakeTeam) DestroyTeamArgsForCall(i int) string {
-	fake.destroyTeamMutex.RLock()
-	defer fake.destroyTeamMutex.RUnlock()
-	argsForCall := fake.destroyTeamArgsForCall[i]
+func (fake *FakeTeam) DestroyCustomRoleArgsForCall(i int) string {
+	fake.destroyCustomRoleMutex.RLock()
+	defer fake.destroyCustomRoleMutex.RUnlock()
+	argsForCall := fake.destroyCustomRoleArgsForCall[i]
 	return argsForCall.arg1
 }
 
-func (fake *FakeTeam) DestroyTeamReturns(result1 error) {
-	fake.destroyTeamMutex.Lock()
-	defer fake.destroyTeamMutex.Unlock()
-	fake.DestroyTeamStub = nil
-	fake.destroyTeamReturns = struct {
-		result1 error
-	}{result1}
+func (fake *FakeTeam) DestroyCustomRoleReturns(result1 bool, result2 error) {
+	fake.destroyCustomRoleMutex.Lock()
+	defer fake.destroyCustomRoleMutex.Unlock()
+	fake.DestroyCustomRoleStub = nil
+	fake.destroyCustomRoleReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DestroyCustomRoleReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.destroyCustomRoleMutex.Lock()
+	defer fake.destroyCustomRoleMutex.Unlock()
+	fake.DestroyCustomRoleStub = nil
+	if fake.destroyCustomRoleReturnsOnCall == nil {
+		fake.destroyCustomRoleReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.destroyCustomRoleReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DestroyNotificationSubscription(arg1 string) (bool, error) {
+	fake.destroyNotificationSubscriptionMutex.Lock()
+	ret, specificReturn := fake.destroyNotificationSubscriptionReturnsOnCall[len(fake.destroyNotificationSubscriptionArgsForCall)]
+	fake.destroyNotificationSubscriptionArgsForCall = append(fake.destroyNotificationSubscriptionArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DestroyNotificationSubscriptionStub
+	fakeReturns := fake.destroyNotificationSubscriptionReturns
+	fake.recordInvocation("DestroyNotificationSubscription", []interface{}{arg1})
+	fake.destroyNotificationSubscriptionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) DestroyNotificationSubscriptionCallCount() int {
+	fake.destroyNotificationSubscriptionMutex.RLock()
+	defer fake.destroyNotificationSubscriptionMutex.RUnlock()
+	return len(fake.destroyNotificationSubscriptionArgsForCall)
+}
+
+func (fake *FakeTeam) DestroyNotificationSubscriptionCalls(stub func(string) (bool, error)) {
+	fake.destroyNotificationSubscriptionMutex.Lock()
+	defer fake.destroyNotificationSubscriptionMutex.Unlock()
+	fake.DestroyNotificationSubscriptionStub = stub
+}
+
+func (fake *FakeTeam) DestroyNotificationSubscriptionArgsForCall(i int) string {
+	fake.destroyNotificationSubscriptionMutex.RLock()
+	defer fake.destroyNotificationSubscriptionMutex.RUnlock()
+	argsForCall := fake.destroyNotificationSubscriptionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DestroyNotificationSubscriptionReturns(result1 bool, result2 error) {
+	fake.destroyNotificationSubscriptionMutex.Lock()
+	defer fake.destroyNotificationSubscriptionMutex.Unlock()
+	fake.DestroyNotificationSubscriptionStub = nil
+	fake.destroyNotificationSubscriptionReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DestroyNotificationSubscriptionReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.destroyNotificationSubscriptionMutex.Lock()
+	defer fake.destroyNotificationSubscriptionMutex.Unlock()
+	fake.DestroyNotificationSubscriptionStub = nil
+	if fake.destroyNotificationSubscriptionReturnsOnCall == nil {
+		fake.destroyNotificationSubscriptionReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.destroyNotificationSubscriptionReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) DestroyTeam(arg1 string) error {
+	fake.destroyTeamMutex.Lock()
+	ret, specificReturn := fake.destroyTeamReturnsOnCall[len(fake.destroyTeamArgsForCall)]
+	fake.destroyTeamArgsForCall = append(fake.destroyTeamArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.DestroyTeamStub
+	fakeReturns := fake.destroyTeamReturns
+	fake.recordInvocation("DestroyTeam", []interface{}{arg1})
+	fake.destroyTeamMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) DestroyTeamCallCount() int {
+	fake.destroyTeamMutex.RLock()
+	defer fake.destroyTeamMutex.RUnlock()
+	return len(fake.destroyTeamArgsForCall)
+}
+
+func (fake *FakeTeam) DestroyTeamCalls(stub func(string) error) {
+	fake.destroyTeamMutex.Lock()
+	defer fake.destroyTeamMutex.Unlock()
+	fake.DestroyTeamStub = stub
+}
+
+func (fake *FakeTeam) DestroyTeamArgsForCall(i int) string {
+	fake.destroyTeamMutex.RLock()
+	defer fake.destroyTeamMutex.RUnlock()
+	argsForCall := fake.destroyTeamArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) DestroyTeamReturns(result1 error) {
+	fake.destroyTeamMutex.Lock()
+	defer fake.destroyTeamMutex.Unlock()
+	fake.DestroyTeamStub = nil
+	fake.destroyTeamReturns = struct {
+		result1 error
+	}{result1}
 }
 
 func (fake *FakeTeam) DestroyTeamReturnsOnCall(i int, result1 error) {
@@ -2787,6 +3385,74 @@ func (fake *FakeTeam) JobBuildsReturnsOnCall(i int, result1 []atc.Build, result2
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) JobSchedulingExplanation(arg1 atc.PipelineRef, arg2 string) (atc.SchedulingExplanation, bool, error) {
+	fake.jobSchedulingExplanationMutex.Lock()
+	ret, specificReturn := fake.jobSchedulingExplanationReturnsOnCall[len(fake.jobSchedulingExplanationArgsForCall)]
+	fake.jobSchedulingExplanationArgsForCall = append(fake.jobSchedulingExplanationArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+	}{arg1, arg2})
+	stub := fake.JobSchedulingExplanationStub
+	fakeReturns := fake.jobSchedulingExplanationReturns
+	fake.recordInvocation("JobSchedulingExplanation", []interface{}{arg1, arg2})
+	fake.jobSchedulingExplanationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) JobSchedulingExplanationCallCount() int {
+	fake.jobSchedulingExplanationMutex.RLock()
+	defer fake.jobSchedulingExplanationMutex.RUnlock()
+	return len(fake.jobSchedulingExplanationArgsForCall)
+}
+
+func (fake *FakeTeam) JobSchedulingExplanationCalls(stub func(atc.PipelineRef, string) (atc.SchedulingExplanation, bool, error)) {
+	fake.jobSchedulingExplanationMutex.Lock()
+	defer fake.jobSchedulingExplanationMutex.Unlock()
+	fake.JobSchedulingExplanationStub = stub
+}
+
+func (fake *FakeTeam) JobSchedulingExplanationArgsForCall(i int) (atc.PipelineRef, string) {
+	fake.jobSchedulingExplanationMutex.RLock()
+	defer fake.jobSchedulingExplanationMutex.RUnlock()
+	argsForCall := fake.jobSchedulingExplanationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) JobSchedulingExplanationReturns(result1 atc.SchedulingExplanation, result2 bool, result3 error) {
+	fake.jobSchedulingExplanationMutex.Lock()
+	defer fake.jobSchedulingExplanationMutex.Unlock()
+	fake.JobSchedulingExplanationStub = nil
+	fake.jobSchedulingExplanationReturns = struct {
+		result1 atc.SchedulingExplanation
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) JobSchedulingExplanationReturnsOnCall(i int, result1 atc.SchedulingExplanation, result2 bool, result3 error) {
+	fake.jobSchedulingExplanationMutex.Lock()
+	defer fake.jobSchedulingExplanationMutex.Unlock()
+	fake.JobSchedulingExplanationStub = nil
+	if fake.jobSchedulingExplanationReturnsOnCall == nil {
+		fake.jobSchedulingExplanationReturnsOnCall = make(map[int]struct {
+			result1 atc.SchedulingExplanation
+			result2 bool
+			result3 error
+		})
+	}
+	fake.jobSchedulingExplanationReturnsOnCall[i] = struct {
+		result1 atc.SchedulingExplanation
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
 func (fake *FakeTeam) ListContainers(arg1 map[string]string) ([]atc.Container, error) {
 	fake.listContainersMutex.Lock()
 	ret, specificReturn := fake.listContainersReturnsOnCall[len(fake.listContainersArgsForCall)]
@@ -3144,6 +3810,130 @@ func (fake *FakeTeam) NameReturnsOnCall(i int, result1 string) {
 	}{result1}
 }
 
+func (fake *FakeTeam) NotificationDeliveries(arg1 string, arg2 int) ([]atc.NotificationDelivery, bool, error) {
+	fake.notificationDeliveriesMutex.Lock()
+	ret, specificReturn := fake.notificationDeliveriesReturnsOnCall[len(fake.notificationDeliveriesArgsForCall)]
+	fake.notificationDeliveriesArgsForCall = append(fake.notificationDeliveriesArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.NotificationDeliveriesStub
+	fakeReturns := fake.notificationDeliveriesReturns
+	fake.recordInvocation("NotificationDeliveries", []interface{}{arg1, arg2})
+	fake.notificationDeliveriesMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) NotificationDeliveriesCallCount() int {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	return len(fake.notificationDeliveriesArgsForCall)
+}
+
+func (fake *FakeTeam) NotificationDeliveriesCalls(stub func(string, int) ([]atc.NotificationDelivery, bool, error)) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = stub
+}
+
+func (fake *FakeTeam) NotificationDeliveriesArgsForCall(i int) (string, int) {
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	argsForCall := fake.notificationDeliveriesArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) NotificationDeliveriesReturns(result1 []atc.NotificationDelivery, result2 bool, result3 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	fake.notificationDeliveriesReturns = struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) NotificationDeliveriesReturnsOnCall(i int, result1 []atc.NotificationDelivery, result2 bool, result3 error) {
+	fake.notificationDeliveriesMutex.Lock()
+	defer fake.notificationDeliveriesMutex.Unlock()
+	fake.NotificationDeliveriesStub = nil
+	if fake.notificationDeliveriesReturnsOnCall == nil {
+		fake.notificationDeliveriesReturnsOnCall = make(map[int]struct {
+			result1 []atc.NotificationDelivery
+			result2 bool
+			result3 error
+		})
+	}
+	fake.notificationDeliveriesReturnsOnCall[i] = struct {
+		result1 []atc.NotificationDelivery
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) NotificationSubscriptions() ([]atc.NotificationSubscription, error) {
+	fake.notificationSubscriptionsMutex.Lock()
+	ret, specificReturn := fake.notificationSubscriptionsReturnsOnCall[len(fake.notificationSubscriptionsArgsForCall)]
+	fake.notificationSubscriptionsArgsForCall = append(fake.notificationSubscriptionsArgsForCall, struct {
+	}{})
+	stub := fake.NotificationSubscriptionsStub
+	fakeReturns := fake.notificationSubscriptionsReturns
+	fake.recordInvocation("NotificationSubscriptions", []interface{}{})
+	fake.notificationSubscriptionsMutex.Unlock()
+	if stub != nil {
+		return stub()
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) NotificationSubscriptionsCallCount() int {
+	fake.notificationSubscriptionsMutex.RLock()
+	defer fake.notificationSubscriptionsMutex.RUnlock()
+	return len(fake.notificationSubscriptionsArgsForCall)
+}
+
+func (fake *FakeTeam) NotificationSubscriptionsCalls(stub func() ([]atc.NotificationSubscription, error)) {
+	fake.notificationSubscriptionsMutex.Lock()
+	defer fake.notificationSubscriptionsMutex.Unlock()
+	fake.NotificationSubscriptionsStub = stub
+}
+
+func (fake *FakeTeam) NotificationSubscriptionsReturns(result1 []atc.NotificationSubscription, result2 error) {
+	fake.notificationSubscriptionsMutex.Lock()
+	defer fake.notificationSubscriptionsMutex.Unlock()
+	fake.NotificationSubscriptionsStub = nil
+	fake.notificationSubscriptionsReturns = struct {
+		result1 []atc.NotificationSubscription
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) NotificationSubscriptionsReturnsOnCall(i int, result1 []atc.NotificationSubscription, result2 error) {
+	fake.notificationSubscriptionsMutex.Lock()
+	defer fake.notificationSubscriptionsMutex.Unlock()
+	fake.NotificationSubscriptionsStub = nil
+	if fake.notificationSubscriptionsReturnsOnCall == nil {
+		fake.notificationSubscriptionsReturnsOnCall = make(map[int]struct {
+			result1 []atc.NotificationSubscription
+			result2 error
+		})
+	}
+	fake.notificationSubscriptionsReturnsOnCall[i] = struct {
+		result1 []atc.NotificationSubscription
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) OrderingPipelines(arg1 []string) error {
 	var arg1Copy []string
 	if arg1 != nil {
@@ -3680,6 +4470,275 @@ func (fake *FakeTeam) PipelineConfigReturnsOnCall(i int, result1 atc.Config, res
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) PipelineConfigHistory(arg1 atc.PipelineRef) ([]atc.PipelineConfigRevision, bool, error) {
+	fake.pipelineConfigHistoryMutex.Lock()
+	ret, specificReturn := fake.pipelineConfigHistoryReturnsOnCall[len(fake.pipelineConfigHistoryArgsForCall)]
+	fake.pipelineConfigHistoryArgsForCall = append(fake.pipelineConfigHistoryArgsForCall, struct {
+		arg1 atc.PipelineRef
+	}{arg1})
+	stub := fake.PipelineConfigHistoryStub
+	fakeReturns := fake.pipelineConfigHistoryReturns
+	fake.recordInvocation("PipelineConfigHistory", []interface{}{arg1})
+	fake.pipelineConfigHistoryMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineConfigHistoryCallCount() int {
+	fake.pipelineConfigHistoryMutex.RLock()
+	defer fake.pipelineConfigHistoryMutex.RUnlock()
+	return len(fake.pipelineConfigHistoryArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineConfigHistoryCalls(stub func(atc.PipelineRef) ([]atc.PipelineConfigRevision, bool, error)) {
+	fake.pipelineConfigHistoryMutex.Lock()
+	defer fake.pipelineConfigHistoryMutex.Unlock()
+	fake.PipelineConfigHistoryStub = stub
+}
+
+func (fake *FakeTeam) PipelineConfigHistoryArgsForCall(i int) atc.PipelineRef {
+	fake.pipelineConfigHistoryMutex.RLock()
+	defer fake.pipelineConfigHistoryMutex.RUnlock()
+	argsForCall := fake.pipelineConfigHistoryArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) PipelineConfigHistoryReturns(result1 []atc.PipelineConfigRevision, result2 bool, result3 error) {
+	fake.pipelineConfigHistoryMutex.Lock()
+	defer fake.pipelineConfigHistoryMutex.Unlock()
+	fake.PipelineConfigHistoryStub = nil
+	fake.pipelineConfigHistoryReturns = struct {
+		result1 []atc.PipelineConfigRevision
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigHistoryReturnsOnCall(i int, result1 []atc.PipelineConfigRevision, result2 bool, result3 error) {
+	fake.pipelineConfigHistoryMutex.Lock()
+	defer fake.pipelineConfigHistoryMutex.Unlock()
+	fake.PipelineConfigHistoryStub = nil
+	if fake.pipelineConfigHistoryReturnsOnCall == nil {
+		fake.pipelineConfigHistoryReturnsOnCall = make(map[int]struct {
+			result1 []atc.PipelineConfigRevision
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineConfigHistoryReturnsOnCall[i] = struct {
+		result1 []atc.PipelineConfigRevision
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigRevision(arg1 atc.PipelineRef, arg2 int) (atc.PipelineConfigRevision, bool, error) {
+	fake.pipelineConfigRevisionMutex.Lock()
+	ret, specificReturn := fake.pipelineConfigRevisionReturnsOnCall[len(fake.pipelineConfigRevisionArgsForCall)]
+	fake.pipelineConfigRevisionArgsForCall = append(fake.pipelineConfigRevisionArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.PipelineConfigRevisionStub
+	fakeReturns := fake.pipelineConfigRevisionReturns
+	fake.recordInvocation("PipelineConfigRevision", []interface{}{arg1, arg2})
+	fake.pipelineConfigRevisionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineConfigRevisionCallCount() int {
+	fake.pipelineConfigRevisionMutex.RLock()
+	defer fake.pipelineConfigRevisionMutex.RUnlock()
+	return len(fake.pipelineConfigRevisionArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineConfigRevisionCalls(stub func(atc.PipelineRef, int) (atc.PipelineConfigRevision, bool, error)) {
+	fake.pipelineConfigRevisionMutex.Lock()
+	defer fake.pipelineConfigRevisionMutex.Unlock()
+	fake.PipelineConfigRevisionStub = stub
+}
+
+func (fake *FakeTeam) PipelineConfigRevisionArgsForCall(i int) (atc.PipelineRef, int) {
+	fake.pipelineConfigRevisionMutex.RLock()
+	defer fake.pipelineConfigRevisionMutex.RUnlock()
+	argsForCall := fake.pipelineConfigRevisionArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) PipelineConfigRevisionReturns(result1 atc.PipelineConfigRevision, result2 bool, result3 error) {
+	fake.pipelineConfigRevisionMutex.Lock()
+	defer fake.pipelineConfigRevisionMutex.Unlock()
+	fake.PipelineConfigRevisionStub = nil
+	fake.pipelineConfigRevisionReturns = struct {
+		result1 atc.PipelineConfigRevision
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineConfigRevisionReturnsOnCall(i int, result1 atc.PipelineConfigRevision, result2 bool, result3 error) {
+	fake.pipelineConfigRevisionMutex.Lock()
+	defer fake.pipelineConfigRevisionMutex.Unlock()
+	fake.PipelineConfigRevisionStub = nil
+	if fake.pipelineConfigRevisionReturnsOnCall == nil {
+		fake.pipelineConfigRevisionReturnsOnCall = make(map[int]struct {
+			result1 atc.PipelineConfigRevision
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineConfigRevisionReturnsOnCall[i] = struct {
+		result1 atc.PipelineConfigRevision
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineGraph(arg1 atc.PipelineRef, arg2 string, arg3 string) (atc.PipelineGraph, bool, error) {
+	fake.pipelineGraphMutex.Lock()
+	ret, specificReturn := fake.pipelineGraphReturnsOnCall[len(fake.pipelineGraphArgsForCall)]
+	fake.pipelineGraphArgsForCall = append(fake.pipelineGraphArgsForCall, struct {
+		arg1 atc.PipelineRef
+		arg2 string
+		arg3 string
+	}{arg1, arg2, arg3})
+	stub := fake.PipelineGraphStub
+	fakeReturns := fake.pipelineGraphReturns
+	fake.recordInvocation("PipelineGraph", []interface{}{arg1, arg2, arg3})
+	fake.pipelineGraphMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2, arg3)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2, ret.result3
+	}
+	return fakeReturns.result1, fakeReturns.result2, fakeReturns.result3
+}
+
+func (fake *FakeTeam) PipelineGraphCallCount() int {
+	fake.pipelineGraphMutex.RLock()
+	defer fake.pipelineGraphMutex.RUnlock()
+	return len(fake.pipelineGraphArgsForCall)
+}
+
+func (fake *FakeTeam) PipelineGraphCalls(stub func(atc.PipelineRef, string, string) (atc.PipelineGraph, bool, error)) {
+	fake.pipelineGraphMutex.Lock()
+	defer fake.pipelineGraphMutex.Unlock()
+	fake.PipelineGraphStub = stub
+}
+
+func (fake *FakeTeam) PipelineGraphArgsForCall(i int) (atc.PipelineRef, string, string) {
+	fake.pipelineGraphMutex.RLock()
+	defer fake.pipelineGraphMutex.RUnlock()
+	argsForCall := fake.pipelineGraphArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2, argsForCall.arg3
+}
+
+func (fake *FakeTeam) PipelineGraphReturns(result1 atc.PipelineGraph, result2 bool, result3 error) {
+	fake.pipelineGraphMutex.Lock()
+	defer fake.pipelineGraphMutex.Unlock()
+	fake.PipelineGraphStub = nil
+	fake.pipelineGraphReturns = struct {
+		result1 atc.PipelineGraph
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) PipelineGraphReturnsOnCall(i int, result1 atc.PipelineGraph, result2 bool, result3 error) {
+	fake.pipelineGraphMutex.Lock()
+	defer fake.pipelineGraphMutex.Unlock()
+	fake.PipelineGraphStub = nil
+	if fake.pipelineGraphReturnsOnCall == nil {
+		fake.pipelineGraphReturnsOnCall = make(map[int]struct {
+			result1 atc.PipelineGraph
+			result2 bool
+			result3 error
+		})
+	}
+	fake.pipelineGraphReturnsOnCall[i] = struct {
+		result1 atc.PipelineGraph
+		result2 bool
+		result3 error
+	}{result1, result2, result3}
+}
+
+func (fake *FakeTeam) RedeliverNotification(arg1 string, arg2 int) (bool, error) {
+	fake.redeliverNotificationMutex.Lock()
+	ret, specificReturn := fake.redeliverNotificationReturnsOnCall[len(fake.redeliverNotificationArgsForCall)]
+	fake.redeliverNotificationArgsForCall = append(fake.redeliverNotificationArgsForCall, struct {
+		arg1 string
+		arg2 int
+	}{arg1, arg2})
+	stub := fake.RedeliverNotificationStub
+	fakeReturns := fake.redeliverNotificationReturns
+	fake.recordInvocation("RedeliverNotification", []interface{}{arg1, arg2})
+	fake.redeliverNotificationMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) RedeliverNotificationCallCount() int {
+	fake.redeliverNotificationMutex.RLock()
+	defer fake.redeliverNotificationMutex.RUnlock()
+	return len(fake.redeliverNotificationArgsForCall)
+}
+
+func (fake *FakeTeam) RedeliverNotificationCalls(stub func(string, int) (bool, error)) {
+	fake.redeliverNotificationMutex.Lock()
+	defer fake.redeliverNotificationMutex.Unlock()
+	fake.RedeliverNotificationStub = stub
+}
+
+func (fake *FakeTeam) RedeliverNotificationArgsForCall(i int) (string, int) {
+	fake.redeliverNotificationMutex.RLock()
+	defer fake.redeliverNotificationMutex.RUnlock()
+	argsForCall := fake.redeliverNotificationArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeTeam) RedeliverNotificationReturns(result1 bool, result2 error) {
+	fake.redeliverNotificationMutex.Lock()
+	defer fake.redeliverNotificationMutex.Unlock()
+	fake.RedeliverNotificationStub = nil
+	fake.redeliverNotificationReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RedeliverNotificationReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.redeliverNotificationMutex.Lock()
+	defer fake.redeliverNotificationMutex.Unlock()
+	fake.RedeliverNotificationStub = nil
+	if fake.redeliverNotificationReturnsOnCall == nil {
+		fake.redeliverNotificationReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.redeliverNotificationReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) RenamePipeline(arg1 string, arg2 string) (bool, []concourse.ConfigWarning, error) {
 	fake.renamePipelineMutex.Lock()
 	ret, specificReturn := fake.renamePipelineReturnsOnCall[len(fake.renamePipelineArgsForCall)]
@@ -4023,6 +5082,70 @@ func (fake *FakeTeam) ResourceVersionsReturnsOnCall(i int, result1 []atc.Resourc
 	}{result1, result2, result3, result4}
 }
 
+func (fake *FakeTeam) RevokeAPIToken(arg1 string) (bool, error) {
+	fake.revokeAPITokenMutex.Lock()
+	ret, specificReturn := fake.revokeAPITokenReturnsOnCall[len(fake.revokeAPITokenArgsForCall)]
+	fake.revokeAPITokenArgsForCall = append(fake.revokeAPITokenArgsForCall, struct {
+		arg1 string
+	}{arg1})
+	stub := fake.RevokeAPITokenStub
+	fakeReturns := fake.revokeAPITokenReturns
+	fake.recordInvocation("RevokeAPIToken", []interface{}{arg1})
+	fake.revokeAPITokenMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1, ret.result2
+	}
+	return fakeReturns.result1, fakeReturns.result2
+}
+
+func (fake *FakeTeam) RevokeAPITokenCallCount() int {
+	fake.revokeAPITokenMutex.RLock()
+	defer fake.revokeAPITokenMutex.RUnlock()
+	return len(fake.revokeAPITokenArgsForCall)
+}
+
+func (fake *FakeTeam) RevokeAPITokenCalls(stub func(string) (bool, error)) {
+	fake.revokeAPITokenMutex.Lock()
+	defer fake.revokeAPITokenMutex.Unlock()
+	fake.RevokeAPITokenStub = stub
+}
+
+func (fake *FakeTeam) RevokeAPITokenArgsForCall(i int) string {
+	fake.revokeAPITokenMutex.RLock()
+	defer fake.revokeAPITokenMutex.RUnlock()
+	argsForCall := fake.revokeAPITokenArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) RevokeAPITokenReturns(result1 bool, result2 error) {
+	fake.revokeAPITokenMutex.Lock()
+	defer fake.revokeAPITokenMutex.Unlock()
+	fake.RevokeAPITokenStub = nil
+	fake.revokeAPITokenReturns = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
+func (fake *FakeTeam) RevokeAPITokenReturnsOnCall(i int, result1 bool, result2 error) {
+	fake.revokeAPITokenMutex.Lock()
+	defer fake.revokeAPITokenMutex.Unlock()
+	fake.RevokeAPITokenStub = nil
+	if fake.revokeAPITokenReturnsOnCall == nil {
+		fake.revokeAPITokenReturnsOnCall = make(map[int]struct {
+			result1 bool
+			result2 error
+		})
+	}
+	fake.revokeAPITokenReturnsOnCall[i] = struct {
+		result1 bool
+		result2 error
+	}{result1, result2}
+}
+
 func (fake *FakeTeam) ScheduleJob(arg1 atc.PipelineRef, arg2 string) (bool, error) {
 	fake.scheduleJobMutex.Lock()
 	ret, specificReturn := fake.scheduleJobReturnsOnCall[len(fake.scheduleJobArgsForCall)]
@@ -4088,6 +5211,128 @@ func (fake *FakeTeam) ScheduleJobReturnsOnCall(i int, result1 bool, result2 erro
 	}{result1, result2}
 }
 
+func (fake *FakeTeam) SetCustomRole(arg1 atc.CustomRole) error {
+	fake.setCustomRoleMutex.Lock()
+	ret, specificReturn := fake.setCustomRoleReturnsOnCall[len(fake.setCustomRoleArgsForCall)]
+	fake.setCustomRoleArgsForCall = append(fake.setCustomRoleArgsForCall, struct {
+		arg1 atc.CustomRole
+	}{arg1})
+	stub := fake.SetCustomRoleStub
+	fakeReturns := fake.setCustomRoleReturns
+	fake.recordInvocation("SetCustomRole", []interface{}{arg1})
+	fake.setCustomRoleMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SetCustomRoleCallCount() int {
+	fake.setCustomRoleMutex.RLock()
+	defer fake.setCustomRoleMutex.RUnlock()
+	return len(fake.setCustomRoleArgsForCall)
+}
+
+func (fake *FakeTeam) SetCustomRoleCalls(stub func(atc.CustomRole) error) {
+	fake.setCustomRoleMutex.Lock()
+	defer fake.setCustomRoleMutex.Unlock()
+	fake.SetCustomRoleStub = stub
+}
+
+func (fake *FakeTeam) SetCustomRoleArgsForCall(i int) atc.CustomRole {
+	fake.setCustomRoleMutex.RLock()
+	defer fake.setCustomRoleMutex.RUnlock()
+	argsForCall := fake.setCustomRoleArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SetCustomRoleReturns(result1 error) {
+	fake.setCustomRoleMutex.Lock()
+	defer fake.setCustomRoleMutex.Unlock()
+	fake.SetCustomRoleStub = nil
+	fake.setCustomRoleReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetCustomRoleReturnsOnCall(i int, result1 error) {
+	fake.setCustomRoleMutex.Lock()
+	defer fake.setCustomRoleMutex.Unlock()
+	fake.SetCustomRoleStub = nil
+	if fake.setCustomRoleReturnsOnCall == nil {
+		fake.setCustomRoleReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setCustomRoleReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetNotificationSubscription(arg1 atc.NotificationSubscription) error {
+	fake.setNotificationSubscriptionMutex.Lock()
+	ret, specificReturn := fake.setNotificationSubscriptionReturnsOnCall[len(fake.setNotificationSubscriptionArgsForCall)]
+	fake.setNotificationSubscriptionArgsForCall = append(fake.setNotificationSubscriptionArgsForCall, struct {
+		arg1 atc.NotificationSubscription
+	}{arg1})
+	stub := fake.SetNotificationSubscriptionStub
+	fakeReturns := fake.setNotificationSubscriptionReturns
+	fake.recordInvocation("SetNotificationSubscription", []interface{}{arg1})
+	fake.setNotificationSubscriptionMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeTeam) SetNotificationSubscriptionCallCount() int {
+	fake.setNotificationSubscriptionMutex.RLock()
+	defer fake.setNotificationSubscriptionMutex.RUnlock()
+	return len(fake.setNotificationSubscriptionArgsForCall)
+}
+
+func (fake *FakeTeam) SetNotificationSubscriptionCalls(stub func(atc.NotificationSubscription) error) {
+	fake.setNotificationSubscriptionMutex.Lock()
+	defer fake.setNotificationSubscriptionMutex.Unlock()
+	fake.SetNotificationSubscriptionStub = stub
+}
+
+func (fake *FakeTeam) SetNotificationSubscriptionArgsForCall(i int) atc.NotificationSubscription {
+	fake.setNotificationSubscriptionMutex.RLock()
+	defer fake.setNotificationSubscriptionMutex.RUnlock()
+	argsForCall := fake.setNotificationSubscriptionArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeTeam) SetNotificationSubscriptionReturns(result1 error) {
+	fake.setNotificationSubscriptionMutex.Lock()
+	defer fake.setNotificationSubscriptionMutex.Unlock()
+	fake.SetNotificationSubscriptionStub = nil
+	fake.setNotificationSubscriptionReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeTeam) SetNotificationSubscriptionReturnsOnCall(i int, result1 error) {
+	fake.setNotificationSubscriptionMutex.Lock()
+	defer fake.setNotificationSubscriptionMutex.Unlock()
+	fake.SetNotificationSubscriptionStub = nil
+	if fake.setNotificationSubscriptionReturnsOnCall == nil {
+		fake.setNotificationSubscriptionReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.setNotificationSubscriptionReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
 func (fake *FakeTeam) SetPinComment(arg1 atc.PipelineRef, arg2 string, arg3 string) (bool, error) {
 	fake.setPinCommentMutex.Lock()
 	ret, specificReturn := fake.setPinCommentReturnsOnCall[len(fake.setPinCommentArgsForCall)]
@@ -4418,6 +5663,8 @@ func (fake *FakeTeam) VersionedResourceTypesReturnsOnCall(i int, result1 atc.Ver
 func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	fake.invocationsMutex.RLock()
 	defer fake.invocationsMutex.RUnlock()
+	fake.aPITokensMutex.RLock()
+	defer fake.aPITokensMutex.RUnlock()
 	fake.aTCTeamMutex.RLock()
 	defer fake.aTCTeamMutex.RUnlock()
 	fake.archivePipelineMutex.RLock()
@@ -4426,6 +5673,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.authMutex.RUnlock()
 	fake.buildInputsForJobMutex.RLock()
 	defer fake.buildInputsForJobMutex.RUnlock()
+	fake.buildLifecycleEventsMutex.RLock()
+	defer fake.buildLifecycleEventsMutex.RUnlock()
 	fake.buildsMutex.RLock()
 	defer fake.buildsMutex.RUnlock()
 	fake.buildsWithVersionAsInputMutex.RLock()
@@ -4442,6 +5691,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.clearResourceCacheMutex.RUnlock()
 	fake.clearTaskCacheMutex.RLock()
 	defer fake.clearTaskCacheMutex.RUnlock()
+	fake.createAPITokenMutex.RLock()
+	defer fake.createAPITokenMutex.RUnlock()
 	fake.createArtifactMutex.RLock()
 	defer fake.createArtifactMutex.RUnlock()
 	fake.createBuildMutex.RLock()
@@ -4454,8 +5705,14 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.createOrUpdatePipelineConfigMutex.RUnlock()
 	fake.createPipelineBuildMutex.RLock()
 	defer fake.createPipelineBuildMutex.RUnlock()
+	fake.customRolesMutex.RLock()
+	defer fake.customRolesMutex.RUnlock()
 	fake.deletePipelineMutex.RLock()
 	defer fake.deletePipelineMutex.RUnlock()
+	fake.destroyCustomRoleMutex.RLock()
+	defer fake.destroyCustomRoleMutex.RUnlock()
+	fake.destroyNotificationSubscriptionMutex.RLock()
+	defer fake.destroyNotificationSubscriptionMutex.RUnlock()
 	fake.destroyTeamMutex.RLock()
 	defer fake.destroyTeamMutex.RUnlock()
 	fake.disableResourceVersionMutex.RLock()
@@ -4478,6 +5735,8 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.jobBuildMutex.RUnlock()
 	fake.jobBuildsMutex.RLock()
 	defer fake.jobBuildsMutex.RUnlock()
+	fake.jobSchedulingExplanationMutex.RLock()
+	defer fake.jobSchedulingExplanationMutex.RUnlock()
 	fake.listContainersMutex.RLock()
 	defer fake.listContainersMutex.RUnlock()
 	fake.listJobsMutex.RLock()
@@ -4490,6 +5749,10 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.listVolumesMutex.RUnlock()
 	fake.nameMutex.RLock()
 	defer fake.nameMutex.RUnlock()
+	fake.notificationDeliveriesMutex.RLock()
+	defer fake.notificationDeliveriesMutex.RUnlock()
+	fake.notificationSubscriptionsMutex.RLock()
+	defer fake.notificationSubscriptionsMutex.RUnlock()
 	fake.orderingPipelinesMutex.RLock()
 	defer fake.orderingPipelinesMutex.RUnlock()
 	fake.orderingPipelinesWithinGroupMutex.RLock()
@@ -4506,6 +5769,14 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.pipelineBuildsMutex.RUnlock()
 	fake.pipelineConfigMutex.RLock()
 	defer fake.pipelineConfigMutex.RUnlock()
+	fake.pipelineConfigHistoryMutex.RLock()
+	defer fake.pipelineConfigHistoryMutex.RUnlock()
+	fake.pipelineConfigRevisionMutex.RLock()
+	defer fake.pipelineConfigRevisionMutex.RUnlock()
+	fake.pipelineGraphMutex.RLock()
+	defer fake.pipelineGraphMutex.RUnlock()
+	fake.redeliverNotificationMutex.RLock()
+	defer fake.redeliverNotificationMutex.RUnlock()
 	fake.renamePipelineMutex.RLock()
 	defer fake.renamePipelineMutex.RUnlock()
 	fake.renameTeamMutex.RLock()
@@ -4516,8 +5787,14 @@ func (fake *FakeTeam) Invocations() map[string][][]interface{} {
 	defer fake.resourceMutex.RUnlock()
 	fake.resourceVersionsMutex.RLock()
 	defer fake.resourceVersionsMutex.RUnlock()
+	fake.revokeAPITokenMutex.RLock()
+	defer fake.revokeAPITokenMutex.RUnlock()
 	fake.scheduleJobMutex.RLock()
 	defer fake.scheduleJobMutex.RUnlock()
+	fake.setCustomRoleMutex.RLock()
+	defer fake.setCustomRoleMutex.RUnlock()
+	fake.setNotificationSubscriptionMutex.RLock()
+	defer fake.setNotificationSubscriptionMutex.RUnlock()
 	fake.setPinCommentMutex.RLock()
 	defer fake.setPinCommentMutex.RUnlock()
 	fake.unpauseJobMutex.RLock()
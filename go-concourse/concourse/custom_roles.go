@@ -0,0 +1,61 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) CustomRoles() (atc.CustomRoles, error) {
+	var roles atc.CustomRoles
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListCustomRoles,
+		Params: rata.Params{
+			"team_name": team.Name(),
+		},
+	}, &internal.Response{
+		Result: &roles,
+	})
+
+	return roles, err
+}
+
+func (team *team) SetCustomRole(role atc.CustomRole) error {
+	jsonBytes, err := json.Marshal(role)
+	if err != nil {
+		return err
+	}
+
+	return team.connection.Send(internal.Request{
+		RequestName: atc.SetCustomRole,
+		Params: rata.Params{
+			"team_name": team.Name(),
+			"role_name": role.Name,
+		},
+		Body:   bytes.NewBuffer(jsonBytes),
+		Header: http.Header{"Content-Type": []string{"application/json"}},
+	}, nil)
+}
+
+func (team *team) DestroyCustomRole(name string) (bool, error) {
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.DestroyCustomRole,
+		Params: rata.Params{
+			"team_name": team.Name(),
+			"role_name": name,
+		},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
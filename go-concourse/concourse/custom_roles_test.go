@@ -0,0 +1,102 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Custom Roles", func() {
+	Describe("CustomRoles", func() {
+		var expectedRoles atc.CustomRoles
+
+		BeforeEach(func() {
+			expectedRoles = atc.CustomRoles{
+				{
+					Name:        "deployer",
+					Permissions: []string{atc.CreateJobBuild},
+					Pipelines:   []string{"deploy-*"},
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/roles"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedRoles),
+				),
+			)
+		})
+
+		It("returns the team's custom roles", func() {
+			roles, err := team.CustomRoles()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(roles).To(Equal(expectedRoles))
+		})
+	})
+
+	Describe("SetCustomRole", func() {
+		var role atc.CustomRole
+
+		BeforeEach(func() {
+			role = atc.CustomRole{
+				Name:        "deployer",
+				Permissions: []string{atc.CreateJobBuild, atc.GetJob},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/roles/deployer"),
+					ghttp.VerifyJSONRepresenting(role),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+		})
+
+		It("saves the role", func() {
+			err := team.SetCustomRole(role)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Describe("DestroyCustomRole", func() {
+		var status int
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/roles/deployer"),
+					ghttp.RespondWith(status, nil),
+				),
+			)
+		})
+
+		Context("when the role exists", func() {
+			BeforeEach(func() {
+				status = http.StatusNoContent
+			})
+
+			It("returns true", func() {
+				destroyed, err := team.DestroyCustomRole("deployer")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(destroyed).To(BeTrue())
+			})
+		})
+
+		Context("when the role does not exist", func() {
+			BeforeEach(func() {
+				status = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				destroyed, err := team.DestroyCustomRole("deployer")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(destroyed).To(BeFalse())
+			})
+		})
+	})
+})
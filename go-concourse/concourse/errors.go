@@ -24,6 +24,17 @@ func (err GenericError) Error() string {
 	return err.Message
 }
 
+// InvalidTeamsError is returned when setting teams returns errors (i.e.
+// validation failures).
+type InvalidTeamsError struct {
+	Errors []string `json:"errors"`
+}
+
+// Error lists the errors returned for the teams.
+func (c InvalidTeamsError) Error() string {
+	return fmt.Sprintf("invalid teams config:\n%s", strings.Join(c.Errors, "\n"))
+}
+
 // InvalidConfigError is returned when saving a pipeline returns errors (i.e.
 // validation failures).
 type InvalidConfigError struct {
@@ -25,3 +25,16 @@ func (client *client) BuildEvents(buildID string) (Events, error) {
 
 	return eventstream.NewSSEEventStream(sseEvents), nil
 }
+
+// StreamBuildEvents connects to the stream which multiplexes the events of
+// many builds, to which builds are then subscribed individually.
+func (client *client) StreamBuildEvents() (eventstream.BuildEventStream, error) {
+	conn, err := client.httpAgent.DialWebSocket(internal.Request{
+		RequestName: atc.StreamBuildEvents,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return eventstream.NewWebSocketBuildEventStream(conn), nil
+}
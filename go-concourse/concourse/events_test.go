@@ -9,10 +9,13 @@ import (
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/atc/event"
 	"github.com/concourse/concourse/go-concourse/concourse"
+	"github.com/concourse/concourse/go-concourse/concourse/eventstream"
+	"github.com/gorilla/websocket"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/ghttp"
 	"github.com/vito/go-sse/sse"
+	"golang.org/x/oauth2"
 )
 
 var _ = Describe("ATC Handler Events", func() {
@@ -128,4 +131,154 @@ var _ = Describe("ATC Handler Events", func() {
 			})
 		})
 	})
+
+	Describe("StreamBuildEvents", func() {
+		var (
+			requests chan event.StreamRequest
+			messages chan event.StreamMessage
+
+			stream    eventstream.BuildEventStream
+			streamErr error
+		)
+
+		BeforeEach(func() {
+			requests = make(chan event.StreamRequest, 10)
+			messages = make(chan event.StreamMessage, 10)
+		})
+
+		streamHandler := func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+
+			// the connection may outlive the spec, so hold on to its channels
+			requests := requests
+			messages := messages
+
+			upgrader := websocket.Upgrader{}
+			conn, err := upgrader.Upgrade(w, r, nil)
+			Expect(err).NotTo(HaveOccurred())
+
+			defer conn.Close()
+
+			go func() {
+				for msg := range messages {
+					_ = conn.WriteJSON(msg)
+				}
+			}()
+
+			for {
+				var req event.StreamRequest
+				err := conn.ReadJSON(&req)
+				if err != nil {
+					close(requests)
+					return
+				}
+
+				requests <- req
+			}
+		}
+
+		JustBeforeEach(func() {
+			stream, streamErr = client.StreamBuildEvents()
+		})
+
+		AfterEach(func() {
+			close(messages)
+		})
+
+		Context("when the server accepts the connection", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/events"),
+						streamHandler,
+					),
+				)
+			})
+
+			It("sends subscriptions and receives the events of each build", func() {
+				Expect(streamErr).NotTo(HaveOccurred())
+
+				cursor := uint(41)
+				Expect(stream.Subscribe(1, nil)).To(Succeed())
+				Expect(stream.Subscribe(2, &cursor)).To(Succeed())
+				Expect(stream.Unsubscribe(1)).To(Succeed())
+
+				Eventually(requests).Should(Receive(Equal(event.StreamRequest{Action: event.StreamSubscribe, BuildID: 1})))
+				Eventually(requests).Should(Receive(Equal(event.StreamRequest{Action: event.StreamSubscribe, BuildID: 2, Cursor: &cursor})))
+				Eventually(requests).Should(Receive(Equal(event.StreamRequest{Action: event.StreamUnsubscribe, BuildID: 1})))
+
+				payload, err := json.Marshal(event.Message{Event: event.Status{Status: atc.StatusStarted}})
+				Expect(err).NotTo(HaveOccurred())
+
+				messages <- event.StreamMessage{Type: event.StreamEvent, BuildID: 2, ID: 42, Event: payload}
+				messages <- event.StreamMessage{Type: event.StreamEnd, BuildID: 2, ID: 43}
+				messages <- event.StreamMessage{Type: event.StreamError, BuildID: 3, Error: "build not found"}
+
+				ev, err := stream.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ev).To(Equal(eventstream.BuildEvent{
+					BuildID: 2,
+					ID:      42,
+					Event:   event.Status{Status: atc.StatusStarted},
+				}))
+
+				ev, err = stream.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ev).To(Equal(eventstream.BuildEvent{BuildID: 2, ID: 43, End: true}))
+
+				ev, err = stream.Next()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ev.BuildID).To(Equal(3))
+				Expect(ev.Err).To(MatchError("build 3: build not found"))
+			})
+
+			It("closes the connection", func() {
+				Expect(streamErr).NotTo(HaveOccurred())
+				Expect(stream.Close()).To(Succeed())
+				Eventually(requests).Should(BeClosed())
+			})
+		})
+
+		Context("when the client has a token", func() {
+			BeforeEach(func() {
+				client = concourse.NewClient(atcServer.URL(), &http.Client{
+					Transport: &oauth2.Transport{
+						Source: oauth2.StaticTokenSource(&oauth2.Token{
+							TokenType:   "Bearer",
+							AccessToken: "some-token",
+						}),
+						Base: &http.Transport{},
+					},
+				}, false)
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/events"),
+						ghttp.VerifyHeaderKV("Authorization", "Bearer some-token"),
+						streamHandler,
+					),
+				)
+			})
+
+			It("authenticates the connection with it", func() {
+				Expect(streamErr).NotTo(HaveOccurred())
+				Expect(stream.Close()).To(Succeed())
+			})
+		})
+
+		Context("when the server returns 401", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/builds/events"),
+						ghttp.RespondWith(http.StatusUnauthorized, ""),
+					),
+				)
+			})
+
+			It("returns back ErrUnauthorized", func() {
+				Expect(streamErr).To(Equal(concourse.ErrUnauthorized))
+			})
+		})
+	})
 })
@@ -0,0 +1,131 @@
+package eventstream
+
+import (
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/atc/event"
+	"github.com/gorilla/websocket"
+)
+
+// BuildEvent is an event received from a BuildEventStream.
+type BuildEvent struct {
+	BuildID int
+
+	// ID is the ID of the event. Pass it as the cursor when subscribing again
+	// to resume the build's events after this one.
+	ID uint
+
+	// Event is the event itself. It is nil when the build's stream has ended
+	// or failed.
+	Event atc.Event
+
+	// End is set once the build has finished and all of its events have been
+	// received.
+	End bool
+
+	// Err is set when the build's events could not be streamed, for example
+	// because the build does not exist or is not visible to the client.
+	Err error
+}
+
+//counterfeiter:generate . BuildEventStream
+
+// BuildEventStream follows the events of many builds over a single
+// connection.
+type BuildEventStream interface {
+	// Subscribe starts streaming the events of a build, beginning after the
+	// event with the ID given by cursor, or from the start if it is nil.
+	Subscribe(buildID int, cursor *uint) error
+
+	Unsubscribe(buildID int) error
+
+	// Next returns the next event of any of the subscribed builds. It only
+	// returns an error if the connection fails.
+	Next() (BuildEvent, error)
+
+	Close() error
+}
+
+type WebSocketBuildEventStream struct {
+	conn *websocket.Conn
+
+	writeL sync.Mutex
+}
+
+func NewWebSocketBuildEventStream(conn *websocket.Conn) *WebSocketBuildEventStream {
+	return &WebSocketBuildEventStream{conn: conn}
+}
+
+func (s *WebSocketBuildEventStream) Subscribe(buildID int, cursor *uint) error {
+	return s.send(event.StreamRequest{
+		Action:  event.StreamSubscribe,
+		BuildID: buildID,
+		Cursor:  cursor,
+	})
+}
+
+func (s *WebSocketBuildEventStream) Unsubscribe(buildID int) error {
+	return s.send(event.StreamRequest{
+		Action:  event.StreamUnsubscribe,
+		BuildID: buildID,
+	})
+}
+
+func (s *WebSocketBuildEventStream) Next() (BuildEvent, error) {
+	var msg event.StreamMessage
+	err := s.conn.ReadJSON(&msg)
+	if err != nil {
+		return BuildEvent{}, err
+	}
+
+	ev := BuildEvent{
+		BuildID: msg.BuildID,
+		ID:      msg.ID,
+	}
+
+	switch msg.Type {
+	case event.StreamEvent:
+		var message event.Message
+		err = json.Unmarshal(msg.Event, &message)
+		if err != nil {
+			return BuildEvent{}, err
+		}
+
+		ev.Event = message.Event
+
+	case event.StreamEnd:
+		ev.End = true
+
+	case event.StreamError:
+		ev.Err = fmt.Errorf("build %d: %s", msg.BuildID, msg.Error)
+
+	default:
+		return BuildEvent{}, fmt.Errorf("unknown message type: %s", msg.Type)
+	}
+
+	return ev, nil
+}
+
+func (s *WebSocketBuildEventStream) Close() error {
+	s.writeL.Lock()
+	defer s.writeL.Unlock()
+
+	_ = s.conn.WriteMessage(
+		websocket.CloseMessage,
+		websocket.FormatCloseMessage(websocket.CloseNormalClosure, ""),
+	)
+
+	return s.conn.Close()
+}
+
+// send writes a request to the connection, which only one goroutine may do
+// at a time.
+func (s *WebSocketBuildEventStream) send(req event.StreamRequest) error {
+	s.writeL.Lock()
+	defer s.writeL.Unlock()
+
+	return s.conn.WriteJSON(req)
+}
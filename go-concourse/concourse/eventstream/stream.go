@@ -53,3 +53,40 @@ func (s *SSEEventStream) NextEvent() (atc.Event, error) {
 func (s *SSEEventStream) Close() error {
 	return s.sseReader.Close()
 }
+
+//counterfeiter:generate . BuildLifecycleStream
+type BuildLifecycleStream interface {
+	NextEvent() (atc.BuildLifecycleEvent, error)
+	Close() error
+}
+
+type SSEBuildLifecycleStream struct {
+	sseReader *sse.EventSource
+}
+
+func NewSSEBuildLifecycleStream(reader *sse.EventSource) *SSEBuildLifecycleStream {
+	return &SSEBuildLifecycleStream{sseReader: reader}
+}
+
+func (s *SSEBuildLifecycleStream) NextEvent() (atc.BuildLifecycleEvent, error) {
+	se, err := s.sseReader.Next()
+	if err != nil {
+		return atc.BuildLifecycleEvent{}, err
+	}
+
+	if se.Name != "event" {
+		return atc.BuildLifecycleEvent{}, fmt.Errorf("unknown event name: %s", se.Name)
+	}
+
+	var ev atc.BuildLifecycleEvent
+	err = json.Unmarshal(se.Data, &ev)
+	if err != nil {
+		return atc.BuildLifecycleEvent{}, err
+	}
+
+	return ev, nil
+}
+
+func (s *SSEBuildLifecycleStream) Close() error {
+	return s.sseReader.Close()
+}
@@ -8,11 +8,13 @@ import (
 	"strings"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/gorilla/websocket"
 	"github.com/tedsuo/rata"
 )
 
 type HTTPAgent interface {
 	Send(request Request) (http.Response, error)
+	DialWebSocket(request Request) (*websocket.Conn, error)
 }
 
 type httpAgent struct {
@@ -0,0 +1,76 @@
+package internal
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gorilla/websocket"
+	"golang.org/x/oauth2"
+)
+
+var websocketSchemes = map[string]string{
+	"http":  "ws",
+	"https": "wss",
+}
+
+// DialWebSocket opens a WebSocket connection to the endpoint named by the
+// request.
+//
+// WebSocket handshakes do not go through the HTTP client, so the token and
+// TLS config are taken from its transport when it is one that this package
+// knows how to look into, which is the case for the clients built by fly.
+func (a *httpAgent) DialWebSocket(request Request) (*websocket.Conn, error) {
+	req, err := a.createHTTPRequest(request)
+	if err != nil {
+		return nil, err
+	}
+
+	scheme, found := websocketSchemes[req.URL.Scheme]
+	if !found {
+		return nil, fmt.Errorf("unknown scheme: %s", req.URL.Scheme)
+	}
+
+	req.URL.Scheme = scheme
+
+	dialer := websocket.Dialer{
+		Proxy: http.ProxyFromEnvironment,
+	}
+
+	transport := a.httpClient.Transport
+	if oauthTransport, ok := transport.(*oauth2.Transport); ok {
+		token, err := oauthTransport.Source.Token()
+		if err != nil {
+			return nil, err
+		}
+
+		token.SetAuthHeader(req)
+
+		transport = oauthTransport.Base
+	}
+
+	if httpTransport, ok := transport.(*http.Transport); ok {
+		dialer.TLSClientConfig = httpTransport.TLSClientConfig
+
+		if httpTransport.Proxy != nil {
+			dialer.Proxy = httpTransport.Proxy
+		}
+	}
+
+	conn, response, err := dialer.Dial(req.URL.String(), req.Header)
+	if err != nil {
+		if response != nil {
+			switch response.StatusCode {
+			case http.StatusUnauthorized:
+				return nil, ErrUnauthorized
+			case http.StatusForbidden:
+				return nil, ErrForbidden
+			}
+
+			return nil, fmt.Errorf("%s: %w", response.Status, err)
+		}
+
+		return nil, err
+	}
+
+	return conn, nil
+}
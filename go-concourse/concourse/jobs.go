@@ -165,6 +165,31 @@ func (team *team) ScheduleJob(pipelineRef atc.PipelineRef, jobName string) (bool
 	}
 }
 
+func (team *team) JobSchedulingExplanation(pipelineRef atc.PipelineRef, jobName string) (atc.SchedulingExplanation, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"job_name":      jobName,
+		"team_name":     team.Name(),
+	}
+
+	var explanation atc.SchedulingExplanation
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetJobSchedulingExplanation,
+		Params:      params,
+		Query:       pipelineRef.QueryParams(),
+	}, &internal.Response{
+		Result: &explanation,
+	})
+	switch err.(type) {
+	case nil:
+		return explanation, true, nil
+	case internal.ResourceNotFoundError:
+		return explanation, false, nil
+	default:
+		return explanation, false, err
+	}
+}
+
 func (team *team) ClearTaskCache(pipelineRef atc.PipelineRef, jobName string, stepName string, cachePath string) (int64, error) {
 	params := rata.Params{
 		"team_name":     team.Name(),
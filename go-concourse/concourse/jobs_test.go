@@ -550,6 +550,66 @@ var _ = Describe("ATC Handler Jobs", func() {
 		})
 	})
 
+	Describe("JobSchedulingExplanation", func() {
+		var (
+			expectedURL = "/api/v1/teams/some-team/pipelines/mypipeline/jobs/myjob/scheduling-explanation"
+			queryParams = "vars.branch=%22master%22"
+			pipelineRef = atc.PipelineRef{Name: "mypipeline", InstanceVars: atc.InstanceVars{"branch": "master"}}
+		)
+
+		Context("when the job exists", func() {
+			var expectedExplanation atc.SchedulingExplanation
+
+			BeforeEach(func() {
+				expectedExplanation = atc.SchedulingExplanation{
+					Resolved: false,
+					Inputs: []atc.InputExplanation{
+						{
+							Name:         "myinput",
+							Resource:     "myresource",
+							Passed:       []string{"rc"},
+							ResolveError: "no satisfiable builds from passed jobs found for set of inputs",
+							FailedPassed: []string{"rc"},
+						},
+					},
+					PipelinePaused: true,
+					BlockedBy:      []string{"pipeline is paused"},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, queryParams),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedExplanation),
+					),
+				)
+			})
+
+			It("returns the explanation", func() {
+				explanation, found, err := team.JobSchedulingExplanation(pipelineRef, "myjob")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(explanation).To(Equal(expectedExplanation))
+			})
+		})
+
+		Context("when the job does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, queryParams),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := team.JobSchedulingExplanation(pipelineRef, "myjob")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+
 	Describe("Clear Job Task Cache", func() {
 		var (
 			expectedURL   string
@@ -0,0 +1,111 @@
+package concourse
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+func (team *team) NotificationSubscriptions() ([]atc.NotificationSubscription, error) {
+	var subscriptions []atc.NotificationSubscription
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListNotificationSubscriptions,
+		Params: rata.Params{
+			"team_name": team.Name(),
+		},
+	}, &internal.Response{
+		Result: &subscriptions,
+	})
+
+	return subscriptions, err
+}
+
+func (team *team) SetNotificationSubscription(subscription atc.NotificationSubscription) error {
+	jsonBytes, err := json.Marshal(subscription)
+	if err != nil {
+		return err
+	}
+
+	return team.connection.Send(internal.Request{
+		RequestName: atc.SetNotificationSubscription,
+		Params: rata.Params{
+			"team_name":         team.Name(),
+			"notification_name": subscription.Name,
+		},
+		Body:   bytes.NewBuffer(jsonBytes),
+		Header: http.Header{"Content-Type": []string{"application/json"}},
+	}, nil)
+}
+
+func (team *team) DestroyNotificationSubscription(name string) (bool, error) {
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.DestroyNotificationSubscription,
+		Params: rata.Params{
+			"team_name":         team.Name(),
+			"notification_name": name,
+		},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
+
+func (team *team) NotificationDeliveries(name string, limit int) ([]atc.NotificationDelivery, bool, error) {
+	query := url.Values{}
+	if limit > 0 {
+		query.Set("limit", strconv.Itoa(limit))
+	}
+
+	var deliveries []atc.NotificationDelivery
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.ListNotificationDeliveries,
+		Params: rata.Params{
+			"team_name":         team.Name(),
+			"notification_name": name,
+		},
+		Query: query,
+	}, &internal.Response{
+		Result: &deliveries,
+	})
+
+	switch err.(type) {
+	case nil:
+		return deliveries, true, nil
+	case internal.ResourceNotFoundError:
+		return nil, false, nil
+	default:
+		return nil, false, err
+	}
+}
+
+func (team *team) RedeliverNotification(name string, deliveryID int) (bool, error) {
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.RedeliverNotification,
+		Params: rata.Params{
+			"team_name":         team.Name(),
+			"notification_name": name,
+			"delivery_id":       strconv.Itoa(deliveryID),
+		},
+	}, nil)
+
+	switch err.(type) {
+	case nil:
+		return true, nil
+	case internal.ResourceNotFoundError:
+		return false, nil
+	default:
+		return false, err
+	}
+}
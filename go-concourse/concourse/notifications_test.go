@@ -0,0 +1,151 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Notifications", func() {
+	Describe("NotificationSubscriptions", func() {
+		var expectedSubscriptions []atc.NotificationSubscription
+
+		BeforeEach(func() {
+			expectedSubscriptions = []atc.NotificationSubscription{
+				{
+					Name:   "some-subscription",
+					URL:    "https://example.com/hook",
+					Events: []atc.NotificationEvent{atc.NotificationBuildFinished},
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/notifications"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedSubscriptions),
+				),
+			)
+		})
+
+		It("returns the team's subscriptions", func() {
+			subscriptions, err := team.NotificationSubscriptions()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(subscriptions).To(Equal(expectedSubscriptions))
+		})
+	})
+
+	Describe("SetNotificationSubscription", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/notifications/some-subscription"),
+					ghttp.VerifyJSONRepresenting(atc.NotificationSubscription{
+						Name:   "some-subscription",
+						URL:    "https://example.com/hook",
+						Secret: "some-secret",
+						Events: []atc.NotificationEvent{atc.NotificationBuildErrored},
+					}),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+		})
+
+		It("saves the subscription", func() {
+			err := team.SetNotificationSubscription(atc.NotificationSubscription{
+				Name:   "some-subscription",
+				URL:    "https://example.com/hook",
+				Secret: "some-secret",
+				Events: []atc.NotificationEvent{atc.NotificationBuildErrored},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(atcServer.ReceivedRequests()).To(HaveLen(1))
+		})
+	})
+
+	Describe("DestroyNotificationSubscription", func() {
+		var status int
+
+		JustBeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("DELETE", "/api/v1/teams/some-team/notifications/some-subscription"),
+					ghttp.RespondWith(status, nil),
+				),
+			)
+		})
+
+		Context("when the subscription exists", func() {
+			BeforeEach(func() {
+				status = http.StatusNoContent
+			})
+
+			It("returns true", func() {
+				destroyed, err := team.DestroyNotificationSubscription("some-subscription")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(destroyed).To(BeTrue())
+			})
+		})
+
+		Context("when the subscription does not exist", func() {
+			BeforeEach(func() {
+				status = http.StatusNotFound
+			})
+
+			It("returns false", func() {
+				destroyed, err := team.DestroyNotificationSubscription("some-subscription")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(destroyed).To(BeFalse())
+			})
+		})
+	})
+
+	Describe("NotificationDeliveries", func() {
+		var expectedDeliveries []atc.NotificationDelivery
+
+		BeforeEach(func() {
+			expectedDeliveries = []atc.NotificationDelivery{
+				{
+					ID:       3,
+					Event:    atc.NotificationBuildFinished,
+					Status:   atc.NotificationDeliverySucceeded,
+					Attempts: 1,
+				},
+			}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/notifications/some-subscription/deliveries", "limit=10"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedDeliveries),
+				),
+			)
+		})
+
+		It("returns the subscription's deliveries", func() {
+			deliveries, found, err := team.NotificationDeliveries("some-subscription", 10)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(deliveries).To(Equal(expectedDeliveries))
+		})
+	})
+
+	Describe("RedeliverNotification", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("PUT", "/api/v1/teams/some-team/notifications/some-subscription/deliveries/3/redeliver"),
+					ghttp.RespondWith(http.StatusNoContent, nil),
+				),
+			)
+		})
+
+		It("redelivers the notification", func() {
+			redelivered, err := team.RedeliverNotification("some-subscription", 3)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(redelivered).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,133 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Pipeline Config History", func() {
+	var pipelineRef atc.PipelineRef
+
+	BeforeEach(func() {
+		pipelineRef = atc.PipelineRef{
+			Name:         "mypipeline",
+			InstanceVars: atc.InstanceVars{"branch": "master"},
+		}
+	})
+
+	Describe("PipelineConfigHistory", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/config/history"
+
+		Context("when the pipeline exists", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "vars.branch=%22master%22"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, []atc.PipelineConfigRevision{
+							{Revision: 2, CreatedBy: "some-user", CreatedAt: 200},
+							{Revision: 1, BuildID: 42, CreatedAt: 100},
+						}),
+					),
+				)
+			})
+
+			It("returns the revisions", func() {
+				history, found, err := team.PipelineConfigHistory(pipelineRef)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(history).To(Equal([]atc.PipelineConfigRevision{
+					{Revision: 2, CreatedBy: "some-user", CreatedAt: 200},
+					{Revision: 1, BuildID: 42, CreatedAt: 100},
+				}))
+			})
+		})
+
+		Context("when the pipeline does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := team.PipelineConfigHistory(pipelineRef)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+
+		Context("when the ATC returns an error", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusInternalServerError, ""),
+					),
+				)
+			})
+
+			It("returns the error", func() {
+				_, _, err := team.PipelineConfigHistory(pipelineRef)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("PipelineConfigRevision", func() {
+		expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/config/history/3"
+
+		Context("when the revision exists", func() {
+			var expectedRevision atc.PipelineConfigRevision
+
+			BeforeEach(func() {
+				expectedRevision = atc.PipelineConfigRevision{
+					Revision:  3,
+					CreatedBy: "some-user",
+					CreatedAt: 300,
+					Config: &atc.Config{
+						Jobs: atc.JobConfigs{{Name: "some-job"}},
+					},
+				}
+
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL, "vars.branch=%22master%22"),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, expectedRevision),
+					),
+				)
+			})
+
+			It("returns the revision with its config", func() {
+				revision, found, err := team.PipelineConfigRevision(pipelineRef, 3)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeTrue())
+				Expect(revision).To(Equal(expectedRevision))
+			})
+		})
+
+		Context("when the revision does not exist", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", expectedURL),
+						ghttp.RespondWith(http.StatusNotFound, ""),
+					),
+				)
+			})
+
+			It("returns false and no error", func() {
+				_, found, err := team.PipelineConfigRevision(pipelineRef, 3)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(found).To(BeFalse())
+			})
+		})
+	})
+})
@@ -0,0 +1,45 @@
+package concourse
+
+import (
+	"net/url"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+// PipelineGraph returns the dependency graph of the pipeline. If a job is
+// given, only the part of the graph upstream or downstream of it is returned.
+func (team *team) PipelineGraph(pipelineRef atc.PipelineRef, fromJob string, direction string) (atc.PipelineGraph, bool, error) {
+	params := rata.Params{
+		"pipeline_name": pipelineRef.Name,
+		"team_name":     team.Name(),
+	}
+
+	query := url.Values{}
+	if fromJob != "" {
+		query.Set("from", fromJob)
+	}
+
+	if direction != "" {
+		query.Set("direction", direction)
+	}
+
+	var graph atc.PipelineGraph
+	err := team.connection.Send(internal.Request{
+		RequestName: atc.GetPipelineGraph,
+		Params:      params,
+		Query:       merge(query, pipelineRef.QueryParams()),
+	}, &internal.Response{
+		Result: &graph,
+	})
+
+	switch err.(type) {
+	case nil:
+		return graph, true, nil
+	case internal.ResourceNotFoundError:
+		return atc.PipelineGraph{}, false, nil
+	default:
+		return atc.PipelineGraph{}, false, err
+	}
+}
@@ -0,0 +1,89 @@
+package concourse_test
+
+import (
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+)
+
+var _ = Describe("ATC Handler Pipeline Graph", func() {
+	var (
+		pipelineRef   atc.PipelineRef
+		expectedGraph atc.PipelineGraph
+	)
+
+	expectedURL := "/api/v1/teams/some-team/pipelines/mypipeline/graph"
+
+	BeforeEach(func() {
+		pipelineRef = atc.PipelineRef{Name: "mypipeline"}
+
+		expectedGraph = atc.PipelineGraph{
+			Nodes: []atc.PipelineGraphNode{
+				{ID: "job:build", Kind: atc.PipelineGraphJob, Name: "build"},
+				{ID: "resource:repo", Kind: atc.PipelineGraphResource, Name: "repo", ResourceType: "git"},
+			},
+			Edges: []atc.PipelineGraphEdge{
+				{Source: "resource:repo", Target: "job:build", Resource: "repo", Trigger: true},
+			},
+		}
+	})
+
+	Context("when the pipeline exists", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL, ""),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedGraph),
+				),
+			)
+		})
+
+		It("returns the graph", func() {
+			graph, found, err := team.PipelineGraph(pipelineRef, "", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(graph).To(Equal(expectedGraph))
+		})
+	})
+
+	Context("when traversing from a job", func() {
+		BeforeEach(func() {
+			pipelineRef.InstanceVars = atc.InstanceVars{"branch": "master"}
+
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL, "direction=upstream&from=build&vars.branch=%22master%22"),
+					ghttp.RespondWithJSONEncoded(http.StatusOK, expectedGraph),
+				),
+			)
+		})
+
+		It("passes the job and direction along", func() {
+			graph, found, err := team.PipelineGraph(pipelineRef, "build", atc.PipelineGraphUpstream)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeTrue())
+			Expect(graph).To(Equal(expectedGraph))
+		})
+	})
+
+	Context("when the pipeline or job does not exist", func() {
+		BeforeEach(func() {
+			atcServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest("GET", expectedURL),
+					ghttp.RespondWith(http.StatusNotFound, ""),
+				),
+			)
+		})
+
+		It("returns false and no error", func() {
+			_, found, err := team.PipelineGraph(pipelineRef, "", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(found).To(BeFalse())
+		})
+	})
+})
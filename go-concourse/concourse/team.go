@@ -4,6 +4,7 @@ import (
 	"io"
 
 	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/eventstream"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
 )
 
@@ -30,6 +31,9 @@ type Team interface {
 	ListPipelines() ([]atc.Pipeline, error)
 	PipelineConfig(pipelineRef atc.PipelineRef) (atc.Config, string, bool, error)
 	CreateOrUpdatePipelineConfig(pipelineRef atc.PipelineRef, configVersion string, passedConfig []byte, checkCredentials bool) (bool, bool, []ConfigWarning, error)
+	PipelineConfigHistory(pipelineRef atc.PipelineRef) ([]atc.PipelineConfigRevision, bool, error)
+	PipelineConfigRevision(pipelineRef atc.PipelineRef, revision int) (atc.PipelineConfigRevision, bool, error)
+	PipelineGraph(pipelineRef atc.PipelineRef, fromJob string, direction string) (atc.PipelineGraph, bool, error)
 
 	CreatePipelineBuild(pipelineRef atc.PipelineRef, plan atc.Plan) (atc.Build, error)
 
@@ -42,6 +46,7 @@ type Team interface {
 	RerunJobBuild(pipelineRef atc.PipelineRef, jobName string, buildName string) (atc.Build, error)
 	ListJobs(pipelineRef atc.PipelineRef) ([]atc.Job, error)
 	ScheduleJob(pipelineRef atc.PipelineRef, jobName string) (bool, error)
+	JobSchedulingExplanation(pipelineRef atc.PipelineRef, jobName string) (atc.SchedulingExplanation, bool, error)
 
 	PauseJob(pipelineRef atc.PipelineRef, jobName string) (bool, error)
 	UnpauseJob(pipelineRef atc.PipelineRef, jobName string) (bool, error)
@@ -71,9 +76,24 @@ type Team interface {
 	ListVolumes() ([]atc.Volume, error)
 	CreateBuild(plan atc.Plan) (atc.Build, error)
 	Builds(page Page) ([]atc.Build, Pagination, error)
+	BuildLifecycleEvents(pipelines []string, jobs []string) (eventstream.BuildLifecycleStream, error)
 	OrderingPipelines(pipelineNames []string) error
 	OrderingPipelinesWithinGroup(groupName string, instanceVars []atc.InstanceVars) error
 
+	NotificationSubscriptions() ([]atc.NotificationSubscription, error)
+	SetNotificationSubscription(subscription atc.NotificationSubscription) error
+	DestroyNotificationSubscription(name string) (bool, error)
+	NotificationDeliveries(name string, limit int) ([]atc.NotificationDelivery, bool, error)
+	RedeliverNotification(name string, deliveryID int) (bool, error)
+
+	CustomRoles() (atc.CustomRoles, error)
+	SetCustomRole(role atc.CustomRole) error
+	DestroyCustomRole(name string) (bool, error)
+
+	APITokens() ([]atc.APIToken, error)
+	CreateAPIToken(token atc.APIToken) (atc.APIToken, error)
+	RevokeAPIToken(name string) (bool, error)
+
 	CreateArtifact(io.Reader, string, []string) (atc.WorkerArtifact, error)
 	GetArtifact(int) (io.ReadCloser, error)
 }
@@ -0,0 +1,37 @@
+package concourse
+
+import (
+	"net/url"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse/eventstream"
+	"github.com/concourse/concourse/go-concourse/concourse/internal"
+	"github.com/tedsuo/rata"
+)
+
+// BuildLifecycleEvents streams the team's builds as they are created,
+// started and finished, optionally only those of the given pipelines and
+// jobs.
+func (team *team) BuildLifecycleEvents(pipelines []string, jobs []string) (eventstream.BuildLifecycleStream, error) {
+	query := url.Values{}
+	for _, pipeline := range pipelines {
+		query.Add(atc.TeamEventsQueryPipeline, pipeline)
+	}
+
+	for _, job := range jobs {
+		query.Add(atc.TeamEventsQueryJob, job)
+	}
+
+	sseEvents, err := team.connection.ConnectToEventStream(internal.Request{
+		RequestName: atc.TeamEvents,
+		Params: rata.Params{
+			"team_name": team.Name(),
+		},
+		Query: query,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return eventstream.NewSSEBuildLifecycleStream(sseEvents), nil
+}
@@ -0,0 +1,80 @@
+package concourse_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/concourse/concourse/atc"
+	"github.com/concourse/concourse/go-concourse/concourse"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	"github.com/vito/go-sse/sse"
+)
+
+var _ = Describe("ATC Handler Team Events", func() {
+	Describe("BuildLifecycleEvents", func() {
+		events := []atc.BuildLifecycleEvent{
+			{Type: atc.BuildCreated, Build: atc.Build{ID: 1, Status: atc.StatusPending}},
+			{Type: atc.BuildFinished, Build: atc.Build{ID: 1, Status: atc.StatusSucceeded}},
+		}
+
+		Context("when the server streams events", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/events", "pipeline=some-pipeline&pipeline=other-pipeline&job=some-job"),
+						func(w http.ResponseWriter, r *http.Request) {
+							w.Header().Add("Content-Type", "text/event-stream; charset=utf-8")
+							w.WriteHeader(http.StatusOK)
+
+							for id, ev := range events {
+								payload, err := json.Marshal(ev)
+								Expect(err).NotTo(HaveOccurred())
+
+								err = sse.Event{
+									ID:   fmt.Sprintf("%d", id),
+									Name: "event",
+									Data: payload,
+								}.Write(w)
+								Expect(err).NotTo(HaveOccurred())
+							}
+						},
+					),
+				)
+			})
+
+			It("returns the events", func() {
+				stream, err := team.BuildLifecycleEvents([]string{"some-pipeline", "other-pipeline"}, []string{"some-job"})
+				Expect(err).NotTo(HaveOccurred())
+
+				defer stream.Close()
+
+				ev, err := stream.NextEvent()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ev).To(Equal(events[0]))
+
+				ev, err = stream.NextEvent()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(ev).To(Equal(events[1]))
+			})
+		})
+
+		Context("when the server returns 403", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("GET", "/api/v1/teams/some-team/events"),
+						ghttp.RespondWith(http.StatusForbidden, ""),
+					),
+				)
+			})
+
+			It("returns ErrForbidden", func() {
+				_, err := team.BuildLifecycleEvents(nil, nil)
+				Expect(err).To(Equal(concourse.ErrForbidden))
+			})
+		})
+	})
+})
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"strings"
 
 	"github.com/concourse/concourse/atc"
 	"github.com/concourse/concourse/go-concourse/concourse/internal"
@@ -118,6 +119,80 @@ func (client *client) ListTeams() ([]atc.Team, error) {
 	return teams, err
 }
 
+// SetTeamsResult lists the names of the teams changed by SetTeams.
+type SetTeamsResult struct {
+	Created []string `json:"created,omitempty"`
+	Updated []string `json:"updated,omitempty"`
+	Deleted []string `json:"deleted,omitempty"`
+}
+
+type setTeamsResponse struct {
+	SetTeamsResult
+
+	Errors   []string        `json:"errors,omitempty"`
+	Warnings []ConfigWarning `json:"warnings,omitempty"`
+}
+
+// SetTeams configures all of the given teams at once. Teams which are not
+// given are destroyed if deleteUnlisted is set.
+func (client *client) SetTeams(teams atc.Teams, deleteUnlisted bool) (SetTeamsResult, []ConfigWarning, error) {
+	payload, err := json.Marshal(atc.SetTeamsRequest{
+		Teams:          teams,
+		DeleteUnlisted: deleteUnlisted,
+	})
+	if err != nil {
+		return SetTeamsResult{}, nil, err
+	}
+
+	response, err := client.httpAgent.Send(internal.Request{
+		ReturnResponseBody: true,
+		RequestName:        atc.SetTeams,
+		Body:               bytes.NewBuffer(payload),
+		Header: http.Header{
+			"Content-Type": {"application/json"},
+		},
+	})
+	if err != nil {
+		return SetTeamsResult{}, nil, err
+	}
+
+	defer response.Body.Close()
+	body, _ := ioutil.ReadAll(response.Body)
+
+	var result setTeamsResponse
+	switch response.StatusCode {
+	case http.StatusOK:
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			return SetTeamsResult{}, nil, err
+		}
+
+		return result.SetTeamsResult, result.Warnings, nil
+	case http.StatusBadRequest:
+		err = json.Unmarshal(body, &result)
+		if err != nil {
+			return SetTeamsResult{}, nil, err
+		}
+
+		return SetTeamsResult{}, result.Warnings, InvalidTeamsError{Errors: result.Errors}
+	case http.StatusForbidden:
+		reason := string(body)
+		if json.Unmarshal(body, &result) == nil && len(result.Errors) > 0 {
+			reason = strings.Join(result.Errors, "; ")
+		}
+
+		return SetTeamsResult{}, nil, internal.ForbiddenError{
+			Reason: reason,
+		}
+	default:
+		return SetTeamsResult{}, nil, internal.UnexpectedResponseError{
+			StatusCode: response.StatusCode,
+			Status:     response.Status,
+			Body:       string(body),
+		}
+	}
+}
+
 func (client *client) FindTeam(teamName string) (Team, error) {
 	var atcTeam atc.Team
 	resp, err := client.httpAgent.Send(internal.Request{
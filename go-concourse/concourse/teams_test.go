@@ -287,4 +287,84 @@ var _ = Describe("ATC Handler Teams", func() {
 			Expect(teams).To(Equal(expectedTeams))
 		})
 	})
+
+	Describe("SetTeams", func() {
+		var teams atc.Teams
+
+		BeforeEach(func() {
+			teams = atc.Teams{
+				{
+					Name: "a-team",
+					Auth: atc.TeamAuth{"owner": {"users": []string{"local:some-user"}}},
+				},
+			}
+		})
+
+		Context("when the teams are set", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams"),
+						ghttp.VerifyJSONRepresenting(atc.SetTeamsRequest{
+							Teams:          teams,
+							DeleteUnlisted: true,
+						}),
+						ghttp.RespondWithJSONEncoded(http.StatusOK, teamserver.SetTeamsResponse{
+							Created:  []string{"a-team"},
+							Deleted:  []string{"b-team"},
+							Warnings: []atc.ConfigWarning{{Type: "invalid_identifier", Message: "some-warning"}},
+						}),
+					),
+				)
+			})
+
+			It("returns the changes and warnings", func() {
+				result, warnings, err := client.SetTeams(teams, true)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(concourse.SetTeamsResult{
+					Created: []string{"a-team"},
+					Deleted: []string{"b-team"},
+				}))
+				Expect(warnings).To(Equal([]concourse.ConfigWarning{{Type: "invalid_identifier", Message: "some-warning"}}))
+			})
+		})
+
+		Context("when the teams are invalid", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams"),
+						ghttp.RespondWithJSONEncoded(http.StatusBadRequest, teamserver.SetTeamsResponse{
+							Errors: []string{"team 'a-team' is configured more than once"},
+						}),
+					),
+				)
+			})
+
+			It("returns an InvalidTeamsError", func() {
+				_, _, err := client.SetTeams(teams, false)
+				Expect(err).To(Equal(concourse.InvalidTeamsError{
+					Errors: []string{"team 'a-team' is configured more than once"},
+				}))
+			})
+		})
+
+		Context("when the request is forbidden", func() {
+			BeforeEach(func() {
+				atcServer.AppendHandlers(
+					ghttp.CombineHandlers(
+						ghttp.VerifyRequest("PUT", "/api/v1/teams"),
+						ghttp.RespondWithJSONEncoded(http.StatusForbidden, teamserver.SetTeamsResponse{
+							Errors: []string{"cannot destroy the last admin team"},
+						}),
+					),
+				)
+			})
+
+			It("returns the reason", func() {
+				_, _, err := client.SetTeams(teams, true)
+				Expect(err).To(Equal(internal.ForbiddenError{Reason: "cannot destroy the last admin team"}))
+			})
+		})
+	})
 })
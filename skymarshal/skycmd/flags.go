@@ -89,9 +89,50 @@ func (flag *AuthTeamFlags) formatFromFile() (atc.TeamAuth, error) {
 		return nil, err
 	}
 
+	return formatRoles(data.Roles)
+}
+
+// FormatTeamsFile reads the file given to fly set-teams, which lists the name,
+// roles and quota of every team. The roles are in the same format as the
+// config file given to fly set-team.
+func FormatTeamsFile(path string) (atc.Teams, error) {
+	content, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var data struct {
+		Teams []struct {
+			Name  string                   `json:"name"`
+			Roles []map[string]interface{} `json:"roles"`
+			Quota *atc.TeamQuota           `json:"quota"`
+		} `json:"teams"`
+	}
+	if err = yaml.Unmarshal(content, &data); err != nil {
+		return nil, err
+	}
+
+	teams := atc.Teams{}
+	for _, team := range data.Teams {
+		auth, err := formatRoles(team.Roles)
+		if err != nil {
+			return nil, fmt.Errorf("team '%s': %w", team.Name, err)
+		}
+
+		teams = append(teams, atc.Team{
+			Name:  team.Name,
+			Auth:  auth,
+			Quota: team.Quota,
+		})
+	}
+
+	return teams, nil
+}
+
+func formatRoles(roles []map[string]interface{}) (atc.TeamAuth, error) {
 	auth := atc.TeamAuth{}
 
-	for _, role := range data.Roles {
+	for _, role := range roles {
 		roleName := role["name"].(string)
 
 		users := []string{}
@@ -38,6 +38,9 @@ type Heartbeater struct {
 
 	registration atc.Worker
 	eventWriter  EventWriter
+
+	lastCPUUsage  uint64
+	lastCPUSample time.Time
 }
 
 func NewHeartbeater(
@@ -283,10 +286,79 @@ func (heartbeater *Heartbeater) pingWorker(logger lager.Logger) (atc.Worker, boo
 
 	registration.ActiveContainers = len(containers)
 	registration.ActiveVolumes = len(volumes)
+	registration.ResourceUsage = heartbeater.resourceUsage(logger, containers)
 
 	return registration, true
 }
 
+// resourceUsage measures the utilization of the worker's resources by its
+// containers. It returns nil if the worker's capacity or container metrics
+// could not be determined, so that the worker is not mistaken for an idle
+// one.
+func (heartbeater *Heartbeater) resourceUsage(logger lager.Logger, containers []gclient.Container) *atc.WorkerResourceUsage {
+	capacity, err := heartbeater.gardenClient.Capacity()
+	if err != nil {
+		logger.Error("failed-to-get-capacity", err)
+		return nil
+	}
+
+	if capacity.MemoryInBytes == 0 && capacity.DiskInBytes == 0 {
+		return nil
+	}
+
+	usage := &atc.WorkerResourceUsage{
+		MemoryCapacityBytes: capacity.MemoryInBytes,
+		DiskCapacityBytes:   capacity.DiskInBytes,
+	}
+
+	var cpuUsage uint64
+
+	if len(containers) > 0 {
+		handles := make([]string, len(containers))
+		for i, container := range containers {
+			handles[i] = container.Handle()
+		}
+
+		metrics, err := heartbeater.gardenClient.BulkMetrics(handles)
+		if err != nil {
+			logger.Error("failed-to-get-container-metrics", err)
+			return nil
+		}
+
+		for _, entry := range metrics {
+			// the container may have been destroyed since it was listed
+			if entry.Err != nil {
+				continue
+			}
+
+			usage.MemoryUsedBytes += entry.Metrics.MemoryStat.TotalUsageTowardLimit
+			usage.DiskUsedBytes += entry.Metrics.DiskStat.TotalBytesUsed
+			cpuUsage += entry.Metrics.CPUStat.Usage
+		}
+	}
+
+	// CPU usage is cumulative, so utilization can only be determined from the
+	// second measurement onwards. Containers which have been destroyed since
+	// the previous measurement make the total go down, in which case the CPU
+	// utilization is left at zero until the next measurement.
+	now := heartbeater.clock.Now()
+
+	if heartbeater.registration.CPUs > 0 && !heartbeater.lastCPUSample.IsZero() && cpuUsage > heartbeater.lastCPUUsage {
+		elapsed := now.Sub(heartbeater.lastCPUSample)
+		if elapsed > 0 {
+			usage.CPU = float64(cpuUsage-heartbeater.lastCPUUsage) / float64(elapsed.Nanoseconds()) / float64(heartbeater.registration.CPUs)
+			if usage.CPU > 1 {
+				usage.CPU = 1
+			}
+		}
+	}
+
+	heartbeater.lastCPUUsage = cpuUsage
+	heartbeater.lastCPUSample = now
+
+	return usage
+}
+
 func (heartbeater *Heartbeater) ttl() time.Duration {
 	return heartbeater.interval * 2
 }
@@ -273,6 +273,72 @@ var _ = Describe("Heartbeater", func() {
 			})
 		})
 
+		Context("when Garden reports its capacity and container metrics", func() {
+			BeforeEach(func() {
+				worker.CPUs = 2
+				expectedWorker.CPUs = 2
+
+				fakeGardenClient.CapacityReturns(garden.Capacity{
+					MemoryInBytes: 4096,
+					DiskInBytes:   8192,
+				}, nil)
+
+				fakeGardenClient.BulkMetricsReturnsOnCall(0, map[string]garden.ContainerMetricsEntry{
+					"some-handle": {
+						Metrics: garden.Metrics{
+							MemoryStat: garden.ContainerMemoryStat{TotalUsageTowardLimit: 1024},
+							DiskStat:   garden.ContainerDiskStat{TotalBytesUsed: 2048},
+							CPUStat:    garden.ContainerCPUStat{Usage: uint64(time.Second)},
+						},
+					},
+					"some-destroyed-handle": {
+						Err: &garden.Error{Err: garden.ContainerNotFoundError{Handle: "some-destroyed-handle"}},
+					},
+				}, nil)
+
+				fakeGardenClient.BulkMetricsReturnsOnCall(1, map[string]garden.ContainerMetricsEntry{
+					"some-handle": {
+						Metrics: garden.Metrics{
+							MemoryStat: garden.ContainerMemoryStat{TotalUsageTowardLimit: 512},
+							DiskStat:   garden.ContainerDiskStat{TotalBytesUsed: 4096},
+							CPUStat:    garden.ContainerCPUStat{Usage: uint64(2 * time.Second)},
+						},
+					},
+				}, nil)
+
+				fakeATC1.AppendHandlers(verifyRegister)
+				fakeATC2.AppendHandlers(verifyHeartbeat)
+			})
+
+			It("registers with the resource usage of its containers", func() {
+				expectedWorker.ActiveContainers = 2
+				expectedWorker.ActiveVolumes = 3
+				expectedWorker.ResourceUsage = &atc.WorkerResourceUsage{
+					MemoryUsedBytes:     1024,
+					MemoryCapacityBytes: 4096,
+					DiskUsedBytes:       2048,
+					DiskCapacityBytes:   8192,
+				}
+				Eventually(registrations).Should(Receive(Equal(registration{expectedWorker, 2 * interval})))
+			})
+
+			It("heartbeats with the CPU utilization since the previous measurement", func() {
+				Eventually(registrations).Should(Receive())
+
+				fakeClock.WaitForWatcherAndIncrement(interval)
+				expectedWorker.ActiveContainers = 5
+				expectedWorker.ActiveVolumes = 2
+				expectedWorker.ResourceUsage = &atc.WorkerResourceUsage{
+					CPU:                 0.5,
+					MemoryUsedBytes:     512,
+					MemoryCapacityBytes: 4096,
+					DiskUsedBytes:       4096,
+					DiskCapacityBytes:   8192,
+				}
+				Eventually(heartbeats).Should(Receive(Equal(registration{expectedWorker, 2 * interval})))
+			})
+		})
+
 		Context("when heartbeat returns worker is landed", func() {
 			BeforeEach(func() {
 				heartbeated := make(chan registration, 100)
@@ -265,6 +265,10 @@ handleEvent event ( model, effects ) =
             , effects
             )
 
+        StepResourceUsage _ ->
+            -- usage is not shown in the build output; it is for accounting
+            ( model, effects )
+
         End ->
             ( { model | state = StepsComplete, eventStreamUrlPath = Nothing }
             , effects
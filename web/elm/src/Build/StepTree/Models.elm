@@ -207,6 +207,7 @@ type BuildEvent
     | Error Origin String Time.Posix
     | ImageCheck Origin Concourse.BuildPlan
     | ImageGet Origin Concourse.BuildPlan
+    | StepResourceUsage Origin
     | End
     | Opened
     | NetworkError
@@ -216,6 +216,12 @@ decodeBuildEvent =
                                 (Json.Decode.field "plan" Concourse.decodeBuildPlan)
                             )
 
+                    "step-resource-usage" ->
+                        Json.Decode.field "data"
+                            (Json.Decode.map StepResourceUsage
+                                (Json.Decode.field "origin" decodeOrigin)
+                            )
+
                     unknown ->
                         Json.Decode.fail ("unknown event type: " ++ unknown)
             )
@@ -29,6 +29,7 @@ type GardenBackend struct {
 	killer        Killer
 	network       Network
 	rootfsManager RootfsManager
+	cgroupSampler CgroupSampler
 	userNamespace UserNamespace
 	initBinPath   string
 
@@ -61,6 +62,15 @@ func WithRootfsManager(r RootfsManager) GardenBackendOpt {
 	}
 }
 
+// WithCgroupSampler configures the CgroupSampler used to gather container
+// metrics.
+//
+func WithCgroupSampler(s CgroupSampler) GardenBackendOpt {
+	return func(b *GardenBackend) {
+		b.cgroupSampler = s
+	}
+}
+
 // WithKiller configures the killer used to terminate tasks.
 //
 func WithKiller(k Killer) GardenBackendOpt {
@@ -138,6 +148,10 @@ func NewGardenBackend(client libcontainerd.Client, opts ...GardenBackendOpt) (b
 		b.rootfsManager = NewRootfsManager()
 	}
 
+	if b.cgroupSampler == nil {
+		b.cgroupSampler = NewCgroupSampler()
+	}
+
 	if b.userNamespace == nil {
 		b.userNamespace = NewUserNamespace()
 	}
@@ -204,6 +218,7 @@ func (b *GardenBackend) Create(gdnSpec garden.ContainerSpec) (garden.Container,
 		cont,
 		b.killer,
 		b.rootfsManager,
+		b.cgroupSampler,
 	), nil
 }
 
@@ -326,6 +341,7 @@ func (b *GardenBackend) Containers(properties garden.Properties) (containers []g
 			containerdContainer,
 			b.killer,
 			b.rootfsManager,
+			b.cgroupSampler,
 		)
 	}
 
@@ -348,6 +364,7 @@ func (b *GardenBackend) Lookup(handle string) (garden.Container, error) {
 		containerdContainer,
 		b.killer,
 		b.rootfsManager,
+		b.cgroupSampler,
 	), nil
 }
 
@@ -381,11 +398,30 @@ func (b *GardenBackend) BulkInfo(handles []string) (info map[string]garden.Conta
 	return
 }
 
-// BulkMetrics - Not Implemented
+// BulkMetrics returns the metrics of each of the containers with the
+// specified handles. Failing to gather the metrics of a container is reported
+// in its entry.
 //
-func (b *GardenBackend) BulkMetrics(handles []string) (metrics map[string]garden.ContainerMetricsEntry, err error) {
-	err = ErrNotImplemented
-	return
+func (b *GardenBackend) BulkMetrics(handles []string) (map[string]garden.ContainerMetricsEntry, error) {
+	metrics := make(map[string]garden.ContainerMetricsEntry, len(handles))
+
+	for _, handle := range handles {
+		container, err := b.Lookup(handle)
+		if err != nil {
+			metrics[handle] = garden.ContainerMetricsEntry{Err: garden.NewError(err.Error())}
+			continue
+		}
+
+		containerMetrics, err := container.Metrics()
+		if err != nil {
+			metrics[handle] = garden.ContainerMetricsEntry{Err: garden.NewError(err.Error())}
+			continue
+		}
+
+		metrics[handle] = garden.ContainerMetricsEntry{Metrics: containerMetrics}
+	}
+
+	return metrics, nil
 }
 
 // checkContainerCapacity ensures that Garden.MaxContainers is respected
@@ -28,6 +28,7 @@ type BackendSuite struct {
 	network *runtimefakes.FakeNetwork
 	userns  *runtimefakes.FakeUserNamespace
 	killer  *runtimefakes.FakeKiller
+	sampler *runtimefakes.FakeCgroupSampler
 }
 
 func (s *BackendSuite) SetupTest() {
@@ -35,12 +36,14 @@ func (s *BackendSuite) SetupTest() {
 	s.killer = new(runtimefakes.FakeKiller)
 	s.network = new(runtimefakes.FakeNetwork)
 	s.userns = new(runtimefakes.FakeUserNamespace)
+	s.sampler = new(runtimefakes.FakeCgroupSampler)
 
 	var err error
 	s.backend, err = runtime.NewGardenBackend(s.client,
 		runtime.WithKiller(s.killer),
 		runtime.WithNetwork(s.network),
 		runtime.WithUserNamespace(s.userns),
+		runtime.WithCgroupSampler(s.sampler),
 	)
 	s.NoError(err)
 }
@@ -367,6 +370,34 @@ func (s *BackendSuite) TestLookupGetContainer() {
 	s.Equal("handle", container.Handle())
 }
 
+func (s *BackendSuite) TestBulkMetrics() {
+	fakeTask := new(libcontainerdfakes.FakeTask)
+	fakeContainer := new(libcontainerdfakes.FakeContainer)
+	fakeContainer.TaskReturns(fakeTask, nil)
+
+	s.client.GetContainerStub = func(_ context.Context, handle string) (containerd.Container, error) {
+		if handle == "missing" {
+			return nil, errors.New("not found")
+		}
+
+		return fakeContainer, nil
+	}
+
+	s.sampler.SampleReturns(garden.Metrics{
+		CPUStat: garden.ContainerCPUStat{Usage: 1000},
+	}, nil)
+
+	metrics, err := s.backend.BulkMetrics([]string{"handle", "missing"})
+	s.NoError(err)
+	s.Len(metrics, 2)
+
+	s.Nil(metrics["handle"].Err)
+	s.Equal(uint64(1000), metrics["handle"].Metrics.CPUStat.Usage)
+
+	s.NotNil(metrics["missing"].Err)
+	s.Contains(metrics["missing"].Err.Error(), "not found")
+}
+
 func (s *BackendSuite) TestDestroyEmptyHandleError() {
 	err := s.backend.Destroy("")
 	s.EqualError(err, "empty handle")
@@ -0,0 +1,304 @@
+package runtime
+
+import (
+	"bufio"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"code.cloudfoundry.org/garden"
+)
+
+//counterfeiter:generate . CgroupSampler
+
+// CgroupSampler is responsible for reading the resource usage of the cgroup
+// that a container's processes belong to.
+//
+type CgroupSampler interface {
+	// Sample reads the current CPU, memory and pid usage of the cgroup that
+	// the process with the given pid belongs to. Where the kernel tracks it,
+	// the memory usage toward the limit is the cgroup's high-water mark.
+	//
+	Sample(pid uint32) (garden.Metrics, error)
+}
+
+// CgroupSamplerOpt defines a functional option that when applied, modifies
+// the configuration of a cgroupSampler.
+//
+type CgroupSamplerOpt func(s *cgroupSampler)
+
+// WithProcRoot configures the path where procfs is mounted.
+//
+func WithProcRoot(path string) CgroupSamplerOpt {
+	return func(s *cgroupSampler) {
+		s.procRoot = path
+	}
+}
+
+// WithCgroupRoot configures the path where the cgroup hierarchies are
+// mounted.
+//
+func WithCgroupRoot(path string) CgroupSamplerOpt {
+	return func(s *cgroupSampler) {
+		s.cgroupRoot = path
+	}
+}
+
+type cgroupSampler struct {
+	procRoot   string
+	cgroupRoot string
+}
+
+var _ CgroupSampler = (*cgroupSampler)(nil)
+
+// NewCgroupSampler instantiates a cgroupSampler
+//
+func NewCgroupSampler(opts ...CgroupSamplerOpt) *cgroupSampler {
+	s := &cgroupSampler{
+		procRoot:   "/proc",
+		cgroupRoot: "/sys/fs/cgroup",
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	return s
+}
+
+// Sample reads the usage from the cgroup v1 memory and cpuacct controllers
+// when they are mounted, falling back to the unified (v2) hierarchy.
+//
+func (s *cgroupSampler) Sample(pid uint32) (garden.Metrics, error) {
+	controllers, err := s.cgroupPaths(pid)
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("cgroup paths: %w", err)
+	}
+
+	if _, found := controllers["memory"]; found {
+		return s.sampleV1(controllers)
+	}
+
+	unified, found := controllers[""]
+	if !found {
+		return garden.Metrics{}, fmt.Errorf("no cgroup found for pid %d", pid)
+	}
+
+	return s.sampleV2(filepath.Join(s.cgroupRoot, unified))
+}
+
+// cgroupPaths maps each controller to the directory of the process's cgroup
+// in its hierarchy, relative to the cgroup root. The unified hierarchy is
+// mapped from the empty string.
+//
+func (s *cgroupSampler) cgroupPaths(pid uint32) (map[string]string, error) {
+	f, err := os.Open(filepath.Join(s.procRoot, strconv.Itoa(int(pid)), "cgroup"))
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	paths := map[string]string{}
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// hierarchy-ID:controller-list:cgroup-path
+		parts := strings.SplitN(scanner.Text(), ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+
+		if parts[1] == "" {
+			paths[""] = parts[2]
+			continue
+		}
+
+		for _, controller := range strings.Split(parts[1], ",") {
+			paths[controller] = filepath.Join(parts[1], parts[2])
+		}
+	}
+
+	return paths, scanner.Err()
+}
+
+func (s *cgroupSampler) sampleV1(controllers map[string]string) (garden.Metrics, error) {
+	var metrics garden.Metrics
+
+	memoryPath := filepath.Join(s.cgroupRoot, controllers["memory"])
+
+	usage, err := readUint(filepath.Join(memoryPath, "memory.usage_in_bytes"))
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("memory usage: %w", err)
+	}
+
+	memoryStat, err := readStat(filepath.Join(memoryPath, "memory.stat"))
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("memory stat: %w", err)
+	}
+
+	metrics.MemoryStat = garden.ContainerMemoryStat{
+		Cache:                 memoryStat["cache"],
+		Rss:                   memoryStat["rss"],
+		Swap:                  memoryStat["swap"],
+		TotalCache:            memoryStat["total_cache"],
+		TotalRss:              memoryStat["total_rss"],
+		TotalInactiveFile:     memoryStat["total_inactive_file"],
+		TotalUsageTowardLimit: saturatingSub(usage, memoryStat["total_inactive_file"]),
+	}
+
+	err = reportPeak(&metrics, filepath.Join(memoryPath, "memory.max_usage_in_bytes"))
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("memory max usage: %w", err)
+	}
+
+	if cpuacct, found := controllers["cpuacct"]; found {
+		cpuPath := filepath.Join(s.cgroupRoot, cpuacct)
+
+		metrics.CPUStat.Usage, err = readUint(filepath.Join(cpuPath, "cpuacct.usage"))
+		if err != nil {
+			return garden.Metrics{}, fmt.Errorf("cpu usage: %w", err)
+		}
+
+		cpuStat, err := readStat(filepath.Join(cpuPath, "cpuacct.stat"))
+		if err != nil {
+			return garden.Metrics{}, fmt.Errorf("cpu stat: %w", err)
+		}
+
+		// cpuacct.stat is reported in USER_HZ, which is 100 on every
+		// architecture we run on
+		metrics.CPUStat.User = cpuStat["user"] * 1e7
+		metrics.CPUStat.System = cpuStat["system"] * 1e7
+	}
+
+	if pids, found := controllers["pids"]; found {
+		metrics.PidStat.Current, err = readUint(filepath.Join(s.cgroupRoot, pids, "pids.current"))
+		if err != nil {
+			return garden.Metrics{}, fmt.Errorf("pids current: %w", err)
+		}
+	}
+
+	return metrics, nil
+}
+
+func (s *cgroupSampler) sampleV2(path string) (garden.Metrics, error) {
+	var metrics garden.Metrics
+
+	usage, err := readUint(filepath.Join(path, "memory.current"))
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("memory current: %w", err)
+	}
+
+	memoryStat, err := readStat(filepath.Join(path, "memory.stat"))
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("memory stat: %w", err)
+	}
+
+	metrics.MemoryStat = garden.ContainerMemoryStat{
+		Cache:                 memoryStat["file"],
+		Rss:                   memoryStat["anon"],
+		TotalCache:            memoryStat["file"],
+		TotalRss:              memoryStat["anon"],
+		TotalInactiveFile:     memoryStat["inactive_file"],
+		TotalUsageTowardLimit: saturatingSub(usage, memoryStat["inactive_file"]),
+	}
+
+	err = reportPeak(&metrics, filepath.Join(path, "memory.peak"))
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("memory peak: %w", err)
+	}
+
+	cpuStat, err := readStat(filepath.Join(path, "cpu.stat"))
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("cpu stat: %w", err)
+	}
+
+	metrics.CPUStat = garden.ContainerCPUStat{
+		Usage:  cpuStat["usage_usec"] * 1e3,
+		User:   cpuStat["user_usec"] * 1e3,
+		System: cpuStat["system_usec"] * 1e3,
+	}
+
+	metrics.PidStat.Current, err = readUint(filepath.Join(path, "pids.current"))
+	if err != nil && !os.IsNotExist(err) {
+		return garden.Metrics{}, fmt.Errorf("pids current: %w", err)
+	}
+
+	return metrics, nil
+}
+
+// reportPeak reports the kernel's high-water mark of the cgroup's memory usage
+// as its usage toward the limit, as garden.Metrics has no field of its own for
+// it. Sampling the current usage periodically misses short-lived spikes, and
+// once the process has exited only leftover page cache remains.
+//
+// memory.peak is only available on cgroup v2 since Linux 5.19, in which case
+// the current usage is left as is.
+//
+func reportPeak(metrics *garden.Metrics, path string) error {
+	peak, err := readUint(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+
+		return err
+	}
+
+	if peak > metrics.MemoryStat.TotalUsageTowardLimit {
+		metrics.MemoryStat.TotalUsageTowardLimit = peak
+	}
+
+	return nil
+}
+
+func readUint(path string) (uint64, error) {
+	contents, err := ioutil.ReadFile(path)
+	if err != nil {
+		return 0, err
+	}
+
+	return strconv.ParseUint(strings.TrimSpace(string(contents)), 10, 64)
+}
+
+// readStat parses files made up of '<key> <value>' lines, such as memory.stat
+// and cpu.stat.
+//
+func readStat(path string) (map[string]uint64, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+
+	defer f.Close()
+
+	stat := map[string]uint64{}
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) != 2 {
+			continue
+		}
+
+		value, err := strconv.ParseUint(fields[1], 10, 64)
+		if err != nil {
+			continue
+		}
+
+		stat[fields[0]] = value
+	}
+
+	return stat, scanner.Err()
+}
+
+func saturatingSub(a, b uint64) uint64 {
+	if b > a {
+		return 0
+	}
+
+	return a - b
+}
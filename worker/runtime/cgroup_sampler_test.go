@@ -0,0 +1,162 @@
+package runtime_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"code.cloudfoundry.org/garden"
+	"github.com/concourse/concourse/worker/runtime"
+	"github.com/stretchr/testify/require"
+	"github.com/stretchr/testify/suite"
+)
+
+type CgroupSamplerSuite struct {
+	suite.Suite
+	*require.Assertions
+
+	procRoot   string
+	cgroupRoot string
+	sampler    runtime.CgroupSampler
+}
+
+func (s *CgroupSamplerSuite) SetupTest() {
+	var err error
+
+	s.procRoot, err = ioutil.TempDir("", "cgroup-sampler-proc")
+	s.NoError(err)
+
+	s.cgroupRoot, err = ioutil.TempDir("", "cgroup-sampler-cgroup")
+	s.NoError(err)
+
+	s.sampler = runtime.NewCgroupSampler(
+		runtime.WithProcRoot(s.procRoot),
+		runtime.WithCgroupRoot(s.cgroupRoot),
+	)
+}
+
+func (s *CgroupSamplerSuite) TearDownTest() {
+	os.RemoveAll(s.procRoot)
+	os.RemoveAll(s.cgroupRoot)
+}
+
+func (s *CgroupSamplerSuite) writeFile(path, content string) {
+	s.NoError(os.MkdirAll(filepath.Dir(path), 0755))
+	s.NoError(ioutil.WriteFile(path, []byte(content), 0644))
+}
+
+func (s *CgroupSamplerSuite) TestSampleProcessNotFound() {
+	_, err := s.sampler.Sample(1234)
+	s.Error(err)
+	s.Contains(err.Error(), "cgroup paths")
+}
+
+func (s *CgroupSamplerSuite) TestSampleV1() {
+	s.writeFile(filepath.Join(s.procRoot, "1234", "cgroup"), `12:pids:/garden/handle
+4:cpu,cpuacct:/garden/handle
+3:memory:/garden/handle
+0::/garden/handle
+`)
+
+	memory := filepath.Join(s.cgroupRoot, "memory", "garden", "handle")
+	s.writeFile(filepath.Join(memory, "memory.usage_in_bytes"), "4096\n")
+	s.writeFile(filepath.Join(memory, "memory.max_usage_in_bytes"), "8192\n")
+	s.writeFile(filepath.Join(memory, "memory.stat"), `cache 1024
+rss 2048
+swap 0
+total_cache 1024
+total_rss 2048
+total_inactive_file 512
+`)
+
+	cpu := filepath.Join(s.cgroupRoot, "cpu,cpuacct", "garden", "handle")
+	s.writeFile(filepath.Join(cpu, "cpuacct.usage"), "123456789\n")
+	s.writeFile(filepath.Join(cpu, "cpuacct.stat"), "user 10\nsystem 2\n")
+
+	s.writeFile(filepath.Join(s.cgroupRoot, "pids", "garden", "handle", "pids.current"), "7\n")
+
+	metrics, err := s.sampler.Sample(1234)
+	s.NoError(err)
+
+	s.Equal(garden.ContainerMemoryStat{
+		Cache:                 1024,
+		Rss:                   2048,
+		TotalCache:            1024,
+		TotalRss:              2048,
+		TotalInactiveFile:     512,
+		TotalUsageTowardLimit: 8192,
+	}, metrics.MemoryStat)
+
+	s.Equal(garden.ContainerCPUStat{
+		Usage:  123456789,
+		User:   100000000,
+		System: 20000000,
+	}, metrics.CPUStat)
+
+	s.Equal(uint64(7), metrics.PidStat.Current)
+}
+
+func (s *CgroupSamplerSuite) TestSampleV1MissingMemoryUsage() {
+	s.writeFile(filepath.Join(s.procRoot, "1234", "cgroup"), "3:memory:/garden/handle\n")
+
+	_, err := s.sampler.Sample(1234)
+	s.Error(err)
+	s.Contains(err.Error(), "memory usage")
+}
+
+func (s *CgroupSamplerSuite) TestSampleV2() {
+	s.writeFile(filepath.Join(s.procRoot, "1234", "cgroup"), "0::/garden/handle\n")
+
+	cgroup := filepath.Join(s.cgroupRoot, "garden", "handle")
+	s.writeFile(filepath.Join(cgroup, "memory.current"), "4096\n")
+	s.writeFile(filepath.Join(cgroup, "memory.stat"), `anon 2048
+file 1024
+inactive_file 512
+`)
+	s.writeFile(filepath.Join(cgroup, "cpu.stat"), `usage_usec 1500
+user_usec 1000
+system_usec 500
+`)
+
+	metrics, err := s.sampler.Sample(1234)
+	s.NoError(err)
+
+	s.Equal(garden.ContainerMemoryStat{
+		Cache:                 1024,
+		Rss:                   2048,
+		TotalCache:            1024,
+		TotalRss:              2048,
+		TotalInactiveFile:     512,
+		TotalUsageTowardLimit: 3584,
+	}, metrics.MemoryStat)
+
+	s.Equal(garden.ContainerCPUStat{
+		Usage:  1500000,
+		User:   1000000,
+		System: 500000,
+	}, metrics.CPUStat)
+
+	s.Equal(uint64(0), metrics.PidStat.Current)
+}
+
+func (s *CgroupSamplerSuite) TestSampleV2Peak() {
+	s.writeFile(filepath.Join(s.procRoot, "1234", "cgroup"), "0::/garden/handle\n")
+
+	cgroup := filepath.Join(s.cgroupRoot, "garden", "handle")
+	s.writeFile(filepath.Join(cgroup, "memory.current"), "4096\n")
+	s.writeFile(filepath.Join(cgroup, "memory.peak"), "16384\n")
+	s.writeFile(filepath.Join(cgroup, "memory.stat"), "inactive_file 512\n")
+	s.writeFile(filepath.Join(cgroup, "cpu.stat"), "usage_usec 1500\n")
+
+	metrics, err := s.sampler.Sample(1234)
+	s.NoError(err)
+
+	s.Equal(uint64(16384), metrics.MemoryStat.TotalUsageTowardLimit)
+}
+
+func (s *CgroupSamplerSuite) TestSampleNoCgroup() {
+	s.writeFile(filepath.Join(s.procRoot, "1234", "cgroup"), "4:cpu,cpuacct:/garden/handle\n")
+
+	_, err := s.sampler.Sample(1234)
+	s.EqualError(err, "no cgroup found for pid 1234")
+}
@@ -33,17 +33,20 @@ type Container struct {
 	container     containerd.Container
 	killer        Killer
 	rootfsManager RootfsManager
+	cgroupSampler CgroupSampler
 }
 
 func NewContainer(
 	container containerd.Container,
 	killer Killer,
 	rootfsManager RootfsManager,
+	cgroupSampler CgroupSampler,
 ) *Container {
 	return &Container{
 		container:     container,
 		killer:        killer,
 		rootfsManager: rootfsManager,
+		cgroupSampler: cgroupSampler,
 	}
 }
 
@@ -230,10 +233,30 @@ func (c *Container) Info() (info garden.ContainerInfo, err error) {
 	return
 }
 
-// Metrics - Not Implemented
-func (c *Container) Metrics() (metrics garden.Metrics, err error) {
-	err = ErrNotImplemented
-	return
+// Metrics returns the resource usage of the container's cgroup, sampled at
+// the time of the call, along with the age of the container.
+//
+func (c *Container) Metrics() (garden.Metrics, error) {
+	ctx := context.Background()
+
+	task, err := c.container.Task(ctx, nil)
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("task lookup: %w", err)
+	}
+
+	metrics, err := c.cgroupSampler.Sample(task.Pid())
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("sample cgroup: %w", err)
+	}
+
+	info, err := c.container.Info(ctx)
+	if err != nil {
+		return garden.Metrics{}, fmt.Errorf("container info: %w", err)
+	}
+
+	metrics.Age = time.Since(info.CreatedAt)
+
+	return metrics, nil
 }
 
 // StreamIn - Not Implemented
@@ -2,12 +2,14 @@ package runtime_test
 
 import (
 	"errors"
+	"time"
 
 	"code.cloudfoundry.org/garden"
 	"github.com/concourse/concourse/worker/runtime"
 	"github.com/concourse/concourse/worker/runtime/libcontainerd/libcontainerdfakes"
 	"github.com/concourse/concourse/worker/runtime/runtimefakes"
 	"github.com/containerd/containerd"
+	"github.com/containerd/containerd/containers"
 	"github.com/opencontainers/runtime-spec/specs-go"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
@@ -23,6 +25,7 @@ type ContainerSuite struct {
 	containerdTask      *libcontainerdfakes.FakeTask
 	rootfsManager       *runtimefakes.FakeRootfsManager
 	killer              *runtimefakes.FakeKiller
+	cgroupSampler       *runtimefakes.FakeCgroupSampler
 }
 
 func (s *ContainerSuite) SetupTest() {
@@ -31,11 +34,13 @@ func (s *ContainerSuite) SetupTest() {
 	s.containerdTask = new(libcontainerdfakes.FakeTask)
 	s.rootfsManager = new(runtimefakes.FakeRootfsManager)
 	s.killer = new(runtimefakes.FakeKiller)
+	s.cgroupSampler = new(runtimefakes.FakeCgroupSampler)
 
 	s.container = runtime.NewContainer(
 		s.containerdContainer,
 		s.killer,
 		s.rootfsManager,
+		s.cgroupSampler,
 	)
 }
 
@@ -481,3 +486,51 @@ func (s *ContainerSuite) TestCurrentMemoryLimitsReturnsLimit() {
 	s.NoError(err)
 	s.Equal(garden.MemoryLimits{LimitInBytes: uint64(limitBytes)}, limits)
 }
+
+func (s *ContainerSuite) TestMetricsTaskLookupFails() {
+	expectedErr := errors.New("task-lookup-err")
+	s.containerdContainer.TaskReturns(nil, expectedErr)
+
+	_, err := s.container.Metrics()
+	s.True(errors.Is(err, expectedErr))
+}
+
+func (s *ContainerSuite) TestMetricsSampleFails() {
+	expectedErr := errors.New("sample-err")
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.cgroupSampler.SampleReturns(garden.Metrics{}, expectedErr)
+
+	_, err := s.container.Metrics()
+	s.True(errors.Is(err, expectedErr))
+}
+
+func (s *ContainerSuite) TestMetricsGetInfoFails() {
+	expectedErr := errors.New("info-err")
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdContainer.InfoReturns(containers.Container{}, expectedErr)
+
+	_, err := s.container.Metrics()
+	s.True(errors.Is(err, expectedErr))
+}
+
+func (s *ContainerSuite) TestMetricsSamplesTaskCgroup() {
+	s.containerdTask.PidReturns(1234)
+	s.containerdContainer.TaskReturns(s.containerdTask, nil)
+	s.containerdContainer.InfoReturns(containers.Container{
+		CreatedAt: time.Now().Add(-time.Minute),
+	}, nil)
+	s.cgroupSampler.SampleReturns(garden.Metrics{
+		MemoryStat: garden.ContainerMemoryStat{TotalUsageTowardLimit: 1024},
+		CPUStat:    garden.ContainerCPUStat{Usage: 5000},
+	}, nil)
+
+	metrics, err := s.container.Metrics()
+	s.NoError(err)
+
+	s.Equal(1, s.cgroupSampler.SampleCallCount())
+	s.Equal(uint32(1234), s.cgroupSampler.SampleArgsForCall(0))
+
+	s.Equal(uint64(1024), metrics.MemoryStat.TotalUsageTowardLimit)
+	s.Equal(uint64(5000), metrics.CPUStat.Usage)
+	s.True(metrics.Age >= time.Minute)
+}
@@ -10,6 +10,7 @@ import (
 func TestSuite(t *testing.T) {
 	suite.Run(t, &BackendSuite{Assertions: require.New(t)})
 	suite.Run(t, &CNINetworkSuite{Assertions: require.New(t)})
+	suite.Run(t, &CgroupSamplerSuite{Assertions: require.New(t)})
 	suite.Run(t, &ContainerSuite{Assertions: require.New(t)})
 	suite.Run(t, &FileStoreSuite{Assertions: require.New(t)})
 	suite.Run(t, &KillerSuite{Assertions: require.New(t)})
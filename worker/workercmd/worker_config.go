@@ -1,6 +1,7 @@
 package workercmd
 
 import (
+	"runtime"
 	"time"
 
 	"github.com/concourse/concourse/atc"
@@ -31,5 +32,6 @@ func (c WorkerConfig) Worker() atc.Worker {
 		HTTPSProxyURL: c.HTTPSProxy,
 		NoProxy:       c.NoProxy,
 		Ephemeral:     c.Ephemeral,
+		CPUs:          runtime.NumCPU(),
 	}
 }